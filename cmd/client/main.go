@@ -13,22 +13,35 @@ import (
 	"github.com/Nickolasll/goph-keeper/internal/client/config"
 	cardrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/bank_card_repository"
 	binrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/binary_repository"
+	"github.com/Nickolasll/goph-keeper/internal/client/infrastructure/clipboard"
 	credrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/credentials_repository"
+	folderrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/folder_repository"
 	httpclient "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/http_client"
+	itemrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/item_repository"
+	jrnlrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/journal_repository"
 	jwkrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/jwk_repository"
+	keyagent "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/key_agent"
+	labelsrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/labels_repository"
+	localcrypto "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/local_crypto"
+	otprepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/otp_repository"
+	revrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/revision_repository"
 	sessrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/session_repository"
+	sshrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/ssh_key_repository"
+	tagrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/tag_repository"
+	tmplrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/template_repository"
 	txtrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/text_repository"
 	unitofwork "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/unit_of_work"
+	uploadrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/upload_repository"
+	vaultclient "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/vault_client"
+	vhrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/vault_header_repository"
 	"github.com/Nickolasll/goph-keeper/internal/client/logger"
 	"github.com/Nickolasll/goph-keeper/internal/client/presentation"
-	"github.com/Nickolasll/goph-keeper/internal/crypto"
 )
 
 //go:embed ca.crt
 var caCRT []byte
 
-//go:embed secret.key
-var secret []byte
+const agentState = "agent.state"
 
 var (
 	Version   string
@@ -41,6 +54,24 @@ func main() {
 
 	log := logger.New(root)
 
+	// Агент запускается отдельным процессом и не должен открывать файл базы данных
+	if len(os.Args) > 1 && os.Args[1] == keyagent.Command {
+		if err := keyagent.Main(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+
+		return
+	}
+
+	// Помощник очистки буфера обмена также не работает с базой данных
+	if len(os.Args) > 1 && os.Args[1] == clipboard.Command {
+		if err := clipboard.Main(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+
+		return
+	}
+
 	cfg, err := config.New(root)
 	if err != nil {
 		log.Fatal(err)
@@ -57,17 +88,8 @@ func main() {
 		}
 	}()
 
-	client := httpclient.New(
-		log,
-		caCRT,
-		cfg.ClientTimeout,
-		cfg.ServerURL+cfg.ServerBasePath,
-	)
-
-	cryptoService, err := crypto.New(secret)
-	if err != nil {
-		log.Fatal(err) //nolint: gocritic
-	}
+	keyCache := keyagent.New(filepath.Join(root, agentState), ex)
+	cryptoService := localcrypto.New(keyCache)
 
 	sessionRepository := sessrepo.New(db, cryptoService, log)
 	textRepository := txtrepo.New(db, cryptoService, log)
@@ -75,6 +97,25 @@ func main() {
 	binaryRepository := binrepo.New(db, cryptoService, log)
 	credentialsRepository := credrepo.New(db, cryptoService, log)
 	bankCardRepository := cardrepo.New(db, cryptoService, log)
+	otpRepository := otprepo.New(db, cryptoService, log)
+	sshKeyRepository := sshrepo.New(db, cryptoService, log)
+	itemRepository := itemrepo.New(db, cryptoService, log)
+	folderRepository := folderrepo.New(db, cryptoService, log)
+	tagRepository := tagrepo.New(db, cryptoService, log)
+	templateRepository := tmplrepo.New(db, cryptoService, log)
+	labelsRepository := labelsrepo.New(db, cryptoService, log)
+	journalRepository := jrnlrepo.New(db, cryptoService, log)
+	uploadRepository := uploadrepo.New(db, cryptoService, log)
+	revisionRepository := revrepo.New(db, log)
+	vaultHeaderRepository := vhrepo.New(db, log)
+
+	client := vaultclient.New(httpclient.New(
+		log,
+		caCRT,
+		cfg.ClientTimeout,
+		cfg.ServerURL+cfg.ServerBasePath,
+		sessionRepository,
+	))
 
 	unitOfWork := unitofwork.New(
 		db,
@@ -83,6 +124,14 @@ func main() {
 		*binaryRepository,
 		*credentialsRepository,
 		*bankCardRepository,
+		*otpRepository,
+		*sshKeyRepository,
+		*itemRepository,
+		*folderRepository,
+		*tagRepository,
+		*templateRepository,
+		*labelsRepository,
+		*revisionRepository,
 	)
 
 	app := application.New(
@@ -94,11 +143,23 @@ func main() {
 		binaryRepository,
 		credentialsRepository,
 		bankCardRepository,
+		otpRepository,
+		sshKeyRepository,
+		itemRepository,
+		folderRepository,
+		tagRepository,
+		templateRepository,
+		labelsRepository,
+		journalRepository,
+		uploadRepository,
 		unitOfWork,
+		vaultHeaderRepository,
+		keyCache,
+		clipboard.New(ex, os.Stderr),
 	)
 
-	cmd := presentation.New(Version, BuildDate, app, log, sessionRepository)
+	cmd := presentation.New(Version, BuildDate, app, log, sessionRepository, cfg.UnlockTimeout, cfg.ClipboardTimeout)
 	if err := cmd.Run(context.Background(), os.Args); err != nil {
-		log.Fatal(err)
+		log.Fatal(err) //nolint: gocritic
 	}
 }
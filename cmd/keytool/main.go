@@ -0,0 +1,41 @@
+// Package main - ротация мастер-ключа шифрования данных сервера GophKeeper.
+// Перешифровывает данные, зашифрованные ключом CRYPTO_PREVIOUS_SECRET, ключом CRYPTO_SECRET.
+// Во время ротации сервер должен быть запущен с обоими ключами
+package main
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
+	"github.com/Nickolasll/goph-keeper/internal/server/config"
+	keyrotation "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/key_rotation"
+	"github.com/Nickolasll/goph-keeper/internal/server/logger"
+)
+
+func main() {
+	log := logger.New()
+	ctx := context.Background()
+
+	cfg, err := config.New(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	keyRing, err := crypto.NewKeyRing(cfg.CryptoSecret, cfg.PreviousCryptoSecrets()...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	pool, err := pgxpool.New(ctx, cfg.PostgresURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer pool.Close()
+
+	rotator := keyrotation.New(pool, keyRing, cfg.RotationBatchSize, cfg.DBTimeOut, log)
+	if err = rotator.Run(); err != nil {
+		log.Fatal(err) //nolint: gocritic
+	}
+}
@@ -5,17 +5,34 @@ import (
 	"context"
 	"crypto/tls"
 	"net/http"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
 
 	"github.com/Nickolasll/goph-keeper/internal/crypto"
 	"github.com/Nickolasll/goph-keeper/internal/server/application"
 	"github.com/Nickolasll/goph-keeper/internal/server/application/jose"
+	usecases "github.com/Nickolasll/goph-keeper/internal/server/application/use_cases"
 	"github.com/Nickolasll/goph-keeper/internal/server/config"
 	bcardrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/bank_card_repository"
 	binrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/binary_repository"
+	binuprepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/binary_upload_repository"
+	changesrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/changes_repository"
 	crederepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/credentials_repository"
+	folderrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/folder_repository"
+	itemrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/item_repository"
+	keyrotation "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/key_rotation"
+	labelrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/label_repository"
+	otprepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/otp_repository"
+	rtrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/refresh_token_repository"
+	sshrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/ssh_key_repository"
+	tagrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/tag_repository"
+	tmplrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/template_repository"
 	txtrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/text_repository"
+	tmbrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/tombstone_repository"
+	tfarepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/two_factor_repository"
+	ukeyrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/user_key_repository"
 	usrrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/user_repository"
 	"github.com/Nickolasll/goph-keeper/internal/server/logger"
 	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
@@ -50,6 +67,27 @@ import (
 // @Tag.name BankCard
 // @Tag.description Группа запросов для работы с банковскими картами
 
+// @Tag.name OTP
+// @Tag.description Группа запросов для работы с секретами одноразовых паролей
+
+// @Tag.name SSHKey
+// @Tag.description Группа запросов для работы с SSH ключами
+
+// @Tag.name Item
+// @Tag.description Группа запросов для работы с произвольными данными по шаблону
+
+// @Tag.name Folder
+// @Tag.description Группа запросов для работы с папками
+
+// @Tag.name Tag
+// @Tag.description Группа запросов для работы с метками
+
+// @Tag.name Template
+// @Tag.description Группа запросов для работы с пользовательскими шаблонами данных
+
+// @Tag.name Labels
+// @Tag.description Группа запросов для назначения папки и меток данным
+
 // @Tag.name All
 // @Tag.description Группа запросов для работы со всеми данными пользователя
 
@@ -62,12 +100,12 @@ func main() {
 		log.Fatal(err)
 	}
 
-	joseService, err := jose.New(cfg.RawJWK, cfg.JWTExpiration, log)
+	joseService, err := jose.New(cfg.RawJWK, cfg.SaltSecret, cfg.JWTExpiration, cfg.RefreshTokenExpiration, log)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	cryptoService, err := crypto.New(cfg.CryptoSecret)
+	cryptoService, err := crypto.NewKeyRing(cfg.CryptoSecret, cfg.PreviousCryptoSecrets()...)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -78,23 +116,72 @@ func main() {
 	}
 	defer pool.Close()
 
+	// Пользователи, зарегистрированные до появления персональных ключей, получают ключи до приема запросов
+	rotator := keyrotation.New(pool, cryptoService, cfg.RotationBatchSize, cfg.DBTimeOut, log)
+	if err = rotator.Backfill(); err != nil {
+		log.Fatal(err) //nolint: gocritic
+	}
+
 	userRepository := usrrepo.New(pool, cfg.DBTimeOut, log)
+	userKeyRepository := ukeyrepo.New(pool, cfg.DBTimeOut, log)
+	refreshTokenRepository := rtrepo.New(pool, cfg.DBTimeOut, log)
+	twoFactorRepository := tfarepo.New(pool, cfg.DBTimeOut, log)
 	textRepository := txtrepo.New(pool, cfg.DBTimeOut, log)
 	binaryRepository := binrepo.New(pool, cfg.DBTimeOut, log)
+	binaryUploadRepository := binuprepo.New(pool, cfg.DBTimeOut, cfg.UploadExpiration, log)
 	credentialsRepository := crederepo.New(pool, cfg.DBTimeOut, log)
 	cardRepository := bcardrepo.New(pool, cfg.DBTimeOut, log)
+	otpRepository := otprepo.New(pool, cfg.DBTimeOut, log)
+	sshKeyRepository := sshrepo.New(pool, cfg.DBTimeOut, log)
+	itemRepository := itemrepo.New(pool, cfg.DBTimeOut, log)
+	folderRepository := folderrepo.New(pool, cfg.DBTimeOut, log)
+	tagRepository := tagrepo.New(pool, cfg.DBTimeOut, log)
+	templateRepository := tmplrepo.New(pool, cfg.DBTimeOut, log)
+	labelRepository := labelrepo.New(pool, cfg.DBTimeOut, log)
+	tombstoneRepository := tmbrepo.New(pool, cfg.DBTimeOut, log)
+	changesRepository := changesrepo.New(
+		pool,
+		cfg.DBTimeOut,
+		log,
+		textRepository,
+		binaryRepository,
+		credentialsRepository,
+		cardRepository,
+		otpRepository,
+		sshKeyRepository,
+		itemRepository,
+		folderRepository,
+		tagRepository,
+		templateRepository,
+		labelRepository,
+		tombstoneRepository,
+	)
 
 	app := application.New(
 		log,
 		joseService,
 		cryptoService,
 		userRepository,
+		userKeyRepository,
+		refreshTokenRepository,
+		twoFactorRepository,
 		textRepository,
 		binaryRepository,
+		binaryUploadRepository,
 		credentialsRepository,
 		cardRepository,
+		otpRepository,
+		sshKeyRepository,
+		itemRepository,
+		folderRepository,
+		tagRepository,
+		templateRepository,
+		labelRepository,
+		changesRepository,
 	)
 
+	go deleteExpiredUploads(app.DeleteExpiredUploads, cfg.UploadCleanupInterval, log)
+
 	router := presentation.New(app, joseService, log)
 
 	cert, err := tls.LoadX509KeyPair(cfg.X509CertPath, cfg.TLSKeyPath)
@@ -122,3 +209,14 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// deleteExpiredUploads - Периодически удаляет брошенные сессии загрузки бинарных данных вместе с их частями
+func deleteExpiredUploads(useCase usecases.DeleteExpiredUploads, interval time.Duration, log *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := useCase.Do(); err != nil {
+			log.Error(err)
+		}
+	}
+}
@@ -22,11 +22,26 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
+                "description": "Бинарные данные возвращаются без содержимого, только с метаданными",
                 "tags": [
                     "All"
                 ],
                 "summary": "Получить все расшифрованные данные пользователя",
                 "operationId": "all",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 100, не больше 1000",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -34,8 +49,199 @@ const docTemplate = `{
                             "$ref": "#/definitions/presentation.GetAllResponse"
                         }
                     },
+                    "400": {
+                        "description": "Невалидные параметры страницы"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/auth/2fa/disable": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Отключение двухфакторной аутентификации",
+                "operationId": "auth-2fa-disable",
+                "parameters": [
+                    {
+                        "description": "Код TOTP или код восстановления",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.twoFactorCodePayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "403": {
+                        "description": "Неправильный код"
+                    },
+                    "404": {
+                        "description": "Двухфакторная аутентификация не включена"
+                    }
+                }
+            }
+        },
+        "/auth/2fa/enroll": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает секрет TOTP и otpauth URI для QR-кода, вход требует код только после подтверждения",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Подключение двухфакторной аутентификации",
+                "operationId": "auth-2fa-enroll",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.TwoFactorEnrollResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "409": {
+                        "description": "Двухфакторная аутентификация уже включена"
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/2fa/login": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Завершение входа кодом второго фактора",
+                "operationId": "auth-2fa-login",
+                "parameters": [
+                    {
+                        "description": "Токен частичной аутентификации и код TOTP или код восстановления",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.twoFactorLoginPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "headers": {
+                            "Authorization eyJhbGciOiJI...qIScZUU8P0Zhck": {
+                                "type": "string",
+                                "description": "JWT"
+                            },
+                            "X-Refresh-Token 3q2-7wAAAAAAAAAA...xXv4": {
+                                "type": "string",
+                                "description": "Refresh token"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "401": {
+                        "description": "Неправильный код, токен частичной аутентификации истек или по нему исчерпаны попытки"
+                    }
+                }
+            }
+        },
+        "/auth/2fa/verify": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Проверяет код из приложения-аутентификатора и возвращает одноразовые коды восстановления",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Подтверждение подключения двухфакторной аутентификации",
+                "operationId": "auth-2fa-verify",
+                "parameters": [
+                    {
+                        "description": "Код TOTP",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.twoFactorCodePayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.TwoFactorVerifyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
                     "401": {
                         "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "403": {
+                        "description": "Неправильный код"
+                    },
+                    "404": {
+                        "description": "Подключение не начато"
+                    },
+                    "409": {
+                        "description": "Двухфакторная аутентификация уже включена"
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ErrorResponse"
+                        }
                     }
                 }
             }
@@ -105,6 +311,19 @@ const docTemplate = `{
                             "Authorization eyJhbGciOiJI...qIScZUU8P0Zhck": {
                                 "type": "string",
                                 "description": "JWT"
+                            },
+                            "X-Refresh-Token 3q2-7wAAAAAAAAAA...xXv4": {
+                                "type": "string",
+                                "description": "Refresh token"
+                            }
+                        }
+                    },
+                    "202": {
+                        "description": "Требуется код второго фактора",
+                        "headers": {
+                            "X-Two-Factor-Challenge eyJhbGciOiJI...qIScZUU8P0Zhck": {
+                                "type": "string",
+                                "description": "Токен частичной аутентификации"
                             }
                         }
                     },
@@ -117,6 +336,93 @@ const docTemplate = `{
                 }
             }
         },
+        "/auth/prelogin": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Параметры вывода ключа хранилища из мастер-пароля",
+                "operationId": "auth-prelogin",
+                "parameters": [
+                    {
+                        "description": "Логин",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.preLoginPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.PreLoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Выпуск новой пары токенов по refresh token",
+                "operationId": "auth-refresh",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.refreshPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "headers": {
+                            "Authorization eyJhbGciOiJI...qIScZUU8P0Zhck": {
+                                "type": "string",
+                                "description": "JWT"
+                            },
+                            "X-Refresh-Token 3q2-7wAAAAAAAAAA...xXv4": {
+                                "type": "string",
+                                "description": "Refresh token"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "401": {
+                        "description": "Refresh token недействителен, истек или был использован повторно"
+                    }
+                }
+            }
+        },
         "/auth/register": {
             "post": {
                 "consumes": [
@@ -129,7 +435,7 @@ const docTemplate = `{
                 "operationId": "auth-register",
                 "parameters": [
                     {
-                        "description": "Логин и Пароль",
+                        "description": "Логин, Пароль и признак сквозного шифрования",
                         "name": "payload",
                         "in": "body",
                         "required": true,
@@ -145,6 +451,10 @@ const docTemplate = `{
                             "Authorization eyJhbGciOiJI...qIScZUU8P0Zhck": {
                                 "type": "string",
                                 "description": "JWT"
+                            },
+                            "X-Refresh-Token 3q2-7wAAAAAAAAAA...xXv4": {
+                                "type": "string",
+                                "description": "Refresh token"
                             }
                         }
                     },
@@ -169,6 +479,20 @@ const docTemplate = `{
                 ],
                 "summary": "Получить все расшифрованные банковские карты",
                 "operationId": "bank-card-all",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 100, не больше 1000",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -176,6 +500,9 @@ const docTemplate = `{
                             "$ref": "#/definitions/presentation.GetAllBankCardsResponse"
                         }
                     },
+                    "400": {
+                        "description": "Невалидные параметры страницы"
+                    },
                     "401": {
                         "description": "Нет токена авторизации или токен невалиден"
                     }
@@ -212,6 +539,10 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
                             "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
                                 "type": "string",
                                 "description": "UUID ресурса"
@@ -228,20 +559,17 @@ const docTemplate = `{
             }
         },
         "/bank_card/{bank_card_id}": {
-            "post": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "tags": [
                     "BankCard"
                 ],
-                "summary": "Обновить и зашифровать существующую банковскую карту",
-                "operationId": "bank-card-update",
+                "summary": "Получить расшифрованную банковскую карту по идентификатору",
+                "operationId": "bank-card-get",
                 "parameters": [
                     {
                         "type": "string",
@@ -249,20 +577,79 @@ const docTemplate = `{
                         "name": "bank_card_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Номер, срок действия, cvv код, ФИО держателя карты",
-                        "name": "data",
-                        "in": "body",
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetBankCardResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Текущая версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BankCard"
+                ],
+                "summary": "Обновить и зашифровать существующую банковскую карту",
+                "operationId": "bank-card-update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bank Card ID",
+                        "name": "bank_card_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Номер, срок действия, cvv код, ФИО держателя карты",
+                        "name": "data",
+                        "in": "body",
                         "required": true,
                         "schema": {
                             "$ref": "#/definitions/presentation.bankCardPayload"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса, при несовпадении вернется 409",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия ресурса"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Некорректный формат данных или идентификатора"
@@ -270,6 +657,47 @@ const docTemplate = `{
                     "401": {
                         "description": "Нет токена авторизации или токен невалиден"
                     },
+                    "404": {
+                        "description": "Не найдено"
+                    },
+                    "409": {
+                        "description": "Версия ресурса изменилась, в ответе актуальная копия",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateBankCardConflictResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "BankCard"
+                ],
+                "summary": "Удалить существующую банковскую карту",
+                "operationId": "bank-card-delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bank Card ID",
+                        "name": "bank_card_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
                     "404": {
                         "description": "Не найдено"
                     }
@@ -283,11 +711,26 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
+                "description": "Содержимое не возвращается, его можно скачать отдельным запросом",
                 "tags": [
                     "Binary"
                 ],
-                "summary": "Получить все расшифрованные бинарные данные",
+                "summary": "Получить расшифрованные метаданные всех бинарных данных",
                 "operationId": "binary-all",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 100, не больше 1000",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -295,6 +738,9 @@ const docTemplate = `{
                             "$ref": "#/definitions/presentation.GetAllBinariesResponse"
                         }
                     },
+                    "400": {
+                        "description": "Невалидные параметры страницы"
+                    },
                     "401": {
                         "description": "Нет токена авторизации или токен невалиден"
                     }
@@ -328,12 +774,22 @@ const docTemplate = `{
                                 "type": "integer"
                             }
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Метаданные в формате JSON (name, mime_type, sha256, meta), закодированные в base64",
+                        "name": "X-Binary-Metadata",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
                             "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
                                 "type": "string",
                                 "description": "UUID ресурса"
@@ -349,59 +805,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/binary/{binary_id}": {
+        "/binary/upload": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "description": "Части загружаются в любом порядке, прерванную загрузку можно продолжить, запросив номера уже полученных частей",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "tags": [
                     "Binary"
                 ],
-                "summary": "Обновить и зашифровать существующие бинарные данные",
-                "operationId": "binary-update",
+                "summary": "Начать загрузку бинарных данных частями",
+                "operationId": "binary-upload-start",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Binary ID",
-                        "name": "binary_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Содержимое файла",
-                        "name": "data",
+                        "description": "Полный размер данных и размер части в байтах, не больше 16 МиБ",
+                        "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "integer"
-                            }
+                            "$ref": "#/definitions/presentation.binaryUploadPayload"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "201": {
+                        "description": "Created",
+                        "headers": {
+                            "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
+                                "type": "string",
+                                "description": "UUID сессии загрузки"
+                            }
+                        }
                     },
                     "400": {
-                        "description": "Некорректный формат данных или идентификатора"
+                        "description": "Некорректный формат данных или размер части"
                     },
                     "401": {
                         "description": "Нет токена авторизации или токен невалиден"
-                    },
-                    "404": {
-                        "description": "Не найдено"
                     }
                 }
             }
         },
-        "/credentials/all": {
+        "/binary/upload/{upload_id}": {
             "get": {
                 "security": [
                     {
@@ -409,53 +859,79 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "Credentials"
+                    "Binary"
+                ],
+                "summary": "Получить состояние загрузки бинарных данных частями",
+                "operationId": "binary-upload-get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "upload_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Получить все расшифрованные логины и пароли",
-                "operationId": "credentials-all",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presentation.GetAllCredentialsResponse"
+                            "$ref": "#/definitions/presentation.BinaryUploadResponse"
                         }
                     },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
                     "401": {
                         "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/credentials/create": {
+        "/binary/upload/{upload_id}/commit": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Credentials"
+                    "Binary"
                 ],
-                "summary": "Создать и зашифровать логин и пароль",
-                "operationId": "credentials-create",
+                "summary": "Завершить загрузку бинарных данных частями",
+                "operationId": "binary-upload-commit",
                 "parameters": [
                     {
-                        "description": "Наименование, логин и пароль",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/presentation.credentialsPayload"
-                        }
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "upload_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Метаданные в формате JSON (name, mime_type, sha256, meta), закодированные в base64",
+                        "name": "X-Binary-Metadata",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
                             "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
                                 "type": "string",
                                 "description": "UUID ресурса"
@@ -463,53 +939,67 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Некорректный формат данных"
+                        "description": "Некорректный формат идентификатора, метаданных или загружены не все части"
                     },
                     "401": {
                         "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
                     }
                 }
             }
         },
-        "/credentials/{credentials_id}": {
-            "post": {
+        "/binary/upload/{upload_id}/{index}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "description": "Размер части должен совпадать с размером из сессии, последняя часть содержит остаток.\nПовторная загрузка части с тем же номером перезаписывает ее",
                 "consumes": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "Credentials"
+                    "Binary"
                 ],
-                "summary": "Обновить и зашифровать существующий логин и пароль",
-                "operationId": "credentials-update",
+                "summary": "Загрузить одну часть бинарных данных",
+                "operationId": "binary-upload-chunk",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Credentials ID",
-                        "name": "credentials_id",
+                        "description": "Upload ID",
+                        "name": "upload_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Наименование, логин и пароль",
+                        "type": "integer",
+                        "description": "Номер части, начиная с 0",
+                        "name": "index",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Содержимое части",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/presentation.credentialsPayload"
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
-                        "description": "Некорректный формат данных или идентификатора"
+                        "description": "Некорректный формат данных, номер или размер части"
                     },
                     "401": {
                         "description": "Нет токена авторизации или токен невалиден"
@@ -520,49 +1010,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
-            "get": {
-                "tags": [
-                    "Status"
-                ],
-                "summary": "Запрос состояния сервиса",
-                "operationId": "health",
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
-                    }
-                }
-            }
-        },
-        "/text/all": {
+        "/binary/{binary_id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "description": "Содержимое не возвращается, его можно скачать запросом /binary/{binary_id}/content",
                 "tags": [
-                    "Text"
+                    "Binary"
+                ],
+                "summary": "Получить расшифрованные метаданные бинарных данных по идентификатору",
+                "operationId": "binary-get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Binary ID",
+                        "name": "binary_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Получить все расшифрованные текстовые данные",
-                "operationId": "text-all",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presentation.GetAllTextsResponse"
+                            "$ref": "#/definitions/presentation.GetBinaryResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Текущая версия ресурса"
+                            }
                         }
                     },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
                     "401": {
                         "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
                     }
                 }
-            }
-        },
-        "/text/create": {
+            },
             "post": {
                 "security": [
                     {
@@ -570,340 +1063,3154 @@ const docTemplate = `{
                     }
                 ],
                 "consumes": [
-                    "text/plain"
+                    "multipart/form-data"
                 ],
                 "tags": [
-                    "Text"
+                    "Binary"
                 ],
-                "summary": "Создать и зашифровать текстовые данные",
-                "operationId": "text-create",
+                "summary": "Обновить и зашифровать существующие бинарные данные",
+                "operationId": "binary-update",
                 "parameters": [
                     {
-                        "description": "Текст для сохранения",
+                        "type": "string",
+                        "description": "Binary ID",
+                        "name": "binary_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Содержимое файла",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Метаданные в формате JSON (name, mime_type, sha256, meta), закодированные в base64",
+                        "name": "X-Binary-Metadata",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса, при несовпадении вернется 409",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "headers": {
-                            "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
+                            "ETag": {
                                 "type": "string",
-                                "description": "UUID ресурса"
+                                "description": "Новая версия ресурса"
                             }
                         }
                     },
                     "400": {
-                        "description": "Некорректный формат данных"
+                        "description": "Некорректный формат данных или идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    },
+                    "409": {
+                        "description": "Версия ресурса изменилась, в ответе актуальная копия",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateBinaryConflictResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Binary"
+                ],
+                "summary": "Удалить существующие бинарные данные",
+                "operationId": "binary-delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Binary ID",
+                        "name": "binary_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
                     },
                     "401": {
                         "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
                     }
                 }
             }
         },
-        "/text/{text_id}": {
-            "post": {
+        "/binary/{binary_id}/content": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "consumes": [
-                    "text/plain"
+                "description": "Данные расшифровываются и отправляются по частям, не загружаясь в память целиком",
+                "produces": [
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "Text"
+                    "Binary"
                 ],
-                "summary": "Обновить и зашифровать существующие текстовые данные",
-                "operationId": "text-update",
+                "summary": "Скачать расшифрованное содержимое бинарных данных",
+                "operationId": "binary-content",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Text ID",
-                        "name": "text_id",
+                        "description": "Binary ID",
+                        "name": "binary_id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Содержимое бинарных данных",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            }
+        },
+        "/changes": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает созданные или обновленные данные и идентификаторы удаленных данных,\nа также ревизию, которую нужно передать в следующем запросе.\nВозвращается не больше limit изменений с наименьшими ревизиями, признак more означает,\nчто после возвращенной ревизии есть еще изменения.\nБинарные данные возвращаются без содержимого, только с метаданными",
+                "tags": [
+                    "All"
+                ],
+                "summary": "Получить расшифрованные изменения данных пользователя после указанной ревизии",
+                "operationId": "changes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Ревизия, после которой нужно вернуть изменения, по умолчанию 0",
+                        "name": "since",
+                        "in": "query"
                     },
                     {
-                        "description": "Текст для сохранения",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 100, не больше 1000",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/presentation.GetChangesResponse"
                         }
+                    },
+                    "400": {
+                        "description": "Невалидная ревизия или размер страницы"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/credentials/all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Credentials"
+                ],
+                "summary": "Получить все расшифрованные логины и пароли",
+                "operationId": "credentials-all",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 100, не больше 1000",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetAllCredentialsResponse"
+                        }
                     },
                     "400": {
-                        "description": "Некорректный формат данных или идентификатора"
+                        "description": "Невалидные параметры страницы"
                     },
                     "401": {
                         "description": "Нет токена авторизации или токен невалиден"
-                    },
-                    "404": {
-                        "description": "Не найдено"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "presentation.GetAllBankCardsResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "type": "object",
-                    "properties": {
-                        "bank_cards": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/presentation.bankCardResponse"
+        },
+        "/credentials/create": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Credentials"
+                ],
+                "summary": "Создать и зашифровать логин и пароль",
+                "operationId": "credentials-create",
+                "parameters": [
+                    {
+                        "description": "Наименование, логин и пароль",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.credentialsPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
+                            "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
+                                "type": "string",
+                                "description": "UUID ресурса"
                             }
                         }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
                     }
-                },
-                "message": {
-                    "type": "string"
-                },
-                "status": {
-                    "type": "boolean"
                 }
             }
         },
-        "presentation.GetAllBinariesResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "type": "object",
-                    "properties": {
-                        "binaries": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/presentation.binaryResponse"
+        "/credentials/{credentials_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Credentials"
+                ],
+                "summary": "Получить расшифрованные логин и пароль по идентификатору",
+                "operationId": "credentials-get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Credentials ID",
+                        "name": "credentials_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetCredentialsResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Текущая версия ресурса"
                             }
                         }
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Credentials"
+                ],
+                "summary": "Обновить и зашифровать существующий логин и пароль",
+                "operationId": "credentials-update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Credentials ID",
+                        "name": "credentials_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Наименование, логин и пароль",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.credentialsPayload"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса, при несовпадении вернется 409",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных или идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    },
+                    "409": {
+                        "description": "Версия ресурса изменилась, в ответе актуальная копия",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateCredentialsConflictResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Credentials"
+                ],
+                "summary": "Удалить существующий логин и пароль",
+                "operationId": "credentials-delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Credentials ID",
+                        "name": "credentials_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            }
+        },
+        "/folder/all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Folder"
+                ],
+                "summary": "Получить все папки с расшифрованными именами",
+                "operationId": "folder-all",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetAllFoldersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/folder/create": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Folder"
+                ],
+                "summary": "Создать папку с зашифрованным именем",
+                "operationId": "folder-create",
+                "parameters": [
+                    {
+                        "description": "Имя и идентификатор родительской папки, нулевой UUID означает корень",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.folderPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "headers": {
+                            "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
+                                "type": "string",
+                                "description": "UUID ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Родительская папка не найдена"
+                    }
+                }
+            }
+        },
+        "/folder/{folder_id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Folder"
+                ],
+                "summary": "Переименовать или переместить существующую папку",
+                "operationId": "folder-update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Folder ID",
+                        "name": "folder_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Имя и идентификатор родительской папки, нулевой UUID означает корень",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.folderPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных или папка перемещается в саму себя"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Вложенные папки и данные из удаленной папки переносятся в ее родительскую папку",
+                "tags": [
+                    "Folder"
+                ],
+                "summary": "Удалить существующую папку",
+                "operationId": "folder-delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Folder ID",
+                        "name": "folder_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "tags": [
+                    "Status"
+                ],
+                "summary": "Запрос состояния сервиса",
+                "operationId": "health",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/item/all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Получить все расшифрованные данные по шаблону",
+                "operationId": "item-all",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 100, не больше 1000",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetAllItemsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Невалидные параметры страницы"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/item/create": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Создать и зашифровать данные по шаблону",
+                "operationId": "item-create",
+                "parameters": [
+                    {
+                        "description": "Название, шаблон, поля с типами и значениями",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.itemPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
+                            "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
+                                "type": "string",
+                                "description": "UUID ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/item/{item_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Получить расшифрованные данные по шаблону по идентификатору",
+                "operationId": "item-get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetItemResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Текущая версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Обновить и зашифровать существующие данные по шаблону",
+                "operationId": "item-update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Название, шаблон, поля с типами и значениями",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.itemPayload"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса, при несовпадении вернется 409",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных или идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    },
+                    "409": {
+                        "description": "Версия ресурса изменилась, в ответе актуальная копия",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateItemConflictResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Item"
+                ],
+                "summary": "Удалить существующие данные по шаблону",
+                "operationId": "item-delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            }
+        },
+        "/labels/{entity_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Получить папку и метки данных",
+                "operationId": "labels-get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetLabelsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Папка и метки данным не назначались"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Папка и метки заменяются целиком, нулевой UUID папки означает корень",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Назначить папку и метки данным",
+                "operationId": "labels-save",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Вид данных, идентификаторы папки и меток",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.labelsPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных или идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Данные, папка или метка не найдены"
+                    }
+                }
+            }
+        },
+        "/otp/all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "OTP"
+                ],
+                "summary": "Получить все расшифрованные секреты одноразовых паролей",
+                "operationId": "otp-all",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 100, не больше 1000",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetAllOTPsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Невалидные параметры страницы"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/otp/create": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OTP"
+                ],
+                "summary": "Создать и зашифровать секрет одноразовых паролей",
+                "operationId": "otp-create",
+                "parameters": [
+                    {
+                        "description": "Сервис, учетная запись, секрет base32, алгоритм, количество цифр и период в секундах",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.otpPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
+                            "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
+                                "type": "string",
+                                "description": "UUID ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/otp/{otp_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "OTP"
+                ],
+                "summary": "Получить расшифрованный секрет одноразовых паролей по идентификатору",
+                "operationId": "otp-get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OTP ID",
+                        "name": "otp_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetOTPResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Текущая версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OTP"
+                ],
+                "summary": "Обновить и зашифровать существующий секрет одноразовых паролей",
+                "operationId": "otp-update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OTP ID",
+                        "name": "otp_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Сервис, учетная запись, секрет base32, алгоритм, количество цифр и период в секундах",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.otpPayload"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса, при несовпадении вернется 409",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных или идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    },
+                    "409": {
+                        "description": "Версия ресурса изменилась, в ответе актуальная копия",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateOTPConflictResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "OTP"
+                ],
+                "summary": "Удалить существующий секрет одноразовых паролей",
+                "operationId": "otp-delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OTP ID",
+                        "name": "otp_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            }
+        },
+        "/ssh_key/all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "SSHKey"
+                ],
+                "summary": "Получить все расшифрованные SSH ключи",
+                "operationId": "ssh-key-all",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 100, не больше 1000",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetAllSSHKeysResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Невалидные параметры страницы"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/ssh_key/create": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SSHKey"
+                ],
+                "summary": "Создать и зашифровать SSH ключ",
+                "operationId": "ssh-key-create",
+                "parameters": [
+                    {
+                        "description": "Закрытый ключ PEM, открытый ключ, комментарий и отпечаток",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.sshKeyPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
+                            "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
+                                "type": "string",
+                                "description": "UUID ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/ssh_key/{ssh_key_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "SSHKey"
+                ],
+                "summary": "Получить расшифрованный SSH ключ по идентификатору",
+                "operationId": "ssh-key-get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSH key ID",
+                        "name": "ssh_key_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetSSHKeyResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Текущая версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SSHKey"
+                ],
+                "summary": "Обновить и зашифровать существующий SSH ключ",
+                "operationId": "ssh-key-update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSH key ID",
+                        "name": "ssh_key_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Закрытый ключ PEM, открытый ключ, комментарий и отпечаток",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.sshKeyPayload"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса, при несовпадении вернется 409",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных или идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    },
+                    "409": {
+                        "description": "Версия ресурса изменилась, в ответе актуальная копия",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateSSHKeyConflictResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "SSHKey"
+                ],
+                "summary": "Удалить существующий SSH ключ",
+                "operationId": "ssh-key-delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSH key ID",
+                        "name": "ssh_key_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            }
+        },
+        "/tag/all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Получить все метки с расшифрованными именами",
+                "operationId": "tag-all",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetAllTagsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/tag/create": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Создать метку с зашифрованным именем",
+                "operationId": "tag-create",
+                "parameters": [
+                    {
+                        "description": "Имя метки",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.tagPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "headers": {
+                            "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
+                                "type": "string",
+                                "description": "UUID ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/tag/{tag_id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Переименовать существующую метку",
+                "operationId": "tag-update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "tag_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Имя метки",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.tagPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных или идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Метка снимается со всех данных",
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Удалить существующую метку",
+                "operationId": "tag-delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "tag_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            }
+        },
+        "/template/all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Template"
+                ],
+                "summary": "Получить все расшифрованные пользовательские шаблоны",
+                "operationId": "template-all",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetAllTemplatesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/template/create": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Если у пользователя включено сквозное шифрование, проверяется только наличие имени",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Template"
+                ],
+                "summary": "Создать зашифрованный пользовательский шаблон",
+                "operationId": "template-create",
+                "parameters": [
+                    {
+                        "description": "Имя, описание и поля шаблона",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.templatePayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "headers": {
+                            "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
+                                "type": "string",
+                                "description": "UUID ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/template/{template_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Данные, созданные по шаблону, не изменяются",
+                "tags": [
+                    "Template"
+                ],
+                "summary": "Удалить существующий пользовательский шаблон",
+                "operationId": "template-delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "template_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            }
+        },
+        "/text/all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Text"
+                ],
+                "summary": "Получить все расшифрованные текстовые данные",
+                "operationId": "text-all",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 100, не больше 1000",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetAllTextsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Невалидные параметры страницы"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/text/create": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Text"
+                ],
+                "summary": "Создать и зашифровать текстовые данные",
+                "operationId": "text-create",
+                "parameters": [
+                    {
+                        "description": "Текст для сохранения",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
+                            "Location 020cb30c-c495-4a18-ac09-fd68c6f7c941": {
+                                "type": "string",
+                                "description": "UUID ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    }
+                }
+            }
+        },
+        "/text/{text_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Text"
+                ],
+                "summary": "Получить расшифрованные текстовые данные по идентификатору",
+                "operationId": "text-get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Text ID",
+                        "name": "text_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.GetTextResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Текущая версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Text"
+                ],
+                "summary": "Обновить и зашифровать существующие текстовые данные",
+                "operationId": "text-update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Text ID",
+                        "name": "text_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Текст для сохранения",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса, при несовпадении вернется 409",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный формат данных или идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    },
+                    "409": {
+                        "description": "Версия ресурса изменилась, в ответе актуальная копия",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateTextConflictResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Text"
+                ],
+                "summary": "Удалить существующие текстовые данные",
+                "operationId": "text-delete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Text ID",
+                        "name": "text_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный формат идентификатора"
+                    },
+                    "401": {
+                        "description": "Нет токена авторизации или токен невалиден"
+                    },
+                    "404": {
+                        "description": "Не найдено"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "item.Field": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "item.FieldSpec": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.BinaryUploadResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "chunk_size": {
+                            "type": "integer"
+                        },
+                        "received": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        },
+                        "size": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetAllBankCardsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "bank_cards": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.bankCardResponse"
+                            }
+                        },
+                        "next_cursor": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetAllBinariesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "binaries": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.binaryResponse"
+                            }
+                        },
+                        "next_cursor": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetAllCredentialsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "credentials": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.credentialsResponse"
+                            }
+                        },
+                        "next_cursor": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetAllFoldersResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "folders": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.folderResponse"
+                            }
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetAllItemsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "items": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.itemResponse"
+                            }
+                        },
+                        "next_cursor": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetAllOTPsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "otps": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.otpResponse"
+                            }
+                        },
+                        "next_cursor": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetAllResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "bank_cards": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.bankCardResponse"
+                            }
+                        },
+                        "binaries": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.binaryResponse"
+                            }
+                        },
+                        "credentials": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.credentialsResponse"
+                            }
+                        },
+                        "items": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.itemResponse"
+                            }
+                        },
+                        "next_cursor": {
+                            "type": "string"
+                        },
+                        "otps": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.otpResponse"
+                            }
+                        },
+                        "ssh_keys": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.sshKeyResponse"
+                            }
+                        },
+                        "texts": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.textResponse"
+                            }
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetAllSSHKeysResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "ssh_keys": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.sshKeyResponse"
+                            }
+                        },
+                        "next_cursor": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetAllTagsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "tags": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.tagResponse"
+                            }
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetAllTemplatesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "templates": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.templateResponse"
+                            }
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetAllTextsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "next_cursor": {
+                            "type": "string"
+                        },
+                        "texts": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.textResponse"
+                            }
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetBankCardResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.bankCardResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetBinaryResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.binaryResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetChangesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "bank_cards": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.bankCardResponse"
+                            }
+                        },
+                        "binaries": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.binaryResponse"
+                            }
+                        },
+                        "credentials": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.credentialsResponse"
+                            }
+                        },
+                        "deleted": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.deletedResponse"
+                            }
+                        },
+                        "folders": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.folderResponse"
+                            }
+                        },
+                        "items": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.itemResponse"
+                            }
+                        },
+                        "labels": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.labelsResponse"
+                            }
+                        },
+                        "more": {
+                            "type": "boolean"
+                        },
+                        "otps": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.otpResponse"
+                            }
+                        },
+                        "revision": {
+                            "type": "integer"
+                        },
+                        "ssh_keys": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.sshKeyResponse"
+                            }
+                        },
+                        "tags": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.tagResponse"
+                            }
+                        },
+                        "templates": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.templateResponse"
+                            }
+                        },
+                        "texts": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presentation.textResponse"
+                            }
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetCredentialsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.credentialsResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetItemResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.itemResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetLabelsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.labelsResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetOTPResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.otpResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetSSHKeyResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.sshKeyResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.GetTextResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.textResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.PreLoginResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "e2e": {
+                            "type": "boolean"
+                        },
+                        "salt": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.TwoFactorEnrollResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "secret": {
+                            "type": "string"
+                        },
+                        "uri": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.TwoFactorVerifyResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "recovery_codes": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.UpdateBankCardConflictResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.bankCardResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.UpdateBinaryConflictResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.binaryResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.UpdateCredentialsConflictResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.credentialsResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.UpdateItemConflictResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.itemResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.UpdateOTPConflictResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.otpResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.UpdateSSHKeyConflictResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.sshKeyResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.UpdateTextConflictResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/presentation.textResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "presentation.bankCardPayload": {
+            "type": "object",
+            "required": [
+                "cvv",
+                "number",
+                "valid_thru"
+            ],
+            "properties": {
+                "card_holder": {
+                    "type": "string"
+                },
+                "cvv": {
+                    "type": "string"
+                },
+                "meta": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "valid_thru": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.bankCardResponse": {
+            "type": "object",
+            "properties": {
+                "card_holder": {
+                    "type": "string"
+                },
+                "cvv": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "meta": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "valid_thru": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.binaryResponse": {
+            "type": "object",
+            "properties": {
+                "chunked": {
+                    "type": "boolean"
+                },
+                "content": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "meta": {
+                    "type": "string"
+                },
+                "mime_type": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sha256": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.binaryUploadPayload": {
+            "type": "object",
+            "required": [
+                "chunk_size",
+                "size"
+            ],
+            "properties": {
+                "chunk_size": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.credentialsPayload": {
+            "type": "object",
+            "required": [
+                "login",
+                "name",
+                "password"
+            ],
+            "properties": {
+                "login": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "meta": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 1
+                }
+            }
+        },
+        "presentation.credentialsResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "login": {
+                    "type": "string"
+                },
+                "meta": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.deletedResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.folderPayload": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.folderResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.itemPayload": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/item.Field"
                     }
                 },
-                "message": {
+                "meta": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "boolean"
+                "name": {
+                    "type": "string"
+                },
+                "template": {
+                    "type": "string"
                 }
             }
         },
-        "presentation.GetAllCredentialsResponse": {
+        "presentation.itemResponse": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "object",
-                    "properties": {
-                        "credentials": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/presentation.credentialsResponse"
-                            }
-                        }
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/item.Field"
                     }
                 },
-                "message": {
+                "id": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "boolean"
+                "meta": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "template": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "presentation.GetAllResponse": {
+        "presentation.labelsPayload": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "object",
-                    "properties": {
-                        "bank_cards": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/presentation.bankCardResponse"
-                            }
-                        },
-                        "binaries": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/presentation.binaryResponse"
-                            }
-                        },
-                        "credentials": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/presentation.credentialsResponse"
-                            }
-                        },
-                        "texts": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/presentation.textResponse"
-                            }
-                        }
-                    }
-                },
-                "message": {
+                "folder_id": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "boolean"
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "text",
+                        "binary",
+                        "credentials",
+                        "bank_card",
+                        "otp",
+                        "ssh_key",
+                        "item"
+                    ]
+                },
+                "tag_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "presentation.GetAllTextsResponse": {
+        "presentation.labelsResponse": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "object",
-                    "properties": {
-                        "texts": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/presentation.textResponse"
-                            }
-                        }
-                    }
+                "entity_id": {
+                    "type": "string"
                 },
-                "message": {
+                "folder_id": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "boolean"
+                "kind": {
+                    "type": "string"
+                },
+                "tag_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "presentation.bankCardPayload": {
+        "presentation.otpPayload": {
             "type": "object",
             "required": [
-                "cvv",
-                "number",
-                "valid_thru"
+                "algorithm",
+                "digits",
+                "period",
+                "secret"
             ],
             "properties": {
-                "card_holder": {
+                "account": {
                     "type": "string"
                 },
-                "cvv": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "digits": {
+                    "type": "string"
+                },
+                "issuer": {
                     "type": "string"
                 },
                 "meta": {
                     "type": "string"
                 },
-                "number": {
+                "period": {
                     "type": "string"
                 },
-                "valid_thru": {
+                "secret": {
                     "type": "string"
                 }
             }
         },
-        "presentation.bankCardResponse": {
+        "presentation.otpResponse": {
             "type": "object",
             "properties": {
-                "card_holder": {
+                "account": {
                     "type": "string"
                 },
-                "cvv": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "digits": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "issuer": {
+                    "type": "string"
+                },
                 "meta": {
                     "type": "string"
                 },
-                "number": {
+                "period": {
                     "type": "string"
                 },
-                "valid_thru": {
+                "secret": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "presentation.binaryResponse": {
+        "presentation.preLoginPayload": {
             "type": "object",
+            "required": [
+                "login"
+            ],
             "properties": {
-                "content": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "id": {
+                "login": {
                     "type": "string"
                 }
             }
         },
-        "presentation.credentialsPayload": {
+        "presentation.refreshPayload": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.registrationPayload": {
             "type": "object",
             "required": [
                 "login",
-                "name",
                 "password"
             ],
             "properties": {
+                "e2e": {
+                    "type": "boolean"
+                },
                 "login": {
-                    "type": "string",
-                    "minLength": 1
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "salt": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "presentation.sshKeyPayload": {
+            "type": "object",
+            "required": [
+                "fingerprint",
+                "private_key",
+                "public_key"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "fingerprint": {
+                    "type": "string"
                 },
                 "meta": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "minLength": 1
+                "private_key": {
+                    "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 1
+                "public_key": {
+                    "type": "string"
                 }
             }
         },
-        "presentation.credentialsResponse": {
+        "presentation.sshKeyResponse": {
             "type": "object",
             "properties": {
-                "id": {
+                "comment": {
                     "type": "string"
                 },
-                "login": {
+                "fingerprint": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "string"
                 },
                 "meta": {
                     "type": "string"
                 },
+                "private_key": {
+                    "type": "string"
+                },
+                "public_key": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.tagPayload": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
                 "name": {
                     "type": "string"
+                }
+            }
+        },
+        "presentation.tagResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
                 },
-                "password": {
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "presentation.registrationPayload": {
+        "presentation.templatePayload": {
             "type": "object",
             "required": [
-                "login",
-                "password"
+                "name"
             ],
             "properties": {
-                "login": {
+                "description": {
                     "type": "string"
                 },
-                "password": {
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/item.FieldSpec"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.templateResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/item.FieldSpec"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 }
             }
@@ -916,6 +4223,35 @@ const docTemplate = `{
                 },
                 "id": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.twoFactorCodePayload": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.twoFactorLoginPayload": {
+            "type": "object",
+            "required": [
+                "challenge",
+                "code"
+            ],
+            "properties": {
+                "challenge": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
                 }
             }
         }
@@ -952,6 +4288,34 @@ const docTemplate = `{
             "description": "Группа запросов для работы с банковскими картами",
             "name": "BankCard"
         },
+        {
+            "description": "Группа запросов для работы с секретами одноразовых паролей",
+            "name": "OTP"
+        },
+        {
+            "description": "Группа запросов для работы с SSH ключами",
+            "name": "SSHKey"
+        },
+        {
+            "description": "Группа запросов для работы с произвольными данными по шаблону",
+            "name": "Item"
+        },
+        {
+            "description": "Группа запросов для работы с папками",
+            "name": "Folder"
+        },
+        {
+            "description": "Группа запросов для работы с метками",
+            "name": "Tag"
+        },
+        {
+            "description": "Группа запросов для работы с пользовательскими шаблонами данных",
+            "name": "Template"
+        },
+        {
+            "description": "Группа запросов для назначения папки и меток данным",
+            "name": "Labels"
+        },
         {
             "description": "Группа запросов для работы со всеми данными пользователя",
             "name": "All"
@@ -3,6 +3,7 @@ module github.com/Nickolasll/goph-keeper
 go 1.21.0
 
 require (
+	github.com/ccojocar/zxcvbn-go v1.0.2
 	github.com/go-chi/chi/v5 v5.0.12
 	github.com/go-playground/validator/v10 v10.18.0
 	github.com/go-resty/resty/v2 v2.11.0
@@ -20,6 +21,7 @@ require (
 	go.etcd.io/bbolt v1.3.9
 	golang.org/x/crypto v0.19.0
 	golang.org/x/sync v0.6.0
+	golang.org/x/term v0.17.0
 )
 
 require (
@@ -53,7 +55,6 @@ require (
 	github.com/butuzov/ireturn v0.3.0 // indirect
 	github.com/butuzov/mirror v1.1.0 // indirect
 	github.com/catenacyber/perfsprint v0.6.0 // indirect
-	github.com/ccojocar/zxcvbn-go v1.0.2 // indirect
 	github.com/cespare/xxhash/v2 v2.1.2 // indirect
 	github.com/charithe/durationcheck v0.0.10 // indirect
 	github.com/chavacava/garif v0.1.0 // indirect
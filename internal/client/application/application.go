@@ -14,6 +14,12 @@ type Application struct {
 	Registration usecases.Registration
 	// Login - Сценарий входа по логину и паролю
 	Login usecases.Login
+	// EnrollTwoFactor - Сценарий подключения двухфакторной аутентификации
+	EnrollTwoFactor usecases.EnrollTwoFactor
+	// VerifyTwoFactor - Сценарий подтверждения подключения двухфакторной аутентификации
+	VerifyTwoFactor usecases.VerifyTwoFactor
+	// DisableTwoFactor - Сценарий отключения двухфакторной аутентификации
+	DisableTwoFactor usecases.DisableTwoFactor
 	// CreateText - Сценарий создания новых текстовых данных
 	CreateText usecases.CreateText
 	// UpdateText - Сценарий обновления существующих текстовых данных
@@ -22,6 +28,8 @@ type Application struct {
 	ShowText usecases.ShowText
 	// SyncText - Сценарий перезаписи текущих пользовательских текстовых данных
 	SyncText usecases.SyncText
+	// DeleteText - Сценарий удаления существующих текстовых данных
+	DeleteText usecases.DeleteText
 	// CreateBinary - Сценарий создания новых бинарных данных
 	CreateBinary usecases.CreateBinary
 	// UpdateBinary - Сценарий обновления существующих бинарных данных
@@ -30,14 +38,24 @@ type Application struct {
 	ShowBinary usecases.ShowBinary
 	// SyncBinary - Сценарий перезаписи текущих пользовательских бинарных данных
 	SyncBinary usecases.SyncBinary
+	// DeleteBinary - Сценарий удаления существующих бинарных данных
+	DeleteBinary usecases.DeleteBinary
+	// DownloadBinary - Сценарий сохранения содержимого бинарных данных
+	DownloadBinary usecases.DownloadBinary
+	// GetBinary - Сценарий получения метаданных бинарных данных по идентификатору
+	GetBinary usecases.GetBinary
 	// CreateCredentials - Сценарий создания новой пары логин и пароль
 	CreateCredentials usecases.CreateCredentials
 	// UpdateCredentials - Сценарий обновления существующей пары логин и пароль
 	UpdateCredentials usecases.UpdateCredentials
 	// ShowCredentials - Сценарий получения расшифрованных логинов и паролей пользователя
 	ShowCredentials usecases.ShowCredentials
+	// GetCredentials - Сценарий получения пары логин и пароль по идентификатору
+	GetCredentials usecases.GetCredentials
 	// SyncCredentials - Сценарий перезаписи текущих пользовательских логинов и паролей
 	SyncCredentials usecases.SyncCredentials
+	// DeleteCredentials - Сценарий удаления существующей пары логин и пароль
+	DeleteCredentials usecases.DeleteCredentials
 	// CreateBankCard - Сценарий создания новой банковской карты
 	CreateBankCard usecases.CreateBankCard
 	// UpdateCredentials - Сценарий обновления существующей банковской карты
@@ -46,8 +64,82 @@ type Application struct {
 	ShowBankCards usecases.ShowBankCards
 	// SyncBankCards - Сценарий перезаписи текущих пользовательских банковских карт
 	SyncBankCards usecases.SyncBankCards
+	// DeleteBankCard - Сценарий удаления существующей банковской карты
+	DeleteBankCard usecases.DeleteBankCard
+	// CreateOTP - Сценарий создания нового секрета одноразовых паролей
+	CreateOTP usecases.CreateOTP
+	// UpdateOTP - Сценарий обновления существующего секрета одноразовых паролей
+	UpdateOTP usecases.UpdateOTP
+	// ShowOTPs - Сценарий получения расшифрованных секретов одноразовых паролей
+	ShowOTPs usecases.ShowOTPs
+	// SyncOTPs - Сценарий перезаписи текущих пользовательских секретов одноразовых паролей
+	SyncOTPs usecases.SyncOTPs
+	// DeleteOTP - Сценарий удаления существующего секрета одноразовых паролей
+	DeleteOTP usecases.DeleteOTP
+	// OTPCode - Сценарий генерации текущего одноразового пароля
+	OTPCode usecases.OTPCode
+	// CreateSSHKey - Сценарий создания нового SSH ключа
+	CreateSSHKey usecases.CreateSSHKey
+	// UpdateSSHKey - Сценарий обновления существующего SSH ключа
+	UpdateSSHKey usecases.UpdateSSHKey
+	// ShowSSHKeys - Сценарий получения расшифрованных SSH ключей
+	ShowSSHKeys usecases.ShowSSHKeys
+	// SyncSSHKeys - Сценарий перезаписи текущих пользовательских SSH ключей
+	SyncSSHKeys usecases.SyncSSHKeys
+	// DeleteSSHKey - Сценарий удаления существующего SSH ключа
+	DeleteSSHKey usecases.DeleteSSHKey
+	// CreateItem - Сценарий создания новых данных по шаблону
+	CreateItem usecases.CreateItem
+	// UpdateItem - Сценарий обновления существующих данных по шаблону
+	UpdateItem usecases.UpdateItem
+	// ShowItems - Сценарий получения расшифрованных данных по шаблону
+	ShowItems usecases.ShowItems
+	// SyncItems - Сценарий перезаписи текущих пользовательских данных по шаблону
+	SyncItems usecases.SyncItems
+	// DeleteItem - Сценарий удаления существующих данных по шаблону
+	DeleteItem usecases.DeleteItem
+	// CreateTemplate - Сценарий создания пользовательского шаблона данных
+	CreateTemplate usecases.CreateTemplate
+	// ShowTemplates - Сценарий получения встроенных и пользовательских шаблонов данных
+	ShowTemplates usecases.ShowTemplates
+	// DeleteTemplate - Сценарий удаления пользовательского шаблона данных
+	DeleteTemplate usecases.DeleteTemplate
+	// CreateFolder - Сценарий создания новой папки
+	CreateFolder usecases.CreateFolder
+	// MoveFolder - Сценарий переименования или перемещения папки
+	MoveFolder usecases.MoveFolder
+	// DeleteFolder - Сценарий удаления папки
+	DeleteFolder usecases.DeleteFolder
+	// ListFolder - Сценарий просмотра содержимого папки
+	ListFolder usecases.ListFolder
+	// SaveLabels - Сценарий перемещения данных в папку и назначения им меток
+	SaveLabels usecases.SaveLabels
+	// FilterLabels - Сценарий отбора данных по папке и меткам
+	FilterLabels usecases.FilterLabels
 	// SyncAll - Сценарий перезаписи всех существующих пользовательских данных
 	SyncAll usecases.SyncAll
+	// SyncPush - Сценарий отправки на сервер изменений, выполненных без связи с сервером
+	SyncPush usecases.SyncPush
+	// ResolveConflict - Сценарий разрешения конфликта версий при обновлении данных
+	ResolveConflict usecases.ResolveConflict
+	// CopyCredentials - Сценарий копирования логина или пароля в буфер обмена
+	CopyCredentials usecases.CopyCredentials
+	// CopyBankCard - Сценарий копирования реквизита банковской карты в буфер обмена
+	CopyBankCard usecases.CopyBankCard
+	// Search - Сценарий нечеткого поиска по расшифрованным локальным данным
+	Search usecases.Search
+	// Audit - Сценарий проверки надежности паролей и сроков действия банковских карт
+	Audit usecases.Audit
+	// ExportVault - Сценарий экспорта всех данных в зашифрованную резервную копию
+	ExportVault usecases.ExportVault
+	// ImportVault - Сценарий восстановления данных из зашифрованной резервной копии
+	ImportVault usecases.ImportVault
+	// ImportItems - Сценарий импорта данных из файла экспорта другого менеджера паролей
+	ImportItems usecases.ImportItems
+	// Unlock - Сценарий разблокирования локального хранилища мастер-паролем
+	Unlock usecases.Unlock
+	// Lock - Сценарий блокирования локального хранилища
+	Lock usecases.Lock
 }
 
 // New - Фабрика приложения
@@ -60,7 +152,19 @@ func New(
 	binaryRepository domain.BinaryRepositoryInterface,
 	credentialsRepository domain.CredentialsRepositoryInterface,
 	bankCardRepository domain.BankCardRepositoryInterface,
+	otpRepository domain.OTPRepositoryInterface,
+	sshKeyRepository domain.SSHKeyRepositoryInterface,
+	itemRepository domain.ItemRepositoryInterface,
+	folderRepository domain.FolderRepositoryInterface,
+	tagRepository domain.TagRepositoryInterface,
+	templateRepository domain.TemplateRepositoryInterface,
+	labelsRepository domain.LabelsRepositoryInterface,
+	journalRepository domain.JournalRepositoryInterface,
+	uploadRepository domain.UploadRepositoryInterface,
 	unitOfWork domain.UnitOfWorkInterface,
+	vaultHeaderRepository domain.VaultHeaderRepositoryInterface,
+	keyCache domain.KeyCacheInterface,
+	clipboard domain.ClipboardInterface,
 ) *Application {
 	jwk, err := jwkRepository.Get()
 
@@ -87,15 +191,29 @@ func New(
 		CheckToken:        &checkToken,
 		Log:               log,
 	}
+	enrollTwoFactor := usecases.EnrollTwoFactor{
+		Client: client,
+		Log:    log,
+	}
+	verifyTwoFactor := usecases.VerifyTwoFactor{
+		Client: client,
+		Log:    log,
+	}
+	disableTwoFactor := usecases.DisableTwoFactor{
+		Client: client,
+		Log:    log,
+	}
 
 	createText := usecases.CreateText{
 		Client:         client,
 		TextRepository: textRepository,
+		Journal:        journalRepository,
 		Log:            log,
 	}
 	updateText := usecases.UpdateText{
 		Client:         client,
 		TextRepository: textRepository,
+		Journal:        journalRepository,
 		Log:            log,
 	}
 	showText := usecases.ShowText{
@@ -104,19 +222,29 @@ func New(
 		Log:            log,
 	}
 	syncText := usecases.SyncText{
+		Client:     client,
+		UnitOfWork: unitOfWork,
+		Journal:    journalRepository,
+		Log:        log,
+	}
+	deleteText := usecases.DeleteText{
 		Client:         client,
 		TextRepository: textRepository,
+		Journal:        journalRepository,
 		Log:            log,
 	}
 
 	createBinary := usecases.CreateBinary{
 		Client:           client,
 		BinaryRepository: binaryRepository,
+		Journal:          journalRepository,
+		UploadRepository: uploadRepository,
 		Log:              log,
 	}
 	updateBinary := usecases.UpdateBinary{
 		Client:           client,
 		BinaryRepository: binaryRepository,
+		Journal:          journalRepository,
 		Log:              log,
 	}
 	showBinary := usecases.ShowBinary{
@@ -125,19 +253,39 @@ func New(
 		Log:              log,
 	}
 	syncBinary := usecases.SyncBinary{
+		Client:     client,
+		UnitOfWork: unitOfWork,
+		Journal:    journalRepository,
+		Log:        log,
+	}
+	deleteBinary := usecases.DeleteBinary{
+		Client:           client,
+		BinaryRepository: binaryRepository,
+		Journal:          journalRepository,
+		Log:              log,
+	}
+	downloadBinary := usecases.DownloadBinary{
+		CheckToken:       &checkToken,
 		Client:           client,
 		BinaryRepository: binaryRepository,
 		Log:              log,
 	}
+	getBinary := usecases.GetBinary{
+		CheckToken:       &checkToken,
+		BinaryRepository: binaryRepository,
+		Log:              log,
+	}
 
 	createCredentials := usecases.CreateCredentials{
 		Client:                client,
 		CredentialsRepository: credentialsRepository,
+		Journal:               journalRepository,
 		Log:                   log,
 	}
 	updateCredentials := usecases.UpdateCredentials{
 		Client:                client,
 		CredentialsRepository: credentialsRepository,
+		Journal:               journalRepository,
 		Log:                   log,
 	}
 	showCredentials := usecases.ShowCredentials{
@@ -145,20 +293,36 @@ func New(
 		CredentialsRepository: credentialsRepository,
 		Log:                   log,
 	}
+	getCredentials := usecases.GetCredentials{
+		CheckToken:            &checkToken,
+		Client:                client,
+		CredentialsRepository: credentialsRepository,
+		Journal:               journalRepository,
+		Log:                   log,
+	}
 	syncCredentials := usecases.SyncCredentials{
+		Client:     client,
+		UnitOfWork: unitOfWork,
+		Journal:    journalRepository,
+		Log:        log,
+	}
+	deleteCredentials := usecases.DeleteCredentials{
 		Client:                client,
 		CredentialsRepository: credentialsRepository,
+		Journal:               journalRepository,
 		Log:                   log,
 	}
 
 	createBankCard := usecases.CreateBankCard{
 		Client:             client,
 		BankCardRepository: bankCardRepository,
+		Journal:            journalRepository,
 		Log:                log,
 	}
 	updateBankCard := usecases.UpdateBankCard{
 		Client:             client,
 		BankCardRepository: bankCardRepository,
+		Journal:            journalRepository,
 		Log:                log,
 	}
 	showBankCards := usecases.ShowBankCards{
@@ -167,36 +331,361 @@ func New(
 		Log:                log,
 	}
 	syncBankCards := usecases.SyncBankCards{
+		Client:     client,
+		UnitOfWork: unitOfWork,
+		Journal:    journalRepository,
+		Log:        log,
+	}
+	deleteBankCard := usecases.DeleteBankCard{
 		Client:             client,
 		BankCardRepository: bankCardRepository,
+		Journal:            journalRepository,
 		Log:                log,
 	}
 
+	createOTP := usecases.CreateOTP{
+		Client:        client,
+		OTPRepository: otpRepository,
+		Journal:       journalRepository,
+		Log:           log,
+	}
+	updateOTP := usecases.UpdateOTP{
+		Client:        client,
+		OTPRepository: otpRepository,
+		Journal:       journalRepository,
+		Log:           log,
+	}
+	showOTPs := usecases.ShowOTPs{
+		CheckToken:    &checkToken,
+		OTPRepository: otpRepository,
+		Log:           log,
+	}
+	syncOTPs := usecases.SyncOTPs{
+		Client:     client,
+		UnitOfWork: unitOfWork,
+		Journal:    journalRepository,
+		Log:        log,
+	}
+	deleteOTP := usecases.DeleteOTP{
+		Client:        client,
+		OTPRepository: otpRepository,
+		Journal:       journalRepository,
+		Log:           log,
+	}
+	otpCode := usecases.OTPCode{
+		CheckToken:    &checkToken,
+		OTPRepository: otpRepository,
+		Log:           log,
+	}
+
+	createSSHKey := usecases.CreateSSHKey{
+		Client:           client,
+		SSHKeyRepository: sshKeyRepository,
+		Journal:          journalRepository,
+		Log:              log,
+	}
+	updateSSHKey := usecases.UpdateSSHKey{
+		Client:           client,
+		SSHKeyRepository: sshKeyRepository,
+		Journal:          journalRepository,
+		Log:              log,
+	}
+	showSSHKeys := usecases.ShowSSHKeys{
+		CheckToken:       &checkToken,
+		SSHKeyRepository: sshKeyRepository,
+		Log:              log,
+	}
+	syncSSHKeys := usecases.SyncSSHKeys{
+		Client:     client,
+		UnitOfWork: unitOfWork,
+		Journal:    journalRepository,
+		Log:        log,
+	}
+	deleteSSHKey := usecases.DeleteSSHKey{
+		Client:           client,
+		SSHKeyRepository: sshKeyRepository,
+		Journal:          journalRepository,
+		Log:              log,
+	}
+
+	createItem := usecases.CreateItem{
+		Client:             client,
+		ItemRepository:     itemRepository,
+		BinaryRepository:   binaryRepository,
+		TemplateRepository: templateRepository,
+		Journal:            journalRepository,
+		Log:                log,
+	}
+	updateItem := usecases.UpdateItem{
+		Client:             client,
+		ItemRepository:     itemRepository,
+		BinaryRepository:   binaryRepository,
+		TemplateRepository: templateRepository,
+		Journal:            journalRepository,
+		Log:                log,
+	}
+	showItems := usecases.ShowItems{
+		CheckToken:     &checkToken,
+		ItemRepository: itemRepository,
+		Log:            log,
+	}
+	syncItems := usecases.SyncItems{
+		Client:     client,
+		UnitOfWork: unitOfWork,
+		Journal:    journalRepository,
+		Log:        log,
+	}
+	deleteItem := usecases.DeleteItem{
+		Client:         client,
+		ItemRepository: itemRepository,
+		Journal:        journalRepository,
+		Log:            log,
+	}
+
+	createTemplate := usecases.CreateTemplate{
+		Client:             client,
+		TemplateRepository: templateRepository,
+		Log:                log,
+	}
+	showTemplates := usecases.ShowTemplates{
+		TemplateRepository: templateRepository,
+		Log:                log,
+	}
+	deleteTemplate := usecases.DeleteTemplate{
+		Client:             client,
+		TemplateRepository: templateRepository,
+		Log:                log,
+	}
+
+	createFolder := usecases.CreateFolder{
+		Client:           client,
+		FolderRepository: folderRepository,
+		Log:              log,
+	}
+	moveFolder := usecases.MoveFolder{
+		Client:           client,
+		FolderRepository: folderRepository,
+		Log:              log,
+	}
+	deleteFolder := usecases.DeleteFolder{
+		Client:           client,
+		FolderRepository: folderRepository,
+		LabelsRepository: labelsRepository,
+		Log:              log,
+	}
+	listFolder := usecases.ListFolder{
+		CheckToken:            &checkToken,
+		FolderRepository:      folderRepository,
+		TagRepository:         tagRepository,
+		LabelsRepository:      labelsRepository,
+		TextRepository:        textRepository,
+		BinaryRepository:      binaryRepository,
+		CredentialsRepository: credentialsRepository,
+		BankCardRepository:    bankCardRepository,
+		OTPRepository:         otpRepository,
+		SSHKeyRepository:      sshKeyRepository,
+		ItemRepository:        itemRepository,
+		Log:                   log,
+	}
+	saveLabels := usecases.SaveLabels{
+		Client:           client,
+		FolderRepository: folderRepository,
+		TagRepository:    tagRepository,
+		LabelsRepository: labelsRepository,
+		Journal:          journalRepository,
+		Log:              log,
+	}
+	filterLabels := usecases.FilterLabels{
+		FolderRepository: folderRepository,
+		TagRepository:    tagRepository,
+		LabelsRepository: labelsRepository,
+		Log:              log,
+	}
+
 	syncAll := usecases.SyncAll{
 		Client:     client,
 		UnitOfWork: unitOfWork,
+		Journal:    journalRepository,
 		Log:        log,
 	}
 
+	syncPush := usecases.SyncPush{
+		Client:                client,
+		Journal:               journalRepository,
+		TextRepository:        textRepository,
+		BinaryRepository:      binaryRepository,
+		CredentialsRepository: credentialsRepository,
+		BankCardRepository:    bankCardRepository,
+		OTPRepository:         otpRepository,
+		SSHKeyRepository:      sshKeyRepository,
+		ItemRepository:        itemRepository,
+		LabelsRepository:      labelsRepository,
+		Log:                   log,
+	}
+
+	resolveConflict := usecases.ResolveConflict{
+		Client:                client,
+		TextRepository:        textRepository,
+		BinaryRepository:      binaryRepository,
+		CredentialsRepository: credentialsRepository,
+		BankCardRepository:    bankCardRepository,
+		OTPRepository:         otpRepository,
+		SSHKeyRepository:      sshKeyRepository,
+		ItemRepository:        itemRepository,
+		Journal:               journalRepository,
+		Log:                   log,
+	}
+
+	copyCredentials := usecases.CopyCredentials{
+		CheckToken:            &checkToken,
+		CredentialsRepository: credentialsRepository,
+		Clipboard:             clipboard,
+		Log:                   log,
+	}
+	copyBankCard := usecases.CopyBankCard{
+		CheckToken:         &checkToken,
+		BankCardRepository: bankCardRepository,
+		Clipboard:          clipboard,
+		Log:                log,
+	}
+
+	search := usecases.Search{
+		CheckToken:            &checkToken,
+		TextRepository:        textRepository,
+		BinaryRepository:      binaryRepository,
+		CredentialsRepository: credentialsRepository,
+		BankCardRepository:    bankCardRepository,
+		Log:                   log,
+	}
+	audit := usecases.Audit{
+		CheckToken:            &checkToken,
+		CredentialsRepository: credentialsRepository,
+		BankCardRepository:    bankCardRepository,
+		Log:                   log,
+	}
+	exportVault := usecases.ExportVault{
+		CheckToken:            &checkToken,
+		Client:                client,
+		TextRepository:        textRepository,
+		BinaryRepository:      binaryRepository,
+		CredentialsRepository: credentialsRepository,
+		BankCardRepository:    bankCardRepository,
+		OTPRepository:         otpRepository,
+		SSHKeyRepository:      sshKeyRepository,
+		ItemRepository:        itemRepository,
+		FolderRepository:      folderRepository,
+		TagRepository:         tagRepository,
+		LabelsRepository:      labelsRepository,
+		Log:                   log,
+	}
+	importVault := usecases.ImportVault{
+		CheckToken:            &checkToken,
+		CreateText:            &createText,
+		CreateBinary:          &createBinary,
+		CreateCredentials:     &createCredentials,
+		CreateBankCard:        &createBankCard,
+		CreateOTP:             &createOTP,
+		CreateSSHKey:          &createSSHKey,
+		CreateItem:            &createItem,
+		CreateFolder:          &createFolder,
+		SaveLabels:            &saveLabels,
+		TextRepository:        textRepository,
+		BinaryRepository:      binaryRepository,
+		CredentialsRepository: credentialsRepository,
+		BankCardRepository:    bankCardRepository,
+		OTPRepository:         otpRepository,
+		SSHKeyRepository:      sshKeyRepository,
+		ItemRepository:        itemRepository,
+		Log:                   log,
+	}
+
+	importItems := usecases.ImportItems{
+		CheckToken:            &checkToken,
+		CreateText:            &createText,
+		CreateCredentials:     &createCredentials,
+		CreateBankCard:        &createBankCard,
+		TextRepository:        textRepository,
+		BinaryRepository:      binaryRepository,
+		CredentialsRepository: credentialsRepository,
+		BankCardRepository:    bankCardRepository,
+		Log:                   log,
+	}
+
+	unlock := usecases.Unlock{
+		VaultHeaderRepository: vaultHeaderRepository,
+		KeyCache:              keyCache,
+		Log:                   log,
+	}
+	lock := usecases.Lock{
+		KeyCache: keyCache,
+		Log:      log,
+	}
+
 	return &Application{
 		Registration:      registration,
 		Login:             login,
+		EnrollTwoFactor:   enrollTwoFactor,
+		VerifyTwoFactor:   verifyTwoFactor,
+		DisableTwoFactor:  disableTwoFactor,
 		CreateText:        createText,
 		UpdateText:        updateText,
 		ShowText:          showText,
 		SyncText:          syncText,
+		DeleteText:        deleteText,
 		CreateBinary:      createBinary,
 		UpdateBinary:      updateBinary,
 		ShowBinary:        showBinary,
 		SyncBinary:        syncBinary,
+		DeleteBinary:      deleteBinary,
+		DownloadBinary:    downloadBinary,
+		GetBinary:         getBinary,
 		CreateCredentials: createCredentials,
 		UpdateCredentials: updateCredentials,
 		ShowCredentials:   showCredentials,
+		GetCredentials:    getCredentials,
 		SyncCredentials:   syncCredentials,
+		DeleteCredentials: deleteCredentials,
 		CreateBankCard:    createBankCard,
 		UpdateBankCard:    updateBankCard,
 		ShowBankCards:     showBankCards,
 		SyncBankCards:     syncBankCards,
+		DeleteBankCard:    deleteBankCard,
+		CreateOTP:         createOTP,
+		UpdateOTP:         updateOTP,
+		ShowOTPs:          showOTPs,
+		SyncOTPs:          syncOTPs,
+		DeleteOTP:         deleteOTP,
+		OTPCode:           otpCode,
+		CreateSSHKey:      createSSHKey,
+		UpdateSSHKey:      updateSSHKey,
+		ShowSSHKeys:       showSSHKeys,
+		SyncSSHKeys:       syncSSHKeys,
+		DeleteSSHKey:      deleteSSHKey,
+		CreateItem:        createItem,
+		UpdateItem:        updateItem,
+		ShowItems:         showItems,
+		SyncItems:         syncItems,
+		DeleteItem:        deleteItem,
+		CreateTemplate:    createTemplate,
+		ShowTemplates:     showTemplates,
+		DeleteTemplate:    deleteTemplate,
+		CreateFolder:      createFolder,
+		MoveFolder:        moveFolder,
+		DeleteFolder:      deleteFolder,
+		ListFolder:        listFolder,
+		SaveLabels:        saveLabels,
+		FilterLabels:      filterLabels,
 		SyncAll:           syncAll,
+		SyncPush:          syncPush,
+		ResolveConflict:   resolveConflict,
+		CopyCredentials:   copyCredentials,
+		CopyBankCard:      copyBankCard,
+		Search:            search,
+		Audit:             audit,
+		ExportVault:       exportVault,
+		ImportVault:       importVault,
+		ImportItems:       importItems,
+		Unlock:            unlock,
+		Lock:              lock,
 	}
 }
@@ -0,0 +1,190 @@
+package usecases
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	zxcvbn "github.com/ccojocar/zxcvbn-go"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// strongScore - Минимальная оценка zxcvbn, при которой пароль не считается слабым
+const strongScore = 3
+
+// validThruPattern - Формат срока действия карты MM/YY
+var validThruPattern = regexp.MustCompile(`^(0[1-9]|1[012])/(\d{2})$`)
+
+// Audit - Сценарий проверки надежности и повторного использования паролей и сроков действия банковских карт.
+// Расшифрованные пароли используются только в памяти и не попадают в отчет и лог
+type Audit struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
+	BankCardRepository domain.BankCardRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, в отчет попадают карты, срок действия которых истек
+// или истекает в течение expiresWithin. Если breaches не nil, пароли проверяются по набору утекших паролей
+func (u Audit) Do(
+	session domain.Session,
+	expiresWithin time.Duration,
+	breaches domain.BreachDatasetInterface,
+) (domain.AuditReport, error) {
+	report := domain.AuditReport{
+		Credentials:     []domain.CredentialsAudit{},
+		Reused:          [][]uuid.UUID{},
+		BankCards:       []domain.BankCardAudit{},
+		BreachesChecked: breaches != nil,
+		Breached:        []uuid.UUID{},
+	}
+	_, err := u.CheckToken.Do(session.Token)
+	if err != nil {
+		return report, err
+	}
+
+	creds, err := u.CredentialsRepository.GetAll(session.UserID)
+	if err != nil {
+		return report, err
+	}
+	report.Credentials, report.Reused, err = auditCredentials(creds, breaches)
+	if err != nil {
+		return report, err
+	}
+	for _, v := range report.Credentials {
+		if v.Breaches > 0 {
+			report.Breached = append(report.Breached, v.ID)
+		}
+	}
+
+	cards, err := u.BankCardRepository.GetAll(session.UserID)
+	if err != nil {
+		return report, err
+	}
+	now := time.Now()
+	for _, v := range cards {
+		expiry, err := cardExpiry(v.ValidThru)
+		if err != nil {
+			u.Log.Warnf("bank card %s has invalid valid thru, skipped", v.ID)
+
+			continue
+		}
+		left := expiry.Sub(now)
+		if left > expiresWithin {
+			continue
+		}
+		report.BankCards = append(report.BankCards, domain.BankCardAudit{
+			ID:         v.ID,
+			Number:     maskCardNumber(v.Number),
+			CardHolder: v.CardHolder,
+			ValidThru:  v.ValidThru,
+			Expired:    left <= 0,
+			DaysLeft:   max(int(left/(24*time.Hour)), 0),
+		})
+	}
+	slices.SortStableFunc(report.BankCards, func(a, b domain.BankCardAudit) int {
+		return a.DaysLeft - b.DaysLeft
+	})
+
+	return report, nil
+}
+
+// auditCredentials - Оценивает пароли, проверяет их по набору утекших паролей, если он задан,
+// и объединяет в группы записи с одинаковым паролем
+func auditCredentials(
+	creds []domain.Credentials,
+	breaches domain.BreachDatasetInterface,
+) ([]domain.CredentialsAudit, [][]uuid.UUID, error) {
+	result := make([]domain.CredentialsAudit, 0, len(creds))
+	passwords := make(map[uuid.UUID]string, len(creds))
+	counts := map[string]int{}
+	for _, v := range creds {
+		// Наименование и логин передаются как пользовательские данные, пароль на их основе считается слабее
+		strength := zxcvbn.PasswordStrength(v.Password, []string{v.Name, v.Login})
+		passwords[v.ID] = v.Password
+		counts[v.Password]++
+		breached := 0
+		if breaches != nil {
+			var err error
+			breached, err = breaches.Count(v.Password)
+			if err != nil {
+				return result, [][]uuid.UUID{}, err
+			}
+		}
+		result = append(result, domain.CredentialsAudit{
+			ID:        v.ID,
+			Name:      v.Name,
+			Login:     v.Login,
+			Score:     strength.Score,
+			Entropy:   strength.Entropy,
+			CrackTime: strength.CrackTimeDisplay,
+			Weak:      strength.Score < strongScore,
+			Breaches:  breached,
+		})
+	}
+
+	slices.SortStableFunc(result, func(a, b domain.CredentialsAudit) int {
+		if a.Score != b.Score {
+			return a.Score - b.Score
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	// Группы нумеруются в порядке появления в отчете, начиная с единицы
+	reused := [][]uuid.UUID{}
+	groups := map[string]int{}
+	for i, v := range result {
+		password := passwords[v.ID]
+		if counts[password] < 2 {
+			continue
+		}
+		group, ok := groups[password]
+		if !ok {
+			reused = append(reused, []uuid.UUID{})
+			group = len(reused)
+			groups[password] = group
+		}
+		result[i].ReuseGroup = group
+		reused[group-1] = append(reused[group-1], v.ID)
+	}
+
+	return result, reused, nil
+}
+
+// cardExpiry - Возвращает момент истечения срока действия карты: карта действует до конца месяца MM/YY
+func cardExpiry(validThru string) (time.Time, error) {
+	groups := validThruPattern.FindStringSubmatch(validThru)
+	if groups == nil {
+		return time.Time{}, domain.ErrBadRequest
+	}
+	month, err := strconv.Atoi(groups[1])
+	if err != nil {
+		return time.Time{}, err
+	}
+	year, err := strconv.Atoi(groups[2])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(2000+year, time.Month(month)+1, 1, 0, 0, 0, 0, time.Local), nil
+}
+
+// maskCardNumber - Скрывает все цифры номера карты, кроме последних четырех
+func maskCardNumber(number string) string {
+	const visible = 4
+	if len(number) <= visible {
+		return number
+	}
+
+	return "**** " + number[len(number)-visible:]
+}
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// sniffLength - Количество первых байт содержимого, по которым определяется MIME тип
+const sniffLength = 512
+
+// sniffMimeType - Определяет MIME тип по первым байтам source.
+// Возвращает reader, который читает source с начала, включая прочитанные для определения байты
+func sniffMimeType(source io.Reader) (string, io.Reader, error) {
+	reader := bufio.NewReaderSize(source, sniffLength)
+	head, err := reader.Peek(sniffLength)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return "", reader, err
+	}
+
+	return http.DetectContentType(head), reader, nil
+}
+
+// checksum - Возвращает контрольную сумму SHA-256 содержимого в шестнадцатеричном виде
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
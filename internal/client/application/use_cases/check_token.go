@@ -101,7 +101,7 @@ func (u CheckToken) Do(token string) (uuid.UUID, error) {
 	return uid, nil
 }
 
-func (u CheckToken) getSessionFromToken(token string) (domain.Session, error) {
+func (u CheckToken) getSessionFromToken(token, refreshToken string) (domain.Session, error) {
 	var session domain.Session
 	userID, err := u.Do(token)
 	if err != nil {
@@ -109,8 +109,9 @@ func (u CheckToken) getSessionFromToken(token string) (domain.Session, error) {
 	}
 
 	session = domain.Session{
-		UserID: userID,
-		Token:  token,
+		UserID:       userID,
+		Token:        token,
+		RefreshToken: refreshToken,
 	}
 
 	return session, nil
@@ -0,0 +1,227 @@
+package usecases
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+)
+
+// theirsValues - Возвращает актуальные значения полей на сервере в порядке conflict.Fields
+func theirsValues(conflict *domain.Conflict) []string {
+	values := make([]string, 0, len(conflict.Fields))
+	for _, v := range conflict.Fields {
+		values = append(values, v.Theirs)
+	}
+
+	return values
+}
+
+func textConflict(base, mine, theirs domain.Text) *domain.Conflict {
+	return &domain.Conflict{
+		Kind:    domain.TextKind,
+		ID:      mine.ID,
+		Version: theirs.Version,
+		Fields: []domain.ConflictField{
+			{Name: "content", Base: base.Content, Mine: mine.Content, Theirs: theirs.Content},
+		},
+	}
+}
+
+func textFromValues(id uuid.UUID, version int64, values []string) domain.Text {
+	return domain.Text{
+		ID:      id,
+		Content: values[0],
+		Version: version,
+	}
+}
+
+func binaryConflict(base, mine, theirs domain.Binary) *domain.Conflict {
+	return &domain.Conflict{
+		Kind:    domain.BinaryKind,
+		ID:      mine.ID,
+		Version: theirs.Version,
+		Fields: []domain.ConflictField{
+			{Name: "content", Base: string(base.Content), Mine: string(mine.Content), Theirs: string(theirs.Content)},
+			{Name: "name", Base: base.Name, Mine: mine.Name, Theirs: theirs.Name},
+			{Name: "meta", Base: base.Meta, Mine: mine.Meta, Theirs: theirs.Meta},
+		},
+	}
+}
+
+// binaryFromValues - Метаданные содержимого вычисляются по выбранному содержимому
+func binaryFromValues(id uuid.UUID, version int64, values []string) domain.Binary {
+	content := []byte(values[0])
+
+	return domain.Binary{
+		ID:       id,
+		Content:  content,
+		Name:     values[1],
+		MimeType: http.DetectContentType(content),
+		Size:     int64(len(content)),
+		SHA256:   checksum(content),
+		Meta:     values[2],
+		Version:  version,
+	}
+}
+
+func credentialsConflict(base, mine, theirs domain.Credentials) *domain.Conflict {
+	return &domain.Conflict{
+		Kind:    domain.CredentialsKind,
+		ID:      mine.ID,
+		Version: theirs.Version,
+		Fields: []domain.ConflictField{
+			{Name: "name", Base: base.Name, Mine: mine.Name, Theirs: theirs.Name},
+			{Name: "login", Base: base.Login, Mine: mine.Login, Theirs: theirs.Login},
+			{Name: "password", Base: base.Password, Mine: mine.Password, Theirs: theirs.Password},
+			{Name: "meta", Base: base.Meta, Mine: mine.Meta, Theirs: theirs.Meta},
+		},
+	}
+}
+
+func credentialsFromValues(id uuid.UUID, version int64, values []string) domain.Credentials {
+	return domain.Credentials{
+		ID:       id,
+		Name:     values[0],
+		Login:    values[1],
+		Password: values[2],
+		Meta:     values[3],
+		Version:  version,
+	}
+}
+
+func bankCardConflict(base, mine, theirs domain.BankCard) *domain.Conflict {
+	return &domain.Conflict{
+		Kind:    domain.BankCardKind,
+		ID:      mine.ID,
+		Version: theirs.Version,
+		Fields: []domain.ConflictField{
+			{Name: "number", Base: base.Number, Mine: mine.Number, Theirs: theirs.Number},
+			{Name: "valid_thru", Base: base.ValidThru, Mine: mine.ValidThru, Theirs: theirs.ValidThru},
+			{Name: "cvv", Base: base.CVV, Mine: mine.CVV, Theirs: theirs.CVV},
+			{Name: "card_holder", Base: base.CardHolder, Mine: mine.CardHolder, Theirs: theirs.CardHolder},
+			{Name: "meta", Base: base.Meta, Mine: mine.Meta, Theirs: theirs.Meta},
+		},
+	}
+}
+
+func bankCardFromValues(id uuid.UUID, version int64, values []string) domain.BankCard {
+	return domain.BankCard{
+		ID:         id,
+		Number:     values[0],
+		ValidThru:  values[1],
+		CVV:        values[2],
+		CardHolder: values[3],
+		Meta:       values[4],
+		Version:    version,
+	}
+}
+
+func otpConflict(base, mine, theirs domain.OTP) *domain.Conflict {
+	return &domain.Conflict{
+		Kind:    domain.OTPKind,
+		ID:      mine.ID,
+		Version: theirs.Version,
+		Fields: []domain.ConflictField{
+			{Name: "issuer", Base: base.Issuer, Mine: mine.Issuer, Theirs: theirs.Issuer},
+			{Name: "account", Base: base.Account, Mine: mine.Account, Theirs: theirs.Account},
+			{Name: "secret", Base: base.Secret, Mine: mine.Secret, Theirs: theirs.Secret},
+			{Name: "algorithm", Base: base.Algorithm, Mine: mine.Algorithm, Theirs: theirs.Algorithm},
+			{Name: "digits", Base: base.Digits, Mine: mine.Digits, Theirs: theirs.Digits},
+			{Name: "period", Base: base.Period, Mine: mine.Period, Theirs: theirs.Period},
+			{Name: "meta", Base: base.Meta, Mine: mine.Meta, Theirs: theirs.Meta},
+		},
+	}
+}
+
+func otpFromValues(id uuid.UUID, version int64, values []string) domain.OTP {
+	return domain.OTP{
+		ID:        id,
+		Issuer:    values[0],
+		Account:   values[1],
+		Secret:    values[2],
+		Algorithm: values[3],
+		Digits:    values[4],
+		Period:    values[5],
+		Meta:      values[6],
+		Version:   version,
+	}
+}
+
+func sshKeyConflict(base, mine, theirs domain.SSHKey) *domain.Conflict {
+	return &domain.Conflict{
+		Kind:    domain.SSHKeyKind,
+		ID:      mine.ID,
+		Version: theirs.Version,
+		Fields: []domain.ConflictField{
+			{Name: "private_key", Base: base.PrivateKey, Mine: mine.PrivateKey, Theirs: theirs.PrivateKey},
+			{Name: "public_key", Base: base.PublicKey, Mine: mine.PublicKey, Theirs: theirs.PublicKey},
+			{Name: "comment", Base: base.Comment, Mine: mine.Comment, Theirs: theirs.Comment},
+			{Name: "fingerprint", Base: base.Fingerprint, Mine: mine.Fingerprint, Theirs: theirs.Fingerprint},
+			{Name: "meta", Base: base.Meta, Mine: mine.Meta, Theirs: theirs.Meta},
+		},
+	}
+}
+
+func sshKeyFromValues(id uuid.UUID, version int64, values []string) domain.SSHKey {
+	return domain.SSHKey{
+		ID:          id,
+		PrivateKey:  values[0],
+		PublicKey:   values[1],
+		Comment:     values[2],
+		Fingerprint: values[3],
+		Meta:        values[4],
+		Version:     version,
+	}
+}
+
+// itemFieldsValue - Возвращает поля данных по шаблону в виде JSON для сравнения и редактирования в конфликте
+func itemFieldsValue(fields []item.Field) string {
+	if fields == nil {
+		fields = []item.Field{}
+	}
+	// Поля состоят только из строк, поэтому ошибки сериализации быть не может
+	data, _ := json.Marshal(fields)
+
+	return string(data)
+}
+
+func itemConflict(base, mine, theirs domain.Item) *domain.Conflict {
+	return &domain.Conflict{
+		Kind:    domain.ItemKind,
+		ID:      mine.ID,
+		Version: theirs.Version,
+		Fields: []domain.ConflictField{
+			{Name: "name", Base: base.Name, Mine: mine.Name, Theirs: theirs.Name},
+			{Name: "template", Base: base.Template, Mine: mine.Template, Theirs: theirs.Template},
+			{
+				Name:   "fields",
+				Base:   itemFieldsValue(base.Fields),
+				Mine:   itemFieldsValue(mine.Fields),
+				Theirs: itemFieldsValue(theirs.Fields),
+			},
+			{Name: "meta", Base: base.Meta, Mine: mine.Meta, Theirs: theirs.Meta},
+		},
+	}
+}
+
+// itemFromValues - Возвращает данные по шаблону из значений полей конфликта,
+// поля данных передаются в JSON и могут быть отредактированы пользователем
+func itemFromValues(id uuid.UUID, version int64, values []string) (domain.Item, error) {
+	obj := domain.Item{
+		ID:       id,
+		Name:     values[0],
+		Template: values[1],
+		Meta:     values[3],
+		Version:  version,
+	}
+	if err := json.Unmarshal([]byte(values[2]), &obj.Fields); err != nil {
+		return obj, fmt.Errorf("%w: fields must be a JSON list: %w", item.ErrInvalidField, err)
+	}
+
+	return obj, item.Validate(obj.Fields)
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// CopyBankCard - Сценарий копирования реквизита банковской карты из локального хранилища в буфер обмена
+type CopyBankCard struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
+	BankCardRepository domain.BankCardRepositoryInterface
+	// Clipboard - Реализация интерфейса ClipboardInterface
+	Clipboard domain.ClipboardInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, копирует поле field ("number", "cvv", "valid-thru" или "card-holder")
+// и очищает буфер обмена через clearAfter, если clearAfter больше нуля
+func (u CopyBankCard) Do(session domain.Session, cardID uuid.UUID, field string, clearAfter time.Duration) error {
+	if _, err := u.CheckToken.Do(session.Token); err != nil {
+		return err
+	}
+
+	card, err := u.BankCardRepository.Get(session.UserID, cardID)
+	if err != nil {
+		return err
+	}
+
+	var secret string
+	switch field {
+	case "number":
+		secret = card.Number
+	case "cvv":
+		secret = card.CVV
+	case "valid-thru":
+		secret = card.ValidThru
+	case "card-holder":
+		secret = card.CardHolder
+	default:
+		return domain.ErrUnknownField
+	}
+
+	return copySecret(u.Clipboard, secret, clearAfter)
+}
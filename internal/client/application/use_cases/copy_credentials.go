@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// CopyCredentials - Сценарий копирования логина или пароля из локального хранилища в буфер обмена
+type CopyCredentials struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// Clipboard - Реализация интерфейса ClipboardInterface
+	Clipboard domain.ClipboardInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, копирует поле field ("login" или "password")
+// и очищает буфер обмена через clearAfter, если clearAfter больше нуля.
+// Если буфер обмена нельзя очистить автоматически, секрет все равно копируется
+// и возвращается domain.ErrClipboardClearUnsupported
+func (u CopyCredentials) Do(session domain.Session, credID uuid.UUID, field string, clearAfter time.Duration) error {
+	if _, err := u.CheckToken.Do(session.Token); err != nil {
+		return err
+	}
+
+	cred, err := u.CredentialsRepository.Get(session.UserID, credID)
+	if err != nil {
+		return err
+	}
+
+	var secret string
+	switch field {
+	case "login":
+		secret = cred.Login
+	case "password":
+		secret = cred.Password
+	default:
+		return domain.ErrUnknownField
+	}
+
+	return copySecret(u.Clipboard, secret, clearAfter)
+}
+
+// copySecret - Записывает секрет в буфер обмена и планирует его очистку
+func copySecret(clipboard domain.ClipboardInterface, secret string, clearAfter time.Duration) error {
+	if err := clipboard.Write(secret); err != nil {
+		return err
+	}
+	if clearAfter <= 0 {
+		return nil
+	}
+
+	return clipboard.ScheduleClear(secret, clearAfter)
+}
@@ -1,6 +1,9 @@
 package usecases
 
 import (
+	"errors"
+
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
@@ -12,6 +15,8 @@ type CreateBankCard struct {
 	Client domain.GophKeeperClientInterface
 	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
 	BankCardRepository domain.BankCardRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
@@ -20,10 +25,16 @@ type CreateBankCard struct {
 func (u CreateBankCard) Do(
 	session domain.Session,
 	number, validThru, cvv, cardHolder, meta string,
-) error {
+) (uuid.UUID, error) {
+	version := domain.InitialVersion
 	cardID, err := u.Client.CreateBankCard(session, number, validThru, cvv, cardHolder, meta)
+	if errors.Is(err, domain.ErrServerUnavailable) {
+		// Версия данных станет известна после отправки журнала на сервер
+		version = 0
+		cardID, err = journalCreate(u.Journal, session.UserID, domain.BankCardKind)
+	}
 	if err != nil {
-		return err
+		return uuid.Nil, err
 	}
 
 	card := domain.BankCard{
@@ -33,11 +44,12 @@ func (u CreateBankCard) Do(
 		CVV:        cvv,
 		CardHolder: cardHolder,
 		Meta:       meta,
+		Version:    version,
 	}
 
 	if err := u.BankCardRepository.Create(session.UserID, &card); err != nil {
-		return err
+		return uuid.Nil, err
 	}
 
-	return nil
+	return cardID, nil
 }
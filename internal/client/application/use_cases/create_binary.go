@@ -1,6 +1,12 @@
 package usecases
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
@@ -12,25 +18,157 @@ type CreateBinary struct {
 	Client domain.GophKeeperClientInterface
 	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
 	BinaryRepository domain.BinaryRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// UploadRepository - Реализация интерфейса UploadRepositoryInterface
+	UploadRepository domain.UploadRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
-func (u CreateBinary) Do(session domain.Session, content []byte) error {
-	textID, err := u.Client.CreateBinary(session, content)
-	if err != nil {
-		return err
+// fingerprint - Возвращает контрольную сумму SHA-256 содержимого source, если его можно перечитать с начала.
+// По ней находится незавершенная загрузка тех же данных. Для потока, который нельзя перечитать, возвращает пустую строку
+func fingerprint(source io.Reader) (string, error) {
+	seeker, ok := source.(io.ReadSeeker)
+	if !ok {
+		return "", nil
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, seeker); err != nil {
+		return "", err
+	}
+	if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+		return "", err
 	}
 
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// pendingUpload - Возвращает идентификатор незавершенной загрузки того же содержимого, если она есть,
+// и функцию, сохраняющую локально идентификатор новой сессии загрузки.
+// Без контрольной суммы загрузку продолжить нельзя, и она не сохраняется
+func (u CreateBinary) pendingUpload(
+	userID uuid.UUID,
+	fingerprint string,
+	size int64,
+) (uuid.UUID, func(uuid.UUID) error, error) {
+	if fingerprint == "" {
+		return uuid.Nil, nil, nil
+	}
+	resumeID := uuid.Nil
+	pending, err := u.UploadRepository.Get(userID, fingerprint)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return uuid.Nil, nil, err
+	}
+	if err == nil && pending.Size == size {
+		resumeID = pending.ID
+	}
+	started := func(uploadID uuid.UUID) error {
+		return u.UploadRepository.Save(userID, domain.PendingUpload{ID: uploadID, Fingerprint: fingerprint, Size: size})
+	}
+
+	return resumeID, started, nil
+}
+
+// commit - Завершает загрузку частями и удаляет локальную запись о ней. Запись удаляется и если сессия
+// истекла до завершения, так как продолжить ее нельзя, следующая попытка начнет загрузку заново
+func (u CreateBinary) commit(
+	session domain.Session,
+	uploadID uuid.UUID,
+	bin domain.Binary,
+	fingerprint string,
+) (uuid.UUID, error) {
+	binID, err := u.Client.CommitBinaryUpload(session, uploadID, bin)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return uuid.Nil, err
+	}
+	if fingerprint != "" {
+		if deleteErr := u.UploadRepository.Delete(session.UserID, fingerprint); deleteErr != nil {
+			return uuid.Nil, deleteErr
+		}
+	}
+
+	return binID, err
+}
+
+// Do - Вызов логики сценария использования. MIME тип определяется по первым байтам содержимого,
+// контрольная сумма SHA-256 считается по мере чтения source.
+// Данные размером не больше domain.BinaryInlineLimit отправляются одним запросом и сохраняются локально,
+// данные большего размера потоково загружаются частями, локально сохраняются только их метаданные.
+// Загрузка частями требует связи с сервером, прерванная загрузка того же содержимого продолжается с места обрыва,
+// если source можно перечитать. progress вызывается по мере отправки, если он задан
+func (u CreateBinary) Do(
+	session domain.Session,
+	name, meta string,
+	source io.Reader,
+	size int64,
+	progress func(int64),
+) (uuid.UUID, error) {
+	var sum string
+	if size > domain.BinaryInlineLimit {
+		var err error
+		if sum, err = fingerprint(source); err != nil {
+			return uuid.Nil, err
+		}
+	}
+	mimeType, source, err := sniffMimeType(source)
+	if err != nil {
+		return uuid.Nil, err
+	}
 	bin := domain.Binary{
-		ID:      textID,
-		Content: content,
+		Name:     name,
+		MimeType: mimeType,
+		Meta:     meta,
+	}
+
+	if size > domain.BinaryInlineLimit {
+		bin.Size = size
+		bin.Chunked = true
+		bin.SHA256 = sum
+		hash := sha256.New()
+		if sum == "" {
+			source = io.TeeReader(source, hash)
+		}
+		resumeID, started, err := u.pendingUpload(session.UserID, sum, size)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		uploadID, err := u.Client.UploadBinary(session, resumeID, source, size, domain.BinaryChunkSize, started, progress)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if sum == "" {
+			bin.SHA256 = hex.EncodeToString(hash.Sum(nil))
+		}
+		bin.ID, err = u.commit(session, uploadID, bin, sum)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		bin.Version = domain.InitialVersion
+
+		return bin.ID, u.BinaryRepository.Create(session.UserID, bin)
 	}
 
-	if err := u.BinaryRepository.Create(session.UserID, bin); err != nil {
-		return err
+	content, err := io.ReadAll(source)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	bin.Content = content
+	bin.Size = int64(len(content))
+	bin.SHA256 = checksum(content)
+	bin.Version = domain.InitialVersion
+	bin.ID, err = u.Client.CreateBinary(session, bin)
+	if errors.Is(err, domain.ErrServerUnavailable) {
+		// Версия данных станет известна после отправки журнала на сервер
+		bin.Version = 0
+		bin.ID, err = journalCreate(u.Journal, session.UserID, domain.BinaryKind)
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if progress != nil {
+		progress(bin.Size)
 	}
 
-	return nil
+	return bin.ID, u.BinaryRepository.Create(session.UserID, bin)
 }
@@ -1,6 +1,9 @@
 package usecases
 
 import (
+	"errors"
+
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
@@ -12,6 +15,8 @@ type CreateCredentials struct {
 	Client domain.GophKeeperClientInterface
 	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
 	CredentialsRepository domain.CredentialsRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
@@ -20,10 +25,16 @@ type CreateCredentials struct {
 func (u CreateCredentials) Do(
 	session domain.Session,
 	name, login, password, meta string,
-) error {
+) (uuid.UUID, error) {
+	version := domain.InitialVersion
 	credID, err := u.Client.CreateCredentials(session, name, login, password, meta)
+	if errors.Is(err, domain.ErrServerUnavailable) {
+		// Версия данных станет известна после отправки журнала на сервер
+		version = 0
+		credID, err = journalCreate(u.Journal, session.UserID, domain.CredentialsKind)
+	}
 	if err != nil {
-		return err
+		return uuid.Nil, err
 	}
 
 	cred := domain.Credentials{
@@ -32,11 +43,12 @@ func (u CreateCredentials) Do(
 		Login:    login,
 		Password: password,
 		Meta:     meta,
+		Version:  version,
 	}
 
 	if err := u.CredentialsRepository.Create(session.UserID, &cred); err != nil {
-		return err
+		return uuid.Nil, err
 	}
 
-	return nil
+	return credID, nil
 }
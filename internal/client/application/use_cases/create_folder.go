@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// CreateFolder - Сценарий создания новой папки. Папки создаются только при наличии связи с сервером,
+// поэтому локальные папки всегда имеют идентификаторы сервера
+type CreateFolder struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// FolderRepository - Реализация интерфейса FolderRepositoryInterface
+	FolderRepository domain.FolderRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, создает папку по пути path и возвращает ее идентификатор.
+// Если parents истина, создаются недостающие родительские папки, а существующая папка не считается ошибкой
+func (u CreateFolder) Do(session domain.Session, path string, parents bool) (uuid.UUID, error) {
+	names := splitFolderPath(path)
+	if len(names) == 0 {
+		return uuid.Nil, fmt.Errorf("%w: empty folder path", domain.ErrBadRequest)
+	}
+
+	folders, err := u.FolderRepository.GetAll(session.UserID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	parentID := uuid.Nil
+	for i, name := range names {
+		last := i == len(names)-1
+		if existing, ok := findChildFolder(folders, parentID, name); ok {
+			if last && !parents {
+				return uuid.Nil, fmt.Errorf("%w: folder already exists: %s", domain.ErrBadRequest, path)
+			}
+			parentID = existing.ID
+
+			continue
+		}
+		if !last && !parents {
+			return uuid.Nil, fmt.Errorf("%w: %s", domain.ErrFolderNotFound, path)
+		}
+
+		folder := domain.Folder{ParentID: parentID, Name: name}
+		folder.ID, err = u.Client.CreateFolder(session, folder)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if err := u.FolderRepository.Create(session.UserID, folder); err != nil {
+			return uuid.Nil, err
+		}
+		folders = append(folders, folder)
+		parentID = folder.ID
+	}
+
+	return parentID, nil
+}
@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+)
+
+// CreateItem - Сценарий создания новых данных по шаблону
+type CreateItem struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// ItemRepository - Реализация интерфейса ItemRepositoryInterface
+	ItemRepository domain.ItemRepositoryInterface
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface для проверки ссылок на файлы
+	BinaryRepository domain.BinaryRepositoryInterface
+	// TemplateRepository - Реализация интерфейса TemplateRepositoryInterface для поиска пользовательских шаблонов
+	TemplateRepository domain.TemplateRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, поля шаблона template заполняются значениями values,
+// поля extra, которых нет в шаблоне, добавляются после них
+func (u CreateItem) Do(
+	session domain.Session,
+	name, template string,
+	values map[string]string,
+	extra []item.Field,
+	meta string,
+) (uuid.UUID, error) {
+	if name == "" {
+		return uuid.Nil, fmt.Errorf("%w: empty item name", item.ErrInvalidField)
+	}
+	tmpl, err := findTemplate(u.TemplateRepository, session.UserID, template)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	fields, err := tmpl.Build(values, extra)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err = checkFileFields(u.BinaryRepository, session.UserID, fields); err != nil {
+		return uuid.Nil, err
+	}
+	obj := domain.Item{
+		Name:     name,
+		Template: tmpl.Name,
+		Fields:   fields,
+		Meta:     meta,
+	}
+
+	version := domain.InitialVersion
+	itemID, err := u.Client.CreateItem(session, obj)
+	if errors.Is(err, domain.ErrServerUnavailable) {
+		// Версия данных станет известна после отправки журнала на сервер
+		version = 0
+		itemID, err = journalCreate(u.Journal, session.UserID, domain.ItemKind)
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	obj.ID = itemID
+	obj.Version = version
+
+	if err := u.ItemRepository.Create(session.UserID, &obj); err != nil {
+		return uuid.Nil, err
+	}
+
+	return itemID, nil
+}
+
+// checkFileFields - Проверяет, что поля типа file ссылаются на существующие локальные бинарные данные
+func checkFileFields(repo domain.BinaryRepositoryInterface, userID uuid.UUID, fields []item.Field) error {
+	for _, v := range fields {
+		if v.Type != item.TypeFile || v.Value == "" {
+			continue
+		}
+		_, err := repo.Get(userID, uuid.MustParse(v.Value))
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return fmt.Errorf("%w: field %q: binary %s not found", item.ErrInvalidField, v.Name, v.Value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/totp"
+)
+
+// CreateOTP - Сценарий создания нового секрета одноразовых паролей
+type CreateOTP struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// OTPRepository - Реализация интерфейса OTPRepositoryInterface
+	OTPRepository domain.OTPRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, параметры генерации кодов key проверяются до отправки на сервер
+func (u CreateOTP) Do(session domain.Session, key totp.Key, meta string) (uuid.UUID, error) {
+	if err := key.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+	otp := domain.NewOTP(key, meta)
+
+	version := domain.InitialVersion
+	otpID, err := u.Client.CreateOTP(session, otp)
+	if errors.Is(err, domain.ErrServerUnavailable) {
+		// Версия данных станет известна после отправки журнала на сервер
+		version = 0
+		otpID, err = journalCreate(u.Journal, session.UserID, domain.OTPKind)
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	otp.ID = otpID
+	otp.Version = version
+
+	if err := u.OTPRepository.Create(session.UserID, &otp); err != nil {
+		return uuid.Nil, err
+	}
+
+	return otpID, nil
+}
@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/sshkey"
+)
+
+// CreateSSHKey - Сценарий создания нового SSH ключа
+type CreateSSHKey struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// SSHKeyRepository - Реализация интерфейса SSHKeyRepositoryInterface
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, открытый ключ и отпечаток получаются из закрытого ключа privateKey.
+// Парольная фраза passphrase нужна только для защищенных ключей старого формата и не сохраняется
+func (u CreateSSHKey) Do(session domain.Session, privateKey, passphrase, comment, meta string) (uuid.UUID, error) {
+	parsed, err := sshkey.Parse(privateKey, passphrase)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	key := domain.NewSSHKey(privateKey, parsed, comment, meta)
+
+	version := domain.InitialVersion
+	keyID, err := u.Client.CreateSSHKey(session, key)
+	if errors.Is(err, domain.ErrServerUnavailable) {
+		// Версия данных станет известна после отправки журнала на сервер
+		version = 0
+		keyID, err = journalCreate(u.Journal, session.UserID, domain.SSHKeyKind)
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	key.ID = keyID
+	key.Version = version
+
+	if err := u.SSHKeyRepository.Create(session.UserID, &key); err != nil {
+		return uuid.Nil, err
+	}
+
+	return keyID, nil
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+)
+
+// CreateTemplate - Сценарий создания пользовательского шаблона данных. Шаблоны создаются только при наличии связи
+// с сервером, поэтому локальные шаблоны всегда имеют идентификаторы сервера
+type CreateTemplate struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// TemplateRepository - Реализация интерфейса TemplateRepositoryInterface
+	TemplateRepository domain.TemplateRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, имя шаблона не должно совпадать со встроенным или уже созданным шаблоном
+func (u CreateTemplate) Do(
+	session domain.Session,
+	name, description string,
+	fields []item.FieldSpec,
+) (uuid.UUID, error) {
+	if name == "" {
+		return uuid.Nil, fmt.Errorf("%w: empty template name", domain.ErrBadRequest)
+	}
+	_, err := findTemplate(u.TemplateRepository, session.UserID, name)
+	if err == nil {
+		return uuid.Nil, fmt.Errorf("%w: template already exists: %s", domain.ErrBadRequest, name)
+	}
+	if !errors.Is(err, item.ErrUnknownTemplate) {
+		return uuid.Nil, err
+	}
+	if err := item.ValidateSpecs(fields); err != nil {
+		return uuid.Nil, err
+	}
+
+	tmpl := domain.ItemTemplate{Name: name, Description: description, Fields: fields}
+	tmpl.ID, err = u.Client.CreateTemplate(session, tmpl)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err := u.TemplateRepository.Create(session.UserID, tmpl); err != nil {
+		return uuid.Nil, err
+	}
+
+	return tmpl.ID, nil
+}
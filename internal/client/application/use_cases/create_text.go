@@ -1,6 +1,9 @@
 package usecases
 
 import (
+	"errors"
+
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
@@ -12,25 +15,34 @@ type CreateText struct {
 	Client domain.GophKeeperClientInterface
 	// TextRepository - Реализация интерфейса TextRepository
 	TextRepository domain.TextRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
 // Do - Вызов логики сценария использования
-func (u CreateText) Do(session domain.Session, content string) error {
+func (u CreateText) Do(session domain.Session, content string) (uuid.UUID, error) {
+	version := domain.InitialVersion
 	textID, err := u.Client.CreateText(session, content)
+	if errors.Is(err, domain.ErrServerUnavailable) {
+		// Версия данных станет известна после отправки журнала на сервер
+		version = 0
+		textID, err = journalCreate(u.Journal, session.UserID, domain.TextKind)
+	}
 	if err != nil {
-		return err
+		return uuid.Nil, err
 	}
 
 	text := domain.Text{
 		ID:      textID,
 		Content: content,
+		Version: version,
 	}
 
 	if err := u.TextRepository.Create(session.UserID, text); err != nil {
-		return err
+		return uuid.Nil, err
 	}
 
-	return nil
+	return textID, nil
 }
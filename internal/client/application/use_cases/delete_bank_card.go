@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// DeleteBankCard - Сценарий удаления существующей банковской карты
+type DeleteBankCard struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
+	BankCardRepository domain.BankCardRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования
+func (u DeleteBankCard) Do(session domain.Session, cardID uuid.UUID) error {
+	op := domain.Operation{
+		Kind:     domain.BankCardKind,
+		Action:   domain.DeleteAction,
+		EntityID: cardID,
+	}
+	err := sendOrJournal(u.Journal, session.UserID, op, func() error {
+		return u.Client.DeleteBankCard(session, cardID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Локальной копии может не быть, если данные еще не были синхронизированы
+	err = u.BankCardRepository.Delete(session.UserID, cardID)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// DeleteBinary - Сценарий удаления существующих бинарных данных
+type DeleteBinary struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
+	BinaryRepository domain.BinaryRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования
+func (u DeleteBinary) Do(session domain.Session, binID uuid.UUID) error {
+	op := domain.Operation{
+		Kind:     domain.BinaryKind,
+		Action:   domain.DeleteAction,
+		EntityID: binID,
+	}
+	err := sendOrJournal(u.Journal, session.UserID, op, func() error {
+		return u.Client.DeleteBinary(session, binID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Локальной копии может не быть, если данные еще не были синхронизированы
+	err = u.BinaryRepository.Delete(session.UserID, binID)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// DeleteCredentials - Сценарий удаления существующей пары логин и пароль
+type DeleteCredentials struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования
+func (u DeleteCredentials) Do(session domain.Session, credID uuid.UUID) error {
+	op := domain.Operation{
+		Kind:     domain.CredentialsKind,
+		Action:   domain.DeleteAction,
+		EntityID: credID,
+	}
+	err := sendOrJournal(u.Journal, session.UserID, op, func() error {
+		return u.Client.DeleteCredentials(session, credID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Локальной копии может не быть, если данные еще не были синхронизированы
+	err = u.CredentialsRepository.Delete(session.UserID, credID)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// DeleteFolder - Сценарий удаления папки, требует связи с сервером.
+// Вложенные папки и данные переносятся в родительскую папку, как и на сервере
+type DeleteFolder struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// FolderRepository - Реализация интерфейса FolderRepositoryInterface
+	FolderRepository domain.FolderRepositoryInterface
+	// LabelsRepository - Реализация интерфейса LabelsRepositoryInterface
+	LabelsRepository domain.LabelsRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования
+func (u DeleteFolder) Do(session domain.Session, path string) error {
+	folders, err := u.FolderRepository.GetAll(session.UserID)
+	if err != nil {
+		return err
+	}
+	folderID, err := resolveFolderPath(folders, path)
+	if err != nil {
+		return err
+	}
+	if folderID == uuid.Nil {
+		return fmt.Errorf("%w: root folder can not be deleted", domain.ErrBadRequest)
+	}
+
+	if err := u.Client.DeleteFolder(session, folderID); err != nil {
+		return err
+	}
+
+	parentID := uuid.Nil
+	for _, v := range folders {
+		if v.ID == folderID {
+			parentID = v.ParentID
+		}
+	}
+	for _, v := range folders {
+		if v.ParentID != folderID {
+			continue
+		}
+		v.ParentID = parentID
+		if err := u.FolderRepository.Update(session.UserID, v); err != nil {
+			return err
+		}
+	}
+
+	values, err := u.LabelsRepository.GetAll(session.UserID)
+	if err != nil {
+		return err
+	}
+	for _, v := range values {
+		if v.FolderID != folderID {
+			continue
+		}
+		v.FolderID = parentID
+		if err := u.LabelsRepository.Update(session.UserID, v); err != nil {
+			return err
+		}
+	}
+
+	return u.FolderRepository.Delete(session.UserID, folderID)
+}
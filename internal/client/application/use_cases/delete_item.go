@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// DeleteItem - Сценарий удаления существующих данных по шаблону
+type DeleteItem struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// ItemRepository - Реализация интерфейса ItemRepositoryInterface
+	ItemRepository domain.ItemRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования
+func (u DeleteItem) Do(session domain.Session, itemID uuid.UUID) error {
+	op := domain.Operation{
+		Kind:     domain.ItemKind,
+		Action:   domain.DeleteAction,
+		EntityID: itemID,
+	}
+	err := sendOrJournal(u.Journal, session.UserID, op, func() error {
+		return u.Client.DeleteItem(session, itemID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Локальной копии может не быть, если данные еще не были синхронизированы
+	err = u.ItemRepository.Delete(session.UserID, itemID)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return err
+	}
+
+	return nil
+}
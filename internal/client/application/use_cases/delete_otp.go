@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// DeleteOTP - Сценарий удаления существующего секрета одноразовых паролей
+type DeleteOTP struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// OTPRepository - Реализация интерфейса OTPRepositoryInterface
+	OTPRepository domain.OTPRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования
+func (u DeleteOTP) Do(session domain.Session, otpID uuid.UUID) error {
+	op := domain.Operation{
+		Kind:     domain.OTPKind,
+		Action:   domain.DeleteAction,
+		EntityID: otpID,
+	}
+	err := sendOrJournal(u.Journal, session.UserID, op, func() error {
+		return u.Client.DeleteOTP(session, otpID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Локальной копии может не быть, если данные еще не были синхронизированы
+	err = u.OTPRepository.Delete(session.UserID, otpID)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// DeleteSSHKey - Сценарий удаления существующего SSH ключа
+type DeleteSSHKey struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// SSHKeyRepository - Реализация интерфейса SSHKeyRepositoryInterface
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования
+func (u DeleteSSHKey) Do(session domain.Session, keyID uuid.UUID) error {
+	op := domain.Operation{
+		Kind:     domain.SSHKeyKind,
+		Action:   domain.DeleteAction,
+		EntityID: keyID,
+	}
+	err := sendOrJournal(u.Journal, session.UserID, op, func() error {
+		return u.Client.DeleteSSHKey(session, keyID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Локальной копии может не быть, если данные еще не были синхронизированы
+	err = u.SSHKeyRepository.Delete(session.UserID, keyID)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+)
+
+// DeleteTemplate - Сценарий удаления пользовательского шаблона данных, требует связи с сервером.
+// Данные, созданные по шаблону, сохраняют свои поля
+type DeleteTemplate struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// TemplateRepository - Реализация интерфейса TemplateRepositoryInterface
+	TemplateRepository domain.TemplateRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, встроенные шаблоны удалить нельзя
+func (u DeleteTemplate) Do(session domain.Session, name string) error {
+	if _, err := item.FindTemplate(name); err == nil {
+		return fmt.Errorf("%w: built-in template can not be deleted: %s", domain.ErrBadRequest, name)
+	}
+	templates, err := u.TemplateRepository.GetAll(session.UserID)
+	if err != nil {
+		return err
+	}
+	for _, v := range templates {
+		if v.Name != name {
+			continue
+		}
+		if err := u.Client.DeleteTemplate(session, v.ID); err != nil {
+			return err
+		}
+
+		return u.TemplateRepository.Delete(session.UserID, v.ID)
+	}
+
+	return fmt.Errorf("%w: %q", item.ErrUnknownTemplate, name)
+}
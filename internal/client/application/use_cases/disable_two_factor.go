@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// DisableTwoFactor - Сценарий отключения двухфакторной аутентификации
+type DisableTwoFactor struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, отключение подтверждается кодом TOTP или кодом восстановления
+func (u DisableTwoFactor) Do(session domain.Session, code string) error {
+	return u.Client.DisableTwoFactor(session, code)
+}
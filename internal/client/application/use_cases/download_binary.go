@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// DownloadBinary - Сценарий сохранения содержимого бинарных данных
+type DownloadBinary struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
+	BinaryRepository domain.BinaryRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования. Локально сохраненное содержимое записывается в dest сразу,
+// содержимое, которое хранится только на сервере, потоково скачивается с сервера.
+// progress вызывается с количеством записанных байт и полным размером данных, если он задан.
+// Если данных нет в локальном хранилище, возвращает domain.ErrEntityNotFound
+func (u DownloadBinary) Do(
+	session domain.Session,
+	binID uuid.UUID,
+	dest io.Writer,
+	progress func(done, total int64),
+) error {
+	if _, err := u.CheckToken.Do(session.Token); err != nil {
+		return err
+	}
+	bin, err := u.BinaryRepository.Get(session.UserID, binID)
+	if err != nil {
+		return err
+	}
+	if bin.Remote() {
+		var written func(int64)
+		if progress != nil {
+			written = func(done int64) {
+				progress(done, bin.Size)
+			}
+		}
+
+		return u.Client.DownloadBinary(session, bin, dest, written)
+	}
+
+	if _, err = dest.Write(bin.Content); err != nil {
+		return err
+	}
+	if progress != nil {
+		progress(int64(len(bin.Content)), int64(len(bin.Content)))
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// EnrollTwoFactor - Сценарий подключения двухфакторной аутентификации
+type EnrollTwoFactor struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, возвращает секрет и otpauth URI для приложения-аутентификатора
+func (u EnrollTwoFactor) Do(session domain.Session) (string, string, error) {
+	return u.Client.EnrollTwoFactor(session)
+}
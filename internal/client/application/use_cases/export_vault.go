@@ -0,0 +1,371 @@
+package usecases
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/vaultfile"
+)
+
+// backupRecord - Запись резервной копии хранилища. Записи хранятся по одной JSON строке,
+// за записью бинарных данных следуют Size байт содержимого
+type backupRecord struct {
+	Kind        domain.OperationKind `json:"kind"`
+	Content     string               `json:"content,omitempty"`
+	Name        string               `json:"name,omitempty"`
+	MimeType    string               `json:"mime_type,omitempty"`
+	Size        int64                `json:"size,omitempty"`
+	SHA256      string               `json:"sha256,omitempty"`
+	Login       string               `json:"login,omitempty"`
+	Password    string               `json:"password,omitempty"`
+	Number      string               `json:"number,omitempty"`
+	ValidThru   string               `json:"valid_thru,omitempty"`
+	CVV         string               `json:"cvv,omitempty"`
+	CardHolder  string               `json:"card_holder,omitempty"`
+	Issuer      string               `json:"issuer,omitempty"`
+	Account     string               `json:"account,omitempty"`
+	Secret      string               `json:"secret,omitempty"`
+	Algorithm   string               `json:"algorithm,omitempty"`
+	Digits      string               `json:"digits,omitempty"`
+	Period      string               `json:"period,omitempty"`
+	PrivateKey  string               `json:"private_key,omitempty"`
+	PublicKey   string               `json:"public_key,omitempty"`
+	Comment     string               `json:"comment,omitempty"`
+	Fingerprint string               `json:"fingerprint,omitempty"`
+	Template    string               `json:"template,omitempty"`
+	Fields      []item.Field         `json:"fields,omitempty"`
+	Meta        string               `json:"meta,omitempty"`
+	Folder      string               `json:"folder,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+}
+
+// fingerprint - Возвращает значения, по которым запись считается дубликатом уже существующих данных.
+// Метаданные не учитываются, у данных по шаблону не учитываются ссылки на файлы,
+// так как бинарные данные при импорте получают новые идентификаторы
+func (r backupRecord) fingerprint() string {
+	switch r.Kind {
+	case domain.TextKind:
+		return fmt.Sprintf("%s\x00%s", r.Kind, r.Content)
+	case domain.BinaryKind:
+		return fmt.Sprintf("%s\x00%s\x00%s", r.Kind, r.Name, r.SHA256)
+	case domain.CredentialsKind:
+		return fmt.Sprintf("%s\x00%s\x00%s\x00%s", r.Kind, r.Name, r.Login, r.Password)
+	case domain.OTPKind:
+		return fmt.Sprintf("%s\x00%s\x00%s\x00%s", r.Kind, r.Issuer, r.Account, r.Secret)
+	case domain.SSHKeyKind:
+		return fmt.Sprintf("%s\x00%s", r.Kind, r.Fingerprint)
+	case domain.ItemKind:
+		fields := make([]item.Field, 0, len(r.Fields))
+		for _, v := range r.Fields {
+			if v.Type == item.TypeFile {
+				v.Value = ""
+			}
+			fields = append(fields, v)
+		}
+
+		return fmt.Sprintf("%s\x00%s\x00%s\x00%s", r.Kind, r.Name, r.Template, itemFieldsValue(fields))
+	default:
+		return fmt.Sprintf("%s\x00%s\x00%s", r.Kind, r.Number, r.ValidThru)
+	}
+}
+
+// binaryRecord - Возвращает запись метаданных бинарных данных. Для локальных данных размер и
+// контрольная сумма считаются по содержимому, у данных старого формата контрольной суммы может не быть
+func binaryRecord(bin domain.Binary) backupRecord {
+	record := backupRecord{
+		Kind:     domain.BinaryKind,
+		Name:     bin.Name,
+		MimeType: bin.MimeType,
+		Size:     bin.Size,
+		SHA256:   bin.SHA256,
+		Meta:     bin.Meta,
+	}
+	if !bin.Remote() {
+		record.Size = int64(len(bin.Content))
+		record.SHA256 = checksum(bin.Content)
+	}
+
+	return record
+}
+
+// otpRecord - Возвращает запись секрета одноразовых паролей
+func otpRecord(otp domain.OTP) backupRecord {
+	return backupRecord{
+		Kind:      domain.OTPKind,
+		Issuer:    otp.Issuer,
+		Account:   otp.Account,
+		Secret:    otp.Secret,
+		Algorithm: otp.Algorithm,
+		Digits:    otp.Digits,
+		Period:    otp.Period,
+		Meta:      otp.Meta,
+	}
+}
+
+// sshKeyRecord - Возвращает запись SSH ключа
+func sshKeyRecord(key domain.SSHKey) backupRecord {
+	return backupRecord{
+		Kind:        domain.SSHKeyKind,
+		PrivateKey:  key.PrivateKey,
+		PublicKey:   key.PublicKey,
+		Comment:     key.Comment,
+		Fingerprint: key.Fingerprint,
+		Meta:        key.Meta,
+	}
+}
+
+// itemRecord - Возвращает запись данных по шаблону
+func itemRecord(obj domain.Item) backupRecord {
+	return backupRecord{
+		Kind:     domain.ItemKind,
+		Name:     obj.Name,
+		Template: obj.Template,
+		Fields:   obj.Fields,
+		Meta:     obj.Meta,
+	}
+}
+
+// backupLabels - Папки и метки локальных данных для записей резервной копии
+type backupLabels struct {
+	folders []domain.Folder
+	tags    []domain.Tag
+	labels  map[uuid.UUID]domain.Labels
+}
+
+// loadBackupLabels - Загружает папки и метки всех локальных данных пользователя
+func loadBackupLabels(
+	userID uuid.UUID,
+	folderRepository domain.FolderRepositoryInterface,
+	tagRepository domain.TagRepositoryInterface,
+	labelsRepository domain.LabelsRepositoryInterface,
+) (backupLabels, error) {
+	result := backupLabels{labels: map[uuid.UUID]domain.Labels{}}
+	var err error
+
+	result.folders, err = folderRepository.GetAll(userID)
+	if err != nil {
+		return result, err
+	}
+	result.tags, err = tagRepository.GetAll(userID)
+	if err != nil {
+		return result, err
+	}
+	values, err := labelsRepository.GetAll(userID)
+	if err != nil {
+		return result, err
+	}
+	for _, v := range values {
+		result.labels[v.EntityID] = v
+	}
+
+	return result, nil
+}
+
+// apply - Дополняет запись путем папки и именами меток данных entityID.
+// Папка хранится путем, так как при импорте папки и метки создаются заново
+func (l backupLabels) apply(record backupRecord, entityID uuid.UUID) backupRecord {
+	v, ok := l.labels[entityID]
+	if !ok {
+		return record
+	}
+	record.Folder = folderPath(l.folders, v.FolderID)
+	if names := tagNames(l.tags, v.TagIDs); len(names) != 0 {
+		record.Tags = names
+	}
+
+	return record
+}
+
+// countingWriter - Считает количество записанных байт
+type countingWriter struct {
+	dest    io.Writer
+	written int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.dest.Write(p)
+	w.written += int64(n)
+
+	return n, err
+}
+
+// ExportVault - Сценарий экспорта всех локальных данных в зашифрованную резервную копию.
+// Содержимое бинарных данных, которое хранится только на сервере, потоково скачивается в файл
+type ExportVault struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// TextRepository - Реализация интерфейса TextRepositoryInterface
+	TextRepository domain.TextRepositoryInterface
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
+	BinaryRepository domain.BinaryRepositoryInterface
+	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
+	BankCardRepository domain.BankCardRepositoryInterface
+	// OTPRepository - Реализация интерфейса OTPRepositoryInterface
+	OTPRepository domain.OTPRepositoryInterface
+	// SSHKeyRepository - Реализация интерфейса SSHKeyRepositoryInterface
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// ItemRepository - Реализация интерфейса ItemRepositoryInterface
+	ItemRepository domain.ItemRepositoryInterface
+	// FolderRepository - Реализация интерфейса FolderRepositoryInterface
+	FolderRepository domain.FolderRepositoryInterface
+	// TagRepository - Реализация интерфейса TagRepositoryInterface
+	TagRepository domain.TagRepositoryInterface
+	// LabelsRepository - Реализация интерфейса LabelsRepositoryInterface
+	LabelsRepository domain.LabelsRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, записывает резервную копию, зашифрованную ключом
+// из парольной фразы passphrase, в dest и возвращает количество экспортированных данных
+func (u ExportVault) Do(session domain.Session, passphrase string, dest io.Writer) (domain.VaultItemsCount, error) {
+	count := domain.VaultItemsCount{}
+	if _, err := u.CheckToken.Do(session.Token); err != nil {
+		return count, err
+	}
+
+	texts, err := u.TextRepository.GetAll(session.UserID)
+	if err != nil {
+		return count, err
+	}
+	bins, err := u.BinaryRepository.GetAll(session.UserID)
+	if err != nil {
+		return count, err
+	}
+	creds, err := u.CredentialsRepository.GetAll(session.UserID)
+	if err != nil {
+		return count, err
+	}
+	cards, err := u.BankCardRepository.GetAll(session.UserID)
+	if err != nil {
+		return count, err
+	}
+	otps, err := u.OTPRepository.GetAll(session.UserID)
+	if err != nil {
+		return count, err
+	}
+	sshKeys, err := u.SSHKeyRepository.GetAll(session.UserID)
+	if err != nil {
+		return count, err
+	}
+	items, err := u.ItemRepository.GetAll(session.UserID)
+	if err != nil {
+		return count, err
+	}
+	labels, err := loadBackupLabels(session.UserID, u.FolderRepository, u.TagRepository, u.LabelsRepository)
+	if err != nil {
+		return count, err
+	}
+
+	file, err := vaultfile.NewWriter(dest, passphrase)
+	if err != nil {
+		return count, err
+	}
+	out := bufio.NewWriter(file)
+	encoder := json.NewEncoder(out)
+
+	for _, v := range texts {
+		if err = encoder.Encode(labels.apply(backupRecord{Kind: domain.TextKind, Content: v.Content}, v.ID)); err != nil {
+			return count, err
+		}
+		count.Texts++
+	}
+	for _, v := range bins {
+		if err = u.exportBinary(session, encoder, out, labels.apply(binaryRecord(v), v.ID), v); err != nil {
+			return count, err
+		}
+		count.Binaries++
+	}
+	for _, v := range creds {
+		err = encoder.Encode(labels.apply(backupRecord{
+			Kind:     domain.CredentialsKind,
+			Name:     v.Name,
+			Login:    v.Login,
+			Password: v.Password,
+			Meta:     v.Meta,
+		}, v.ID))
+		if err != nil {
+			return count, err
+		}
+		count.Credentials++
+	}
+	for _, v := range cards {
+		err = encoder.Encode(labels.apply(backupRecord{
+			Kind:       domain.BankCardKind,
+			Number:     v.Number,
+			ValidThru:  v.ValidThru,
+			CVV:        v.CVV,
+			CardHolder: v.CardHolder,
+			Meta:       v.Meta,
+		}, v.ID))
+		if err != nil {
+			return count, err
+		}
+		count.BankCards++
+	}
+	for _, v := range otps {
+		if err = encoder.Encode(labels.apply(otpRecord(v), v.ID)); err != nil {
+			return count, err
+		}
+		count.OTPs++
+	}
+	for _, v := range sshKeys {
+		if err = encoder.Encode(labels.apply(sshKeyRecord(v), v.ID)); err != nil {
+			return count, err
+		}
+		count.SSHKeys++
+	}
+	for _, v := range items {
+		if err = encoder.Encode(labels.apply(itemRecord(v), v.ID)); err != nil {
+			return count, err
+		}
+		count.Items++
+	}
+
+	if err = out.Flush(); err != nil {
+		return count, err
+	}
+
+	return count, file.Close()
+}
+
+// exportBinary - Записывает запись метаданных record и содержимое бинарных данных
+func (u ExportVault) exportBinary(
+	session domain.Session,
+	encoder *json.Encoder,
+	out io.Writer,
+	record backupRecord,
+	bin domain.Binary,
+) error {
+	size := record.Size
+	err := encoder.Encode(record)
+	if err != nil {
+		return err
+	}
+	if !bin.Remote() {
+		_, err = out.Write(bin.Content)
+
+		return err
+	}
+
+	content := &countingWriter{dest: out}
+	if err = u.Client.DownloadBinary(session, bin, content, nil); err != nil {
+		return err
+	}
+	if content.written != size {
+		return fmt.Errorf("binary %s: downloaded %d bytes, expected %d: %w", bin.ID, content.written, size, domain.ErrInvalidBackupRecord)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// FilterLabels - Сценарий отбора данных по папке и меткам
+type FilterLabels struct {
+	// FolderRepository - Реализация интерфейса FolderRepositoryInterface
+	FolderRepository domain.FolderRepositoryInterface
+	// TagRepository - Реализация интерфейса TagRepositoryInterface
+	TagRepository domain.TagRepositoryInterface
+	// LabelsRepository - Реализация интерфейса LabelsRepositoryInterface
+	LabelsRepository domain.LabelsRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, возвращает идентификаторы данных, которые лежат в папке folder
+// или во вложенных в нее папках и имеют все метки tags.
+// Если отбор не задан, возвращает nil: подходят любые данные
+func (u FilterLabels) Do(session domain.Session, folder string, tags []string) (map[uuid.UUID]bool, error) {
+	folders, err := u.FolderRepository.GetAll(session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	folderID, err := resolveFolderPath(folders, folder)
+	if err != nil {
+		return nil, err
+	}
+	tags = slices.DeleteFunc(slices.Clone(tags), func(v string) bool { return v == "" })
+	if folderID == uuid.Nil && len(tags) == 0 {
+		return nil, nil
+	}
+
+	matched := map[uuid.UUID]bool{}
+	known, err := u.TagRepository.GetAll(session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	tagIDs := []uuid.UUID{}
+	for _, name := range tags {
+		idx := slices.IndexFunc(known, func(v domain.Tag) bool { return v.Name == name })
+		if idx < 0 {
+			// Данных с несуществующей меткой нет
+			return matched, nil
+		}
+		tagIDs = append(tagIDs, known[idx].ID)
+	}
+
+	subtree := folderSubtree(folders, folderID)
+	values, err := u.LabelsRepository.GetAll(session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range values {
+		if folderID != uuid.Nil && !subtree[v.FolderID] {
+			continue
+		}
+		if !slices.ContainsFunc(tagIDs, func(id uuid.UUID) bool { return !slices.Contains(v.TagIDs, id) }) {
+			matched[v.EntityID] = true
+		}
+	}
+
+	return matched, nil
+}
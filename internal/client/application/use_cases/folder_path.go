@@ -0,0 +1,102 @@
+package usecases
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// folderPathSeparator - Разделитель имен папок в пути
+const folderPathSeparator = "/"
+
+// splitFolderPath - Разбивает путь папки на имена, пустой путь и "/" обозначают корень хранилища
+func splitFolderPath(path string) []string {
+	names := []string{}
+	for _, v := range strings.Split(path, folderPathSeparator) {
+		if v != "" {
+			names = append(names, v)
+		}
+	}
+
+	return names
+}
+
+// findChildFolder - Ищет папку с именем name среди вложенных папок parentID
+func findChildFolder(folders []domain.Folder, parentID uuid.UUID, name string) (domain.Folder, bool) {
+	for _, v := range folders {
+		if v.ParentID == parentID && v.Name == name {
+			return v, true
+		}
+	}
+
+	return domain.Folder{}, false
+}
+
+// resolveFolderPath - Возвращает идентификатор папки по пути, uuid.Nil для корня хранилища
+func resolveFolderPath(folders []domain.Folder, path string) (uuid.UUID, error) {
+	folderID := uuid.Nil
+	for _, name := range splitFolderPath(path) {
+		folder, ok := findChildFolder(folders, folderID, name)
+		if !ok {
+			return uuid.Nil, fmt.Errorf("%w: %s", domain.ErrFolderNotFound, path)
+		}
+		folderID = folder.ID
+	}
+
+	return folderID, nil
+}
+
+// folderPath - Возвращает полный путь папки, пустую строку для корня хранилища
+func folderPath(folders []domain.Folder, folderID uuid.UUID) string {
+	byID := make(map[uuid.UUID]domain.Folder, len(folders))
+	for _, v := range folders {
+		byID[v.ID] = v
+	}
+
+	names := []string{}
+	// Ограничение числа шагов защищает от зацикленной иерархии в поврежденных локальных данных
+	for folderID != uuid.Nil && len(names) <= len(folders) {
+		folder, ok := byID[folderID]
+		if !ok {
+			break
+		}
+		names = append(names, folder.Name)
+		folderID = folder.ParentID
+	}
+	slices.Reverse(names)
+
+	return strings.Join(names, folderPathSeparator)
+}
+
+// folderSubtree - Возвращает идентификаторы папки и всех вложенных в нее папок
+func folderSubtree(folders []domain.Folder, folderID uuid.UUID) map[uuid.UUID]bool {
+	subtree := map[uuid.UUID]bool{folderID: true}
+	for added := true; added; {
+		added = false
+		for _, v := range folders {
+			if subtree[v.ParentID] && !subtree[v.ID] {
+				subtree[v.ID] = true
+				added = true
+			}
+		}
+	}
+
+	return subtree
+}
+
+// tagNames - Возвращает имена меток по идентификаторам, неизвестные метки пропускаются
+func tagNames(tags []domain.Tag, tagIDs []uuid.UUID) []string {
+	names := []string{}
+	for _, v := range tags {
+		if slices.Contains(tagIDs, v.ID) {
+			names = append(names, v.Name)
+		}
+	}
+	slices.Sort(names)
+
+	return names
+}
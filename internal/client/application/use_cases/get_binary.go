@@ -0,0 +1,28 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// GetBinary - Сценарий получения локальных метаданных бинарных данных по идентификатору
+type GetBinary struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
+	BinaryRepository domain.BinaryRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования.
+// Если данных нет в локальном хранилище, возвращает domain.ErrEntityNotFound
+func (u GetBinary) Do(session domain.Session, binID uuid.UUID) (domain.Binary, error) {
+	if _, err := u.CheckToken.Do(session.Token); err != nil {
+		return domain.Binary{}, err
+	}
+
+	return u.BinaryRepository.Get(session.UserID, binID)
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// GetCredentials - Сценарий получения пары логин и пароль по идентификатору
+type GetCredentials struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования. Если remote истинно, актуальная копия запрашивается с сервера
+// и сохраняется локально, кроме случая, когда у данных есть неотправленные изменения.
+// Если данных нет, возвращает domain.ErrEntityNotFound
+func (u GetCredentials) Do(session domain.Session, credID uuid.UUID, remote bool) (domain.Credentials, error) {
+	if _, err := u.CheckToken.Do(session.Token); err != nil {
+		return domain.Credentials{}, err
+	}
+	if !remote {
+		return u.CredentialsRepository.Get(session.UserID, credID)
+	}
+
+	cred, err := u.Client.GetCredentials(session, credID)
+	if err != nil {
+		return cred, err
+	}
+	pending, err := hasPendingOperations(u.Journal, session.UserID, credID)
+	if err != nil {
+		return cred, err
+	}
+	if !pending {
+		err = u.CredentialsRepository.ApplyChanges(session.UserID, []domain.Credentials{cred}, nil)
+		if err != nil {
+			return cred, err
+		}
+	}
+
+	return cred, nil
+}
@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/client/importers"
+)
+
+// ImportItems - Сценарий импорта данных из файла экспорта другого менеджера паролей.
+// Данные создаются через существующие сценарии создания не чаще одного запроса за заданный интервал,
+// данные, совпадающие с локальными, пропускаются, как и данные, отклоненные сервером как некорректные
+type ImportItems struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// CreateText - Сценарий создания текста
+	CreateText *CreateText
+	// CreateCredentials - Сценарий создания логина и пароля
+	CreateCredentials *CreateCredentials
+	// CreateBankCard - Сценарий создания банковской карты
+	CreateBankCard *CreateBankCard
+	// TextRepository - Реализация интерфейса TextRepositoryInterface
+	TextRepository domain.TextRepositoryInterface
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
+	BinaryRepository domain.BinaryRepositoryInterface
+	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
+	BankCardRepository domain.BankCardRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, создает разобранные данные items с интервалом interval
+// между запросами и возвращает количество созданных и пропущенных как дубликаты данных,
+// а также записи, отклоненные сервером. При ошибке возвращается количество данных, созданных до нее
+func (u ImportItems) Do(
+	session domain.Session,
+	items importers.Result,
+	interval time.Duration,
+) (created, skipped domain.VaultItemsCount, rejected []importers.Skipped, err error) {
+	if _, err = u.CheckToken.Do(session.Token); err != nil {
+		return created, skipped, rejected, err
+	}
+	seen, err := existingFingerprints(
+		session.UserID,
+		u.TextRepository,
+		u.BinaryRepository,
+		u.CredentialsRepository,
+		u.BankCardRepository,
+	)
+	if err != nil {
+		return created, skipped, rejected, err
+	}
+
+	// Первый запрос выполняется сразу, следующие ждут очередного тика
+	var limiter <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		limiter = ticker.C
+	}
+	first := true
+	create := func(record backupRecord, name string, do func() error) error {
+		fingerprint := record.fingerprint()
+		if seen[fingerprint] {
+			skipped.Add(record.Kind)
+
+			return nil
+		}
+		if !first && limiter != nil {
+			<-limiter
+		}
+		first = false
+		if err := do(); err != nil {
+			// Некорректная запись не прерывает импорт остальных
+			if errors.Is(err, domain.ErrBadRequest) {
+				rejected = append(rejected, importers.Skipped{Name: name, Reason: "rejected by the server: " + err.Error()})
+
+				return nil
+			}
+
+			return err
+		}
+		seen[fingerprint] = true
+		created.Add(record.Kind)
+
+		return nil
+	}
+
+	for _, v := range items.Texts {
+		name, _, _ := strings.Cut(v.Content, "\n")
+		err = create(backupRecord{Kind: domain.TextKind, Content: v.Content}, name, func() error {
+			_, err := u.CreateText.Do(session, v.Content)
+
+			return err
+		})
+		if err != nil {
+			return created, skipped, rejected, err
+		}
+	}
+	for _, v := range items.Credentials {
+		record := backupRecord{Kind: domain.CredentialsKind, Name: v.Name, Login: v.Login, Password: v.Password}
+		err = create(record, v.Name, func() error {
+			_, err := u.CreateCredentials.Do(session, v.Name, v.Login, v.Password, v.Meta)
+
+			return err
+		})
+		if err != nil {
+			return created, skipped, rejected, err
+		}
+	}
+	for _, v := range items.BankCards {
+		record := backupRecord{Kind: domain.BankCardKind, Number: v.Number, ValidThru: v.ValidThru}
+		name, _, _ := strings.Cut(v.Meta, "\n")
+		err = create(record, name, func() error {
+			_, err := u.CreateBankCard.Do(session, v.Number, v.ValidThru, v.CVV, v.CardHolder, v.Meta)
+
+			return err
+		})
+		if err != nil {
+			return created, skipped, rejected, err
+		}
+	}
+
+	return created, skipped, rejected, nil
+}
@@ -0,0 +1,323 @@
+package usecases
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/sshkey"
+	"github.com/Nickolasll/goph-keeper/internal/vaultfile"
+)
+
+// ImportVault - Сценарий восстановления данных из зашифрованной резервной копии.
+// Данные создаются заново через существующие сценарии создания, поэтому получают новые идентификаторы.
+// Данные, совпадающие с локальными или с уже импортированными, пропускаются, поэтому импорт можно повторить
+type ImportVault struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// CreateText - Сценарий создания текста
+	CreateText *CreateText
+	// CreateBinary - Сценарий создания бинарных данных
+	CreateBinary *CreateBinary
+	// CreateCredentials - Сценарий создания логина и пароля
+	CreateCredentials *CreateCredentials
+	// CreateBankCard - Сценарий создания банковской карты
+	CreateBankCard *CreateBankCard
+	// CreateOTP - Сценарий создания секрета одноразовых паролей
+	CreateOTP *CreateOTP
+	// CreateSSHKey - Сценарий создания SSH ключа
+	CreateSSHKey *CreateSSHKey
+	// CreateItem - Сценарий создания данных по шаблону
+	CreateItem *CreateItem
+	// CreateFolder - Сценарий создания папки
+	CreateFolder *CreateFolder
+	// SaveLabels - Сценарий перемещения данных в папку и назначения им меток
+	SaveLabels *SaveLabels
+	// TextRepository - Реализация интерфейса TextRepositoryInterface
+	TextRepository domain.TextRepositoryInterface
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
+	BinaryRepository domain.BinaryRepositoryInterface
+	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
+	BankCardRepository domain.BankCardRepositoryInterface
+	// OTPRepository - Реализация интерфейса OTPRepositoryInterface
+	OTPRepository domain.OTPRepositoryInterface
+	// SSHKeyRepository - Реализация интерфейса SSHKeyRepositoryInterface
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// ItemRepository - Реализация интерфейса ItemRepositoryInterface
+	ItemRepository domain.ItemRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// existingFingerprints - Возвращает отпечатки всех локальных данных для поиска дубликатов
+func existingFingerprints(
+	userID uuid.UUID,
+	textRepository domain.TextRepositoryInterface,
+	binaryRepository domain.BinaryRepositoryInterface,
+	credentialsRepository domain.CredentialsRepositoryInterface,
+	bankCardRepository domain.BankCardRepositoryInterface,
+) (map[string]bool, error) {
+	seen := map[string]bool{}
+
+	texts, err := textRepository.GetAll(userID)
+	if err != nil {
+		return seen, err
+	}
+	for _, v := range texts {
+		seen[backupRecord{Kind: domain.TextKind, Content: v.Content}.fingerprint()] = true
+	}
+
+	bins, err := binaryRepository.GetAll(userID)
+	if err != nil {
+		return seen, err
+	}
+	for _, v := range bins {
+		seen[binaryRecord(v).fingerprint()] = true
+	}
+
+	creds, err := credentialsRepository.GetAll(userID)
+	if err != nil {
+		return seen, err
+	}
+	for _, v := range creds {
+		record := backupRecord{Kind: domain.CredentialsKind, Name: v.Name, Login: v.Login, Password: v.Password}
+		seen[record.fingerprint()] = true
+	}
+
+	cards, err := bankCardRepository.GetAll(userID)
+	if err != nil {
+		return seen, err
+	}
+	for _, v := range cards {
+		seen[backupRecord{Kind: domain.BankCardKind, Number: v.Number, ValidThru: v.ValidThru}.fingerprint()] = true
+	}
+
+	return seen, nil
+}
+
+// Do - Вызов логики сценария использования, расшифровывает резервную копию из source ключом из парольной
+// фразы passphrase и возвращает количество созданных и пропущенных как дубликаты данных.
+// Неверная парольная фраза обнаруживается до создания данных
+func (u ImportVault) Do(
+	session domain.Session,
+	passphrase string,
+	source io.Reader,
+) (created, skipped domain.VaultItemsCount, err error) {
+	if _, err = u.CheckToken.Do(session.Token); err != nil {
+		return created, skipped, err
+	}
+	seen, err := existingFingerprints(
+		session.UserID,
+		u.TextRepository,
+		u.BinaryRepository,
+		u.CredentialsRepository,
+		u.BankCardRepository,
+	)
+	if err != nil {
+		return created, skipped, err
+	}
+	otps, err := u.OTPRepository.GetAll(session.UserID)
+	if err != nil {
+		return created, skipped, err
+	}
+	for _, v := range otps {
+		seen[otpRecord(v).fingerprint()] = true
+	}
+	sshKeys, err := u.SSHKeyRepository.GetAll(session.UserID)
+	if err != nil {
+		return created, skipped, err
+	}
+	for _, v := range sshKeys {
+		seen[sshKeyRecord(v).fingerprint()] = true
+	}
+	items, err := u.ItemRepository.GetAll(session.UserID)
+	if err != nil {
+		return created, skipped, err
+	}
+	for _, v := range items {
+		seen[itemRecord(v).fingerprint()] = true
+	}
+
+	file, err := vaultfile.NewReader(source, passphrase)
+	if err != nil {
+		return created, skipped, err
+	}
+	in := bufio.NewReader(file)
+
+	for {
+		line, readErr := in.ReadBytes('\n')
+		if errors.Is(readErr, io.EOF) {
+			if len(bytes.TrimSpace(line)) == 0 {
+				return created, skipped, nil
+			}
+		} else if readErr != nil {
+			return created, skipped, readErr
+		}
+		record := backupRecord{}
+		if err = json.Unmarshal(line, &record); err != nil {
+			return created, skipped, fmt.Errorf("%w: %w", domain.ErrInvalidBackupRecord, err)
+		}
+
+		duplicate := seen[record.fingerprint()]
+		var entityID uuid.UUID
+		switch record.Kind {
+		case domain.TextKind:
+			if !duplicate {
+				entityID, err = u.CreateText.Do(session, record.Content)
+			}
+		case domain.BinaryKind:
+			entityID, err = u.importBinary(session, record, in, duplicate)
+		case domain.CredentialsKind:
+			if !duplicate {
+				entityID, err = u.CreateCredentials.Do(session, record.Name, record.Login, record.Password, record.Meta)
+			}
+		case domain.BankCardKind:
+			if !duplicate {
+				entityID, err = u.CreateBankCard.Do(session, record.Number, record.ValidThru, record.CVV, record.CardHolder, record.Meta)
+			}
+		case domain.OTPKind:
+			if !duplicate {
+				entityID, err = u.importOTP(session, record)
+			}
+		case domain.SSHKeyKind:
+			if !duplicate {
+				entityID, err = u.importSSHKey(session, record)
+			}
+		case domain.ItemKind:
+			if !duplicate {
+				entityID, err = u.importItem(session, record)
+			}
+		default:
+			return created, skipped, fmt.Errorf("%w: unknown kind %q", domain.ErrInvalidBackupRecord, record.Kind)
+		}
+		if err == nil && !duplicate {
+			err = u.importLabels(session, record, entityID)
+		}
+		if err != nil {
+			return created, skipped, err
+		}
+
+		seen[record.fingerprint()] = true
+		if duplicate {
+			skipped.Add(record.Kind)
+		} else {
+			created.Add(record.Kind)
+		}
+	}
+}
+
+// importLabels - Восстанавливает папку и метки созданных данных, недостающие папки создаются
+func (u ImportVault) importLabels(session domain.Session, record backupRecord, entityID uuid.UUID) error {
+	if record.Folder != "" {
+		if _, err := u.CreateFolder.Do(session, record.Folder, true); err != nil {
+			return err
+		}
+	}
+
+	return u.SaveLabels.Do(session, record.Kind, entityID, record.Folder, record.Tags)
+}
+
+// importOTP - Создает секрет одноразовых паролей, запись с неверными параметрами генерации кодов отклоняется
+func (u ImportVault) importOTP(session domain.Session, record backupRecord) (uuid.UUID, error) {
+	otp := domain.OTP{
+		Issuer:    record.Issuer,
+		Account:   record.Account,
+		Secret:    record.Secret,
+		Algorithm: record.Algorithm,
+		Digits:    record.Digits,
+		Period:    record.Period,
+	}
+	key, err := otp.Key()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %w", domain.ErrInvalidBackupRecord, err)
+	}
+
+	return u.CreateOTP.Do(session, key, record.Meta)
+}
+
+// importSSHKey - Создает SSH ключ, запись с закрытым ключом, который не удается разобрать без парольной фразы, отклоняется
+func (u ImportVault) importSSHKey(session domain.Session, record backupRecord) (uuid.UUID, error) {
+	keyID, err := u.CreateSSHKey.Do(session, record.PrivateKey, "", record.Comment, record.Meta)
+	if errors.Is(err, sshkey.ErrInvalidKey) || errors.Is(err, sshkey.ErrPassphraseRequired) {
+		return keyID, fmt.Errorf("%w: %w", domain.ErrInvalidBackupRecord, err)
+	}
+
+	return keyID, err
+}
+
+// importItem - Создает данные по шаблону, запись с неизвестным шаблоном или неверными полями отклоняется.
+// Ссылки на файлы, которых нет в локальном хранилище, очищаются
+func (u ImportVault) importItem(session domain.Session, record backupRecord) (uuid.UUID, error) {
+	tmpl, err := findTemplate(u.CreateItem.TemplateRepository, session.UserID, record.Template)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %w", domain.ErrInvalidBackupRecord, err)
+	}
+	values := map[string]string{}
+	extra := []item.Field{}
+	for _, v := range record.Fields {
+		if v.Type == item.TypeFile && v.Value != "" {
+			if id, err := uuid.Parse(v.Value); err == nil {
+				_, err = u.BinaryRepository.Get(session.UserID, id)
+				if errors.Is(err, domain.ErrEntityNotFound) {
+					v.Value = ""
+				} else if err != nil {
+					return uuid.Nil, err
+				}
+			}
+		}
+		if slices.ContainsFunc(tmpl.Fields, func(spec item.FieldSpec) bool { return spec.Name == v.Name }) {
+			values[v.Name] = v.Value
+		} else {
+			extra = append(extra, v)
+		}
+	}
+
+	itemID, err := u.CreateItem.Do(session, record.Name, tmpl.Name, values, extra, record.Meta)
+	if errors.Is(err, item.ErrInvalidField) {
+		return itemID, fmt.Errorf("%w: %w", domain.ErrInvalidBackupRecord, err)
+	}
+
+	return itemID, err
+}
+
+// importBinary - Создает бинарные данные из содержимого, которое следует за записью, или пропускает его
+func (u ImportVault) importBinary(
+	session domain.Session,
+	record backupRecord,
+	in io.Reader,
+	duplicate bool,
+) (uuid.UUID, error) {
+	if record.Size < 0 {
+		return uuid.Nil, domain.ErrInvalidBackupRecord
+	}
+	content := io.LimitReader(in, record.Size)
+	binID := uuid.Nil
+	if !duplicate {
+		var err error
+		binID, err = u.CreateBinary.Do(session, record.Name, record.Meta, content, record.Size, nil)
+		if err != nil {
+			return binID, err
+		}
+	}
+	// Дочитываем содержимое, если оно было пропущено или прочитано не полностью
+	rest, err := io.Copy(io.Discard, content)
+	if err != nil {
+		return binID, err
+	}
+	if !duplicate && rest != 0 {
+		return binID, domain.ErrInvalidBackupRecord
+	}
+
+	return binID, nil
+}
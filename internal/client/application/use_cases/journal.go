@@ -0,0 +1,144 @@
+package usecases
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// hasPendingOperations - Проверяет, есть ли в журнале неотправленные операции над данными
+func hasPendingOperations(
+	journal domain.JournalRepositoryInterface,
+	userID, entityID uuid.UUID,
+) (bool, error) {
+	ops, err := journal.GetAll(userID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, op := range ops {
+		if op.EntityID == entityID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// checkNoPendingOperations - Возвращает ErrPendingOperations, если в журнале есть неотправленные операции.
+// Перезапись локальных данных с сервера уничтожила бы изменения, сделанные без связи с сервером
+func checkNoPendingOperations(journal domain.JournalRepositoryInterface, userID uuid.UUID) error {
+	ops, err := journal.GetAll(userID)
+	if err != nil {
+		return err
+	}
+
+	if len(ops) != 0 {
+		return domain.ErrPendingOperations
+	}
+
+	return nil
+}
+
+// journalCreate - Выдает локальный идентификатор данным, созданным без связи с сервером,
+// и откладывает их создание в журнал
+func journalCreate(
+	journal domain.JournalRepositoryInterface,
+	userID uuid.UUID,
+	kind domain.OperationKind,
+) (uuid.UUID, error) {
+	entityID := uuid.New()
+	op := domain.Operation{
+		Kind:     kind,
+		Action:   domain.CreateAction,
+		EntityID: entityID,
+	}
+
+	return entityID, journal.Append(userID, op)
+}
+
+// updateOperation - Возвращает операцию обновления данных с версией и значениями данных до обновления
+func updateOperation(
+	kind domain.OperationKind,
+	entityID uuid.UUID,
+	baseVersion int64,
+	base any,
+) (domain.Operation, error) {
+	raw, err := json.Marshal(base)
+	if err != nil {
+		return domain.Operation{}, err
+	}
+
+	return domain.Operation{
+		Kind:        kind,
+		Action:      domain.UpdateAction,
+		EntityID:    entityID,
+		BaseVersion: baseVersion,
+		Base:        raw,
+	}, nil
+}
+
+// operationBase - Возвращает данные до обновления, записанные в операции
+func operationBase[T any](op domain.Operation) (T, error) {
+	var base T
+	err := json.Unmarshal(op.Base, &base)
+
+	return base, err
+}
+
+// hasPendingChange - Проверяет, есть ли в журнале создание или обновление данных.
+// При отправке такой операции на сервер уходит текущее локальное состояние данных
+func hasPendingChange(
+	journal domain.JournalRepositoryInterface,
+	userID uuid.UUID,
+	kind domain.OperationKind,
+	entityID uuid.UUID,
+) (bool, error) {
+	ops, err := journal.GetAll(userID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, op := range ops {
+		if op.EntityID == entityID && op.Kind == kind && op.Action != domain.DeleteAction {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// sendOrJournal - Отправляет изменение на сервер, если сервер доступен и по данным нет отложенных операций.
+// Иначе откладывает изменение в журнал, чтобы не нарушить порядок операций.
+// Повторное обновление не добавляется в журнал, если там уже есть создание или обновление данных:
+// в журнале остается операция с версией, от которой начаты изменения без связи с сервером
+func sendOrJournal(
+	journal domain.JournalRepositoryInterface,
+	userID uuid.UUID,
+	op domain.Operation,
+	send func() error,
+) error {
+	pending, err := hasPendingOperations(journal, userID, op.EntityID)
+	if err != nil {
+		return err
+	}
+
+	if !pending {
+		err = send()
+		if !errors.Is(err, domain.ErrServerUnavailable) {
+			return err
+		}
+	}
+
+	if op.Action == domain.UpdateAction {
+		changed, err := hasPendingChange(journal, userID, op.Kind, op.EntityID)
+		if err != nil || changed {
+			return err
+		}
+	}
+
+	return journal.Append(userID, op)
+}
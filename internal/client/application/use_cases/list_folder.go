@@ -0,0 +1,140 @@
+package usecases
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// ListFolder - Сценарий просмотра содержимого папки по локальным данным
+type ListFolder struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// FolderRepository - Реализация интерфейса FolderRepositoryInterface
+	FolderRepository domain.FolderRepositoryInterface
+	// TagRepository - Реализация интерфейса TagRepositoryInterface
+	TagRepository domain.TagRepositoryInterface
+	// LabelsRepository - Реализация интерфейса LabelsRepositoryInterface
+	LabelsRepository domain.LabelsRepositoryInterface
+	// TextRepository - Реализация интерфейса TextRepositoryInterface
+	TextRepository domain.TextRepositoryInterface
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
+	BinaryRepository domain.BinaryRepositoryInterface
+	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
+	BankCardRepository domain.BankCardRepositoryInterface
+	// OTPRepository - Реализация интерфейса OTPRepositoryInterface
+	OTPRepository domain.OTPRepositoryInterface
+	// SSHKeyRepository - Реализация интерфейса SSHKeyRepositoryInterface
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// ItemRepository - Реализация интерфейса ItemRepositoryInterface
+	ItemRepository domain.ItemRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, возвращает вложенные папки и данные папки path.
+// Данные без сохраненной папки лежат в корне хранилища
+func (u ListFolder) Do(session domain.Session, path string) (domain.FolderContent, error) {
+	content := domain.FolderContent{Folders: []domain.Folder{}, Entries: []domain.FolderEntry{}}
+	_, err := u.CheckToken.Do(session.Token)
+	if err != nil {
+		return content, err
+	}
+
+	folders, err := u.FolderRepository.GetAll(session.UserID)
+	if err != nil {
+		return content, err
+	}
+	folderID, err := resolveFolderPath(folders, path)
+	if err != nil {
+		return content, err
+	}
+	content.Path = folderPath(folders, folderID)
+	for _, v := range folders {
+		if v.ParentID == folderID {
+			content.Folders = append(content.Folders, v)
+		}
+	}
+	slices.SortFunc(content.Folders, func(a, b domain.Folder) int { return strings.Compare(a.Name, b.Name) })
+
+	tags, err := u.TagRepository.GetAll(session.UserID)
+	if err != nil {
+		return content, err
+	}
+	values, err := u.LabelsRepository.GetAll(session.UserID)
+	if err != nil {
+		return content, err
+	}
+	labels := make(map[uuid.UUID]domain.Labels, len(values))
+	for _, v := range values {
+		labels[v.EntityID] = v
+	}
+	add := func(kind domain.OperationKind, id uuid.UUID, title string) {
+		if labels[id].FolderID == folderID {
+			content.Entries = append(content.Entries, domain.FolderEntry{
+				Kind:  kind,
+				ID:    id,
+				Title: title,
+				Tags:  tagNames(tags, labels[id].TagIDs),
+			})
+		}
+	}
+
+	texts, err := u.TextRepository.GetAll(session.UserID)
+	if err != nil {
+		return content, err
+	}
+	for _, v := range texts {
+		add(domain.TextKind, v.ID, textTitle(v.Content))
+	}
+	bins, err := u.BinaryRepository.GetAll(session.UserID)
+	if err != nil {
+		return content, err
+	}
+	for _, v := range bins {
+		add(domain.BinaryKind, v.ID, v.Name)
+	}
+	creds, err := u.CredentialsRepository.GetAll(session.UserID)
+	if err != nil {
+		return content, err
+	}
+	for _, v := range creds {
+		add(domain.CredentialsKind, v.ID, v.Name)
+	}
+	cards, err := u.BankCardRepository.GetAll(session.UserID)
+	if err != nil {
+		return content, err
+	}
+	for _, v := range cards {
+		add(domain.BankCardKind, v.ID, v.CardHolder)
+	}
+	otps, err := u.OTPRepository.GetAll(session.UserID)
+	if err != nil {
+		return content, err
+	}
+	for _, v := range otps {
+		add(domain.OTPKind, v.ID, v.Title())
+	}
+	sshKeys, err := u.SSHKeyRepository.GetAll(session.UserID)
+	if err != nil {
+		return content, err
+	}
+	for _, v := range sshKeys {
+		add(domain.SSHKeyKind, v.ID, v.Title())
+	}
+	items, err := u.ItemRepository.GetAll(session.UserID)
+	if err != nil {
+		return content, err
+	}
+	for _, v := range items {
+		add(domain.ItemKind, v.ID, v.Name)
+	}
+
+	return content, nil
+}
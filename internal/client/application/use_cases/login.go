@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"errors"
+
 	"github.com/sirupsen/logrus"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
@@ -18,18 +20,39 @@ type Login struct {
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
-func (u Login) Do(login, password string) (domain.Session, error) {
+// Do - Вызов логики сценария использования.
+// Для пользователей со сквозным шифрованием ключ хранилища выводится из мастер-пароля.
+// Если у пользователя включена двухфакторная аутентификация, код второго фактора запрашивается через prompt
+func (u Login) Do(login, password string, prompt func() (string, error)) (domain.Session, error) {
 	var session domain.Session
-	token, err := u.Client.Login(login, password)
+	var vaultKey []byte
+	e2e, salt, err := u.Client.PreLogin(login)
+	if err != nil {
+		return session, err
+	}
+	if e2e {
+		password, vaultKey = deriveVaultKey(password, salt)
+	}
+
+	token, refreshToken, err := u.Client.Login(login, password)
+	var twoFactorRequired *domain.TwoFactorRequiredError
+	if errors.As(err, &twoFactorRequired) {
+		var code string
+		code, err = prompt()
+		if err != nil {
+			return session, err
+		}
+		token, refreshToken, err = u.Client.TwoFactorLogin(twoFactorRequired.Challenge, code)
+	}
 	if err != nil {
 		return session, err
 	}
 
-	session, err = u.CheckToken.getSessionFromToken(token)
+	session, err = u.CheckToken.getSessionFromToken(token, refreshToken)
 	if err != nil {
 		return session, err
 	}
+	session.VaultKey = vaultKey
 
 	if err := u.SessionRepository.Save(session); err != nil {
 		return session, err
@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// MoveFolder - Сценарий переименования или перемещения папки, требует связи с сервером
+type MoveFolder struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// FolderRepository - Реализация интерфейса FolderRepositoryInterface
+	FolderRepository domain.FolderRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования. Если папка target существует, папка source перемещается в нее,
+// иначе source перемещается в родительскую папку target и получает последнее имя из пути target
+func (u MoveFolder) Do(session domain.Session, source, target string) error {
+	folders, err := u.FolderRepository.GetAll(session.UserID)
+	if err != nil {
+		return err
+	}
+	sourceID, err := resolveFolderPath(folders, source)
+	if err != nil {
+		return err
+	}
+	if sourceID == uuid.Nil {
+		return fmt.Errorf("%w: root folder can not be moved", domain.ErrBadRequest)
+	}
+	folder := folders[slices.IndexFunc(folders, func(v domain.Folder) bool { return v.ID == sourceID })]
+
+	targetID, err := resolveFolderPath(folders, target)
+	if err == nil {
+		folder.ParentID = targetID
+	} else if errors.Is(err, domain.ErrFolderNotFound) {
+		names := splitFolderPath(target)
+		folder.ParentID, err = resolveFolderPath(folders, strings.Join(names[:len(names)-1], folderPathSeparator))
+		if err != nil {
+			return err
+		}
+		folder.Name = names[len(names)-1]
+	} else {
+		return err
+	}
+
+	if folderSubtree(folders, folder.ID)[folder.ParentID] {
+		return domain.ErrFolderCycle
+	}
+	if existing, ok := findChildFolder(folders, folder.ParentID, folder.Name); ok && existing.ID != folder.ID {
+		return fmt.Errorf("%w: folder already exists: %s", domain.ErrBadRequest, target)
+	}
+
+	if err := u.Client.UpdateFolder(session, folder); err != nil {
+		return err
+	}
+
+	return u.FolderRepository.Update(session.UserID, folder)
+}
@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// OTPCode - Сценарий генерации текущего одноразового пароля по локальному секрету, не требует связи с сервером
+type OTPCode struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// OTPRepository - Реализация интерфейса OTPRepositoryInterface
+	OTPRepository domain.OTPRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, возвращает секрет, код для момента времени now
+// и время, оставшееся до смены кода
+func (u OTPCode) Do(
+	session domain.Session,
+	otpID uuid.UUID,
+	now time.Time,
+) (otp domain.OTP, code string, remaining time.Duration, err error) {
+	if _, err = u.CheckToken.Do(session.Token); err != nil {
+		return otp, code, remaining, err
+	}
+
+	otp, err = u.OTPRepository.Get(session.UserID, otpID)
+	if err != nil {
+		return otp, code, remaining, err
+	}
+	key, err := otp.Key()
+	if err != nil {
+		return otp, code, remaining, err
+	}
+	code, err = key.Code(now)
+	if err != nil {
+		return otp, code, remaining, err
+	}
+
+	return otp, code, key.Remaining(now), nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
 )
 
 // Registration - Сценарий регистрации по логину и паролю
@@ -18,18 +19,31 @@ type Registration struct {
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
-func (u Registration) Do(login, password string) (domain.Session, error) {
+// Do - Вызов логики сценария использования.
+// Если e2e истинно, ключ хранилища выводится из мастер-пароля со случайной солью, соль сохраняется на сервере,
+// а данные шифруются на клиенте
+func (u Registration) Do(login, password string, e2e bool) (domain.Session, error) {
 	var session domain.Session
-	token, err := u.Client.Register(login, password)
+	var vaultKey, salt []byte
+	if e2e {
+		var err error
+		salt, err = crypto.NewSalt()
+		if err != nil {
+			return session, err
+		}
+		password, vaultKey = deriveVaultKey(password, salt)
+	}
+
+	token, refreshToken, err := u.Client.Register(login, password, e2e, salt)
 	if err != nil {
 		return session, err
 	}
 
-	session, err = u.CheckToken.getSessionFromToken(token)
+	session, err = u.CheckToken.getSessionFromToken(token, refreshToken)
 	if err != nil {
 		return session, err
 	}
+	session.VaultKey = vaultKey
 
 	if err := u.SessionRepository.Save(session); err != nil {
 		return session, err
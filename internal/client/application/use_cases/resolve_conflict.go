@@ -0,0 +1,267 @@
+package usecases
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/sshkey"
+)
+
+// ResolveConflict - Сценарий разрешения конфликта версий, возникшего при обновлении данных
+type ResolveConflict struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// TextRepository - Реализация интерфейса TextRepositoryInterface
+	TextRepository domain.TextRepositoryInterface
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
+	BinaryRepository domain.BinaryRepositoryInterface
+	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
+	BankCardRepository domain.BankCardRepositoryInterface
+	// OTPRepository - Реализация интерфейса OTPRepositoryInterface
+	OTPRepository domain.OTPRepositoryInterface
+	// SSHKeyRepository - Реализация интерфейса SSHKeyRepositoryInterface
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// ItemRepository - Реализация интерфейса ItemRepositoryInterface
+	ItemRepository domain.ItemRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Сохраняет выбранные пользователем значения полей поверх актуальной версии данных на сервере.
+// values - итоговые значения полей в порядке conflict.Fields.
+// Если итоговые значения совпадают с серверными, данные только сохраняются локально.
+// Если данные снова были изменены на другом устройстве, возвращает новый *domain.Conflict.
+// Выбранные значения заменяют отложенное в журнале обновление данных, поэтому оно удаляется из журнала
+func (u ResolveConflict) Do(session domain.Session, conflict *domain.Conflict, values []string) error {
+	if len(values) != len(conflict.Fields) {
+		return domain.ErrBadRequest
+	}
+	theirs := theirsValues(conflict)
+	send := !slices.Equal(values, theirs)
+
+	var err error
+	switch conflict.Kind {
+	case domain.TextKind:
+		err = u.resolveText(session, conflict, values, send)
+	case domain.BinaryKind:
+		err = u.resolveBinary(session, conflict, values, send)
+	case domain.CredentialsKind:
+		err = u.resolveCredentials(session, conflict, values, send)
+	case domain.BankCardKind:
+		err = u.resolveBankCard(session, conflict, values, send)
+	case domain.OTPKind:
+		err = u.resolveOTP(session, conflict, values, send)
+	case domain.SSHKeyKind:
+		err = u.resolveSSHKey(session, conflict, values, send)
+	case domain.ItemKind:
+		err = u.resolveItem(session, conflict, values, send)
+	default:
+		return domain.ErrBadRequest
+	}
+	if err != nil {
+		return err
+	}
+
+	return u.dropPendingUpdates(session.UserID, conflict)
+}
+
+// dropPendingUpdates - Удаляет из журнала обновления данных, по которым разрешен конфликт
+func (u ResolveConflict) dropPendingUpdates(userID uuid.UUID, conflict *domain.Conflict) error {
+	ops, err := u.Journal.GetAll(userID)
+	if err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		if op.EntityID != conflict.ID || op.Kind != conflict.Kind || op.Action != domain.UpdateAction {
+			continue
+		}
+		if err := u.Journal.Delete(userID, op.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u ResolveConflict) resolveText(
+	session domain.Session,
+	conflict *domain.Conflict,
+	values []string,
+	send bool,
+) error {
+	text := textFromValues(conflict.ID, conflict.Version, values)
+	if send {
+		updated, err := u.Client.UpdateText(session, text)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base := textFromValues(conflict.ID, conflict.Version, theirsValues(conflict))
+
+			return textConflict(base, text, updated)
+		}
+		if err != nil {
+			return err
+		}
+		text.Version = updated.Version
+	}
+
+	return u.TextRepository.Update(session.UserID, text)
+}
+
+func (u ResolveConflict) resolveBinary(
+	session domain.Session,
+	conflict *domain.Conflict,
+	values []string,
+	send bool,
+) error {
+	bin := binaryFromValues(conflict.ID, conflict.Version, values)
+	if send {
+		updated, err := u.Client.UpdateBinary(session, bin)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base := binaryFromValues(conflict.ID, conflict.Version, theirsValues(conflict))
+
+			return binaryConflict(base, bin, updated)
+		}
+		if err != nil {
+			return err
+		}
+		bin.Version = updated.Version
+	}
+
+	return u.BinaryRepository.Update(session.UserID, bin)
+}
+
+func (u ResolveConflict) resolveCredentials(
+	session domain.Session,
+	conflict *domain.Conflict,
+	values []string,
+	send bool,
+) error {
+	cred := credentialsFromValues(conflict.ID, conflict.Version, values)
+	if send {
+		updated, err := u.Client.UpdateCredentials(session, &cred)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base := credentialsFromValues(conflict.ID, conflict.Version, theirsValues(conflict))
+
+			return credentialsConflict(base, cred, *updated)
+		}
+		if err != nil {
+			return err
+		}
+		cred.Version = updated.Version
+	}
+
+	return u.CredentialsRepository.Update(session.UserID, &cred)
+}
+
+func (u ResolveConflict) resolveBankCard(
+	session domain.Session,
+	conflict *domain.Conflict,
+	values []string,
+	send bool,
+) error {
+	card := bankCardFromValues(conflict.ID, conflict.Version, values)
+	if send {
+		updated, err := u.Client.UpdateBankCard(session, &card)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base := bankCardFromValues(conflict.ID, conflict.Version, theirsValues(conflict))
+
+			return bankCardConflict(base, card, *updated)
+		}
+		if err != nil {
+			return err
+		}
+		card.Version = updated.Version
+	}
+
+	return u.BankCardRepository.Update(session.UserID, &card)
+}
+
+func (u ResolveConflict) resolveOTP(
+	session domain.Session,
+	conflict *domain.Conflict,
+	values []string,
+	send bool,
+) error {
+	otp := otpFromValues(conflict.ID, conflict.Version, values)
+	if _, err := otp.Key(); err != nil {
+		return err
+	}
+	if send {
+		updated, err := u.Client.UpdateOTP(session, &otp)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base := otpFromValues(conflict.ID, conflict.Version, theirsValues(conflict))
+
+			return otpConflict(base, otp, *updated)
+		}
+		if err != nil {
+			return err
+		}
+		otp.Version = updated.Version
+	}
+
+	return u.OTPRepository.Update(session.UserID, &otp)
+}
+
+func (u ResolveConflict) resolveSSHKey(
+	session domain.Session,
+	conflict *domain.Conflict,
+	values []string,
+	send bool,
+) error {
+	key := sshKeyFromValues(conflict.ID, conflict.Version, values)
+	// Открытый ключ и отпечаток должны соответствовать выбранному закрытому ключу,
+	// защищенный ключ старого формата без парольной фразы не разобрать, тогда значения остаются выбранными
+	if parsed, err := sshkey.Parse(key.PrivateKey, ""); err == nil {
+		key.PublicKey = parsed.PublicKey
+		key.Fingerprint = parsed.Fingerprint
+	}
+	if send {
+		updated, err := u.Client.UpdateSSHKey(session, &key)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base := sshKeyFromValues(conflict.ID, conflict.Version, theirsValues(conflict))
+
+			return sshKeyConflict(base, key, *updated)
+		}
+		if err != nil {
+			return err
+		}
+		key.Version = updated.Version
+	}
+
+	return u.SSHKeyRepository.Update(session.UserID, &key)
+}
+
+func (u ResolveConflict) resolveItem(
+	session domain.Session,
+	conflict *domain.Conflict,
+	values []string,
+	send bool,
+) error {
+	obj, err := itemFromValues(conflict.ID, conflict.Version, values)
+	if err != nil {
+		return err
+	}
+	if send {
+		updated, err := u.Client.UpdateItem(session, &obj)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			// Серверные значения получены из разобранных данных, поэтому всегда разбираются
+			base, _ := itemFromValues(conflict.ID, conflict.Version, theirsValues(conflict))
+
+			return itemConflict(base, obj, *updated)
+		}
+		if err != nil {
+			return err
+		}
+		obj.Version = updated.Version
+	}
+
+	return u.ItemRepository.Update(session.UserID, &obj)
+}
@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// SaveLabels - Сценарий перемещения данных в папку и назначения им меток.
+// Папка должна существовать, отсутствующие метки создаются на сервере
+type SaveLabels struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// FolderRepository - Реализация интерфейса FolderRepositoryInterface
+	FolderRepository domain.FolderRepositoryInterface
+	// TagRepository - Реализация интерфейса TagRepositoryInterface
+	TagRepository domain.TagRepositoryInterface
+	// LabelsRepository - Реализация интерфейса LabelsRepositoryInterface
+	LabelsRepository domain.LabelsRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования. Пустой folder оставляет папку без изменений, "/" переносит
+// данные в корень хранилища. Метки tags заменяют текущие, nil оставляет их без изменений, пустые имена
+// пропускаются, поэтому единственное пустое имя снимает все метки
+func (u SaveLabels) Do(
+	session domain.Session,
+	kind domain.OperationKind,
+	entityID uuid.UUID,
+	folder string,
+	tags []string,
+) error {
+	if folder == "" && tags == nil {
+		return nil
+	}
+
+	labels, err := u.LabelsRepository.Get(session.UserID, entityID)
+	if errors.Is(err, domain.ErrEntityNotFound) {
+		labels = domain.Labels{EntityID: entityID, TagIDs: []uuid.UUID{}}
+	} else if err != nil {
+		return err
+	}
+	labels.Kind = kind
+
+	if folder != "" {
+		folders, err := u.FolderRepository.GetAll(session.UserID)
+		if err != nil {
+			return err
+		}
+		labels.FolderID, err = resolveFolderPath(folders, folder)
+		if err != nil {
+			return err
+		}
+	}
+
+	if tags != nil {
+		labels.TagIDs, err = u.resolveTags(session, tags)
+		if err != nil {
+			return err
+		}
+	}
+
+	op := domain.Operation{
+		Kind:     domain.LabelsKind,
+		Action:   domain.UpdateAction,
+		EntityID: entityID,
+	}
+	err = sendOrJournal(u.Journal, session.UserID, op, func() error {
+		return u.Client.SaveLabels(session, labels)
+	})
+	if err != nil {
+		return err
+	}
+
+	return u.LabelsRepository.Create(session.UserID, labels)
+}
+
+// resolveTags - Возвращает идентификаторы меток по именам, отсутствующие метки создаются на сервере.
+// Создание меток требует связи с сервером
+func (u SaveLabels) resolveTags(session domain.Session, names []string) ([]uuid.UUID, error) {
+	tags, err := u.TagRepository.GetAll(session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs := []uuid.UUID{}
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		idx := slices.IndexFunc(tags, func(v domain.Tag) bool { return v.Name == name })
+		if idx >= 0 {
+			if !slices.Contains(tagIDs, tags[idx].ID) {
+				tagIDs = append(tagIDs, tags[idx].ID)
+			}
+
+			continue
+		}
+
+		tag := domain.Tag{Name: name}
+		tag.ID, err = u.Client.CreateTag(session, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.TagRepository.Create(session.UserID, tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	return tagIDs, nil
+}
@@ -0,0 +1,167 @@
+package usecases
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/fuzzy"
+)
+
+// titleLength - Максимальная длина краткого описания текстовых данных в символах
+const titleLength = 40
+
+// searchField - Поле данных, по которому выполняется поиск
+type searchField struct {
+	name  string
+	value string
+}
+
+// Search - Сценарий нечеткого поиска по расшифрованным локальным данным.
+// Пароли, номера карт и CVV в поиске не участвуют, расшифрованные данные не записываются на диск
+type Search struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// TextRepository - Реализация интерфейса TextRepositoryInterface
+	TextRepository domain.TextRepositoryInterface
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
+	BinaryRepository domain.BinaryRepositoryInterface
+	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
+	BankCardRepository domain.BankCardRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, возвращает данные видов kinds, совпавшие с запросом,
+// в порядке убывания оценки. Если kinds пуст, поиск выполняется по всем видам данных.
+// Запрос разбивается на слова, каждое слово должно совпасть хотя бы с одним полем данных
+func (u Search) Do(session domain.Session, query string, kinds []domain.OperationKind) ([]domain.SearchResult, error) {
+	results := []domain.SearchResult{}
+	_, err := u.CheckToken.Do(session.Token)
+	if err != nil {
+		return results, err
+	}
+
+	words := strings.Fields(query)
+	if len(words) == 0 {
+		return results, nil
+	}
+	want := func(kind domain.OperationKind) bool {
+		return len(kinds) == 0 || slices.Contains(kinds, kind)
+	}
+	add := func(kind domain.OperationKind, id uuid.UUID, title string, fields ...searchField) {
+		if result, ok := match(words, fields); ok {
+			result.Kind = kind
+			result.ID = id
+			result.Title = title
+			results = append(results, result)
+		}
+	}
+
+	if want(domain.TextKind) {
+		texts, err := u.TextRepository.GetAll(session.UserID)
+		if err != nil {
+			return results, err
+		}
+		for _, v := range texts {
+			add(domain.TextKind, v.ID, textTitle(v.Content), searchField{"content", v.Content})
+		}
+	}
+
+	if want(domain.BinaryKind) {
+		bins, err := u.BinaryRepository.GetAll(session.UserID)
+		if err != nil {
+			return results, err
+		}
+		for _, v := range bins {
+			add(
+				domain.BinaryKind, v.ID, v.Name,
+				searchField{"name", v.Name},
+				searchField{"meta", v.Meta},
+				searchField{"mime_type", v.MimeType},
+			)
+		}
+	}
+
+	if want(domain.CredentialsKind) {
+		creds, err := u.CredentialsRepository.GetAll(session.UserID)
+		if err != nil {
+			return results, err
+		}
+		for _, v := range creds {
+			add(
+				domain.CredentialsKind, v.ID, v.Name,
+				searchField{"name", v.Name},
+				searchField{"login", v.Login},
+				searchField{"meta", v.Meta},
+			)
+		}
+	}
+
+	if want(domain.BankCardKind) {
+		cards, err := u.BankCardRepository.GetAll(session.UserID)
+		if err != nil {
+			return results, err
+		}
+		for _, v := range cards {
+			add(
+				domain.BankCardKind, v.ID, v.CardHolder,
+				searchField{"card_holder", v.CardHolder},
+				searchField{"meta", v.Meta},
+			)
+		}
+	}
+
+	slices.SortStableFunc(results, func(a, b domain.SearchResult) int {
+		if a.Score != b.Score {
+			return b.Score - a.Score
+		}
+
+		return strings.Compare(a.Title, b.Title)
+	})
+
+	return results, nil
+}
+
+// match - Сопоставляет слова запроса с полями данных. Оценка данных равна сумме лучших оценок слов,
+// полем результата становится поле с наибольшей оценкой среди всех слов
+func match(words []string, fields []searchField) (domain.SearchResult, bool) {
+	result := domain.SearchResult{}
+	top := 0
+	for _, word := range words {
+		best := 0
+		for _, field := range fields {
+			score, ok := fuzzy.Score(word, field.value)
+			if !ok || score <= best {
+				continue
+			}
+			best = score
+			if score > top {
+				top = score
+				result.Field = field.name
+			}
+		}
+		if best == 0 {
+			return result, false
+		}
+		result.Score += best
+	}
+
+	return result, true
+}
+
+// textTitle - Возвращает первую строку текста, обрезанную до titleLength символов
+func textTitle(content string) string {
+	line, _, _ := strings.Cut(content, "\n")
+	runes := []rune(line)
+	if len(runes) > titleLength {
+		return string(runes[:titleLength]) + "..."
+	}
+
+	return line
+}
@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// ShowItems - Сценарий получения всех локальных расшифрованных данных по шаблону
+type ShowItems struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// ItemRepository - Реализация интерфейса ItemRepositoryInterface
+	ItemRepository domain.ItemRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования
+func (u ShowItems) Do(session domain.Session) ([]domain.Item, error) {
+	result := []domain.Item{}
+	_, err := u.CheckToken.Do(session.Token)
+	if err != nil {
+		return result, err
+	}
+
+	result, err = u.ItemRepository.GetAll(session.UserID)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// ShowOTPs - Сценарий получения всех локальных расшифрованных секретов одноразовых паролей
+type ShowOTPs struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// OTPRepository - Реализация интерфейса OTPRepositoryInterface
+	OTPRepository domain.OTPRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования
+func (u ShowOTPs) Do(session domain.Session) ([]domain.OTP, error) {
+	result := []domain.OTP{}
+	_, err := u.CheckToken.Do(session.Token)
+	if err != nil {
+		return result, err
+	}
+
+	result, err = u.OTPRepository.GetAll(session.UserID)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
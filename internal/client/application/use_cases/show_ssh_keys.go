@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// ShowSSHKeys - Сценарий получения всех локальных расшифрованных SSH ключей
+type ShowSSHKeys struct {
+	// CheckToken - Сценарий проверки JWT, возвращает UserID в формате строки
+	CheckToken *CheckToken
+	// SSHKeyRepository - Реализация интерфейса SSHKeyRepositoryInterface
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования
+func (u ShowSSHKeys) Do(session domain.Session) ([]domain.SSHKey, error) {
+	result := []domain.SSHKey{}
+	_, err := u.CheckToken.Do(session.Token)
+	if err != nil {
+		return result, err
+	}
+
+	result, err = u.SSHKeyRepository.GetAll(session.UserID)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
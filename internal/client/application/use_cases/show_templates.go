@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+)
+
+// ShowTemplates - Сценарий получения встроенных и пользовательских шаблонов данных
+type ShowTemplates struct {
+	// TemplateRepository - Реализация интерфейса TemplateRepositoryInterface
+	TemplateRepository domain.TemplateRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, встроенные шаблоны выводятся первыми
+func (u ShowTemplates) Do(session domain.Session) ([]item.Template, error) {
+	result := slices.Clone(item.Templates())
+	templates, err := u.TemplateRepository.GetAll(session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range templates {
+		result = append(result, v.Template())
+	}
+
+	return result, nil
+}
+
+// findTemplate - Возвращает шаблон по имени: сначала среди встроенных, затем среди пользовательских шаблонов
+func findTemplate(repo domain.TemplateRepositoryInterface, userID uuid.UUID, name string) (item.Template, error) {
+	tmpl, err := item.FindTemplate(name)
+	if err == nil {
+		return tmpl, nil
+	}
+	templates, err := repo.GetAll(userID)
+	if err != nil {
+		return item.Template{}, err
+	}
+	for _, v := range templates {
+		if v.Name == name {
+			return v.Template(), nil
+		}
+	}
+
+	return item.Template{}, fmt.Errorf("%w: %q", item.ErrUnknownTemplate, name)
+}
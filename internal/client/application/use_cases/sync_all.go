@@ -1,58 +1,199 @@
 package usecases
 
 import (
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
 )
 
 // SyncAll - Сценарий синхронизации всех пользовательских данных
+// При первой синхронизации локальные данные полностью заменяются данными с сервера,
+// при последующих применяются только изменения после последней полученной ревизии
 type SyncAll struct {
 	// Client - Реализация интерфейса GophKeeperClient
 	Client domain.GophKeeperClientInterface
 	// UnitOfWork - Реализация интерфейса UnitOfWorkInterface
 	UnitOfWork domain.UnitOfWorkInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
+// Do - Вызов логики сценария использования.
+// Изменения загружаются страницами, каждая страница сохраняется в отдельной транзакции вместе с ревизией,
+// поэтому прерванная синхронизация продолжается с последней сохраненной страницы
 func (u SyncAll) Do(session domain.Session) error {
-	texts, bankCards, binaries, credentials, err := u.Client.GetAll(session)
+	if err := checkNoPendingOperations(u.Journal, session.UserID); err != nil {
+		return err
+	}
+
+	since, err := u.UnitOfWork.RevisionRepository().Get(session.UserID)
 	if err != nil {
 		return err
 	}
 
-	err = u.UnitOfWork.Begin()
+	replace := since == 0
+	for {
+		changes, err := u.Client.GetChanges(session, since)
+		if err != nil {
+			return err
+		}
+
+		err = u.save(session.UserID, changes, replace)
+		if err != nil {
+			return err
+		}
+
+		if !changes.More {
+			return nil
+		}
+		replace = false
+		since = changes.Revision
+	}
+}
+
+// save - Сохраняет страницу изменений и ревизию, с которой нужно запрашивать следующую страницу.
+// Если replace истинно, локальные данные заменяются данными страницы
+func (u SyncAll) save(userID uuid.UUID, changes domain.Changes, replace bool) error {
+	err := u.UnitOfWork.Begin()
 	if err != nil {
 		return err
 	}
 	defer u.UnitOfWork.Rollback() // nolint: errcheck
 
-	err = u.UnitOfWork.TextRepository().ReplaceAll(session.UserID, texts)
+	if replace {
+		err = u.replaceAll(userID, changes)
+	} else {
+		err = u.applyChanges(userID, changes)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.RevisionRepository().Save(userID, changes.Revision)
+	if err != nil {
+		return err
+	}
+
+	return u.UnitOfWork.Commit()
+}
+
+func (u SyncAll) replaceAll(userID uuid.UUID, changes domain.Changes) error {
+	err := u.UnitOfWork.TextRepository().ReplaceAll(userID, changes.Texts)
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.BankCardRepository().ReplaceAll(userID, changes.BankCards)
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.BinaryRepository().ReplaceAll(userID, changes.Binaries)
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.CredentialsRepository().ReplaceAll(userID, changes.Credentials)
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.OTPRepository().ReplaceAll(userID, changes.OTPs)
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.SSHKeyRepository().ReplaceAll(userID, changes.SSHKeys)
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.ItemRepository().ReplaceAll(userID, changes.Items)
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.FolderRepository().ReplaceAll(userID, changes.Folders)
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.TagRepository().ReplaceAll(userID, changes.Tags)
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.TemplateRepository().ReplaceAll(userID, changes.Templates)
+	if err != nil {
+		return err
+	}
+
+	return u.UnitOfWork.LabelsRepository().ReplaceAll(userID, changes.Labels)
+}
+
+func (u SyncAll) applyChanges(userID uuid.UUID, changes domain.Changes) error {
+	deleted := map[domain.OperationKind][]uuid.UUID{}
+	// Папка и метки удаленных данных удаляются вместе с ними
+	deletedEntities := []uuid.UUID{}
+	for _, v := range changes.Deleted {
+		deleted[v.Kind] = append(deleted[v.Kind], v.ID)
+		if v.Kind != domain.FolderKind && v.Kind != domain.TagKind && v.Kind != domain.TemplateKind {
+			deletedEntities = append(deletedEntities, v.ID)
+		}
+	}
+
+	err := u.UnitOfWork.TextRepository().ApplyChanges(userID, changes.Texts, deleted[domain.TextKind])
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.BankCardRepository().ApplyChanges(userID, changes.BankCards, deleted[domain.BankCardKind])
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.BinaryRepository().ApplyChanges(userID, changes.Binaries, deleted[domain.BinaryKind])
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.CredentialsRepository().ApplyChanges(userID, changes.Credentials, deleted[domain.CredentialsKind])
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.OTPRepository().ApplyChanges(userID, changes.OTPs, deleted[domain.OTPKind])
+	if err != nil {
+		return err
+	}
+
+	err = u.UnitOfWork.SSHKeyRepository().ApplyChanges(userID, changes.SSHKeys, deleted[domain.SSHKeyKind])
 	if err != nil {
 		return err
 	}
 
-	err = u.UnitOfWork.BankCardRepository().ReplaceAll(session.UserID, bankCards)
+	err = u.UnitOfWork.ItemRepository().ApplyChanges(userID, changes.Items, deleted[domain.ItemKind])
 	if err != nil {
 		return err
 	}
 
-	err = u.UnitOfWork.BinaryRepository().ReplaceAll(session.UserID, binaries)
+	err = u.UnitOfWork.FolderRepository().ApplyChanges(userID, changes.Folders, deleted[domain.FolderKind])
 	if err != nil {
 		return err
 	}
 
-	err = u.UnitOfWork.CredentialsRepository().ReplaceAll(session.UserID, credentials)
+	err = u.UnitOfWork.TagRepository().ApplyChanges(userID, changes.Tags, deleted[domain.TagKind])
 	if err != nil {
 		return err
 	}
 
-	err = u.UnitOfWork.Commit()
+	err = u.UnitOfWork.TemplateRepository().ApplyChanges(userID, changes.Templates, deleted[domain.TemplateKind])
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return u.UnitOfWork.LabelsRepository().ApplyChanges(userID, changes.Labels, deletedEntities)
 }
@@ -10,22 +10,30 @@ import (
 type SyncBankCards struct {
 	// Client - Реализация интерфейса GophKeeperClient
 	Client domain.GophKeeperClientInterface
-	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
-	BankCardRepository domain.BankCardRepositoryInterface
+	// UnitOfWork - Реализация интерфейса UnitOfWorkInterface
+	UnitOfWork domain.UnitOfWorkInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
+// Do - Вызов логики сценария использования, данные с сервера получаются постранично
 func (u SyncBankCards) Do(session domain.Session) error {
-	cards, err := u.Client.GetAllBankCards(session)
-	if err != nil {
+	if err := checkNoPendingOperations(u.Journal, session.UserID); err != nil {
 		return err
 	}
 
-	if err := u.BankCardRepository.ReplaceAll(session.UserID, cards); err != nil {
-		return err
-	}
-
-	return nil
+	return syncPages(
+		u.UnitOfWork,
+		func(cursor string) ([]domain.BankCard, string, error) {
+			return u.Client.GetAllBankCards(session, cursor)
+		},
+		func(items []domain.BankCard) error {
+			return u.UnitOfWork.BankCardRepository().ReplaceAll(session.UserID, items)
+		},
+		func(items []domain.BankCard) error {
+			return u.UnitOfWork.BankCardRepository().ApplyChanges(session.UserID, items, nil)
+		},
+	)
 }
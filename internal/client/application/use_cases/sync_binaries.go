@@ -10,22 +10,30 @@ import (
 type SyncBinary struct {
 	// Client - Реализация интерфейса GophKeeperClient
 	Client domain.GophKeeperClientInterface
-	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
-	BinaryRepository domain.BinaryRepositoryInterface
+	// UnitOfWork - Реализация интерфейса UnitOfWorkInterface
+	UnitOfWork domain.UnitOfWorkInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
+// Do - Вызов логики сценария использования, данные с сервера получаются постранично
 func (u SyncBinary) Do(session domain.Session) error {
-	bins, err := u.Client.GetAllBinaries(session)
-	if err != nil {
+	if err := checkNoPendingOperations(u.Journal, session.UserID); err != nil {
 		return err
 	}
 
-	if err := u.BinaryRepository.ReplaceAll(session.UserID, bins); err != nil {
-		return err
-	}
-
-	return nil
+	return syncPages(
+		u.UnitOfWork,
+		func(cursor string) ([]domain.Binary, string, error) {
+			return u.Client.GetAllBinaries(session, cursor)
+		},
+		func(items []domain.Binary) error {
+			return u.UnitOfWork.BinaryRepository().ReplaceAll(session.UserID, items)
+		},
+		func(items []domain.Binary) error {
+			return u.UnitOfWork.BinaryRepository().ApplyChanges(session.UserID, items, nil)
+		},
+	)
 }
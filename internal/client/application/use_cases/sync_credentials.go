@@ -10,22 +10,30 @@ import (
 type SyncCredentials struct {
 	// Client - Реализация интерфейса GophKeeperClient
 	Client domain.GophKeeperClientInterface
-	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
-	CredentialsRepository domain.CredentialsRepositoryInterface
+	// UnitOfWork - Реализация интерфейса UnitOfWorkInterface
+	UnitOfWork domain.UnitOfWorkInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
+// Do - Вызов логики сценария использования, данные с сервера получаются постранично
 func (u SyncCredentials) Do(session domain.Session) error {
-	creds, err := u.Client.GetAllCredentials(session)
-	if err != nil {
+	if err := checkNoPendingOperations(u.Journal, session.UserID); err != nil {
 		return err
 	}
 
-	if err := u.CredentialsRepository.ReplaceAll(session.UserID, creds); err != nil {
-		return err
-	}
-
-	return nil
+	return syncPages(
+		u.UnitOfWork,
+		func(cursor string) ([]domain.Credentials, string, error) {
+			return u.Client.GetAllCredentials(session, cursor)
+		},
+		func(items []domain.Credentials) error {
+			return u.UnitOfWork.CredentialsRepository().ReplaceAll(session.UserID, items)
+		},
+		func(items []domain.Credentials) error {
+			return u.UnitOfWork.CredentialsRepository().ApplyChanges(session.UserID, items, nil)
+		},
+	)
 }
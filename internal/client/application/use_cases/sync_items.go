@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// SyncItems - Сценарий синхронизации данных по шаблону
+type SyncItems struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// UnitOfWork - Реализация интерфейса UnitOfWorkInterface
+	UnitOfWork domain.UnitOfWorkInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, данные с сервера получаются постранично
+func (u SyncItems) Do(session domain.Session) error {
+	if err := checkNoPendingOperations(u.Journal, session.UserID); err != nil {
+		return err
+	}
+
+	return syncPages(
+		u.UnitOfWork,
+		func(cursor string) ([]domain.Item, string, error) {
+			return u.Client.GetAllItems(session, cursor)
+		},
+		func(items []domain.Item) error {
+			return u.UnitOfWork.ItemRepository().ReplaceAll(session.UserID, items)
+		},
+		func(items []domain.Item) error {
+			return u.UnitOfWork.ItemRepository().ApplyChanges(session.UserID, items, nil)
+		},
+	)
+}
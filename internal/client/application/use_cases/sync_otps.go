@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// SyncOTPs - Сценарий синхронизации секретов одноразовых паролей
+type SyncOTPs struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// UnitOfWork - Реализация интерфейса UnitOfWorkInterface
+	UnitOfWork domain.UnitOfWorkInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, данные с сервера получаются постранично
+func (u SyncOTPs) Do(session domain.Session) error {
+	if err := checkNoPendingOperations(u.Journal, session.UserID); err != nil {
+		return err
+	}
+
+	return syncPages(
+		u.UnitOfWork,
+		func(cursor string) ([]domain.OTP, string, error) {
+			return u.Client.GetAllOTPs(session, cursor)
+		},
+		func(items []domain.OTP) error {
+			return u.UnitOfWork.OTPRepository().ReplaceAll(session.UserID, items)
+		},
+		func(items []domain.OTP) error {
+			return u.UnitOfWork.OTPRepository().ApplyChanges(session.UserID, items, nil)
+		},
+	)
+}
@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// syncPages - Постранично получает данные с сервера и сохраняет их в одной транзакции:
+// первая страница заменяет локальные данные, последующие дополняют их.
+// В памяти одновременно находится не больше одной страницы, при ошибке локальные данные не меняются
+func syncPages[T any](
+	uow domain.UnitOfWorkInterface,
+	fetch func(cursor string) ([]T, string, error),
+	replace func(items []T) error,
+	apply func(items []T) error,
+) error {
+	err := uow.Begin()
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback() // nolint: errcheck
+
+	items, cursor, err := fetch("")
+	if err != nil {
+		return err
+	}
+	if err = replace(items); err != nil {
+		return err
+	}
+
+	for cursor != "" {
+		items, cursor, err = fetch(cursor)
+		if err != nil {
+			return err
+		}
+		if err = apply(items); err != nil {
+			return err
+		}
+	}
+
+	return uow.Commit()
+}
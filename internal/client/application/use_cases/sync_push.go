@@ -0,0 +1,457 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// SyncPush - Сценарий отправки на сервер изменений, выполненных без связи с сервером
+type SyncPush struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// TextRepository - Реализация интерфейса TextRepositoryInterface
+	TextRepository domain.TextRepositoryInterface
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
+	BinaryRepository domain.BinaryRepositoryInterface
+	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
+	BankCardRepository domain.BankCardRepositoryInterface
+	// OTPRepository - Реализация интерфейса OTPRepositoryInterface
+	OTPRepository domain.OTPRepositoryInterface
+	// SSHKeyRepository - Реализация интерфейса SSHKeyRepositoryInterface
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// ItemRepository - Реализация интерфейса ItemRepositoryInterface
+	ItemRepository domain.ItemRepositoryInterface
+	// LabelsRepository - Реализация интерфейса LabelsRepositoryInterface
+	LabelsRepository domain.LabelsRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, возвращает количество отправленных операций.
+// Операции воспроизводятся в порядке добавления в журнал, при ошибке неотправленные операции остаются в журнале.
+// Если обновляемые данные были изменены на другом устройстве, возвращает *domain.Conflict,
+// операция остается в журнале до разрешения конфликта
+func (u SyncPush) Do(session domain.Session) (int, error) {
+	ops, err := u.Journal.GetAll(session.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	pushed := 0
+	// Идентификаторы, выданные сервером данным, созданным без связи с сервером
+	serverIDs := map[uuid.UUID]uuid.UUID{}
+	for _, op := range ops {
+		if serverID, ok := serverIDs[op.EntityID]; ok {
+			op.EntityID = serverID
+		}
+
+		entityID, err := u.push(session, op)
+		if err != nil {
+			return pushed, err
+		}
+
+		if entityID != op.EntityID {
+			serverIDs[op.EntityID] = entityID
+			// Сохраняем замену в журнале, чтобы повторная отправка после ошибки использовала идентификатор сервера
+			err = u.Journal.ReplaceEntityID(session.UserID, op.EntityID, entityID)
+			if err != nil {
+				return pushed, err
+			}
+			if err := u.moveLabels(session.UserID, op.EntityID, entityID); err != nil {
+				return pushed, err
+			}
+		}
+
+		if err := u.Journal.Delete(session.UserID, op.ID); err != nil {
+			return pushed, err
+		}
+		pushed++
+	}
+
+	return pushed, nil
+}
+
+// push - Отправляет операцию на сервер, возвращает идентификатор данных на сервере
+func (u SyncPush) push(session domain.Session, op domain.Operation) (uuid.UUID, error) {
+	entityID := op.EntityID
+	var err error
+	switch op.Kind {
+	case domain.TextKind:
+		entityID, err = u.pushText(session, op)
+	case domain.BinaryKind:
+		entityID, err = u.pushBinary(session, op)
+	case domain.CredentialsKind:
+		entityID, err = u.pushCredentials(session, op)
+	case domain.BankCardKind:
+		entityID, err = u.pushBankCard(session, op)
+	case domain.OTPKind:
+		entityID, err = u.pushOTP(session, op)
+	case domain.SSHKeyKind:
+		entityID, err = u.pushSSHKey(session, op)
+	case domain.ItemKind:
+		entityID, err = u.pushItem(session, op)
+	case domain.LabelsKind:
+		err = u.pushLabels(session, op)
+	default:
+		u.Log.Warnf("unknown journal operation kind: %s", op.Kind)
+	}
+
+	if errors.Is(err, domain.ErrEntityNotFound) {
+		// Данные уже удалены на сервере или локально, операция больше не имеет смысла
+		u.Log.Warnf("skip journal operation %d: %s %s %s", op.ID, op.Action, op.Kind, op.EntityID)
+
+		return op.EntityID, nil
+	}
+
+	return entityID, err
+}
+
+func (u SyncPush) pushText(session domain.Session, op domain.Operation) (uuid.UUID, error) {
+	if op.Action == domain.DeleteAction {
+		return op.EntityID, u.Client.DeleteText(session, op.EntityID)
+	}
+
+	text, err := u.TextRepository.Get(session.UserID, op.EntityID)
+	if err != nil {
+		return op.EntityID, err
+	}
+
+	if op.Action == domain.UpdateAction {
+		// Обновление отправляется с версией, от которой начаты изменения без связи с сервером
+		text.Version = op.BaseVersion
+		updated, err := u.Client.UpdateText(session, text)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base, err := operationBase[domain.Text](op)
+			if err != nil {
+				return op.EntityID, err
+			}
+
+			return op.EntityID, textConflict(base, text, updated)
+		}
+		if err != nil {
+			return op.EntityID, err
+		}
+		text.Version = updated.Version
+
+		return op.EntityID, u.TextRepository.Update(session.UserID, text)
+	}
+
+	text.ID, err = u.Client.CreateText(session, text.Content)
+	if err != nil {
+		return op.EntityID, err
+	}
+	text.Version = domain.InitialVersion
+	if err := u.TextRepository.Create(session.UserID, text); err != nil {
+		return op.EntityID, err
+	}
+	if err := u.TextRepository.Delete(session.UserID, op.EntityID); err != nil {
+		return op.EntityID, err
+	}
+
+	return text.ID, nil
+}
+
+func (u SyncPush) pushBinary(session domain.Session, op domain.Operation) (uuid.UUID, error) {
+	if op.Action == domain.DeleteAction {
+		return op.EntityID, u.Client.DeleteBinary(session, op.EntityID)
+	}
+
+	bin, err := u.BinaryRepository.Get(session.UserID, op.EntityID)
+	if err != nil {
+		return op.EntityID, err
+	}
+
+	if op.Action == domain.UpdateAction {
+		// Обновление отправляется с версией, от которой начаты изменения без связи с сервером
+		bin.Version = op.BaseVersion
+		updated, err := u.Client.UpdateBinary(session, bin)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base, err := operationBase[domain.Binary](op)
+			if err != nil {
+				return op.EntityID, err
+			}
+
+			return op.EntityID, binaryConflict(base, bin, updated)
+		}
+		if err != nil {
+			return op.EntityID, err
+		}
+		bin.Version = updated.Version
+
+		return op.EntityID, u.BinaryRepository.Update(session.UserID, bin)
+	}
+
+	bin.ID, err = u.Client.CreateBinary(session, bin)
+	if err != nil {
+		return op.EntityID, err
+	}
+	bin.Version = domain.InitialVersion
+	if err := u.BinaryRepository.Create(session.UserID, bin); err != nil {
+		return op.EntityID, err
+	}
+	if err := u.BinaryRepository.Delete(session.UserID, op.EntityID); err != nil {
+		return op.EntityID, err
+	}
+
+	return bin.ID, nil
+}
+
+func (u SyncPush) pushCredentials(session domain.Session, op domain.Operation) (uuid.UUID, error) {
+	if op.Action == domain.DeleteAction {
+		return op.EntityID, u.Client.DeleteCredentials(session, op.EntityID)
+	}
+
+	cred, err := u.CredentialsRepository.Get(session.UserID, op.EntityID)
+	if err != nil {
+		return op.EntityID, err
+	}
+
+	if op.Action == domain.UpdateAction {
+		// Обновление отправляется с версией, от которой начаты изменения без связи с сервером
+		cred.Version = op.BaseVersion
+		updated, err := u.Client.UpdateCredentials(session, &cred)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base, err := operationBase[domain.Credentials](op)
+			if err != nil {
+				return op.EntityID, err
+			}
+
+			return op.EntityID, credentialsConflict(base, cred, *updated)
+		}
+		if err != nil {
+			return op.EntityID, err
+		}
+		cred.Version = updated.Version
+
+		return op.EntityID, u.CredentialsRepository.Update(session.UserID, &cred)
+	}
+
+	cred.ID, err = u.Client.CreateCredentials(session, cred.Name, cred.Login, cred.Password, cred.Meta)
+	if err != nil {
+		return op.EntityID, err
+	}
+	cred.Version = domain.InitialVersion
+	if err := u.CredentialsRepository.Create(session.UserID, &cred); err != nil {
+		return op.EntityID, err
+	}
+	if err := u.CredentialsRepository.Delete(session.UserID, op.EntityID); err != nil {
+		return op.EntityID, err
+	}
+
+	return cred.ID, nil
+}
+
+func (u SyncPush) pushBankCard(session domain.Session, op domain.Operation) (uuid.UUID, error) {
+	if op.Action == domain.DeleteAction {
+		return op.EntityID, u.Client.DeleteBankCard(session, op.EntityID)
+	}
+
+	card, err := u.BankCardRepository.Get(session.UserID, op.EntityID)
+	if err != nil {
+		return op.EntityID, err
+	}
+
+	if op.Action == domain.UpdateAction {
+		// Обновление отправляется с версией, от которой начаты изменения без связи с сервером
+		card.Version = op.BaseVersion
+		updated, err := u.Client.UpdateBankCard(session, &card)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base, err := operationBase[domain.BankCard](op)
+			if err != nil {
+				return op.EntityID, err
+			}
+
+			return op.EntityID, bankCardConflict(base, card, *updated)
+		}
+		if err != nil {
+			return op.EntityID, err
+		}
+		card.Version = updated.Version
+
+		return op.EntityID, u.BankCardRepository.Update(session.UserID, &card)
+	}
+
+	card.ID, err = u.Client.CreateBankCard(session, card.Number, card.ValidThru, card.CVV, card.CardHolder, card.Meta)
+	if err != nil {
+		return op.EntityID, err
+	}
+	card.Version = domain.InitialVersion
+	if err := u.BankCardRepository.Create(session.UserID, &card); err != nil {
+		return op.EntityID, err
+	}
+	if err := u.BankCardRepository.Delete(session.UserID, op.EntityID); err != nil {
+		return op.EntityID, err
+	}
+
+	return card.ID, nil
+}
+
+func (u SyncPush) pushOTP(session domain.Session, op domain.Operation) (uuid.UUID, error) {
+	if op.Action == domain.DeleteAction {
+		return op.EntityID, u.Client.DeleteOTP(session, op.EntityID)
+	}
+
+	otp, err := u.OTPRepository.Get(session.UserID, op.EntityID)
+	if err != nil {
+		return op.EntityID, err
+	}
+
+	if op.Action == domain.UpdateAction {
+		// Обновление отправляется с версией, от которой начаты изменения без связи с сервером
+		otp.Version = op.BaseVersion
+		updated, err := u.Client.UpdateOTP(session, &otp)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base, err := operationBase[domain.OTP](op)
+			if err != nil {
+				return op.EntityID, err
+			}
+
+			return op.EntityID, otpConflict(base, otp, *updated)
+		}
+		if err != nil {
+			return op.EntityID, err
+		}
+		otp.Version = updated.Version
+
+		return op.EntityID, u.OTPRepository.Update(session.UserID, &otp)
+	}
+
+	otp.ID, err = u.Client.CreateOTP(session, otp)
+	if err != nil {
+		return op.EntityID, err
+	}
+	otp.Version = domain.InitialVersion
+	if err := u.OTPRepository.Create(session.UserID, &otp); err != nil {
+		return op.EntityID, err
+	}
+	if err := u.OTPRepository.Delete(session.UserID, op.EntityID); err != nil {
+		return op.EntityID, err
+	}
+
+	return otp.ID, nil
+}
+
+func (u SyncPush) pushSSHKey(session domain.Session, op domain.Operation) (uuid.UUID, error) {
+	if op.Action == domain.DeleteAction {
+		return op.EntityID, u.Client.DeleteSSHKey(session, op.EntityID)
+	}
+
+	key, err := u.SSHKeyRepository.Get(session.UserID, op.EntityID)
+	if err != nil {
+		return op.EntityID, err
+	}
+
+	if op.Action == domain.UpdateAction {
+		// Обновление отправляется с версией, от которой начаты изменения без связи с сервером
+		key.Version = op.BaseVersion
+		updated, err := u.Client.UpdateSSHKey(session, &key)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base, err := operationBase[domain.SSHKey](op)
+			if err != nil {
+				return op.EntityID, err
+			}
+
+			return op.EntityID, sshKeyConflict(base, key, *updated)
+		}
+		if err != nil {
+			return op.EntityID, err
+		}
+		key.Version = updated.Version
+
+		return op.EntityID, u.SSHKeyRepository.Update(session.UserID, &key)
+	}
+
+	key.ID, err = u.Client.CreateSSHKey(session, key)
+	if err != nil {
+		return op.EntityID, err
+	}
+	key.Version = domain.InitialVersion
+	if err := u.SSHKeyRepository.Create(session.UserID, &key); err != nil {
+		return op.EntityID, err
+	}
+	if err := u.SSHKeyRepository.Delete(session.UserID, op.EntityID); err != nil {
+		return op.EntityID, err
+	}
+
+	return key.ID, nil
+}
+
+func (u SyncPush) pushItem(session domain.Session, op domain.Operation) (uuid.UUID, error) {
+	if op.Action == domain.DeleteAction {
+		return op.EntityID, u.Client.DeleteItem(session, op.EntityID)
+	}
+
+	obj, err := u.ItemRepository.Get(session.UserID, op.EntityID)
+	if err != nil {
+		return op.EntityID, err
+	}
+
+	if op.Action == domain.UpdateAction {
+		// Обновление отправляется с версией, от которой начаты изменения без связи с сервером
+		obj.Version = op.BaseVersion
+		updated, err := u.Client.UpdateItem(session, &obj)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			base, err := operationBase[domain.Item](op)
+			if err != nil {
+				return op.EntityID, err
+			}
+
+			return op.EntityID, itemConflict(base, obj, *updated)
+		}
+		if err != nil {
+			return op.EntityID, err
+		}
+		obj.Version = updated.Version
+
+		return op.EntityID, u.ItemRepository.Update(session.UserID, &obj)
+	}
+
+	obj.ID, err = u.Client.CreateItem(session, obj)
+	if err != nil {
+		return op.EntityID, err
+	}
+	obj.Version = domain.InitialVersion
+	if err := u.ItemRepository.Create(session.UserID, &obj); err != nil {
+		return op.EntityID, err
+	}
+	if err := u.ItemRepository.Delete(session.UserID, op.EntityID); err != nil {
+		return op.EntityID, err
+	}
+
+	return obj.ID, nil
+}
+
+func (u SyncPush) pushLabels(session domain.Session, op domain.Operation) error {
+	labels, err := u.LabelsRepository.Get(session.UserID, op.EntityID)
+	if err != nil {
+		return err
+	}
+
+	return u.Client.SaveLabels(session, labels)
+}
+
+// moveLabels - Переносит папку и метки данных с локального идентификатора на выданный сервером
+func (u SyncPush) moveLabels(userID, oldID, newID uuid.UUID) error {
+	labels, err := u.LabelsRepository.Get(userID, oldID)
+	if errors.Is(err, domain.ErrEntityNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	labels.EntityID = newID
+	if err := u.LabelsRepository.Create(userID, labels); err != nil {
+		return err
+	}
+
+	return u.LabelsRepository.Delete(userID, oldID)
+}
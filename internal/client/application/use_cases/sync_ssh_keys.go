@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// SyncSSHKeys - Сценарий синхронизации SSH ключей
+type SyncSSHKeys struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// UnitOfWork - Реализация интерфейса UnitOfWorkInterface
+	UnitOfWork domain.UnitOfWorkInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, данные с сервера получаются постранично
+func (u SyncSSHKeys) Do(session domain.Session) error {
+	if err := checkNoPendingOperations(u.Journal, session.UserID); err != nil {
+		return err
+	}
+
+	return syncPages(
+		u.UnitOfWork,
+		func(cursor string) ([]domain.SSHKey, string, error) {
+			return u.Client.GetAllSSHKeys(session, cursor)
+		},
+		func(items []domain.SSHKey) error {
+			return u.UnitOfWork.SSHKeyRepository().ReplaceAll(session.UserID, items)
+		},
+		func(items []domain.SSHKey) error {
+			return u.UnitOfWork.SSHKeyRepository().ApplyChanges(session.UserID, items, nil)
+		},
+	)
+}
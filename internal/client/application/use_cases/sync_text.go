@@ -10,22 +10,30 @@ import (
 type SyncText struct {
 	// Client - Реализация интерфейса GophKeeperClient
 	Client domain.GophKeeperClientInterface
-	// TextRepository - Реализация интерфейса TextRepositoryInterface
-	TextRepository domain.TextRepositoryInterface
+	// UnitOfWork - Реализация интерфейса UnitOfWorkInterface
+	UnitOfWork domain.UnitOfWorkInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
+// Do - Вызов логики сценария использования, данные с сервера получаются постранично
 func (u SyncText) Do(session domain.Session) error {
-	texts, err := u.Client.GetAllTexts(session)
-	if err != nil {
+	if err := checkNoPendingOperations(u.Journal, session.UserID); err != nil {
 		return err
 	}
 
-	if err := u.TextRepository.ReplaceAll(session.UserID, texts); err != nil {
-		return err
-	}
-
-	return nil
+	return syncPages(
+		u.UnitOfWork,
+		func(cursor string) ([]domain.Text, string, error) {
+			return u.Client.GetAllTexts(session, cursor)
+		},
+		func(items []domain.Text) error {
+			return u.UnitOfWork.TextRepository().ReplaceAll(session.UserID, items)
+		},
+		func(items []domain.Text) error {
+			return u.UnitOfWork.TextRepository().ApplyChanges(session.UserID, items, nil)
+		},
+	)
 }
@@ -0,0 +1,130 @@
+package usecases
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
+)
+
+const vaultSaltLength = 16
+
+// vaultVerifier - Контрольное значение, по которому проверяется мастер-пароль
+var vaultVerifier = []byte("gophkeeper")
+
+// legacyVaultKey - Ключ, которым локальное хранилище шифровалось до появления мастер-пароля.
+// Используется только для перешифрования данных хранилища старого формата при первом разблокировании
+var legacyVaultKey = []byte("1234567812345678")
+
+// Unlock - Сценарий разблокирования локального хранилища мастер-паролем
+type Unlock struct {
+	// VaultHeaderRepository - Реализация интерфейса VaultHeaderRepositoryInterface
+	VaultHeaderRepository domain.VaultHeaderRepositoryInterface
+	// KeyCache - Реализация интерфейса KeyCacheInterface
+	KeyCache domain.KeyCacheInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+func (u Unlock) init(password string) ([]byte, error) {
+	salt := make([]byte, vaultSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	key := crypto.DeriveKey(password, salt)
+	cryptoService, err := crypto.New(key)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := cryptoService.Encrypt(vaultVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	legacyCryptoService, err := crypto.New(legacyVaultKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.VaultHeaderRepository.Init(domain.VaultHeader{Salt: salt, Verifier: verifier}, legacyCryptoService, cryptoService)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (u Unlock) verify(password string, header *domain.VaultHeader) ([]byte, error) {
+	key := crypto.DeriveKey(password, header.Salt)
+	cryptoService, err := crypto.New(key)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := cryptoService.Decrypt(header.Verifier)
+	if err != nil || !bytes.Equal(verifier, vaultVerifier) {
+		return nil, domain.ErrWrongMasterPassword
+	}
+
+	return key, nil
+}
+
+// Do - Вызов логики сценария использования, возвращает true, если мастер-пароль был задан.
+// При первом разблокировании мастер-пароль задается, соль и контрольное значение сохраняются в заголовке хранилища,
+// а данные хранилища старого формата перешифровываются ключом, выведенным из мастер-пароля.
+// Выведенный ключ кэшируется на время ttl
+func (u Unlock) Do(password string, ttl time.Duration) (bool, error) {
+	var key []byte
+	created := false
+
+	header, err := u.VaultHeaderRepository.Get()
+	switch {
+	case errors.Is(err, domain.ErrEntityNotFound):
+		key, err = u.init(password)
+		created = true
+	case err != nil:
+		return false, err
+	default:
+		key, err = u.verify(password, header)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return created, u.KeyCache.Put(key, ttl)
+}
+
+// IsSet - Проверяет, задан ли мастер-пароль локального хранилища
+func (u Unlock) IsSet() (bool, error) {
+	_, err := u.VaultHeaderRepository.Get()
+	if errors.Is(err, domain.ErrEntityNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// Lock - Сценарий блокирования локального хранилища
+type Lock struct {
+	// KeyCache - Реализация интерфейса KeyCacheInterface
+	KeyCache domain.KeyCacheInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, удаляет ключ локального хранилища из кэша
+func (u Lock) Do() error {
+	return u.KeyCache.Clear()
+}
+
+// IsLocked - Проверяет, заблокировано ли локальное хранилище
+func (u Lock) IsLocked() bool {
+	_, err := u.KeyCache.Get()
+
+	return err != nil
+}
@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
@@ -13,11 +15,14 @@ type UpdateBankCard struct {
 	Client domain.GophKeeperClientInterface
 	// BankCardRepository - Реализация интерфейса BankCardRepositoryInterface
 	BankCardRepository domain.BankCardRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
+// Do - Вызов логики сценария использования.
+// Если данные были изменены на другом устройстве, возвращает *domain.Conflict
 func (u UpdateBankCard) Do(
 	session domain.Session,
 	cardID uuid.UUID,
@@ -27,6 +32,7 @@ func (u UpdateBankCard) Do(
 	if err != nil {
 		return err
 	}
+	base := card
 
 	if number != "" {
 		card.Number = number
@@ -44,7 +50,23 @@ func (u UpdateBankCard) Do(
 		card.Meta = meta
 	}
 
-	if err := u.Client.UpdateBankCard(session, &card); err != nil {
+	op, err := updateOperation(domain.BankCardKind, card.ID, base.Version, base)
+	if err != nil {
+		return err
+	}
+	err = sendOrJournal(u.Journal, session.UserID, op, func() error {
+		updated, err := u.Client.UpdateBankCard(session, &card)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			return bankCardConflict(base, card, *updated)
+		}
+		if err != nil {
+			return err
+		}
+		card.Version = updated.Version
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
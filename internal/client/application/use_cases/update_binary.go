@@ -1,6 +1,9 @@
 package usecases
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
@@ -13,24 +16,56 @@ type UpdateBinary struct {
 	Client domain.GophKeeperClientInterface
 	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface
 	BinaryRepository domain.BinaryRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
+// Do - Вызов логики сценария использования. Метаданные содержимого определяются заново,
+// произвольные метаданные meta заменяются, только если они переданы.
+// Если данные были изменены на другом устройстве, возвращает *domain.Conflict
 func (u UpdateBinary) Do(
 	session domain.Session,
 	binID uuid.UUID,
+	name string,
 	content []byte,
+	meta string,
 ) error {
 	bin, err := u.BinaryRepository.Get(session.UserID, binID)
 	if err != nil {
 		return err
 	}
+	base := bin
 
+	// Новое содержимое отправляется одним запросом и заменяет загруженные частями данные
 	bin.Content = content
+	bin.Name = name
+	bin.MimeType = http.DetectContentType(content)
+	bin.Size = int64(len(content))
+	bin.SHA256 = checksum(content)
+	bin.Chunked = false
+	if meta != "" {
+		bin.Meta = meta
+	}
 
-	if err := u.Client.UpdateBinary(session, bin); err != nil {
+	op, err := updateOperation(domain.BinaryKind, bin.ID, base.Version, base)
+	if err != nil {
+		return err
+	}
+	err = sendOrJournal(u.Journal, session.UserID, op, func() error {
+		updated, err := u.Client.UpdateBinary(session, bin)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			return binaryConflict(base, bin, updated)
+		}
+		if err != nil {
+			return err
+		}
+		bin.Version = updated.Version
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
@@ -13,11 +15,14 @@ type UpdateCredentials struct {
 	Client domain.GophKeeperClientInterface
 	// CredentialsRepository - Реализация интерфейса CredentialsRepositoryInterface
 	CredentialsRepository domain.CredentialsRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
+// Do - Вызов логики сценария использования.
+// Если данные были изменены на другом устройстве, возвращает *domain.Conflict
 func (u UpdateCredentials) Do(
 	session domain.Session,
 	credID uuid.UUID,
@@ -27,6 +32,7 @@ func (u UpdateCredentials) Do(
 	if err != nil {
 		return err
 	}
+	base := cred
 
 	if name != "" {
 		cred.Name = name
@@ -41,7 +47,23 @@ func (u UpdateCredentials) Do(
 		cred.Meta = meta
 	}
 
-	if err := u.Client.UpdateCredentials(session, &cred); err != nil {
+	op, err := updateOperation(domain.CredentialsKind, cred.ID, base.Version, base)
+	if err != nil {
+		return err
+	}
+	err = sendOrJournal(u.Journal, session.UserID, op, func() error {
+		updated, err := u.Client.UpdateCredentials(session, &cred)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			return credentialsConflict(base, cred, *updated)
+		}
+		if err != nil {
+			return err
+		}
+		cred.Version = updated.Version
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
@@ -0,0 +1,145 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+)
+
+// UpdateItem - Сценарий обновления существующих данных по шаблону
+type UpdateItem struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// ItemRepository - Реализация интерфейса ItemRepositoryInterface
+	ItemRepository domain.ItemRepositoryInterface
+	// BinaryRepository - Реализация интерфейса BinaryRepositoryInterface для проверки ссылок на файлы
+	BinaryRepository domain.BinaryRepositoryInterface
+	// TemplateRepository - Реализация интерфейса TemplateRepositoryInterface для поиска пользовательских шаблонов
+	TemplateRepository domain.TemplateRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, пустые name и meta не изменяют данные.
+// Сначала удаляются поля remove, затем values задают значения существующих полей,
+// а поля extra заменяют тип и значение существующих полей или добавляются в конец.
+// Обязательные поля шаблона удалить нельзя.
+// Если данные были изменены на другом устройстве, возвращает *domain.Conflict
+func (u UpdateItem) Do(
+	session domain.Session,
+	itemID uuid.UUID,
+	name string,
+	values map[string]string,
+	extra []item.Field,
+	remove []string,
+	meta string,
+) error {
+	obj, err := u.ItemRepository.Get(session.UserID, itemID)
+	if err != nil {
+		return err
+	}
+	base := obj
+	tmpl, err := findTemplate(u.TemplateRepository, session.UserID, obj.Template)
+	if errors.Is(err, item.ErrUnknownTemplate) {
+		// Шаблон мог быть удален или еще не синхронизирован с сервера
+		tmpl = item.Template{Name: obj.Template}
+	} else if err != nil {
+		return err
+	}
+	obj.Fields, err = updateItemFields(obj, tmpl, values, extra, remove)
+	if err != nil {
+		return err
+	}
+	if err = checkFileFields(u.BinaryRepository, session.UserID, obj.Fields); err != nil {
+		return err
+	}
+	if name != "" {
+		obj.Name = name
+	}
+	if meta != "" {
+		obj.Meta = meta
+	}
+
+	op, err := updateOperation(domain.ItemKind, obj.ID, base.Version, base)
+	if err != nil {
+		return err
+	}
+	err = sendOrJournal(u.Journal, session.UserID, op, func() error {
+		updated, err := u.Client.UpdateItem(session, &obj)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			return itemConflict(base, obj, *updated)
+		}
+		if err != nil {
+			return err
+		}
+		obj.Version = updated.Version
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := u.ItemRepository.Update(session.UserID, &obj); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateItemFields - Возвращает новую копию полей данных obj, созданных по шаблону tmpl, с примененными изменениями
+func updateItemFields(
+	obj domain.Item,
+	tmpl item.Template,
+	values map[string]string,
+	extra []item.Field,
+	remove []string,
+) ([]item.Field, error) {
+	fields := slices.Clone(obj.Fields)
+	for _, name := range remove {
+		i := slices.IndexFunc(fields, func(f item.Field) bool { return f.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("%w: no field %q to remove", item.ErrInvalidField, name)
+		}
+		for _, spec := range tmpl.Fields {
+			if spec.Name == name && spec.Required {
+				return nil, fmt.Errorf("%w: %q is required by template %s", item.ErrInvalidField, name, tmpl.Name)
+			}
+		}
+		fields = slices.Delete(fields, i, i+1)
+	}
+	for name, value := range values {
+		i := slices.IndexFunc(fields, func(f item.Field) bool { return f.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("%w: no field %q, pass its type", item.ErrInvalidField, name)
+		}
+		fields[i].Value = value
+	}
+	for _, v := range extra {
+		i := slices.IndexFunc(fields, func(f item.Field) bool { return f.Name == v.Name })
+		if i < 0 {
+			fields = append(fields, v)
+		} else {
+			fields[i] = v
+		}
+	}
+	for _, spec := range tmpl.Fields {
+		i := slices.IndexFunc(fields, func(f item.Field) bool { return f.Name == spec.Name })
+		if spec.Required && i >= 0 && fields[i].Value == "" {
+			return nil, fmt.Errorf("%w: %q is required by template %s", item.ErrInvalidField, spec.Name, tmpl.Name)
+		}
+	}
+	if err := item.Validate(fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// UpdateOTP - Сценарий обновления существующего секрета одноразовых паролей
+type UpdateOTP struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// OTPRepository - Реализация интерфейса OTPRepositoryInterface
+	OTPRepository domain.OTPRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, пустые строки и нулевые значения не изменяют данные.
+// Если данные были изменены на другом устройстве, возвращает *domain.Conflict
+func (u UpdateOTP) Do(
+	session domain.Session,
+	otpID uuid.UUID,
+	issuer, account, secret, algorithm string,
+	digits, period int,
+	meta string,
+) error {
+	otp, err := u.OTPRepository.Get(session.UserID, otpID)
+	if err != nil {
+		return err
+	}
+	base := otp
+
+	if issuer != "" {
+		otp.Issuer = issuer
+	}
+	if account != "" {
+		otp.Account = account
+	}
+	if secret != "" {
+		otp.Secret = secret
+	}
+	if algorithm != "" {
+		otp.Algorithm = algorithm
+	}
+	if digits != 0 {
+		otp.Digits = strconv.Itoa(digits)
+	}
+	if period != 0 {
+		otp.Period = strconv.Itoa(period)
+	}
+	if meta != "" {
+		otp.Meta = meta
+	}
+	if _, err = otp.Key(); err != nil {
+		return err
+	}
+
+	op, err := updateOperation(domain.OTPKind, otp.ID, base.Version, base)
+	if err != nil {
+		return err
+	}
+	err = sendOrJournal(u.Journal, session.UserID, op, func() error {
+		updated, err := u.Client.UpdateOTP(session, &otp)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			return otpConflict(base, otp, *updated)
+		}
+		if err != nil {
+			return err
+		}
+		otp.Version = updated.Version
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := u.OTPRepository.Update(session.UserID, &otp); err != nil {
+		return err
+	}
+
+	return nil
+}
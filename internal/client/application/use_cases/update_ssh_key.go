@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/sshkey"
+)
+
+// UpdateSSHKey - Сценарий обновления существующего SSH ключа
+type UpdateSSHKey struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// SSHKeyRepository - Реализация интерфейса SSHKeyRepositoryInterface
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, пустые строки не изменяют данные.
+// При замене закрытого ключа открытый ключ и отпечаток получаются из нового ключа.
+// Если данные были изменены на другом устройстве, возвращает *domain.Conflict
+func (u UpdateSSHKey) Do(
+	session domain.Session,
+	keyID uuid.UUID,
+	privateKey, passphrase, comment, meta string,
+) error {
+	key, err := u.SSHKeyRepository.Get(session.UserID, keyID)
+	if err != nil {
+		return err
+	}
+	base := key
+
+	if privateKey != "" {
+		parsed, err := sshkey.Parse(privateKey, passphrase)
+		if err != nil {
+			return err
+		}
+		key.PrivateKey = privateKey
+		key.PublicKey = parsed.PublicKey
+		key.Fingerprint = parsed.Fingerprint
+	}
+	if comment != "" {
+		key.Comment = comment
+	}
+	if meta != "" {
+		key.Meta = meta
+	}
+
+	op, err := updateOperation(domain.SSHKeyKind, key.ID, base.Version, base)
+	if err != nil {
+		return err
+	}
+	err = sendOrJournal(u.Journal, session.UserID, op, func() error {
+		updated, err := u.Client.UpdateSSHKey(session, &key)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			return sshKeyConflict(base, key, *updated)
+		}
+		if err != nil {
+			return err
+		}
+		key.Version = updated.Version
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := u.SSHKeyRepository.Update(session.UserID, &key); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
@@ -13,11 +15,14 @@ type UpdateText struct {
 	Client domain.GophKeeperClientInterface
 	// TextRepository - Реализация интерфейса TextRepository
 	TextRepository domain.TextRepositoryInterface
+	// Journal - Реализация интерфейса JournalRepositoryInterface
+	Journal domain.JournalRepositoryInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов логики сценария использования
+// Do - Вызов логики сценария использования.
+// Если данные были изменены на другом устройстве, возвращает *domain.Conflict
 func (u UpdateText) Do(
 	session domain.Session,
 	textID uuid.UUID,
@@ -27,10 +32,27 @@ func (u UpdateText) Do(
 	if err != nil {
 		return err
 	}
+	base := text
 
 	text.Content = content
 
-	if err := u.Client.UpdateText(session, text); err != nil {
+	op, err := updateOperation(domain.TextKind, text.ID, base.Version, base)
+	if err != nil {
+		return err
+	}
+	err = sendOrJournal(u.Journal, session.UserID, op, func() error {
+		updated, err := u.Client.UpdateText(session, text)
+		if errors.Is(err, domain.ErrVersionConflict) {
+			return textConflict(base, text, updated)
+		}
+		if err != nil {
+			return err
+		}
+		text.Version = updated.Version
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
@@ -0,0 +1,15 @@
+package usecases
+
+import (
+	"encoding/hex"
+
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
+)
+
+// deriveVaultKey - Выводит из мастер-пароля пароль для сервера и ключ хранилища.
+// Сервер получает только ключ аутентификации и не может восстановить ключ хранилища
+func deriveVaultKey(password string, salt []byte) (string, []byte) {
+	vaultKey, authKey := crypto.DeriveKeys(password, salt)
+
+	return hex.EncodeToString(authKey), vaultKey
+}
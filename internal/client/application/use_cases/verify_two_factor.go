@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// VerifyTwoFactor - Сценарий подтверждения подключения двухфакторной аутентификации
+type VerifyTwoFactor struct {
+	// Client - Реализация интерфейса GophKeeperClient
+	Client domain.GophKeeperClientInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов логики сценария использования, возвращает одноразовые коды восстановления
+func (u VerifyTwoFactor) Do(session domain.Session, code string) ([]string, error) {
+	return u.Client.VerifyTwoFactor(session, code)
+}
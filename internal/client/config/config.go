@@ -23,15 +23,21 @@ type Config struct {
 	ClientTimeout time.Duration `json:"db_client_timeout"`
 	// ServerURL - URL сервера
 	ServerURL string `json:"server_url"`
+	// UnlockTimeout - Время, на которое разблокируется локальное хранилище
+	UnlockTimeout time.Duration `json:"unlock_timeout"`
+	// ClipboardTimeout - Время, через которое скопированный секрет удаляется из буфера обмена
+	ClipboardTimeout time.Duration `json:"clipboard_timeout"`
 }
 
 // New - Возвращает инстанс конфигурации сервера из файла
 func New(root string) (*Config, error) {
 	cfg := Config{
-		DBFileMode:     dbFileMode,
-		ClientTimeout:  time.Duration(30) * time.Second, //nolint: gomnd
-		ServerBasePath: "api/v1/",
-		DBFilePath:     "user.db",
+		DBFileMode:       dbFileMode,
+		ClientTimeout:    time.Duration(30) * time.Second, //nolint: gomnd
+		ServerBasePath:   "api/v1/",
+		DBFilePath:       "user.db",
+		UnlockTimeout:    time.Duration(15) * time.Minute, //nolint: gomnd
+		ClipboardTimeout: time.Duration(30) * time.Second, //nolint: gomnd
 	}
 
 	configPath := filepath.Join(root, configName)
@@ -1,39 +1,151 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"io"
+
+	"github.com/google/uuid"
+)
 
 // GophKeeperClientInterface - Интерфейс клиента GophKeeper
 type GophKeeperClientInterface interface {
-	// Login - Вход по логину и паролю, возвращает токен авторизации
-	Login(login, password string) (string, error)
-	// Register - Регистрация по логину и паролю, возвращает токен авторизации
-	Register(login, password string) (string, error)
+	// Login - Вход по логину и паролю, возвращает токен авторизации и refresh token.
+	// Если у пользователя включена двухфакторная аутентификация, возвращает TwoFactorRequiredError
+	Login(login, password string) (string, string, error)
+	// TwoFactorLogin - Завершает вход кодом TOTP или кодом восстановления, возвращает токен авторизации и refresh token
+	TwoFactorLogin(challenge, code string) (string, string, error)
+	// EnrollTwoFactor - Начинает подключение двухфакторной аутентификации, возвращает секрет и otpauth URI
+	EnrollTwoFactor(session Session) (string, string, error)
+	// VerifyTwoFactor - Подтверждает подключение двухфакторной аутентификации кодом, возвращает коды восстановления
+	VerifyTwoFactor(session Session, code string) ([]string, error)
+	// DisableTwoFactor - Отключает двухфакторную аутентификацию, подтверждается кодом TOTP или кодом восстановления
+	DisableTwoFactor(session Session, code string) error
+	// Register - Регистрация по логину и паролю, возвращает токен авторизации и refresh token.
+	// Если e2e истинно, сервер хранит данные пользователя в том виде, в котором их передает клиент,
+	// и сохраняет salt, из которой клиент вывел ключи, чтобы вернуть ее при PreLogin
+	Register(login, password string, e2e bool, salt []byte) (string, string, error)
+	// PreLogin - Возвращает признак сквозного шифрования и соль для вывода ключа хранилища
+	PreLogin(login string) (bool, []byte, error)
+	// Refresh - Выпускает новую пару токенов по refresh token
+	Refresh(refreshToken string) (string, string, error)
 	// GetCerts - Возвращает публичный ключ для валидации и парсинга JWT
 	GetCerts() ([]byte, error)
 	// CreateText - Создает текст, возвращает идентификатор ресурса от сервера
 	CreateText(session Session, content string) (uuid.UUID, error)
-	// UpdateText - Обновляет существующий текст
-	UpdateText(session Session, text Text) error
-	// GetAllTexts - Получает все расшифрованные тексты пользователя
-	GetAllTexts(session Session) ([]Text, error)
-	// CreateBinary - Создает бинарные данные, возвращает идентификатор ресурса от сервера
-	CreateBinary(session Session, content []byte) (uuid.UUID, error)
-	// UpdateBinary - Обновляет существующие бинарные данные
-	UpdateBinary(session Session, bin Binary) error
-	// GetAllBinaries - Получает все расшифрованные бинарные данные пользователя
-	GetAllBinaries(session Session) ([]Binary, error)
+	// UpdateText - Обновляет существующий текст, возвращает его с новой версией.
+	// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
+	UpdateText(session Session, text Text) (Text, error)
+	// GetAllTexts - Получает страницу расшифрованных текстов пользователя, начиная с курсора cursor,
+	// и курсор следующей страницы. Пустой курсор означает первую или последнюю страницу
+	GetAllTexts(session Session, cursor string) ([]Text, string, error)
+	// GetText - Получает расшифрованный текст по идентификатору
+	GetText(session Session, textID uuid.UUID) (Text, error)
+	// DeleteText - Удаляет существующий текст
+	DeleteText(session Session, textID uuid.UUID) error
+	// CreateBinary - Создает бинарные данные с содержимым и метаданными, возвращает идентификатор ресурса от сервера
+	CreateBinary(session Session, bin Binary) (uuid.UUID, error)
+	// UpdateBinary - Обновляет существующие бинарные данные, возвращает их с новой версией.
+	// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
+	UpdateBinary(session Session, bin Binary) (Binary, error)
+	// GetAllBinaries - Получает страницу расшифрованных метаданных бинарных данных пользователя без содержимого
+	// и курсор следующей страницы
+	GetAllBinaries(session Session, cursor string) ([]Binary, string, error)
+	// GetBinary - Получает расшифрованные метаданные бинарных данных по идентификатору без содержимого
+	GetBinary(session Session, binID uuid.UUID) (Binary, error)
+	// DeleteBinary - Удаляет существующие бинарные данные
+	DeleteBinary(session Session, binID uuid.UUID) error
+	// UploadBinary - Загружает size байт из source частями по chunkSize байт, возвращает идентификатор сессии загрузки.
+	// Если задан resumeID и сессия еще существует на сервере, загрузка продолжается в ней, а уже принятые сервером
+	// части читаются из source без отправки. Иначе создается новая сессия, и ее идентификатор передается в started,
+	// если он задан. После каждой части вызывает progress с количеством обработанных байт, если он задан
+	UploadBinary(
+		session Session,
+		resumeID uuid.UUID,
+		source io.Reader,
+		size int64,
+		chunkSize int,
+		started func(uuid.UUID) error,
+		progress func(int64),
+	) (uuid.UUID, error)
+	// CommitBinaryUpload - Завершает загрузку частями и сохраняет метаданные bin, возвращает идентификатор ресурса от сервера
+	CommitBinaryUpload(session Session, uploadID uuid.UUID, bin Binary) (uuid.UUID, error)
+	// DownloadBinary - Потоково скачивает содержимое бинарных данных в dest.
+	// По мере записи вызывает progress с количеством записанных байт, если он задан
+	DownloadBinary(session Session, bin Binary, dest io.Writer, progress func(int64)) error
 	// CreateCredentials - Создает пару логин и пароль, возвращает идентификатор ресурса от сервера
 	CreateCredentials(session Session, name, login, password, meta string) (uuid.UUID, error)
-	// UpdateCredentials - Обновляет существующую пару логина и пароля
-	UpdateCredentials(session Session, cred *Credentials) error
-	// GetAllCredentials - Получает все расшифрованные логины и пароли пользователя
-	GetAllCredentials(session Session) ([]Credentials, error)
+	// UpdateCredentials - Обновляет существующую пару логина и пароля, возвращает ее с новой версией.
+	// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
+	UpdateCredentials(session Session, cred *Credentials) (*Credentials, error)
+	// GetAllCredentials - Получает страницу расшифрованных логинов и паролей пользователя и курсор следующей страницы
+	GetAllCredentials(session Session, cursor string) ([]Credentials, string, error)
+	// GetCredentials - Получает расшифрованные логин и пароль по идентификатору
+	GetCredentials(session Session, credID uuid.UUID) (Credentials, error)
+	// DeleteCredentials - Удаляет существующую пару логина и пароля
+	DeleteCredentials(session Session, credID uuid.UUID) error
 	// CreateBankCard - Создает банковскую карту, возвращает идентификатор ресурса от сервера
 	CreateBankCard(session Session, number, validThru, cvv, cardHolder, meta string) (uuid.UUID, error)
-	// UpdateBankCard - Обновляет существующую банковскую карту
-	UpdateBankCard(session Session, card *BankCard) error
-	// GetAllBankCards - Получает все расшифрованные банковские карты пользователя
-	GetAllBankCards(session Session) ([]BankCard, error)
-	// GetAll - Получает все расшифрованные данные пользователя
-	GetAll(session Session) ([]Text, []BankCard, []Binary, []Credentials, error)
+	// UpdateBankCard - Обновляет существующую банковскую карту, возвращает ее с новой версией.
+	// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
+	UpdateBankCard(session Session, card *BankCard) (*BankCard, error)
+	// GetAllBankCards - Получает страницу расшифрованных банковских карт пользователя и курсор следующей страницы
+	GetAllBankCards(session Session, cursor string) ([]BankCard, string, error)
+	// GetBankCard - Получает расшифрованную банковскую карту по идентификатору
+	GetBankCard(session Session, cardID uuid.UUID) (BankCard, error)
+	// DeleteBankCard - Удаляет существующую банковскую карту
+	DeleteBankCard(session Session, cardID uuid.UUID) error
+	// CreateOTP - Создает секрет одноразовых паролей, возвращает идентификатор ресурса от сервера
+	CreateOTP(session Session, otp OTP) (uuid.UUID, error)
+	// UpdateOTP - Обновляет существующий секрет одноразовых паролей, возвращает его с новой версией.
+	// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
+	UpdateOTP(session Session, otp *OTP) (*OTP, error)
+	// GetAllOTPs - Получает страницу расшифрованных секретов одноразовых паролей пользователя
+	// и курсор следующей страницы
+	GetAllOTPs(session Session, cursor string) ([]OTP, string, error)
+	// GetOTP - Получает расшифрованный секрет одноразовых паролей по идентификатору
+	GetOTP(session Session, otpID uuid.UUID) (OTP, error)
+	// DeleteOTP - Удаляет существующий секрет одноразовых паролей
+	DeleteOTP(session Session, otpID uuid.UUID) error
+	// CreateSSHKey - Создает SSH ключ, возвращает идентификатор ресурса от сервера
+	CreateSSHKey(session Session, key SSHKey) (uuid.UUID, error)
+	// UpdateSSHKey - Обновляет существующий SSH ключ, возвращает его с новой версией.
+	// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
+	UpdateSSHKey(session Session, key *SSHKey) (*SSHKey, error)
+	// GetAllSSHKeys - Получает страницу расшифрованных SSH ключей пользователя и курсор следующей страницы
+	GetAllSSHKeys(session Session, cursor string) ([]SSHKey, string, error)
+	// GetSSHKey - Получает расшифрованный SSH ключ по идентификатору
+	GetSSHKey(session Session, keyID uuid.UUID) (SSHKey, error)
+	// DeleteSSHKey - Удаляет существующий SSH ключ
+	DeleteSSHKey(session Session, keyID uuid.UUID) error
+	// CreateItem - Создает данные по шаблону, возвращает идентификатор ресурса от сервера
+	CreateItem(session Session, obj Item) (uuid.UUID, error)
+	// UpdateItem - Обновляет существующие данные по шаблону, возвращает их с новой версией.
+	// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
+	UpdateItem(session Session, obj *Item) (*Item, error)
+	// GetAllItems - Получает страницу расшифрованных данных по шаблону пользователя и курсор следующей страницы
+	GetAllItems(session Session, cursor string) ([]Item, string, error)
+	// GetItem - Получает расшифрованные данные по шаблону по идентификатору
+	GetItem(session Session, itemID uuid.UUID) (Item, error)
+	// DeleteItem - Удаляет существующие данные по шаблону
+	DeleteItem(session Session, itemID uuid.UUID) error
+	// CreateFolder - Создает папку, возвращает идентификатор ресурса от сервера
+	CreateFolder(session Session, folder Folder) (uuid.UUID, error)
+	// UpdateFolder - Переименовывает или перемещает существующую папку
+	UpdateFolder(session Session, folder Folder) error
+	// DeleteFolder - Удаляет папку, вложенные папки и данные переносятся в ее родительскую папку
+	DeleteFolder(session Session, folderID uuid.UUID) error
+	// CreateTag - Создает метку, возвращает идентификатор ресурса от сервера
+	CreateTag(session Session, name string) (uuid.UUID, error)
+	// CreateTemplate - Создает пользовательский шаблон, возвращает идентификатор ресурса от сервера
+	CreateTemplate(session Session, tmpl ItemTemplate) (uuid.UUID, error)
+	// DeleteTemplate - Удаляет существующий пользовательский шаблон
+	DeleteTemplate(session Session, templateID uuid.UUID) error
+	// SaveLabels - Заменяет папку и метки данных
+	SaveLabels(session Session, labels Labels) error
+	// GetAll - Получает страницу всех расшифрованных данных пользователя и курсор следующей страницы
+	GetAll(session Session, cursor string) (
+		[]Text, []BankCard, []Binary, []Credentials, []OTP, []SSHKey, []Item, string, error,
+	)
+	// GetChanges - Получает страницу расшифрованных изменений данных пользователя после ревизии since,
+	// если после ревизии страницы есть еще изменения, признак More истинен
+	GetChanges(session Session, since int64) (Changes, error)
 }
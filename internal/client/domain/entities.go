@@ -1,6 +1,15 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/sshkey"
+	"github.com/Nickolasll/goph-keeper/internal/totp"
+)
 
 // Session - Сущность сессии
 type Session struct {
@@ -8,6 +17,10 @@ type Session struct {
 	UserID uuid.UUID
 	// Token - Токен авторизации
 	Token string
+	// RefreshToken - Токен для выпуска новой пары токенов после истечения токена авторизации
+	RefreshToken string
+	// VaultKey - Ключ хранилища для сквозного шифрования, nil если сквозное шифрование не используется
+	VaultKey []byte
 }
 
 // Text - Сущность типа хранимой информации "Произвольный текст"
@@ -16,16 +29,46 @@ type Text struct {
 	ID uuid.UUID
 	// Content - Текст
 	Content string
+	// Version - Версия данных на сервере, 0 если версия неизвестна
+	Version int64
 }
 
 // Binary - Сущность типа хранимой информации "Произвольные бинарные данные"
 type Binary struct {
 	// ID - Уникальный идентификатор "Бинарных данных данных"
 	ID uuid.UUID
-	// Content - Бинарные данные
+	// Content - Бинарные данные, пустые, если содержимое хранится только на сервере
 	Content []byte
+	// Name - Имя исходного файла
+	Name string
+	// MimeType - MIME тип содержимого, определяется при загрузке
+	MimeType string
+	// Size - Размер данных в байтах
+	Size int64
+	// SHA256 - Контрольная сумма SHA-256 содержимого в шестнадцатеричном виде
+	SHA256 string
+	// Meta - Произвольные текстовые метаданные
+	Meta string
+	// Chunked - Признак данных, загруженных частями
+	Chunked bool
+	// Version - Версия данных на сервере, 0 если версия неизвестна
+	Version int64
 }
 
+// Remote - Возвращает истину, если содержимое хранится только на сервере.
+// Списки с сервера содержат только метаданные, а данные, созданные без связи с сервером, всегда хранятся локально
+func (b Binary) Remote() bool {
+	return b.Chunked || (len(b.Content) == 0 && b.Version != 0)
+}
+
+const (
+	// BinaryChunkSize - Размер части при загрузке бинарных данных частями
+	BinaryChunkSize = 4 << 20
+	// BinaryInlineLimit - Максимальный размер бинарных данных, которые загружаются одним запросом
+	// и хранятся локально, данные большего размера загружаются частями
+	BinaryInlineLimit = 1 << 20
+)
+
 // Credentials - Сущность типа хранимой информации "Логин и пароль"
 type Credentials struct {
 	// ID - Уникальный идентификатор "логина и пароля"
@@ -38,6 +81,8 @@ type Credentials struct {
 	Password string
 	// Meta - Зашифрованные произвольные текстовые метаданные
 	Meta string
+	// Version - Версия данных на сервере, 0 если версия неизвестна
+	Version int64
 }
 
 // BankCard - Сущность типа хранимой информации "Банковкая карта"
@@ -54,4 +99,459 @@ type BankCard struct {
 	CardHolder string
 	// Meta - Зашифрованные произвольные текстовые метаданные
 	Meta string
+	// Version - Версия данных на сервере, 0 если версия неизвестна
+	Version int64
+}
+
+// OTP - Сущность типа хранимой информации "Секрет одноразовых паролей TOTP" приложения-аутентификатора
+type OTP struct {
+	// ID - Уникальный идентификатор секрета
+	ID uuid.UUID
+	// Issuer - Наименование сервиса
+	Issuer string
+	// Account - Учетная запись в сервисе
+	Account string
+	// Secret - Секрет в кодировке base32
+	Secret string
+	// Algorithm - Алгоритм HMAC: SHA1, SHA256 или SHA512
+	Algorithm string
+	// Digits - Количество цифр в коде. Хранится строкой, чтобы его можно было зашифровать
+	Digits string
+	// Period - Период действия кода в секундах. Хранится строкой, чтобы его можно было зашифровать
+	Period string
+	// Meta - Произвольные текстовые метаданные
+	Meta string
+	// Version - Версия данных на сервере, 0 если версия неизвестна
+	Version int64
+}
+
+// Key - Возвращает проверенные параметры генерации кодов
+func (o OTP) Key() (totp.Key, error) {
+	key := totp.Key{
+		Issuer:    o.Issuer,
+		Account:   o.Account,
+		Secret:    o.Secret,
+		Algorithm: o.Algorithm,
+	}
+	digits, err := strconv.Atoi(o.Digits)
+	if err != nil {
+		return key, totp.ErrInvalidKey
+	}
+	period, err := strconv.Atoi(o.Period)
+	if err != nil {
+		return key, totp.ErrInvalidKey
+	}
+	key.Digits = digits
+	key.Period = time.Duration(period) * time.Second
+
+	return key, key.Validate()
+}
+
+// Title - Возвращает наименование секрета для вывода: сервис и учетную запись
+func (o OTP) Title() string {
+	switch {
+	case o.Issuer == "":
+		return o.Account
+	case o.Account == "":
+		return o.Issuer
+	default:
+		return o.Issuer + ":" + o.Account
+	}
+}
+
+// NewOTP - Возвращает секрет одноразовых паролей с параметрами генерации кодов key
+func NewOTP(key totp.Key, meta string) OTP {
+	return OTP{
+		Issuer:    key.Issuer,
+		Account:   key.Account,
+		Secret:    key.Secret,
+		Algorithm: key.Algorithm,
+		Digits:    strconv.Itoa(key.Digits),
+		Period:    strconv.Itoa(int(key.Period / time.Second)),
+		Meta:      meta,
+	}
+}
+
+// SSHKey - Сущность типа хранимой информации "SSH ключ"
+type SSHKey struct {
+	// ID - Уникальный идентификатор ключа
+	ID uuid.UUID
+	// PrivateKey - Закрытый ключ в формате PEM, может быть защищен парольной фразой
+	PrivateKey string
+	// PublicKey - Открытый ключ в формате authorized_keys без комментария
+	PublicKey string
+	// Comment - Комментарий ключа
+	Comment string
+	// Fingerprint - Отпечаток открытого ключа SHA256
+	Fingerprint string
+	// Meta - Произвольные текстовые метаданные
+	Meta string
+	// Version - Версия данных на сервере, 0 если версия неизвестна
+	Version int64
+}
+
+// Title - Возвращает наименование ключа для вывода: комментарий или отпечаток, если комментария нет
+func (k SSHKey) Title() string {
+	if k.Comment == "" {
+		return k.Fingerprint
+	}
+
+	return k.Comment
+}
+
+// NewSSHKey - Возвращает SSH ключ с закрытым ключом privateKey и его открытой частью key
+func NewSSHKey(privateKey string, key sshkey.Key, comment, meta string) SSHKey {
+	return SSHKey{
+		PrivateKey:  privateKey,
+		PublicKey:   key.PublicKey,
+		Comment:     comment,
+		Fingerprint: key.Fingerprint,
+		Meta:        meta,
+	}
+}
+
+// Item - Сущность типа хранимой информации "Произвольные данные по шаблону"
+type Item struct {
+	// ID - Уникальный идентификатор данных
+	ID uuid.UUID
+	// Name - Название данных
+	Name string
+	// Template - Имя шаблона, по которому созданы данные
+	Template string
+	// Fields - Поля данных с типами и значениями
+	Fields []item.Field
+	// Meta - Произвольные текстовые метаданные
+	Meta string
+	// Version - Версия данных на сервере, 0 если версия неизвестна
+	Version int64
+}
+
+// Folder - Папка для упорядочивания данных, папки образуют иерархию
+type Folder struct {
+	// ID - Уникальный идентификатор папки
+	ID uuid.UUID
+	// ParentID - Идентификатор родительской папки, uuid.Nil для папок в корне хранилища
+	ParentID uuid.UUID
+	// Name - Имя папки
+	Name string
+}
+
+// Tag - Метка для упорядочивания данных, одни данные могут иметь несколько меток
+type Tag struct {
+	// ID - Уникальный идентификатор метки
+	ID uuid.UUID
+	// Name - Имя метки
+	Name string
+}
+
+// ItemTemplate - Пользовательский шаблон данных, хранится на сервере и синхронизируется как другие данные
+type ItemTemplate struct {
+	// ID - Уникальный идентификатор шаблона
+	ID uuid.UUID
+	// Name - Имя шаблона, уникально среди встроенных и пользовательских шаблонов
+	Name string
+	// Description - Описание шаблона для вывода пользователю
+	Description string
+	// Fields - Описания полей шаблона в порядке вывода
+	Fields []item.FieldSpec
+}
+
+// Template - Возвращает шаблон, по которому строятся поля данных
+func (t ItemTemplate) Template() item.Template {
+	return item.Template{Name: t.Name, Description: t.Description, Fields: t.Fields}
+}
+
+// Labels - Папка и метки данных
+type Labels struct {
+	// EntityID - Идентификатор данных
+	EntityID uuid.UUID
+	// Kind - Тип данных
+	Kind OperationKind
+	// FolderID - Идентификатор папки, uuid.Nil если данные лежат в корне хранилища
+	FolderID uuid.UUID
+	// TagIDs - Идентификаторы меток данных
+	TagIDs []uuid.UUID
+}
+
+// VaultHeader - Заголовок локального хранилища, содержит параметры вывода ключа из мастер-пароля
+type VaultHeader struct {
+	// Salt - Соль для вывода ключа локального хранилища
+	Salt []byte
+	// Verifier - Зашифрованное выведенным ключом контрольное значение для проверки мастер-пароля
+	Verifier []byte
+}
+
+// InitialVersion - Версия только что созданных на сервере данных
+const InitialVersion int64 = 1
+
+// OperationKind - Тип данных, над которыми выполнена операция из журнала изменений
+type OperationKind string
+
+const (
+	// TextKind - Произвольный текст
+	TextKind OperationKind = "text"
+	// BinaryKind - Произвольные бинарные данные
+	BinaryKind OperationKind = "binary"
+	// CredentialsKind - Логин и пароль
+	CredentialsKind OperationKind = "credentials"
+	// BankCardKind - Банковская карта
+	BankCardKind OperationKind = "bank_card"
+	// OTPKind - Секрет одноразовых паролей
+	OTPKind OperationKind = "otp"
+	// SSHKeyKind - SSH ключ
+	SSHKeyKind OperationKind = "ssh_key"
+	// ItemKind - Произвольные данные по шаблону
+	ItemKind OperationKind = "item"
+	// FolderKind - Папка
+	FolderKind OperationKind = "folder"
+	// TagKind - Метка
+	TagKind OperationKind = "tag"
+	// TemplateKind - Пользовательский шаблон данных
+	TemplateKind OperationKind = "template"
+	// LabelsKind - Папка и метки данных, встречается только в журнале изменений
+	LabelsKind OperationKind = "labels"
+)
+
+// OperationAction - Действие, выполненное над данными
+type OperationAction string
+
+const (
+	// CreateAction - Создание данных
+	CreateAction OperationAction = "create"
+	// UpdateAction - Обновление данных
+	UpdateAction OperationAction = "update"
+	// DeleteAction - Удаление данных
+	DeleteAction OperationAction = "delete"
+)
+
+// PendingUpload - Незавершенная загрузка бинарных данных частями, по ней загрузка продолжается после обрыва
+type PendingUpload struct {
+	// ID - Идентификатор сессии загрузки на сервере
+	ID uuid.UUID
+	// Fingerprint - Контрольная сумма SHA-256 загружаемого содержимого в шестнадцатеричном виде
+	Fingerprint string
+	// Size - Размер загружаемого содержимого в байтах
+	Size int64
+}
+
+// Operation - Сущность отложенной операции журнала изменений, выполненной без связи с сервером
+type Operation struct {
+	// ID - Порядковый номер операции в журнале, назначается репозиторием
+	ID uint64
+	// Kind - Тип данных
+	Kind OperationKind
+	// Action - Действие над данными
+	Action OperationAction
+	// EntityID - Идентификатор данных, для созданных без связи с сервером это локальный идентификатор
+	EntityID uuid.UUID
+	// BaseVersion - Версия данных на сервере, от которой выполнено обновление.
+	// Для данных, созданных без связи с сервером, равна нулю, и версия при отправке не проверяется
+	BaseVersion int64
+	// Base - Данные до обновления в формате JSON, по ним выводится конфликт версий при отправке
+	Base []byte
+}
+
+// Tombstone - Запись об удалении данных на сервере
+type Tombstone struct {
+	// ID - Идентификатор удаленных данных
+	ID uuid.UUID
+	// Kind - Тип удаленных данных
+	Kind OperationKind
+}
+
+// Changes - Изменения данных пользователя на сервере после ревизии, известной клиенту
+type Changes struct {
+	// Revision - Ревизия, с которой нужно запрашивать следующие изменения
+	Revision int64
+	// More - Признак того, что после Revision на сервере есть еще изменения
+	More bool
+	// Texts - Созданные или обновленные текстовые данные
+	Texts []Text
+	// Binaries - Созданные или обновленные бинарные данные
+	Binaries []Binary
+	// Credentials - Созданные или обновленные логины и пароли
+	Credentials []Credentials
+	// BankCards - Созданные или обновленные банковские карты
+	BankCards []BankCard
+	// OTPs - Созданные или обновленные секреты одноразовых паролей
+	OTPs []OTP
+	// SSHKeys - Созданные или обновленные SSH ключи
+	SSHKeys []SSHKey
+	// Items - Созданные или обновленные данные по шаблону
+	Items []Item
+	// Folders - Созданные, переименованные или перемещенные папки
+	Folders []Folder
+	// Tags - Созданные или переименованные метки
+	Tags []Tag
+	// Templates - Созданные пользовательские шаблоны
+	Templates []ItemTemplate
+	// Labels - Измененные папки и метки данных
+	Labels []Labels
+	// Deleted - Записи об удалении данных
+	Deleted []Tombstone
+}
+
+// ConflictField - Значения поля данных в конфликте версий
+type ConflictField struct {
+	// Name - Наименование поля
+	Name string
+	// Base - Значение до локального изменения
+	Base string
+	// Mine - Локальное значение
+	Mine string
+	// Theirs - Актуальное значение на сервере
+	Theirs string
+}
+
+// Conflict - Конфликт версий: обновляемые данные были изменены на другом устройстве
+type Conflict struct {
+	// Kind - Тип данных
+	Kind OperationKind
+	// ID - Идентификатор данных
+	ID uuid.UUID
+	// Version - Актуальная версия данных на сервере
+	Version int64
+	// Fields - Значения полей данных в порядке их объявления в сущности
+	Fields []ConflictField
+}
+
+// Error - Реализует интерфейс error, чтобы конфликт можно было вернуть из сценария обновления
+func (c *Conflict) Error() string {
+	return ErrVersionConflict.Error()
+}
+
+// Unwrap - Позволяет проверять конфликт через errors.Is(err, ErrVersionConflict)
+func (c *Conflict) Unwrap() error {
+	return ErrVersionConflict
+}
+
+// FolderEntry - Данные, лежащие в папке
+type FolderEntry struct {
+	// Kind - Тип данных
+	Kind OperationKind
+	// ID - Идентификатор данных
+	ID uuid.UUID
+	// Title - Краткое описание данных: наименование, имя файла, держатель карты или начало текста
+	Title string
+	// Tags - Имена меток данных
+	Tags []string
+}
+
+// FolderContent - Содержимое папки
+type FolderContent struct {
+	// Path - Полный путь папки, пустая строка для корня хранилища
+	Path string
+	// Folders - Вложенные папки
+	Folders []Folder
+	// Entries - Данные, лежащие непосредственно в папке
+	Entries []FolderEntry
+}
+
+// SearchResult - Найденные при поиске по локальному хранилищу данные
+type SearchResult struct {
+	// Kind - Тип данных
+	Kind OperationKind
+	// ID - Идентификатор данных
+	ID uuid.UUID
+	// Title - Краткое описание данных: наименование, имя файла, держатель карты или начало текста
+	Title string
+	// Field - Наименование поля с лучшим совпадением
+	Field string
+	// Score - Оценка совпадения, результаты с большей оценкой выводятся выше
+	Score int
+}
+
+// CredentialsAudit - Оценка надежности пароля одной пары логин и пароль, сам пароль в отчет не попадает
+type CredentialsAudit struct {
+	// ID - Идентификатор логина и пароля
+	ID uuid.UUID
+	// Name - Наименование
+	Name string
+	// Login - Логин
+	Login string
+	// Score - Оценка надежности пароля от 0 (подбирается мгновенно) до 4 (надежный)
+	Score int
+	// Entropy - Оценка энтропии пароля в битах
+	Entropy float64
+	// CrackTime - Примерное время подбора пароля
+	CrackTime string
+	// Weak - Пароль признан слабым
+	Weak bool
+	// ReuseGroup - Номер группы записей с одинаковым паролем, 0 если пароль больше нигде не используется
+	ReuseGroup int
+	// Breaches - Количество утечек, в которых встречается пароль, 0 если пароль не найден или проверка не выполнялась
+	Breaches int
+}
+
+// BankCardAudit - Банковская карта, срок действия которой истек или скоро истечет
+type BankCardAudit struct {
+	// ID - Идентификатор банковской карты
+	ID uuid.UUID
+	// Number - Маскированный номер карты, видны только последние 4 цифры
+	Number string
+	// CardHolder - Имя и фамилия держателя карты
+	CardHolder string
+	// ValidThru - Срок действия карты
+	ValidThru string
+	// Expired - Срок действия карты истек
+	Expired bool
+	// DaysLeft - Количество полных дней до истечения срока действия, 0 если срок истек
+	DaysLeft int
+}
+
+// AuditReport - Отчет о надежности паролей и сроках действия банковских карт
+type AuditReport struct {
+	// Credentials - Оценки всех паролей, от самых слабых к самым надежным
+	Credentials []CredentialsAudit
+	// Reused - Группы идентификаторов записей с одинаковым паролем, номер группы на единицу больше индекса
+	Reused [][]uuid.UUID
+	// BankCards - Карты с истекшим или истекающим сроком действия
+	BankCards []BankCardAudit
+	// BreachesChecked - Пароли проверены по набору утекших паролей
+	BreachesChecked bool
+	// Breached - Идентификаторы записей, пароли которых найдены в наборе утекших паролей
+	Breached []uuid.UUID
+}
+
+// VaultItemsCount - Количество данных каждого типа при экспорте и импорте резервной копии хранилища
+type VaultItemsCount struct {
+	// Texts - Количество текстов
+	Texts int
+	// Binaries - Количество бинарных данных
+	Binaries int
+	// Credentials - Количество логинов и паролей
+	Credentials int
+	// BankCards - Количество банковских карт
+	BankCards int
+	// OTPs - Количество секретов одноразовых паролей
+	OTPs int
+	// SSHKeys - Количество SSH ключей
+	SSHKeys int
+	// Items - Количество данных по шаблону
+	Items int
+}
+
+// Total - Возвращает общее количество данных
+func (c VaultItemsCount) Total() int {
+	return c.Texts + c.Binaries + c.Credentials + c.BankCards + c.OTPs + c.SSHKeys + c.Items
+}
+
+// Add - Увеличивает количество данных типа kind
+func (c *VaultItemsCount) Add(kind OperationKind) {
+	switch kind {
+	case TextKind:
+		c.Texts++
+	case BinaryKind:
+		c.Binaries++
+	case CredentialsKind:
+		c.Credentials++
+	case BankCardKind:
+		c.BankCards++
+	case OTPKind:
+		c.OTPs++
+	case SSHKeyKind:
+		c.SSHKeys++
+	case ItemKind:
+		c.Items++
+	}
 }
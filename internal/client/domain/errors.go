@@ -8,3 +8,30 @@ var ErrLoginConflict = errors.New("user with this login already exists")
 var ErrBadRequest = errors.New("invalid input")
 var ErrInvalidToken = errors.New("invalid token")
 var ErrClientConnectionError = errors.New("http client connection error")
+var ErrServerUnavailable = errors.New("server is unavailable")
+var ErrPendingOperations = errors.New("there are local changes that were not pushed, run `gophkeeper sync push` first")
+var ErrVersionConflict = errors.New("data was changed on another device")
+var ErrVaultLocked = errors.New("local storage is locked, run `gophkeeper unlock` first")
+var ErrWrongMasterPassword = errors.New("wrong master password")
+var ErrVaultNotMigrated = errors.New(
+	"local storage can not be migrated to the master password and contains changes that were not pushed, " +
+		"push them with the previous client version first",
+)
+var ErrInvalidTwoFactorCode = errors.New("invalid two-factor code")
+var ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+var ErrTwoFactorNotEnabled = errors.New("two-factor authentication is not enabled")
+var ErrUnknownField = errors.New("unknown field")
+var ErrClipboardClearUnsupported = errors.New("clipboard can not be cleared automatically, clear it manually")
+var ErrInvalidBackupRecord = errors.New("invalid vault backup record")
+var ErrFolderNotFound = errors.New("folder not found")
+var ErrFolderCycle = errors.New("folder can not be moved into itself or its subfolder")
+
+// TwoFactorRequiredError - Пароль верный, но для входа требуется код второго фактора
+type TwoFactorRequiredError struct {
+	// Challenge - Токен частичной аутентификации, обменивается на пару токенов вместе с кодом
+	Challenge string
+}
+
+func (e *TwoFactorRequiredError) Error() string {
+	return "two-factor code required"
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/lestrrat-go/jwx/v2/jwk"
 )
@@ -27,6 +29,10 @@ type TextRepositoryInterface interface {
 	GetAll(userID uuid.UUID) ([]Text, error)
 	// ReplaceAll - Заменяет все локальные текстовые данные пользователя на новые
 	ReplaceAll(userID uuid.UUID, texts []Text) error
+	// Delete - Удаляет текстовые данные по идентификатору данных и пользователя
+	Delete(userID, textID uuid.UUID) error
+	// ApplyChanges - Сохраняет созданные или обновленные на сервере текстовые данные и удаляет удаленные
+	ApplyChanges(userID uuid.UUID, texts []Text, deletedIDs []uuid.UUID) error
 }
 
 // JWKRepositoryInterface - Интерфейс хранилища публичного ключа
@@ -51,6 +57,10 @@ type BinaryRepositoryInterface interface {
 	GetAll(userID uuid.UUID) ([]Binary, error)
 	// ReplaceAll - Заменяет все локальные бинарные данные пользователя на новые
 	ReplaceAll(userID uuid.UUID, bins []Binary) error
+	// Delete - Удаляет бинарные данные по идентификатору данных и пользователя
+	Delete(userID, binID uuid.UUID) error
+	// ApplyChanges - Сохраняет созданные или обновленные на сервере бинарные данные и удаляет удаленные
+	ApplyChanges(userID uuid.UUID, bins []Binary, deletedIDs []uuid.UUID) error
 }
 
 // CredentialsRepositoryInterface - Интерфейс репозитория для логинов и паролей
@@ -65,6 +75,10 @@ type CredentialsRepositoryInterface interface {
 	GetAll(userID uuid.UUID) ([]Credentials, error)
 	// ReplaceAll - Заменяет все локальные логины и пароли пользователя на новые
 	ReplaceAll(userID uuid.UUID, creds []Credentials) error
+	// Delete - Удаляет пару логин и пароль по идентификатору данных и пользователя
+	Delete(userID, credID uuid.UUID) error
+	// ApplyChanges - Сохраняет созданные или обновленные на сервере логины и пароли и удаляет удаленные
+	ApplyChanges(userID uuid.UUID, creds []Credentials, deletedIDs []uuid.UUID) error
 }
 
 // BankCardRepositoryInterface - Интерфейс репозитория для банковских карт
@@ -79,6 +93,162 @@ type BankCardRepositoryInterface interface {
 	GetAll(userID uuid.UUID) ([]BankCard, error)
 	// ReplaceAll - Заменяет все локальные банковские пользователя на новые
 	ReplaceAll(userID uuid.UUID, creds []BankCard) error
+	// Delete - Удаляет банковскую карту по идентификатору данных и пользователя
+	Delete(userID, cardID uuid.UUID) error
+	// ApplyChanges - Сохраняет созданные или обновленные на сервере банковские карты и удаляет удаленные
+	ApplyChanges(userID uuid.UUID, cards []BankCard, deletedIDs []uuid.UUID) error
+}
+
+// OTPRepositoryInterface - Интерфейс репозитория для секретов одноразовых паролей
+type OTPRepositoryInterface interface {
+	// Create - Сохраняет новый секрет одноразовых паролей
+	Create(userID uuid.UUID, otp *OTP) error
+	// Update - Сохраняет существующий секрет одноразовых паролей
+	Update(userID uuid.UUID, otp *OTP) error
+	// Get - Возвращает секрет одноразовых паролей по идентификатору данных и пользователя, если он существует
+	Get(userID, otpID uuid.UUID) (OTP, error)
+	// GetAll - Возвращает все секреты одноразовых паролей для пользователя
+	GetAll(userID uuid.UUID) ([]OTP, error)
+	// ReplaceAll - Заменяет все локальные секреты одноразовых паролей пользователя на новые
+	ReplaceAll(userID uuid.UUID, otps []OTP) error
+	// Delete - Удаляет секрет одноразовых паролей по идентификатору данных и пользователя
+	Delete(userID, otpID uuid.UUID) error
+	// ApplyChanges - Сохраняет созданные или обновленные на сервере секреты одноразовых паролей и удаляет удаленные
+	ApplyChanges(userID uuid.UUID, otps []OTP, deletedIDs []uuid.UUID) error
+}
+
+// SSHKeyRepositoryInterface - Интерфейс репозитория для SSH ключей
+type SSHKeyRepositoryInterface interface {
+	// Create - Сохраняет новый SSH ключ
+	Create(userID uuid.UUID, key *SSHKey) error
+	// Update - Сохраняет существующий SSH ключ
+	Update(userID uuid.UUID, key *SSHKey) error
+	// Get - Возвращает SSH ключ по идентификатору данных и пользователя, если он существует
+	Get(userID, keyID uuid.UUID) (SSHKey, error)
+	// GetAll - Возвращает все SSH ключи для пользователя
+	GetAll(userID uuid.UUID) ([]SSHKey, error)
+	// ReplaceAll - Заменяет все локальные SSH ключи пользователя на новые
+	ReplaceAll(userID uuid.UUID, keys []SSHKey) error
+	// Delete - Удаляет SSH ключ по идентификатору данных и пользователя
+	Delete(userID, keyID uuid.UUID) error
+	// ApplyChanges - Сохраняет созданные или обновленные на сервере SSH ключи и удаляет удаленные
+	ApplyChanges(userID uuid.UUID, keys []SSHKey, deletedIDs []uuid.UUID) error
+}
+
+// ItemRepositoryInterface - Интерфейс репозитория для данных по шаблону
+type ItemRepositoryInterface interface {
+	// Create - Сохраняет новые данные по шаблону
+	Create(userID uuid.UUID, obj *Item) error
+	// Update - Сохраняет существующие данные по шаблону
+	Update(userID uuid.UUID, obj *Item) error
+	// Get - Возвращает данные по шаблону по идентификатору данных и пользователя, если они существуют
+	Get(userID, itemID uuid.UUID) (Item, error)
+	// GetAll - Возвращает все данные по шаблону для пользователя
+	GetAll(userID uuid.UUID) ([]Item, error)
+	// ReplaceAll - Заменяет все локальные данные по шаблону пользователя на новые
+	ReplaceAll(userID uuid.UUID, items []Item) error
+	// Delete - Удаляет данные по шаблону по идентификатору данных и пользователя
+	Delete(userID, itemID uuid.UUID) error
+	// ApplyChanges - Сохраняет созданные или обновленные на сервере данные по шаблону и удаляет удаленные
+	ApplyChanges(userID uuid.UUID, items []Item, deletedIDs []uuid.UUID) error
+}
+
+// FolderRepositoryInterface - Интерфейс репозитория для папок
+type FolderRepositoryInterface interface {
+	// Create - Сохраняет новую папку
+	Create(userID uuid.UUID, folder Folder) error
+	// Update - Сохраняет существующую папку
+	Update(userID uuid.UUID, folder Folder) error
+	// Get - Возвращает папку по идентификатору папки и пользователя, если она существует
+	Get(userID, folderID uuid.UUID) (Folder, error)
+	// GetAll - Возвращает все папки пользователя
+	GetAll(userID uuid.UUID) ([]Folder, error)
+	// ReplaceAll - Заменяет все локальные папки пользователя на новые
+	ReplaceAll(userID uuid.UUID, folders []Folder) error
+	// Delete - Удаляет папку по идентификатору папки и пользователя
+	Delete(userID, folderID uuid.UUID) error
+	// ApplyChanges - Сохраняет созданные или обновленные на сервере папки и удаляет удаленные
+	ApplyChanges(userID uuid.UUID, folders []Folder, deletedIDs []uuid.UUID) error
+}
+
+// TagRepositoryInterface - Интерфейс репозитория для меток
+type TagRepositoryInterface interface {
+	// Create - Сохраняет новую метку
+	Create(userID uuid.UUID, tag Tag) error
+	// Update - Сохраняет существующую метку
+	Update(userID uuid.UUID, tag Tag) error
+	// Get - Возвращает метку по идентификатору метки и пользователя, если она существует
+	Get(userID, tagID uuid.UUID) (Tag, error)
+	// GetAll - Возвращает все метки пользователя
+	GetAll(userID uuid.UUID) ([]Tag, error)
+	// ReplaceAll - Заменяет все локальные метки пользователя на новые
+	ReplaceAll(userID uuid.UUID, tags []Tag) error
+	// Delete - Удаляет метку по идентификатору метки и пользователя
+	Delete(userID, tagID uuid.UUID) error
+	// ApplyChanges - Сохраняет созданные или обновленные на сервере метки и удаляет удаленные
+	ApplyChanges(userID uuid.UUID, tags []Tag, deletedIDs []uuid.UUID) error
+}
+
+// LabelsRepositoryInterface - Интерфейс репозитория для папок и меток данных
+type LabelsRepositoryInterface interface {
+	// Create - Сохраняет папку и метки новых данных
+	Create(userID uuid.UUID, labels Labels) error
+	// Update - Сохраняет папку и метки существующих данных
+	Update(userID uuid.UUID, labels Labels) error
+	// Get - Возвращает папку и метки данных по идентификатору данных и пользователя, если они сохранены
+	Get(userID, entityID uuid.UUID) (Labels, error)
+	// GetAll - Возвращает папки и метки всех данных пользователя
+	GetAll(userID uuid.UUID) ([]Labels, error)
+	// ReplaceAll - Заменяет папки и метки всех локальных данных пользователя на новые
+	ReplaceAll(userID uuid.UUID, values []Labels) error
+	// Delete - Удаляет папку и метки данных по идентификатору данных и пользователя
+	Delete(userID, entityID uuid.UUID) error
+	// ApplyChanges - Сохраняет созданные или обновленные на сервере папки и метки данных и удаляет удаленные
+	ApplyChanges(userID uuid.UUID, values []Labels, deletedIDs []uuid.UUID) error
+}
+
+// JournalRepositoryInterface - Интерфейс журнала изменений, выполненных без связи с сервером
+type JournalRepositoryInterface interface {
+	// Append - Добавляет операцию в конец журнала
+	Append(userID uuid.UUID, op Operation) error
+	// GetAll - Возвращает все операции пользователя в порядке добавления
+	GetAll(userID uuid.UUID) ([]Operation, error)
+	// Delete - Удаляет операцию из журнала
+	Delete(userID uuid.UUID, opID uint64) error
+	// ReplaceEntityID - Заменяет локальный идентификатор данных на выданный сервером во всех операциях пользователя
+	ReplaceEntityID(userID, oldID, newID uuid.UUID) error
+}
+
+// UploadRepositoryInterface - Интерфейс репозитория незавершенных загрузок бинарных данных частями
+type UploadRepositoryInterface interface {
+	// Save - Сохраняет незавершенную загрузку по контрольной сумме загружаемого содержимого
+	Save(userID uuid.UUID, upload PendingUpload) error
+	// Get - Возвращает незавершенную загрузку по контрольной сумме загружаемого содержимого, если она существует
+	Get(userID uuid.UUID, fingerprint string) (PendingUpload, error)
+	// Delete - Удаляет незавершенную загрузку, отсутствие загрузки не считается ошибкой
+	Delete(userID uuid.UUID, fingerprint string) error
+}
+
+// RevisionRepositoryInterface - Интерфейс репозитория последней полученной от сервера ревизии данных
+type RevisionRepositoryInterface interface {
+	// Get - Возвращает последнюю полученную ревизию пользователя, 0 если синхронизации еще не было
+	Get(userID uuid.UUID) (int64, error)
+	// Save - Сохраняет последнюю полученную ревизию пользователя
+	Save(userID uuid.UUID, revision int64) error
+}
+
+// TemplateRepositoryInterface - Интерфейс репозитория для пользовательских шаблонов
+type TemplateRepositoryInterface interface {
+	// Create - Сохраняет новый шаблон
+	Create(userID uuid.UUID, tmpl ItemTemplate) error
+	// GetAll - Возвращает все шаблоны пользователя
+	GetAll(userID uuid.UUID) ([]ItemTemplate, error)
+	// ReplaceAll - Заменяет все локальные шаблоны пользователя на новые
+	ReplaceAll(userID uuid.UUID, templates []ItemTemplate) error
+	// Delete - Удаляет шаблон по идентификатору шаблона и пользователя
+	Delete(userID, templateID uuid.UUID) error
+	// ApplyChanges - Сохраняет созданные на сервере шаблоны и удаляет удаленные
+	ApplyChanges(userID uuid.UUID, templates []ItemTemplate, deletedIDs []uuid.UUID) error
 }
 
 // UnitOfWorkInterface - Интерфейс Unit Of Work для инкапсулирования транзакционной целостности
@@ -98,4 +268,56 @@ type UnitOfWorkInterface interface {
 	CredentialsRepository() CredentialsRepositoryInterface
 	// BankCardRepository - Возвращает BankCardRepository для работы в пределах транзакции
 	BankCardRepository() BankCardRepositoryInterface
+	// OTPRepository - Возвращает OTPRepository для работы в пределах транзакции
+	OTPRepository() OTPRepositoryInterface
+	// SSHKeyRepository - Возвращает SSHKeyRepository для работы в пределах транзакции
+	SSHKeyRepository() SSHKeyRepositoryInterface
+	// ItemRepository - Возвращает ItemRepository для работы в пределах транзакции
+	ItemRepository() ItemRepositoryInterface
+	// FolderRepository - Возвращает FolderRepository для работы в пределах транзакции
+	FolderRepository() FolderRepositoryInterface
+	// TagRepository - Возвращает TagRepository для работы в пределах транзакции
+	TagRepository() TagRepositoryInterface
+	// TemplateRepository - Возвращает TemplateRepository для работы в пределах транзакции
+	TemplateRepository() TemplateRepositoryInterface
+	// LabelsRepository - Возвращает LabelsRepository для работы в пределах транзакции
+	LabelsRepository() LabelsRepositoryInterface
+	// RevisionRepository - Возвращает RevisionRepository для работы в пределах транзакции
+	RevisionRepository() RevisionRepositoryInterface
+}
+
+// VaultHeaderRepositoryInterface - Интерфейс репозитория заголовка локального хранилища
+type VaultHeaderRepositoryInterface interface {
+	// Get - Возвращает заголовок локального хранилища, если он существует
+	Get() (*VaultHeader, error)
+	// Init - Сохраняет новый заголовок хранилища и перешифровывает существующие данные из ключа previous ключом current.
+	// Данные, которые не расшифровываются ключом previous, удаляются, если среди них нет неотправленных изменений,
+	// иначе возвращается ErrVaultNotMigrated
+	Init(header VaultHeader, previous, current CryptoServiceInterface) error
+}
+
+// KeyCacheInterface - Интерфейс кэша ключа локального хранилища
+type KeyCacheInterface interface {
+	// Get - Возвращает ключ локального хранилища, ErrVaultLocked если хранилище заблокировано
+	Get() ([]byte, error)
+	// Put - Сохраняет ключ локального хранилища на время ttl
+	Put(key []byte, ttl time.Duration) error
+	// Clear - Удаляет ключ локального хранилища из кэша
+	Clear() error
+}
+
+// ClipboardInterface - Интерфейс системного буфера обмена
+type ClipboardInterface interface {
+	// Write - Записывает секрет в буфер обмена
+	Write(secret string) error
+	// ScheduleClear - Очищает буфер обмена через время after, если он все еще содержит секрет.
+	// Буфер терминала OSC 52 нельзя прочитать, он очищается без проверки.
+	// Возвращает ErrClipboardClearUnsupported, если буфер нельзя очистить автоматически
+	ScheduleClear(secret string, after time.Duration) error
+}
+
+// BreachDatasetInterface - Интерфейс локального набора утекших паролей
+type BreachDatasetInterface interface {
+	// Count - Возвращает количество утечек, в которых встречается пароль, 0 если пароль не найден
+	Count(password string) (int, error)
 }
@@ -0,0 +1,133 @@
+package importers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// BitwardenJSON - Имя формата незашифрованного JSON экспорта Bitwarden
+const BitwardenJSON = "bitwarden-json"
+
+// Типы записей Bitwarden
+const (
+	bitwardenTypeLogin = 1
+	bitwardenTypeNote  = 2
+	bitwardenTypeCard  = 3
+)
+
+type bitwardenExport struct {
+	Encrypted bool            `json:"encrypted"`
+	Items     []bitwardenItem `json:"items"`
+}
+
+type bitwardenItem struct {
+	Type   int              `json:"type"`
+	Name   string           `json:"name"`
+	Notes  string           `json:"notes"`
+	Login  *bitwardenLogin  `json:"login"`
+	Card   *bitwardenCard   `json:"card"`
+	Fields []bitwardenField `json:"fields"`
+}
+
+type bitwardenLogin struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	URIs     []struct {
+		URI string `json:"uri"`
+	} `json:"uris"`
+}
+
+type bitwardenCard struct {
+	CardholderName string `json:"cardholderName"`
+	Brand          string `json:"brand"`
+	Number         string `json:"number"`
+	ExpMonth       string `json:"expMonth"`
+	ExpYear        string `json:"expYear"`
+	Code           string `json:"code"`
+}
+
+type bitwardenField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// notes - Возвращает заметку записи вместе с дополнительными полями
+func (i bitwardenItem) notes() string {
+	lines := []string{i.Notes}
+	for _, v := range i.Fields {
+		lines = append(lines, v.Name+": "+v.Value)
+	}
+
+	return joinMeta(lines...)
+}
+
+// ParseBitwardenJSON - Разбирает незашифрованный JSON экспорт Bitwarden.
+// Логины, карты и заметки импортируются, остальные типы записей пропускаются
+func ParseBitwardenJSON(source io.Reader) (Result, error) {
+	result := Result{}
+	export := bitwardenExport{}
+	if err := json.NewDecoder(source).Decode(&export); err != nil {
+		return result, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+	if export.Encrypted {
+		return result, ErrEncryptedExport
+	}
+
+	for i, item := range export.Items {
+		row := i + 1
+		switch item.Type {
+		case bitwardenTypeLogin:
+			if item.Login == nil {
+				result.skip(row, item.Name, "no login data")
+
+				continue
+			}
+			uris := make([]string, 0, len(item.Login.URIs))
+			for _, v := range item.Login.URIs {
+				uris = append(uris, v.URI)
+			}
+			uri := strings.Join(uris, " ")
+			cred, reason := newCredentials(item.Name, item.Login.Username, item.Login.Password, uri, item.notes())
+			if reason != "" {
+				result.skip(row, item.Name, reason)
+
+				continue
+			}
+			result.Credentials = append(result.Credentials, cred)
+		case bitwardenTypeNote:
+			text, reason := newText(item.Name, item.notes())
+			if reason != "" {
+				result.skip(row, item.Name, reason)
+
+				continue
+			}
+			result.Texts = append(result.Texts, text)
+		case bitwardenTypeCard:
+			if item.Card == nil || item.Card.Number == "" {
+				result.skip(row, item.Name, "no card number")
+
+				continue
+			}
+			expiry, ok := validThru(item.Card.ExpMonth, item.Card.ExpYear)
+			if !ok {
+				result.skip(row, item.Name, "invalid expiration date")
+
+				continue
+			}
+			card, reason := newBankCard(item.Card.Number, expiry, item.Card.Code, item.Card.CardholderName)
+			if reason != "" {
+				result.skip(row, item.Name, reason)
+
+				continue
+			}
+			card.Meta = joinMeta(item.Name, item.notes())
+			result.BankCards = append(result.BankCards, card)
+		default:
+			result.skip(row, item.Name, fmt.Sprintf("unsupported item type %d", item.Type))
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,159 @@
+package importers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// OnePasswordCSV - Имя формата CSV экспорта 1Password
+	OnePasswordCSV = "1password-csv"
+	// ChromeCSV - Имя формата CSV экспорта паролей Chrome и других браузеров на Chromium
+	ChromeCSV = "chrome-csv"
+)
+
+// Поля строки CSV, к которым приводятся заголовки разных форматов
+const (
+	csvTitle    = "title"
+	csvURL      = "url"
+	csvUsername = "username"
+	csvPassword = "password"
+	csvNotes    = "notes"
+	csvOTP      = "otp"
+	csvTags     = "tags"
+	csvArchived = "archived"
+)
+
+// onePasswordColumns - Заголовки 1Password 8 и 1Password 7
+var onePasswordColumns = map[string]string{
+	"title":    csvTitle,
+	"url":      csvURL,
+	"website":  csvURL,
+	"username": csvUsername,
+	"password": csvPassword,
+	"notes":    csvNotes,
+	"otpauth":  csvOTP,
+	"tags":     csvTags,
+	"archived": csvArchived,
+}
+
+// chromeColumns - Заголовки экспорта паролей Chrome
+var chromeColumns = map[string]string{
+	"name":     csvTitle,
+	"url":      csvURL,
+	"username": csvUsername,
+	"password": csvPassword,
+	"note":     csvNotes,
+}
+
+// csvRow - Строка CSV с полями, приведенными к общим именам
+type csvRow struct {
+	line   int
+	fields map[string]string
+}
+
+// readCSV - Читает CSV с заголовком, неизвестные столбцы отбрасываются.
+// Файл без столбца пароля не считается файлом экспорта
+func readCSV(source io.Reader, columns map[string]string) ([]csvRow, error) {
+	reader := csv.NewReader(source)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	fields := make([]string, len(header))
+	hasPassword := false
+	for i, v := range header {
+		v = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(v, "\uFEFF")))
+		fields[i] = columns[v]
+		hasPassword = hasPassword || fields[i] == csvPassword
+	}
+	if !hasPassword {
+		return nil, fmt.Errorf("%w: no password column in header", ErrInvalidFile)
+	}
+
+	rows := []csvRow{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+		}
+		line, _ := reader.FieldPos(0)
+		row := csvRow{line: line, fields: map[string]string{}}
+		for i, v := range record {
+			if i < len(fields) && fields[i] != "" {
+				row.fields[fields[i]] = strings.TrimSpace(v)
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+// addCSVRow - Добавляет строку как логин и пароль или как заметку, если в строке есть только текст
+func (r *Result) addCSVRow(row csvRow, notes string) {
+	title := row.fields[csvTitle]
+	if row.fields[csvPassword] == "" && row.fields[csvUsername] == "" && notes != "" {
+		text, _ := newText(title, notes)
+		r.Texts = append(r.Texts, text)
+
+		return
+	}
+
+	cred, reason := newCredentials(title, row.fields[csvUsername], row.fields[csvPassword], row.fields[csvURL], notes)
+	if reason != "" {
+		r.skip(row.line, title, reason)
+
+		return
+	}
+	r.Credentials = append(r.Credentials, cred)
+}
+
+// ParseOnePasswordCSV - Разбирает CSV экспорт 1Password. Архивные записи пропускаются,
+// ссылка одноразовых паролей и метки сохраняются в метаданные
+func ParseOnePasswordCSV(source io.Reader) (Result, error) {
+	result := Result{}
+	rows, err := readCSV(source, onePasswordColumns)
+	if err != nil {
+		return result, err
+	}
+	for _, row := range rows {
+		if strings.EqualFold(row.fields[csvArchived], "true") {
+			result.skip(row.line, row.fields[csvTitle], "archived")
+
+			continue
+		}
+		notes := joinMeta(row.fields[csvNotes], prefixed("otp: ", row.fields[csvOTP]), prefixed("tags: ", row.fields[csvTags]))
+		result.addCSVRow(row, notes)
+	}
+
+	return result, nil
+}
+
+// ParseChromeCSV - Разбирает CSV экспорт паролей Chrome, записи без наименования называются по хосту адреса
+func ParseChromeCSV(source io.Reader) (Result, error) {
+	result := Result{}
+	rows, err := readCSV(source, chromeColumns)
+	if err != nil {
+		return result, err
+	}
+	for _, row := range rows {
+		result.addCSVRow(row, row.fields[csvNotes])
+	}
+
+	return result, nil
+}
+
+// prefixed - Возвращает значение с подписью или пустую строку для пустого значения
+func prefixed(prefix, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return prefix + value
+}
@@ -0,0 +1,209 @@
+// Package importers содержит разбор файлов экспорта других менеджеров паролей.
+// Каждый формат регистрируется под своим именем, результат разбора содержит сущности
+// клиента без идентификаторов и список пропущенных строк
+package importers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+var (
+	// ErrUnknownFormat - Формат не зарегистрирован
+	ErrUnknownFormat = errors.New("unknown import format")
+	// ErrInvalidFile - Файл не соответствует формату
+	ErrInvalidFile = errors.New("invalid import file")
+	// ErrEncryptedExport - Файл экспорта зашифрован менеджером паролей
+	ErrEncryptedExport = errors.New("encrypted exports are not supported, export the vault unencrypted")
+)
+
+var (
+	cardNumberPattern = regexp.MustCompile(`^\d{4} \d{4} \d{4} \d{4}$`)
+	cvvPattern        = regexp.MustCompile(`^\d{3,4}$`)
+	cardHolderPattern = regexp.MustCompile(`^((?:[A-Za-z]+ ?){0,3})$`)
+)
+
+// Skipped - Строка файла, которая не была импортирована
+type Skipped struct {
+	// Row - Номер строки CSV или порядковый номер записи в JSON и XML, начиная с 1
+	Row int
+	// Name - Наименование записи, если оно известно
+	Name string
+	// Reason - Причина пропуска
+	Reason string
+}
+
+// Result - Результат разбора файла
+type Result struct {
+	// Texts - Защищенные заметки
+	Texts []domain.Text
+	// Credentials - Логины и пароли
+	Credentials []domain.Credentials
+	// BankCards - Банковские карты
+	BankCards []domain.BankCard
+	// Skipped - Пропущенные строки
+	Skipped []Skipped
+}
+
+// Count - Возвращает количество разобранных данных по типам
+func (r Result) Count() domain.VaultItemsCount {
+	return domain.VaultItemsCount{
+		Texts:       len(r.Texts),
+		Credentials: len(r.Credentials),
+		BankCards:   len(r.BankCards),
+	}
+}
+
+func (r *Result) skip(row int, name, reason string) {
+	r.Skipped = append(r.Skipped, Skipped{Row: row, Name: name, Reason: reason})
+}
+
+// Parser - Функция разбора файла экспорта
+type Parser func(source io.Reader) (Result, error)
+
+var parsers = map[string]Parser{
+	BitwardenJSON:  ParseBitwardenJSON,
+	KeePassXML:     ParseKeePassXML,
+	OnePasswordCSV: ParseOnePasswordCSV,
+	ChromeCSV:      ParseChromeCSV,
+}
+
+// Register - Регистрирует разбор формата, повторная регистрация заменяет предыдущую
+func Register(format string, parser Parser) {
+	parsers[format] = parser
+}
+
+// Formats - Возвращает отсортированные имена зарегистрированных форматов
+func Formats() []string {
+	formats := make([]string, 0, len(parsers))
+	for format := range parsers {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+
+	return formats
+}
+
+// Parse - Разбирает файл экспорта в формате format
+func Parse(format string, source io.Reader) (Result, error) {
+	parser, ok := parsers[format]
+	if !ok {
+		return Result{}, fmt.Errorf("%w: %q, expected one of %s", ErrUnknownFormat, format, strings.Join(Formats(), ", "))
+	}
+
+	return parser(source)
+}
+
+// joinMeta - Собирает метаданные из непустых строк
+func joinMeta(lines ...string) string {
+	meta := make([]string, 0, len(lines))
+	for _, v := range lines {
+		if v = strings.TrimSpace(v); v != "" {
+			meta = append(meta, v)
+		}
+	}
+
+	return strings.Join(meta, "\n")
+}
+
+// nameFromURL - Возвращает имя хоста, если у записи нет наименования
+func nameFromURL(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Hostname() == "" {
+		return uri
+	}
+
+	return parsed.Hostname()
+}
+
+// newCredentials - Создает логин и пароль или возвращает причину пропуска записи
+func newCredentials(name, login, password, uri string, notes ...string) (domain.Credentials, string) {
+	if name == "" {
+		name = nameFromURL(uri)
+	}
+	if password == "" {
+		return domain.Credentials{}, "no password"
+	}
+	if name == "" {
+		return domain.Credentials{}, "no name"
+	}
+
+	return domain.Credentials{
+		Name:     name,
+		Login:    login,
+		Password: password,
+		Meta:     joinMeta(append([]string{prefixed("url: ", uri)}, notes...)...),
+	}, ""
+}
+
+// newText - Создает заметку из наименования и текста
+func newText(name, notes string) (domain.Text, string) {
+	if strings.TrimSpace(notes) == "" {
+		return domain.Text{}, "empty note"
+	}
+
+	return domain.Text{Content: joinMeta(name, notes)}, ""
+}
+
+// validThru - Приводит месяц и год к формату MM/YY
+func validThru(month, year string) (string, bool) {
+	month = strings.TrimSpace(month)
+	year = strings.TrimSpace(year)
+	if len(month) == 1 {
+		month = "0" + month
+	}
+	if len(year) == 4 {
+		year = year[2:]
+	}
+	if len(month) != 2 || len(year) != 2 || month < "01" || month > "12" || strings.Trim(month+year, "0123456789") != "" {
+		return "", false
+	}
+
+	return month + "/" + year, true
+}
+
+// cardNumber - Приводит номер карты к группам по четыре цифры через пробел, как его принимает сервер
+func cardNumber(number string) string {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(number)
+	groups := make([]string, 0, len(digits)/4+1)
+	for len(digits) > 4 {
+		groups = append(groups, digits[:4])
+		digits = digits[4:]
+	}
+
+	return strings.Join(append(groups, digits), " ")
+}
+
+// newBankCard - Создает банковскую карту или возвращает причину пропуска записи.
+// Проверки совпадают с проверками сервера, чтобы запись не отклонялась при создании
+func newBankCard(number, validThru, cvv, cardHolder string) (domain.BankCard, string) {
+	number = cardNumber(number)
+	cardHolder = strings.TrimSpace(cardHolder)
+	cvv = strings.TrimSpace(cvv)
+	if !cardNumberPattern.MatchString(number) {
+		return domain.BankCard{}, "card number must contain 16 digits"
+	}
+	if cvv == "" {
+		return domain.BankCard{}, "no cvv"
+	}
+	if !cvvPattern.MatchString(cvv) {
+		return domain.BankCard{}, "invalid cvv"
+	}
+	if !cardHolderPattern.MatchString(cardHolder) {
+		return domain.BankCard{}, "card holder must be up to three words of latin letters"
+	}
+
+	return domain.BankCard{
+		Number:     number,
+		ValidThru:  validThru,
+		CVV:        cvv,
+		CardHolder: cardHolder,
+	}, ""
+}
@@ -0,0 +1,174 @@
+package importers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+func parseFixture(t *testing.T, format, name string) Result {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	defer file.Close()
+
+	result, err := Parse(format, file)
+	require.NoError(t, err)
+
+	return result
+}
+
+func TestParseBitwardenJSON(t *testing.T) {
+	result := parseFixture(t, BitwardenJSON, "bitwarden.json")
+
+	assert.Equal(t, []domain.Credentials{{
+		Name:     "GitHub",
+		Login:    "octocat",
+		Password: "correct-horse-battery",
+		Meta:     "url: https://github.com/login\npersonal account\nrecovery email: octocat@example.com",
+	}}, result.Credentials)
+	assert.Equal(t, []domain.Text{{Content: "Wi-Fi\nSSID: home\npassword: hunter2"}}, result.Texts)
+	assert.Equal(t, []domain.BankCard{{
+		Number:     "4111 1111 1111 1111",
+		ValidThru:  "03/30",
+		CVV:        "123",
+		CardHolder: "IVAN IVANOV",
+		Meta:       "Visa",
+	}}, result.BankCards)
+	assert.Equal(t, []Skipped{
+		{Row: 2, Name: "Broken login", Reason: "no password"},
+		{Row: 5, Name: "Passport", Reason: "unsupported item type 4"},
+	}, result.Skipped)
+}
+
+func TestParseBitwardenJSONEncrypted(t *testing.T) {
+	_, err := Parse(BitwardenJSON, strings.NewReader(`{"encrypted": true, "items": []}`))
+	assert.ErrorIs(t, err, ErrEncryptedExport)
+
+	_, err = Parse(BitwardenJSON, strings.NewReader(`name,password`))
+	assert.ErrorIs(t, err, ErrInvalidFile)
+}
+
+func TestParseKeePassXML(t *testing.T) {
+	result := parseFixture(t, KeePassXML, "keepass.xml")
+
+	// Запись из истории изменений и запись из корзины не импортируются
+	assert.Equal(t, []domain.Credentials{{
+		Name:     "Mail",
+		Login:    "ivan@example.com",
+		Password: "mail-secret",
+		Meta:     "url: https://mail.example.com\nmain mailbox\nPIN: 4321",
+	}}, result.Credentials)
+	assert.Equal(t, []domain.Text{{Content: "Office\ndoor code 1234"}}, result.Texts)
+	assert.Empty(t, result.BankCards)
+	assert.Equal(t, []Skipped{{Row: 3, Name: "Forum", Reason: "no password"}}, result.Skipped)
+}
+
+func TestParseOnePasswordCSV(t *testing.T) {
+	result := parseFixture(t, OnePasswordCSV, "1password.csv")
+
+	assert.Equal(t, []domain.Credentials{
+		{
+			Name:     "GitLab",
+			Login:    "tanuki",
+			Password: "gitlab-secret",
+			Meta:     "url: https://gitlab.com\notp: otpauth://totp/GitLab:tanuki?secret=JBSWY3DPEHPK3PXP\ntags: work",
+		},
+		{
+			Name:     "Bank, main",
+			Login:    "ivan",
+			Password: `pa,ss"word`,
+			Meta:     "url: https://bank.example.com\nfirst line\nsecond line",
+		},
+	}, result.Credentials)
+	assert.Empty(t, result.Texts)
+	assert.Equal(t, []Skipped{
+		{Row: 5, Name: "Old forum", Reason: "archived"},
+		{Row: 6, Name: "Empty", Reason: "no password"},
+	}, result.Skipped)
+}
+
+func TestParseChromeCSV(t *testing.T) {
+	result := parseFixture(t, ChromeCSV, "chrome.csv")
+
+	assert.Equal(t, []domain.Credentials{
+		{
+			Name:     "accounts.example.com",
+			Login:    "ivan",
+			Password: "example-secret",
+			Meta:     "url: https://accounts.example.com/signin",
+		},
+		{
+			Name:     "Shop",
+			Login:    "buyer",
+			Password: "shop-secret",
+			Meta:     "url: https://shop.example.com/\nloyalty card 42",
+		},
+	}, result.Credentials)
+	assert.Equal(t, []Skipped{{Row: 4, Name: "NoPassword", Reason: "no password"}}, result.Skipped)
+	assert.Equal(t, domain.VaultItemsCount{Credentials: 2}, result.Count())
+}
+
+func TestParseCSVWithoutPasswordColumn(t *testing.T) {
+	_, err := Parse(ChromeCSV, strings.NewReader("name,url\nShop,https://shop.example.com\n"))
+	assert.ErrorIs(t, err, ErrInvalidFile)
+}
+
+func TestParseUnknownFormat(t *testing.T) {
+	_, err := Parse("lastpass-csv", strings.NewReader(""))
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+	assert.Equal(t, []string{"1password-csv", "bitwarden-json", "chrome-csv", "keepass-xml"}, Formats())
+}
+
+func TestNewBankCard(t *testing.T) {
+	card, reason := newBankCard("4111-1111-1111-1111", "03/30", "123", " IVAN IVANOV ")
+	assert.Empty(t, reason)
+	assert.Equal(t, domain.BankCard{
+		Number:     "4111 1111 1111 1111",
+		ValidThru:  "03/30",
+		CVV:        "123",
+		CardHolder: "IVAN IVANOV",
+	}, card)
+
+	tests := []struct {
+		number, cvv, cardHolder string
+		reason                  string
+	}{
+		{"4111 1111 1111", "123", "IVAN IVANOV", "card number must contain 16 digits"},
+		{"3782 822463 10005", "1234", "IVAN IVANOV", "card number must contain 16 digits"},
+		{"4111 1111 1111 111x", "123", "IVAN IVANOV", "card number must contain 16 digits"},
+		{"4111111111111111", "", "IVAN IVANOV", "no cvv"},
+		{"4111111111111111", "12", "IVAN IVANOV", "invalid cvv"},
+		{"4111111111111111", "123", "ИВАН ИВАНОВ", "card holder must be up to three words of latin letters"},
+		{"4111111111111111", "123", "A B C D", "card holder must be up to three words of latin letters"},
+	}
+	for _, tt := range tests {
+		_, reason = newBankCard(tt.number, "03/30", tt.cvv, tt.cardHolder)
+		assert.Equal(t, tt.reason, reason, tt.number)
+	}
+}
+
+func TestValidThru(t *testing.T) {
+	tests := []struct {
+		month, year string
+		want        string
+		ok          bool
+	}{
+		{"3", "2030", "03/30", true},
+		{"12", "31", "12/31", true},
+		{"13", "2030", "", false},
+		{"0a", "2030", "", false},
+		{"", "", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := validThru(tt.month, tt.year)
+		assert.Equal(t, tt.ok, ok, tt.month+"/"+tt.year)
+		assert.Equal(t, tt.want, got, tt.month+"/"+tt.year)
+	}
+}
@@ -0,0 +1,125 @@
+package importers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// KeePassXML - Имя формата XML экспорта KeePass 2 и KeePassXC
+const KeePassXML = "keepass-xml"
+
+// Стандартные поля записи KeePass, остальные поля сохраняются в метаданные
+const (
+	keePassTitle    = "Title"
+	keePassUserName = "UserName"
+	keePassPassword = "Password"
+	keePassURL      = "URL"
+	keePassNotes    = "Notes"
+)
+
+type keePassFile struct {
+	XMLName xml.Name `xml:"KeePassFile"`
+	Meta    struct {
+		RecycleBinEnabled string `xml:"RecycleBinEnabled"`
+		RecycleBinUUID    string `xml:"RecycleBinUUID"`
+	} `xml:"Meta"`
+	Root struct {
+		Groups []keePassGroup `xml:"Group"`
+	} `xml:"Root"`
+}
+
+type keePassGroup struct {
+	UUID    string         `xml:"UUID"`
+	Name    string         `xml:"Name"`
+	Entries []keePassEntry `xml:"Entry"`
+	Groups  []keePassGroup `xml:"Group"`
+}
+
+// keePassEntry - Запись KeePass, история изменений записи не разбирается
+type keePassEntry struct {
+	Strings []struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	} `xml:"String"`
+}
+
+// fields - Возвращает поля записи по имени
+func (e keePassEntry) fields() map[string]string {
+	fields := make(map[string]string, len(e.Strings))
+	for _, v := range e.Strings {
+		fields[v.Key] = v.Value
+	}
+
+	return fields
+}
+
+// ParseKeePassXML - Разбирает XML экспорт KeePass 2 или KeePassXC.
+// Записи без пароля и логина с заметкой импортируются как заметки, записи корзины пропускаются
+func ParseKeePassXML(source io.Reader) (Result, error) {
+	result := Result{}
+	file := keePassFile{}
+	if err := xml.NewDecoder(source).Decode(&file); err != nil {
+		return result, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	recycleBin := ""
+	if !strings.EqualFold(file.Meta.RecycleBinEnabled, "False") {
+		recycleBin = file.Meta.RecycleBinUUID
+	}
+	row := 0
+	var walk func(groups []keePassGroup)
+	walk = func(groups []keePassGroup) {
+		for _, group := range groups {
+			if recycleBin != "" && group.UUID == recycleBin {
+				continue
+			}
+			for _, entry := range group.Entries {
+				row++
+				result.addKeePassEntry(row, entry.fields())
+			}
+			walk(group.Groups)
+		}
+	}
+	walk(file.Root.Groups)
+
+	return result, nil
+}
+
+func (r *Result) addKeePassEntry(row int, fields map[string]string) {
+	title := fields[keePassTitle]
+	custom := make([]string, 0, len(fields))
+	for key, value := range fields {
+		switch key {
+		case keePassTitle, keePassUserName, keePassPassword, keePassURL, keePassNotes:
+		default:
+			if value != "" {
+				custom = append(custom, key+": "+value)
+			}
+		}
+	}
+	sort.Strings(custom)
+	notes := joinMeta(append([]string{fields[keePassNotes]}, custom...)...)
+
+	if fields[keePassPassword] == "" && fields[keePassUserName] == "" {
+		text, reason := newText(title, notes)
+		if reason != "" {
+			r.skip(row, title, reason)
+
+			return
+		}
+		r.Texts = append(r.Texts, text)
+
+		return
+	}
+
+	cred, reason := newCredentials(title, fields[keePassUserName], fields[keePassPassword], fields[keePassURL], notes)
+	if reason != "" {
+		r.skip(row, title, reason)
+
+		return
+	}
+	r.Credentials = append(r.Credentials, cred)
+}
@@ -255,6 +255,92 @@ func (r BinaryRepository) ReplaceAll(
 	return err
 }
 
+// ApplyChanges - Сохраняет созданные или обновленные на сервере бинарные данные и удаляет удаленные
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r BinaryRepository) ApplyChanges(
+	userID uuid.UUID,
+	bins []domain.Binary,
+	deletedIDs []uuid.UUID,
+) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range bins {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.Crypto.Encrypt(buf)
+		if err != nil {
+			return err
+		}
+
+		err = bkt.Put([]byte(v.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range deletedIDs {
+		err = bkt.Delete([]byte(id.String()))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Delete - Удаляет бинарные данные по идентификатору данных и пользователя
+func (r BinaryRepository) Delete(userID, binID uuid.UUID) error {
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		key := []byte(binID.String())
+		if bkt.Get(key) == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		return bkt.Delete(key)
+	})
+}
+
 // New - Возвращает инстанс репозитория BinaryRepository
 func New(
 	db *bolt.DB,
@@ -0,0 +1,278 @@
+// Package clipboard содержит имплементацию интерфейса ClipboardInterface через утилиты X11 и Wayland
+// или escape-последовательность OSC 52, а также помощника, который очищает буфер обмена по таймауту
+package clipboard
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// Command - Имя скрытой команды запуска помощника очистки буфера обмена
+const Command = "clipboard-clear"
+
+// osc52Name - Имя способа OSC 52, передается помощнику очистки вместо имени утилиты
+const osc52Name = "osc52"
+
+var errUnknownTool = errors.New("unknown clipboard tool")
+
+// backend - Способ записи и чтения буфера обмена
+type backend interface {
+	// write - Записывает значение в буфер обмена
+	write(value string) error
+	// read - Возвращает текущее содержимое буфера обмена
+	read() (string, error)
+	// clear - Очищает буфер обмена
+	clear() error
+}
+
+// tool - Утилита командной строки для работы с буфером обмена
+type tool struct {
+	// name - Имя утилиты, передается помощнику очистки
+	name string
+	// env - Переменная окружения графической сессии, без которой утилита не работает
+	env string
+	// copy - Команда записи, значение передается в stdin
+	copy []string
+	// paste - Команда чтения, значение читается из stdout
+	paste []string
+	// erase - Команда очистки, если не задана, записывается пустое значение
+	erase []string
+}
+
+// tools - Поддерживаемые утилиты в порядке предпочтения
+var tools = []tool{
+	{
+		name:  "wl-clipboard",
+		env:   "WAYLAND_DISPLAY",
+		copy:  []string{"wl-copy"},
+		paste: []string{"wl-paste", "--no-newline"},
+		erase: []string{"wl-copy", "--clear"},
+	},
+	{
+		name:  "xclip",
+		env:   "DISPLAY",
+		copy:  []string{"xclip", "-selection", "clipboard", "-in"},
+		paste: []string{"xclip", "-selection", "clipboard", "-out"},
+	},
+	{
+		name:  "xsel",
+		env:   "DISPLAY",
+		copy:  []string{"xsel", "--clipboard", "--input"},
+		paste: []string{"xsel", "--clipboard", "--output"},
+		erase: []string{"xsel", "--clipboard", "--delete"},
+	},
+}
+
+// available - Проверяет, что графическая сессия запущена и утилита установлена
+func (t tool) available() bool {
+	if os.Getenv(t.env) == "" {
+		return false
+	}
+	for _, args := range [][]string{t.copy, t.paste} {
+		if _, err := exec.LookPath(args[0]); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t tool) write(value string) error {
+	cmd := exec.Command(t.copy[0], t.copy[1:]...) //nolint: gosec
+	cmd.Stdin = strings.NewReader(value)
+
+	return cmd.Run()
+}
+
+func (t tool) read() (string, error) {
+	out, err := exec.Command(t.paste[0], t.paste[1:]...).Output() //nolint: gosec
+
+	return string(out), err
+}
+
+func (t tool) clear() error {
+	if len(t.erase) == 0 {
+		return t.write("")
+	}
+
+	return exec.Command(t.erase[0], t.erase[1:]...).Run() //nolint: gosec
+}
+
+// osc52 - Запись в буфер обмена терминала escape-последовательностью OSC 52.
+// Терминал не отдает содержимое буфера, поэтому он очищается без проверки
+type osc52 struct {
+	terminal io.Writer
+}
+
+func (o osc52) write(value string) error {
+	_, err := fmt.Fprintf(o.terminal, "\x1b]52;c;%s\a", base64.StdEncoding.EncodeToString([]byte(value)))
+
+	return err
+}
+
+func (o osc52) read() (string, error) {
+	return "", domain.ErrClipboardClearUnsupported
+}
+
+func (o osc52) clear() error {
+	return o.write("")
+}
+
+// detect - Возвращает первую доступную утилиту или OSC 52, если ни одна утилита недоступна
+func detect(terminal io.Writer) backend {
+	for _, t := range tools {
+		if t.available() {
+			return t
+		}
+	}
+
+	return osc52{terminal: terminal}
+}
+
+// digest - Возвращает SHA-256 значения, помощнику очистки передается только он, а не сам секрет
+func digest(value string) []byte {
+	sum := sha256.Sum256([]byte(value))
+
+	return sum[:]
+}
+
+// lookup - Возвращает утилиту по имени, для OSC 52 escape-последовательность пишется в stdout
+func lookup(name string) (backend, error) {
+	if name == osc52Name {
+		return osc52{terminal: os.Stdout}, nil
+	}
+	for _, t := range tools {
+		if t.name == name {
+			return t, nil
+		}
+	}
+
+	return nil, errUnknownTool
+}
+
+// clearIfHolds - Очищает буфер обмена, если он содержит значение с указанным SHA-256.
+// Если содержимое буфера прочитать нельзя, буфер очищается без проверки
+func clearIfHolds(b backend, sum []byte) error {
+	current, err := b.read()
+	if errors.Is(err, domain.ErrClipboardClearUnsupported) {
+		return b.clear()
+	} else if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(digest(current), sum) != 1 {
+		return nil
+	}
+
+	return b.clear()
+}
+
+// Main - Точка входа помощника очистки. Читает SHA-256 секрета из stdin, ждет и очищает буфер обмена,
+// если пользователь не скопировал в него ничего другого. Буфер терминала очищается без проверки
+func Main(args []string) error {
+	var toolName string
+	var after time.Duration
+
+	flags := flag.NewFlagSet(Command, flag.ContinueOnError)
+	flags.StringVar(&toolName, "tool", "", "clipboard tool name")
+	flags.DurationVar(&after, "after", 0, "clear timeout")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	b, err := lookup(toolName)
+	if err != nil {
+		return err
+	}
+
+	// Помощник должен пережить закрытие терминала, из которого был скопирован секрет
+	signal.Ignore(syscall.SIGHUP)
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return err
+	}
+	sum, err := hex.DecodeString(strings.TrimSpace(line))
+	if err != nil {
+		return err
+	}
+
+	time.Sleep(after)
+
+	return clearIfHolds(b, sum)
+}
+
+// Clipboard - Имплементация системного буфера обмена
+type Clipboard struct {
+	// Executable - Путь до исполняемого файла клиента, используется для запуска помощника очистки
+	Executable string
+	backend    backend
+}
+
+// Write - Записывает секрет в буфер обмена
+func (c Clipboard) Write(secret string) error {
+	return c.backend.write(secret)
+}
+
+// ScheduleClear - Запускает отдельный процесс, который очистит буфер обмена через время after,
+// если он все еще содержит секрет. Для OSC 52 помощник пишет очистку в тот же терминал без проверки,
+// если терминал не файл, возвращает ErrClipboardClearUnsupported
+func (c Clipboard) ScheduleClear(secret string, after time.Duration) error {
+	var name string
+	var terminal *os.File
+	switch b := c.backend.(type) {
+	case tool:
+		name = b.name
+	case osc52:
+		f, ok := b.terminal.(*os.File)
+		if !ok {
+			return domain.ErrClipboardClearUnsupported
+		}
+		name, terminal = osc52Name, f
+	default:
+		return domain.ErrClipboardClearUnsupported
+	}
+
+	cmd := exec.Command(c.Executable, Command, "-tool", name, "-after", after.String()) //nolint: gosec
+	if terminal != nil {
+		cmd.Stdout = terminal
+	}
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintln(stdin, hex.EncodeToString(digest(secret))); err != nil {
+		return err
+	}
+	if err = stdin.Close(); err != nil {
+		return err
+	}
+
+	return cmd.Process.Release()
+}
+
+// New - Возвращает инстанс буфера обмена. Если утилиты X11 и Wayland недоступны,
+// секрет записывается в terminal escape-последовательностью OSC 52
+func New(executable string, terminal io.Writer) *Clipboard {
+	return &Clipboard{
+		Executable: executable,
+		backend:    detect(terminal),
+	}
+}
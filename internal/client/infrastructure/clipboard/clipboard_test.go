@@ -0,0 +1,95 @@
+package clipboard
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// memoryBackend - Буфер обмена в памяти
+type memoryBackend struct {
+	value   string
+	cleared bool
+}
+
+func (m *memoryBackend) write(value string) error {
+	m.value = value
+
+	return nil
+}
+
+func (m *memoryBackend) read() (string, error) {
+	return m.value, nil
+}
+
+func (m *memoryBackend) clear() error {
+	m.value = ""
+	m.cleared = true
+
+	return nil
+}
+
+func TestClearIfHolds(t *testing.T) {
+	b := &memoryBackend{}
+	require.NoError(t, b.write("secret"))
+
+	require.NoError(t, clearIfHolds(b, digest("secret")))
+	assert.True(t, b.cleared)
+	assert.Empty(t, b.value)
+}
+
+func TestClearIfHoldsChanged(t *testing.T) {
+	b := &memoryBackend{}
+	require.NoError(t, b.write("copied later by the user"))
+
+	require.NoError(t, clearIfHolds(b, digest("secret")))
+	assert.False(t, b.cleared)
+	assert.Equal(t, "copied later by the user", b.value)
+}
+
+func TestOSC52Write(t *testing.T) {
+	terminal := &bytes.Buffer{}
+	clipboard := Clipboard{backend: osc52{terminal: terminal}}
+
+	require.NoError(t, clipboard.Write("secret"))
+	assert.Equal(t, "\x1b]52;c;c2VjcmV0\a", terminal.String())
+}
+
+func TestClearIfHoldsOSC52(t *testing.T) {
+	terminal := &bytes.Buffer{}
+
+	require.NoError(t, clearIfHolds(osc52{terminal: terminal}, digest("secret")))
+	assert.Equal(t, "\x1b]52;c;\a", terminal.String())
+}
+
+func TestLookupOSC52(t *testing.T) {
+	b, err := lookup(osc52Name)
+	require.NoError(t, err)
+	assert.Equal(t, osc52{terminal: os.Stdout}, b)
+}
+
+func TestOSC52ClearUnsupported(t *testing.T) {
+	clipboard := Clipboard{backend: osc52{terminal: &bytes.Buffer{}}}
+
+	err := clipboard.ScheduleClear("secret", 0)
+	require.ErrorIs(t, err, domain.ErrClipboardClearUnsupported)
+}
+
+func TestDetectFallback(t *testing.T) {
+	t.Setenv("WAYLAND_DISPLAY", "")
+	t.Setenv("DISPLAY", "")
+	terminal := &bytes.Buffer{}
+
+	b := detect(terminal)
+	assert.Equal(t, osc52{terminal: terminal}, b)
+}
+
+func TestMainUnknownTool(t *testing.T) {
+	err := Main([]string{"-tool", "unknown"})
+	require.ErrorIs(t, err, errUnknownTool)
+}
@@ -0,0 +1,355 @@
+// Package folderrepository содержит имплементацию интерфейса FolderRepositoryInterface
+package folderrepository
+
+import (
+	"encoding/json"
+	"errors"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const bucketName = "Folder"
+
+// FolderRepository - Имплементация репозитория для папок
+type FolderRepository struct {
+	// DB - Интерфейс базы данных bbolt
+	DB *bolt.DB
+	// Crypto - Инстанс сервиса шифрования
+	Crypto domain.CryptoServiceInterface
+	Tx     *bolt.Tx
+	log    *logrus.Logger
+}
+
+// Create - Сохраняет новую папку
+func (r FolderRepository) Create(
+	userID uuid.UUID,
+	folder domain.Folder,
+) error {
+	buf, err := json.Marshal(folder)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := r.Crypto.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.DB.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = tx.Rollback()
+	}()
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	err = bkt.Put([]byte(folder.ID.String()), encrypted)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// Update - Сохраняет существующую папку
+func (r FolderRepository) Update(
+	userID uuid.UUID,
+	folder domain.Folder,
+) error {
+	buf, err := json.Marshal(folder)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := r.Crypto.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+
+	err = r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+		err = bkt.Put([]byte(folder.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Get - Возвращает папку по идентификатору папки и пользователя, если она существует
+func (r FolderRepository) Get(userID, folderID uuid.UUID) (domain.Folder, error) {
+	var folder domain.Folder
+	var raw []byte
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+		raw = bkt.Get([]byte(folderID.String()))
+
+		return nil
+	})
+
+	if err != nil {
+		return folder, err
+	}
+
+	if raw == nil {
+		return folder, domain.ErrEntityNotFound
+	}
+
+	decrypted, err := r.Crypto.Decrypt(raw)
+	if err != nil {
+		return folder, err
+	}
+
+	err = json.Unmarshal(decrypted, &folder)
+	if err != nil {
+		return folder, err
+	}
+
+	return folder, nil
+}
+
+// GetAll - возвращает все папки пользователя
+func (r FolderRepository) GetAll(userID uuid.UUID) ([]domain.Folder, error) {
+	result := []domain.Folder{}
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return nil
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return nil
+		}
+
+		err := bkt.ForEach(func(_, v []byte) error {
+			var folder domain.Folder
+			decrypted, err := r.Crypto.Decrypt(v)
+			if err != nil {
+				return err
+			}
+			err = json.Unmarshal(decrypted, &folder)
+			if err != nil {
+				return err
+			}
+			result = append(result, folder)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// ReplaceAll - Заменяет все локальные папки пользователя на новые
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r FolderRepository) ReplaceAll(
+	userID uuid.UUID,
+	folders []domain.Folder,
+) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	err = root.DeleteBucket([]byte(bucketName))
+	if err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+		return err
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range folders {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.Crypto.Encrypt(buf)
+		if err != nil {
+			return err
+		}
+
+		err = bkt.Put([]byte(v.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// ApplyChanges - Сохраняет созданные или обновленные на сервере папки и удаляет удаленные
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r FolderRepository) ApplyChanges(
+	userID uuid.UUID,
+	folders []domain.Folder,
+	deletedIDs []uuid.UUID,
+) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range folders {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.Crypto.Encrypt(buf)
+		if err != nil {
+			return err
+		}
+
+		err = bkt.Put([]byte(v.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range deletedIDs {
+		err = bkt.Delete([]byte(id.String()))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Delete - Удаляет папку по идентификатору папки и пользователя
+func (r FolderRepository) Delete(userID, folderID uuid.UUID) error {
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		key := []byte(folderID.String())
+		if bkt.Get(key) == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		return bkt.Delete(key)
+	})
+}
+
+// New - Возвращает инстанс репозитория FolderRepository
+func New(
+	db *bolt.DB,
+	crypto domain.CryptoServiceInterface,
+	log *logrus.Logger,
+) *FolderRepository {
+	return &FolderRepository{
+		DB:     db,
+		Crypto: crypto,
+		log:    log,
+	}
+}
@@ -5,7 +5,11 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -15,10 +19,21 @@ import (
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
 )
 
+const refreshTokenHeader = "X-Refresh-Token"
+const ifMatchHeader = "If-Match"
+const eTagHeader = "ETag"
+const challengeHeader = "X-Two-Factor-Challenge"
+const binaryMetadataHeader = "X-Binary-Metadata"
+
+// chunkAttempts - Количество попыток отправки одной части бинарных данных
+const chunkAttempts = 3
+
 // HTTPClient - Имплементация клиента GophKeeper
 type HTTPClient struct {
-	client *resty.Client
-	log    *logrus.Logger
+	client            *resty.Client
+	stream            *resty.Client
+	sessionRepository domain.SessionRepositoryInterface
+	log               *logrus.Logger
 }
 
 // New - Конструктор нового инстанса клиента
@@ -27,6 +42,7 @@ func New(
 	cert []byte,
 	timeout time.Duration,
 	baseURL string,
+	sessionRepository domain.SessionRepositoryInterface,
 ) *HTTPClient {
 	caCertPool := x509.NewCertPool()
 	caCertPool.AppendCertsFromPEM(cert)
@@ -40,15 +56,21 @@ func New(
 		SetTLSClientConfig(tlsConfig).
 		SetTimeout(timeout).
 		SetBaseURL(baseURL)
+	stream := resty.New().
+		SetTLSClientConfig(tlsConfig).
+		SetBaseURL(baseURL)
 
 	return &HTTPClient{
-		client: client,
-		log:    log,
+		client:            client,
+		stream:            stream,
+		sessionRepository: sessionRepository,
+		log:               log,
 	}
 }
 
-// Login - Вход по логину и паролю, возвращает токен авторизации
-func (c HTTPClient) Login(login, password string) (string, error) {
+// Login - Вход по логину и паролю, возвращает токен авторизации и refresh token.
+// Если у пользователя включена двухфакторная аутентификация, возвращает TwoFactorRequiredError
+func (c HTTPClient) Login(login, password string) (token, refreshToken string, err error) {
 	resp, err := c.client.R().
 		SetHeader("Content-Type", "application/json").
 		SetBody(map[string]any{
@@ -57,77 +79,402 @@ func (c HTTPClient) Login(login, password string) (string, error) {
 		}).Post("/auth/login")
 
 	if err != nil {
-		return "", err
+		return "", "", unavailable(err)
 	}
 	statusCode := resp.StatusCode()
 	switch statusCode {
 	case http.StatusUnauthorized:
-		return "", domain.ErrUnauthorized
+		return "", "", domain.ErrUnauthorized
+	case http.StatusAccepted:
+		return "", "", &domain.TwoFactorRequiredError{Challenge: resp.Header().Get(challengeHeader)}
 	case http.StatusOK:
-		return resp.Header().Get("Authorization"), nil
+		return resp.Header().Get("Authorization"), resp.Header().Get(refreshTokenHeader), nil
 	default:
 		c.log.Error(resp.RawResponse)
 
-		return "", domain.ErrClientConnectionError
+		return "", "", domain.ErrClientConnectionError
+	}
+}
+
+// TwoFactorLogin - Завершает вход кодом TOTP или кодом восстановления, возвращает токен авторизации и refresh token
+func (c HTTPClient) TwoFactorLogin(challenge, code string) (token, refreshToken string, err error) {
+	resp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"challenge": challenge,
+			"code":      code,
+		}).Post("/auth/2fa/login")
+
+	if err != nil {
+		return "", "", unavailable(err)
+	}
+	statusCode := resp.StatusCode()
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return "", "", domain.ErrInvalidTwoFactorCode
+	case http.StatusOK:
+		return resp.Header().Get("Authorization"), resp.Header().Get(refreshTokenHeader), nil
+	default:
+		c.log.Error(resp.RawResponse)
+
+		return "", "", domain.ErrClientConnectionError
+	}
+}
+
+// EnrollTwoFactor - Начинает подключение двухфакторной аутентификации, возвращает секрет и otpauth URI
+func (c HTTPClient) EnrollTwoFactor(session domain.Session) (secret, uri string, err error) {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return req.Post("/auth/2fa/enroll")
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	statusCode := resp.StatusCode()
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return "", "", domain.ErrUnauthorized
+	case http.StatusConflict:
+		return "", "", domain.ErrTwoFactorAlreadyEnabled
+	case http.StatusOK:
+		var response twoFactorEnrollResponse
+		if err = json.Unmarshal(resp.Body(), &response); err != nil {
+			return "", "", err
+		}
+
+		return response.Data.Secret, response.Data.URI, nil
+	default:
+		c.log.Error(resp.RawResponse)
+
+		return "", "", domain.ErrClientConnectionError
 	}
 }
 
-// Register - Регистрация по логину и паролю, возвращает токен авторизации
-func (c HTTPClient) Register(login, password string) (string, error) {
+// VerifyTwoFactor - Подтверждает подключение двухфакторной аутентификации кодом, возвращает коды восстановления
+func (c HTTPClient) VerifyTwoFactor(session domain.Session, code string) ([]string, error) {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{"code": code}).
+			Post("/auth/2fa/verify")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statusCode := resp.StatusCode()
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return nil, domain.ErrUnauthorized
+	case http.StatusForbidden:
+		return nil, domain.ErrInvalidTwoFactorCode
+	case http.StatusNotFound:
+		return nil, domain.ErrTwoFactorNotEnabled
+	case http.StatusConflict:
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	case http.StatusOK:
+		var response twoFactorVerifyResponse
+		if err = json.Unmarshal(resp.Body(), &response); err != nil {
+			return nil, err
+		}
+
+		return response.Data.RecoveryCodes, nil
+	default:
+		c.log.Error(resp.RawResponse)
+
+		return nil, domain.ErrClientConnectionError
+	}
+}
+
+// DisableTwoFactor - Отключает двухфакторную аутентификацию, подтверждается кодом TOTP или кодом восстановления
+func (c HTTPClient) DisableTwoFactor(session domain.Session, code string) error {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{"code": code}).
+			Post("/auth/2fa/disable")
+	})
+	if err != nil {
+		return err
+	}
+
+	statusCode := resp.StatusCode()
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return domain.ErrUnauthorized
+	case http.StatusForbidden:
+		return domain.ErrInvalidTwoFactorCode
+	case http.StatusNotFound:
+		return domain.ErrTwoFactorNotEnabled
+	case http.StatusOK:
+		return nil
+	default:
+		c.log.Error(resp.RawResponse)
+
+		return domain.ErrClientConnectionError
+	}
+}
+
+// Register - Регистрация по логину и паролю, возвращает токен авторизации и refresh token
+func (c HTTPClient) Register(login, password string, e2e bool, salt []byte) (token, refreshToken string, err error) {
 	resp, err := c.client.R().
 		SetHeader("Content-Type", "application/json").
 		SetBody(map[string]any{
 			"login":    login,
 			"password": password,
+			"e2e":      e2e,
+			"salt":     salt,
 		}).Post("/auth/register")
 
 	if err != nil {
-		return "", err
+		return "", "", unavailable(err)
 	}
 
 	statusCode := resp.StatusCode()
 	switch statusCode {
 	case http.StatusConflict:
-		return "", domain.ErrLoginConflict
+		return "", "", domain.ErrLoginConflict
 	case http.StatusOK:
-		return resp.Header().Get("Authorization"), nil
+		return resp.Header().Get("Authorization"), resp.Header().Get(refreshTokenHeader), nil
 	default:
 		c.log.Error(resp.RawResponse)
 
-		return "", domain.ErrClientConnectionError
+		return "", "", domain.ErrClientConnectionError
+	}
+}
+
+// PreLogin - Возвращает признак сквозного шифрования и соль для вывода ключа хранилища
+func (c HTTPClient) PreLogin(login string) (e2e bool, salt []byte, err error) {
+	resp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"login": login,
+		}).Post("/auth/prelogin")
+
+	if err != nil {
+		return false, nil, unavailable(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		c.log.Error(resp.RawResponse)
+
+		return false, nil, domain.ErrClientConnectionError
+	}
+
+	var response preLoginResponse
+	if err := json.Unmarshal(resp.Body(), &response); err != nil {
+		return false, nil, err
+	}
+
+	return response.Data.E2E, response.Data.Salt, nil
+}
+
+// Refresh - Выпускает новую пару токенов по refresh token, переданный refresh token становится недействительным
+func (c HTTPClient) Refresh(refreshToken string) (newToken, newRefreshToken string, err error) {
+	resp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"refresh_token": refreshToken,
+		}).Post("/auth/refresh")
+
+	if err != nil {
+		return "", "", unavailable(err)
+	}
+
+	statusCode := resp.StatusCode()
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return "", "", domain.ErrUnauthorized
+	case http.StatusOK:
+		return resp.Header().Get("Authorization"), resp.Header().Get(refreshTokenHeader), nil
+	default:
+		c.log.Error(resp.RawResponse)
+
+		return "", "", domain.ErrClientConnectionError
+	}
+}
+
+// refreshSession - Обновляет пару токенов сессии и сохраняет ее.
+// Если сохраненная сессия уже содержит другой токен, например, обновленный предыдущим запросом, то используется она
+func (c HTTPClient) refreshSession(session domain.Session) (domain.Session, error) {
+	if c.sessionRepository != nil {
+		stored, err := c.sessionRepository.Get()
+		if err == nil && stored.UserID == session.UserID {
+			if stored.Token != session.Token {
+				return *stored, nil
+			}
+			session = *stored
+		}
+	}
+
+	if session.RefreshToken == "" {
+		return session, domain.ErrUnauthorized
+	}
+
+	token, refreshToken, err := c.Refresh(session.RefreshToken)
+	if err != nil {
+		return session, err
+	}
+	session.Token = token
+	session.RefreshToken = refreshToken
+
+	if c.sessionRepository != nil {
+		if err := c.sessionRepository.Save(session); err != nil {
+			return session, err
+		}
+	}
+
+	return session, nil
+}
+
+// authorized - Выполняет запрос с токеном авторизации сессии.
+// При ответе 401 обновляет пару токенов и повторяет запрос один раз
+func (c HTTPClient) authorized(
+	session domain.Session,
+	send func(req *resty.Request) (*resty.Response, error),
+) (*resty.Response, error) {
+	return c.authorizedBy(c.client, session, send)
+}
+
+// authorizedBy - Выполняет authorized-запрос указанным клиентом
+func (c HTTPClient) authorizedBy(
+	client *resty.Client,
+	session domain.Session,
+	send func(req *resty.Request) (*resty.Response, error),
+) (*resty.Response, error) {
+	resp, err := send(client.R().SetHeader("Authorization", session.Token))
+	if err != nil {
+		return resp, unavailable(err)
+	}
+	if resp.StatusCode() != http.StatusUnauthorized {
+		return resp, nil
+	}
+
+	session, err = c.refreshSession(session)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return resp, nil
+		}
+
+		return resp, err
+	}
+
+	resp, err = send(client.R().SetHeader("Authorization", session.Token))
+	if err != nil {
+		return resp, unavailable(err)
+	}
+
+	return resp, nil
+}
+
+// unavailable - Оборачивает ошибку транспорта, сервер недоступен и запрос можно повторить позже
+func unavailable(err error) error {
+	return fmt.Errorf("%w: %w", domain.ErrServerUnavailable, err)
+}
+
+// requestOption - Дополнительная настройка запроса, например заголовок
+type requestOption func(req *resty.Request)
+
+// withHeader - Добавляет заголовок к запросу
+func withHeader(name, value string) requestOption {
+	return func(req *resty.Request) {
+		req.SetHeader(name, value)
 	}
 }
 
 func (c HTTPClient) create(
-	authToken, uri, contentType string,
+	session domain.Session,
+	uri, contentType string,
 	body any,
+	options ...requestOption,
 ) (string, error) {
-	resp, err := c.client.R().
-		SetHeader("Content-Type", contentType).
-		SetHeader("Authorization", authToken).
-		SetBody(body).
-		Post(uri)
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		for _, option := range options {
+			option(req)
+		}
+
+		return req.
+			SetHeader("Content-Type", contentType).
+			SetBody(body).
+			Post(uri)
+	})
 
 	if err != nil {
 		return "", err
 	}
 
-	if resp.StatusCode() == http.StatusCreated {
+	switch resp.StatusCode() {
+	case http.StatusCreated:
 		return resp.Header().Get("Location"), nil
+	case http.StatusNotFound:
+		return "", domain.ErrEntityNotFound
+	default:
+		return "", domain.ErrClientConnectionError
 	}
-
-	return "", domain.ErrClientConnectionError
 }
 
 func (c HTTPClient) update(
-	authToken, uri, contentType string,
+	session domain.Session,
+	uri, contentType string,
 	body any,
-) error {
-	resp, err := c.client.R().
-		SetHeader("Content-Type", contentType).
-		SetHeader("Authorization", authToken).
-		SetBody(body).
-		Post(uri)
+	version int64,
+	conflict any,
+	options ...requestOption,
+) (int64, error) {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		for _, option := range options {
+			option(req)
+		}
+		if version != 0 {
+			req.SetHeader(ifMatchHeader, `"`+strconv.FormatInt(version, 10)+`"`)
+		}
+
+		return req.
+			SetHeader("Content-Type", contentType).
+			SetBody(body).
+			Post(uri)
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	statusCode := resp.StatusCode()
+	switch statusCode {
+	case http.StatusNotFound:
+		return 0, domain.ErrEntityNotFound
+	case http.StatusBadRequest:
+		return 0, domain.ErrBadRequest
+	case http.StatusConflict:
+		err = json.Unmarshal(resp.Body(), conflict)
+		if err != nil {
+			return 0, err
+		}
+
+		return parseETag(resp), domain.ErrVersionConflict
+	case http.StatusOK:
+		return parseETag(resp), nil
+	default:
+		c.log.Error(resp.RawResponse)
+
+		return 0, domain.ErrClientConnectionError
+	}
+}
+
+// parseETag - Возвращает версию ресурса из заголовка ETag, 0 если заголовок не передан
+func parseETag(resp *resty.Response) int64 {
+	raw := strings.Trim(strings.TrimPrefix(resp.Header().Get(eTagHeader), "W/"), `"`)
+	version, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return version
+}
+
+func (c HTTPClient) delete(session domain.Session, uri string) error {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return req.Delete(uri)
+	})
 
 	if err != nil {
 		return err
@@ -148,13 +495,49 @@ func (c HTTPClient) update(
 	}
 }
 
+// withCursor - Добавляет к запросу списка курсор страницы, если он задан
+func withCursor(req *resty.Request, cursor string) *resty.Request {
+	if cursor != "" {
+		req.SetQueryParam("cursor", cursor)
+	}
+
+	return req
+}
+
+// get - Получает один ресурс по uri и разбирает ответ в dest
+func (c HTTPClient) get(session domain.Session, uri string, dest any) error {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return req.Get(uri)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	statusCode := resp.StatusCode()
+	switch statusCode {
+	case http.StatusNotFound:
+		return domain.ErrEntityNotFound
+	case http.StatusBadRequest:
+		return domain.ErrBadRequest
+	case http.StatusInternalServerError:
+		return c.parseErrorResponse(resp.Body())
+	case http.StatusOK:
+		return json.Unmarshal(resp.Body(), dest)
+	default:
+		c.log.Error(resp.RawResponse)
+
+		return domain.ErrClientConnectionError
+	}
+}
+
 // CreateText - Создает текст, возвращает идентификатор ресурса от сервера
 func (c HTTPClient) CreateText(
 	session domain.Session,
 	content string,
 ) (uuid.UUID, error) {
 	var uid uuid.UUID
-	id, err := c.create(session.Token, "text/create", "plain/text", content)
+	id, err := c.create(session, "text/create", "plain/text", content)
 
 	if err != nil {
 		return uid, err
@@ -168,18 +551,23 @@ func (c HTTPClient) CreateText(
 	return uid, nil
 }
 
-// UpdateText - Обновляет существующий текст
+// UpdateText - Обновляет существующий текст, возвращает его с новой версией.
+// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
 func (c HTTPClient) UpdateText(
 	session domain.Session,
 	text domain.Text,
-) error {
-	err := c.update(session.Token, "text/"+text.ID.String(), "plain/text", text.Content)
-
+) (domain.Text, error) {
+	conflict := updateTextConflictResponse{}
+	version, err := c.update(session, "text/"+text.ID.String(), "plain/text", text.Content, text.Version, &conflict)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return conflict.Data, err
+	}
 	if err != nil {
-		return err
+		return text, err
 	}
+	text.Version = version
 
-	return nil
+	return text, nil
 }
 
 // GetCerts - Возвращает публичный ключ для валидации и парсинга JWT
@@ -187,7 +575,7 @@ func (c HTTPClient) GetCerts() ([]byte, error) {
 	resp, err := c.client.R().Get("/auth/certs")
 
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, unavailable(err)
 	}
 
 	if resp.StatusCode() == http.StatusOK {
@@ -199,13 +587,19 @@ func (c HTTPClient) GetCerts() ([]byte, error) {
 	return []byte{}, domain.ErrClientConnectionError
 }
 
-// CreateBinary - Создает бинарные данные, возвращает идентификатор ресурса от сервера
+// CreateBinary - Создает бинарные данные с содержимым и метаданными, возвращает идентификатор ресурса от сервера
 func (c HTTPClient) CreateBinary(
 	session domain.Session,
-	content []byte,
+	bin domain.Binary,
 ) (uuid.UUID, error) {
 	var uid uuid.UUID
-	id, err := c.create(session.Token, "binary/create", "multipart/form-data", content)
+	metadata, err := binaryMetadataToHeader(bin)
+	if err != nil {
+		return uid, err
+	}
+	id, err := c.create(
+		session, "binary/create", "multipart/form-data", bin.Content, withHeader(binaryMetadataHeader, metadata),
+	)
 
 	if err != nil {
 		return uid, err
@@ -219,18 +613,249 @@ func (c HTTPClient) CreateBinary(
 	return uid, nil
 }
 
-// UpdateBinary - Обновляет существующие бинарные данные
+// UpdateBinary - Обновляет существующие бинарные данные, возвращает их с новой версией.
+// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
 func (c HTTPClient) UpdateBinary(
 	session domain.Session,
 	bin domain.Binary,
+) (domain.Binary, error) {
+	conflict := updateBinaryConflictResponse{}
+	metadata, err := binaryMetadataToHeader(bin)
+	if err != nil {
+		return bin, err
+	}
+	version, err := c.update(
+		session, "binary/"+bin.ID.String(), "multipart/form-data", bin.Content, bin.Version, &conflict,
+		withHeader(binaryMetadataHeader, metadata),
+	)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		theirs, parseErr := conflict.Data.toDomain()
+		if parseErr != nil {
+			return bin, parseErr
+		}
+
+		return theirs, err
+	}
+	if err != nil {
+		return bin, err
+	}
+	bin.Version = version
+
+	return bin, nil
+}
+
+// receivedChunks - Возвращает номера частей, уже принятых сервером в сессии uploadID.
+// Если сессия истекла или создана для других данных, возвращает ErrEntityNotFound
+func (c HTTPClient) receivedChunks(
+	session domain.Session,
+	uploadID uuid.UUID,
+	size int64,
+	chunkSize int,
+) (map[int64]bool, error) {
+	response := binaryUploadResponse{}
+	err := c.get(session, "binary/upload/"+uploadID.String(), &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Data.Size != size || response.Data.ChunkSize != chunkSize {
+		return nil, domain.ErrEntityNotFound
+	}
+	received := make(map[int64]bool, len(response.Data.Received))
+	for _, index := range response.Data.Received {
+		received[index] = true
+	}
+
+	return received, nil
+}
+
+// UploadBinary - Загружает size байт из source частями по chunkSize байт, возвращает идентификатор сессии загрузки.
+// Если задан resumeID и сессия еще существует на сервере, загрузка продолжается в ней, а принятые сервером части
+// читаются из source без отправки. Иначе создается новая сессия, и ее идентификатор передается в started.
+// Часть, которую не удалось отправить из-за недоступности сервера, отправляется повторно,
+// повторная отправка части на сервере перезаписывает ее
+func (c HTTPClient) UploadBinary(
+	session domain.Session,
+	resumeID uuid.UUID,
+	source io.Reader,
+	size int64,
+	chunkSize int,
+	started func(uuid.UUID) error,
+	progress func(int64),
+) (uuid.UUID, error) {
+	uploadID := resumeID
+	received := map[int64]bool{}
+	if resumeID != uuid.Nil {
+		var err error
+		received, err = c.receivedChunks(session, resumeID, size, chunkSize)
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			uploadID = uuid.Nil
+		} else if err != nil {
+			return uuid.Nil, err
+		}
+	}
+	if uploadID == uuid.Nil {
+		payload := map[string]any{
+			"size":       size,
+			"chunk_size": chunkSize,
+		}
+		location, err := c.create(session, "binary/upload", "application/json", payload)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		uploadID, err = c.parseID(location)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		received = map[int64]bool{}
+		if started != nil {
+			if err = started(uploadID); err != nil {
+				return uuid.Nil, err
+			}
+		}
+	}
+
+	buf := make([]byte, chunkSize)
+	var sent int64
+	for index := int64(0); sent < size; index++ {
+		length := int64(chunkSize)
+		if size-sent < length {
+			length = size - sent
+		}
+		chunk := buf[:length]
+		if _, err := io.ReadFull(source, chunk); err != nil {
+			return uploadID, err
+		}
+		if !received[index] {
+			if err := c.uploadChunk(session, uploadID.String(), index, chunk); err != nil {
+				return uploadID, err
+			}
+		}
+		sent += length
+		if progress != nil {
+			progress(sent)
+		}
+	}
+
+	return uploadID, nil
+}
+
+// CommitBinaryUpload - Завершает загрузку частями и сохраняет метаданные bin, возвращает идентификатор ресурса от сервера
+func (c HTTPClient) CommitBinaryUpload(session domain.Session, uploadID uuid.UUID, bin domain.Binary) (uuid.UUID, error) {
+	var uid uuid.UUID
+	metadata, err := binaryMetadataToHeader(bin)
+	if err != nil {
+		return uid, err
+	}
+	id, err := c.create(
+		session, "binary/upload/"+uploadID.String()+"/commit", "application/json", nil,
+		withHeader(binaryMetadataHeader, metadata),
+	)
+	if err != nil {
+		return uid, err
+	}
+
+	return c.parseID(id)
+}
+
+// uploadChunk - Отправляет одну часть, при недоступности сервера повторяет попытку
+func (c HTTPClient) uploadChunk(session domain.Session, uploadID string, index int64, chunk []byte) error {
+	uri := "binary/upload/" + uploadID + "/" + strconv.FormatInt(index, 10)
+	var err error
+	for attempt := 0; attempt < chunkAttempts; attempt++ {
+		var resp *resty.Response
+		resp, err = c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+			return req.
+				SetHeader("Content-Type", "application/octet-stream").
+				SetBody(chunk).
+				Put(uri)
+		})
+		if errors.Is(err, domain.ErrServerUnavailable) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		statusCode := resp.StatusCode()
+		switch statusCode {
+		case http.StatusNoContent:
+			return nil
+		case http.StatusUnauthorized:
+			return domain.ErrUnauthorized
+		case http.StatusNotFound:
+			return domain.ErrEntityNotFound
+		case http.StatusBadRequest:
+			return domain.ErrBadRequest
+		default:
+			c.log.Error(resp.RawResponse)
+
+			return domain.ErrClientConnectionError
+		}
+	}
+
+	return err
+}
+
+// DownloadBinary - Потоково скачивает содержимое бинарных данных в dest.
+// Запрос выполняется без общего таймаута, так как время скачивания зависит от размера данных
+func (c HTTPClient) DownloadBinary(
+	session domain.Session,
+	bin domain.Binary,
+	dest io.Writer,
+	progress func(int64),
 ) error {
-	err := c.update(session.Token, "binary/"+bin.ID.String(), "multipart/form-data", bin.Content)
+	resp, err := c.authorizedBy(c.stream, session, func(req *resty.Request) (*resty.Response, error) {
+		raw, sendErr := req.SetDoNotParseResponse(true).Get("binary/" + bin.ID.String() + "/content")
+		if sendErr == nil && raw.StatusCode() == http.StatusUnauthorized {
+			// Тело ответа не читается автоматически, перед повтором запроса его нужно закрыть
+			sendErr = raw.RawBody().Close()
+		}
 
+		return raw, sendErr
+	})
 	if err != nil {
 		return err
 	}
+	body := resp.RawBody()
+	defer func() {
+		if closeErr := body.Close(); closeErr != nil {
+			c.log.Error(closeErr)
+		}
+	}()
+
+	statusCode := resp.StatusCode()
+	switch statusCode {
+	case http.StatusOK:
+		_, err = io.Copy(dest, io.TeeReader(body, &progressCounter{progress: progress}))
+
+		return err
+	case http.StatusUnauthorized:
+		return domain.ErrUnauthorized
+	case http.StatusNotFound:
+		return domain.ErrEntityNotFound
+	case http.StatusBadRequest:
+		return domain.ErrBadRequest
+	default:
+		c.log.Error(resp.RawResponse)
+
+		return domain.ErrClientConnectionError
+	}
+}
+
+// progressCounter - Считает записанные байты и сообщает их количество
+type progressCounter struct {
+	done     int64
+	progress func(int64)
+}
+
+// Write - Учитывает записанные байты
+func (p *progressCounter) Write(data []byte) (int, error) {
+	p.done += int64(len(data))
+	if p.progress != nil {
+		p.progress(p.done)
+	}
 
-	return nil
+	return len(data), nil
 }
 
 func (c HTTPClient) parseID(id string) (uuid.UUID, error) {
@@ -252,7 +877,7 @@ func (c HTTPClient) CreateCredentials(
 	if err != nil {
 		return uid, err
 	}
-	id, err := c.create(session.Token, "credentials/create", "application/json", payload)
+	id, err := c.create(session, "credentials/create", "application/json", payload)
 
 	if err != nil {
 		return uid, err
@@ -266,22 +891,28 @@ func (c HTTPClient) CreateCredentials(
 	return uid, nil
 }
 
-// UpdateCredentials - Обновляет существующие логин и пароль
+// UpdateCredentials - Обновляет существующую пару логина и пароля, возвращает ее с новой версией.
+// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
 func (c HTTPClient) UpdateCredentials(
 	session domain.Session,
 	cred *domain.Credentials,
-) error {
+) (*domain.Credentials, error) {
 	payload, err := credentialsToJSON(cred.Name, cred.Login, cred.Password, cred.Meta)
 	if err != nil {
-		return err
+		return cred, err
+	}
+	conflict := updateCredentialsConflictResponse{}
+	version, err := c.update(session, "credentials/"+cred.ID.String(), "application/json", payload, cred.Version, &conflict)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return &conflict.Data, err
 	}
-	err = c.update(session.Token, "credentials/"+cred.ID.String(), "application/json", payload)
-
 	if err != nil {
-		return err
+		return cred, err
 	}
+	updated := *cred
+	updated.Version = version
 
-	return nil
+	return &updated, nil
 }
 
 // CreateBankCard - Создает банковскую карту, возвращает идентификатор ресурса от сервера
@@ -294,7 +925,7 @@ func (c HTTPClient) CreateBankCard(
 	if err != nil {
 		return uid, err
 	}
-	id, err := c.create(session.Token, "bank_card/create", "application/json", payload)
+	id, err := c.create(session, "bank_card/create", "application/json", payload)
 
 	if err != nil {
 		return uid, err
@@ -308,11 +939,12 @@ func (c HTTPClient) CreateBankCard(
 	return uid, nil
 }
 
-// UpdateBankCard - Обновляет существующую банковскую карту
+// UpdateBankCard - Обновляет существующую банковскую карту, возвращает ее с новой версией.
+// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
 func (c HTTPClient) UpdateBankCard(
 	session domain.Session,
 	card *domain.BankCard,
-) error {
+) (*domain.BankCard, error) {
 	payload, err := bankCardToJSON(
 		card.Number,
 		card.ValidThru,
@@ -320,16 +952,255 @@ func (c HTTPClient) UpdateBankCard(
 		card.CardHolder,
 		card.Meta,
 	)
+	if err != nil {
+		return card, err
+	}
+	conflict := updateBankCardConflictResponse{}
+	version, err := c.update(session, "bank_card/"+card.ID.String(), "application/json", payload, card.Version, &conflict)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return &conflict.Data, err
+	}
+	if err != nil {
+		return card, err
+	}
+	updated := *card
+	updated.Version = version
+
+	return &updated, nil
+}
+
+// CreateOTP - Создает секрет одноразовых паролей, возвращает идентификатор ресурса от сервера
+func (c HTTPClient) CreateOTP(session domain.Session, otp domain.OTP) (uuid.UUID, error) {
+	var uid uuid.UUID
+	payload, err := otpToJSON(otp)
+	if err != nil {
+		return uid, err
+	}
+	id, err := c.create(session, "otp/create", "application/json", payload)
+
+	if err != nil {
+		return uid, err
+	}
+
+	return c.parseID(id)
+}
+
+// UpdateOTP - Обновляет существующий секрет одноразовых паролей, возвращает его с новой версией.
+// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
+func (c HTTPClient) UpdateOTP(session domain.Session, otp *domain.OTP) (*domain.OTP, error) {
+	payload, err := otpToJSON(*otp)
+	if err != nil {
+		return otp, err
+	}
+	conflict := updateOTPConflictResponse{}
+	version, err := c.update(session, "otp/"+otp.ID.String(), "application/json", payload, otp.Version, &conflict)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return &conflict.Data, err
+	}
+	if err != nil {
+		return otp, err
+	}
+	updated := *otp
+	updated.Version = version
+
+	return &updated, nil
+}
+
+// CreateSSHKey - Создает SSH ключ, возвращает идентификатор ресурса от сервера
+func (c HTTPClient) CreateSSHKey(session domain.Session, key domain.SSHKey) (uuid.UUID, error) {
+	var uid uuid.UUID
+	payload, err := sshKeyToJSON(key)
+	if err != nil {
+		return uid, err
+	}
+	id, err := c.create(session, "ssh_key/create", "application/json", payload)
+
+	if err != nil {
+		return uid, err
+	}
+
+	return c.parseID(id)
+}
+
+// UpdateSSHKey - Обновляет существующий SSH ключ, возвращает его с новой версией.
+// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
+func (c HTTPClient) UpdateSSHKey(session domain.Session, key *domain.SSHKey) (*domain.SSHKey, error) {
+	payload, err := sshKeyToJSON(*key)
+	if err != nil {
+		return key, err
+	}
+	conflict := updateSSHKeyConflictResponse{}
+	version, err := c.update(session, "ssh_key/"+key.ID.String(), "application/json", payload, key.Version, &conflict)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		actual, parseErr := conflict.Data.toDomain()
+		if parseErr != nil {
+			return key, parseErr
+		}
+
+		return &actual, err
+	}
+	if err != nil {
+		return key, err
+	}
+	updated := *key
+	updated.Version = version
+
+	return &updated, nil
+}
+
+// CreateItem - Создает данные по шаблону, возвращает идентификатор ресурса от сервера
+func (c HTTPClient) CreateItem(session domain.Session, obj domain.Item) (uuid.UUID, error) {
+	var uid uuid.UUID
+	payload, err := itemToJSON(obj)
+	if err != nil {
+		return uid, err
+	}
+	id, err := c.create(session, "item/create", "application/json", payload)
+
+	if err != nil {
+		return uid, err
+	}
+
+	return c.parseID(id)
+}
+
+// UpdateItem - Обновляет существующие данные по шаблону, возвращает их с новой версией.
+// При конфликте версий возвращает актуальную копию с сервера и ErrVersionConflict
+func (c HTTPClient) UpdateItem(session domain.Session, obj *domain.Item) (*domain.Item, error) {
+	payload, err := itemToJSON(*obj)
+	if err != nil {
+		return obj, err
+	}
+	conflict := updateItemConflictResponse{}
+	version, err := c.update(session, "item/"+obj.ID.String(), "application/json", payload, obj.Version, &conflict)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return &conflict.Data, err
+	}
+	if err != nil {
+		return obj, err
+	}
+	updated := *obj
+	updated.Version = version
+
+	return &updated, nil
+}
+
+// CreateFolder - Создает папку, возвращает идентификатор ресурса от сервера.
+// Если родительской папки нет на сервере, возвращает ErrEntityNotFound
+func (c HTTPClient) CreateFolder(session domain.Session, folder domain.Folder) (uuid.UUID, error) {
+	var uid uuid.UUID
+	payload, err := folderToJSON(folder)
+	if err != nil {
+		return uid, err
+	}
+	id, err := c.create(session, "folder/create", "application/json", payload)
+
+	if err != nil {
+		return uid, err
+	}
+
+	return c.parseID(id)
+}
+
+// UpdateFolder - Переименовывает или перемещает существующую папку.
+// Если папка перемещается в саму себя или во вложенную папку, возвращает ErrBadRequest
+func (c HTTPClient) UpdateFolder(session domain.Session, folder domain.Folder) error {
+	payload, err := folderToJSON(folder)
 	if err != nil {
 		return err
 	}
-	err = c.update(session.Token, "bank_card/"+card.ID.String(), "application/json", payload)
+	// Папки не версионируются, конфликт версий невозможен
+	_, err = c.update(session, "folder/"+folder.ID.String(), "application/json", payload, 0, nil)
+
+	return err
+}
+
+// CreateTag - Создает метку, возвращает идентификатор ресурса от сервера
+func (c HTTPClient) CreateTag(session domain.Session, name string) (uuid.UUID, error) {
+	var uid uuid.UUID
+	payload, err := tagToJSON(name)
+	if err != nil {
+		return uid, err
+	}
+	id, err := c.create(session, "tag/create", "application/json", payload)
+
+	if err != nil {
+		return uid, err
+	}
+
+	return c.parseID(id)
+}
+
+// CreateTemplate - Создает пользовательский шаблон, возвращает идентификатор ресурса от сервера
+func (c HTTPClient) CreateTemplate(session domain.Session, tmpl domain.ItemTemplate) (uuid.UUID, error) {
+	var uid uuid.UUID
+	payload, err := templateToJSON(tmpl)
+	if err != nil {
+		return uid, err
+	}
+	id, err := c.create(session, "template/create", "application/json", payload)
+
+	if err != nil {
+		return uid, err
+	}
+
+	return c.parseID(id)
+}
 
+// SaveLabels - Заменяет папку и метки данных
+func (c HTTPClient) SaveLabels(session domain.Session, labels domain.Labels) error {
+	payload, err := labelsToJSON(labels)
 	if err != nil {
 		return err
 	}
+	_, err = c.update(session, "labels/"+labels.EntityID.String(), "application/json", payload, 0, nil)
+
+	return err
+}
+
+// DeleteText - Удаляет существующий текст
+func (c HTTPClient) DeleteText(session domain.Session, textID uuid.UUID) error {
+	return c.delete(session, "text/"+textID.String())
+}
 
-	return nil
+// DeleteBinary - Удаляет существующие бинарные данные
+func (c HTTPClient) DeleteBinary(session domain.Session, binID uuid.UUID) error {
+	return c.delete(session, "binary/"+binID.String())
+}
+
+// DeleteCredentials - Удаляет существующие логин и пароль
+func (c HTTPClient) DeleteCredentials(session domain.Session, credID uuid.UUID) error {
+	return c.delete(session, "credentials/"+credID.String())
+}
+
+// DeleteBankCard - Удаляет существующую банковскую карту
+func (c HTTPClient) DeleteBankCard(session domain.Session, cardID uuid.UUID) error {
+	return c.delete(session, "bank_card/"+cardID.String())
+}
+
+// DeleteOTP - Удаляет существующий секрет одноразовых паролей
+func (c HTTPClient) DeleteOTP(session domain.Session, otpID uuid.UUID) error {
+	return c.delete(session, "otp/"+otpID.String())
+}
+
+// DeleteSSHKey - Удаляет существующий SSH ключ
+func (c HTTPClient) DeleteSSHKey(session domain.Session, keyID uuid.UUID) error {
+	return c.delete(session, "ssh_key/"+keyID.String())
+}
+
+// DeleteItem - Удаляет существующие данные по шаблону
+func (c HTTPClient) DeleteItem(session domain.Session, itemID uuid.UUID) error {
+	return c.delete(session, "item/"+itemID.String())
+}
+
+// DeleteFolder - Удаляет папку, вложенные папки и данные переносятся в ее родительскую папку
+func (c HTTPClient) DeleteFolder(session domain.Session, folderID uuid.UUID) error {
+	return c.delete(session, "folder/"+folderID.String())
+}
+
+// DeleteTemplate - Удаляет существующий пользовательский шаблон
+func (c HTTPClient) DeleteTemplate(session domain.Session, templateID uuid.UUID) error {
+	return c.delete(session, "template/"+templateID.String())
 }
 
 func (c HTTPClient) parseErrorResponse(body []byte) error {
@@ -342,14 +1213,75 @@ func (c HTTPClient) parseErrorResponse(body []byte) error {
 	return errors.New(errorResp.Message)
 }
 
-// GetAllTexts - Получает все расшифрованные тексты пользователя
-func (c HTTPClient) GetAllTexts(session domain.Session) ([]domain.Text, error) {
-	resp, err := c.client.R().
-		SetHeader("Authorization", session.Token).
-		Get("text/all")
+// GetText - Получает расшифрованный текст по идентификатору
+func (c HTTPClient) GetText(session domain.Session, textID uuid.UUID) (domain.Text, error) {
+	respData := getTextResponse{}
+	err := c.get(session, "text/"+textID.String(), &respData)
+
+	return respData.Data, err
+}
+
+// GetBinary - Получает расшифрованные метаданные бинарных данных по идентификатору без содержимого
+func (c HTTPClient) GetBinary(session domain.Session, binID uuid.UUID) (domain.Binary, error) {
+	respData := getBinaryResponse{}
+	if err := c.get(session, "binary/"+binID.String(), &respData); err != nil {
+		return domain.Binary{}, err
+	}
+
+	return respData.Data.toDomain()
+}
+
+// GetCredentials - Получает расшифрованные логин и пароль по идентификатору
+func (c HTTPClient) GetCredentials(session domain.Session, credID uuid.UUID) (domain.Credentials, error) {
+	respData := getCredentialsResponse{}
+	err := c.get(session, "credentials/"+credID.String(), &respData)
+
+	return respData.Data, err
+}
+
+// GetBankCard - Получает расшифрованную банковскую карту по идентификатору
+func (c HTTPClient) GetBankCard(session domain.Session, cardID uuid.UUID) (domain.BankCard, error) {
+	respData := getBankCardResponse{}
+	err := c.get(session, "bank_card/"+cardID.String(), &respData)
+
+	return respData.Data, err
+}
+
+// GetOTP - Получает расшифрованный секрет одноразовых паролей по идентификатору
+func (c HTTPClient) GetOTP(session domain.Session, otpID uuid.UUID) (domain.OTP, error) {
+	respData := getOTPResponse{}
+	err := c.get(session, "otp/"+otpID.String(), &respData)
+
+	return respData.Data, err
+}
+
+// GetSSHKey - Получает расшифрованный SSH ключ по идентификатору
+func (c HTTPClient) GetSSHKey(session domain.Session, keyID uuid.UUID) (domain.SSHKey, error) {
+	respData := getSSHKeyResponse{}
+	err := c.get(session, "ssh_key/"+keyID.String(), &respData)
+	if err != nil {
+		return domain.SSHKey{}, err
+	}
+
+	return respData.Data.toDomain()
+}
+
+// GetItem - Получает расшифрованные данные по шаблону по идентификатору
+func (c HTTPClient) GetItem(session domain.Session, itemID uuid.UUID) (domain.Item, error) {
+	respData := getItemResponse{}
+	err := c.get(session, "item/"+itemID.String(), &respData)
+
+	return respData.Data, err
+}
+
+// GetAllTexts - Получает страницу расшифрованных текстов пользователя и курсор следующей страницы
+func (c HTTPClient) GetAllTexts(session domain.Session, cursor string) ([]domain.Text, string, error) {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return withCursor(req, cursor).Get("text/all")
+	})
 
 	if err != nil {
-		return []domain.Text{}, err
+		return []domain.Text{}, "", err
 	}
 
 	statusCode := resp.StatusCode()
@@ -357,32 +1289,33 @@ func (c HTTPClient) GetAllTexts(session domain.Session) ([]domain.Text, error) {
 	if statusCode == http.StatusInternalServerError {
 		err := c.parseErrorResponse(resp.Body())
 
-		return []domain.Text{}, err
+		return []domain.Text{}, "", err
 	}
 
 	if statusCode == http.StatusOK {
 		respData := getAllTextsResponse{}
 		err = json.Unmarshal(resp.Body(), &respData)
 		if err != nil {
-			return []domain.Text{}, err
+			return []domain.Text{}, "", err
 		}
 
-		return respData.Data.Texts, nil
+		return respData.Data.Texts, respData.Data.NextCursor, nil
 	}
 
 	c.log.Error(resp.RawResponse)
 
-	return []domain.Text{}, domain.ErrClientConnectionError
+	return []domain.Text{}, "", domain.ErrClientConnectionError
 }
 
-// GetAllBinaries - Получает все расшифрованные бинарные данные пользователя
-func (c HTTPClient) GetAllBinaries(session domain.Session) ([]domain.Binary, error) {
-	resp, err := c.client.R().
-		SetHeader("Authorization", session.Token).
-		Get("binary/all")
+// GetAllBinaries - Получает страницу расшифрованных метаданных бинарных данных пользователя без содержимого
+// и курсор следующей страницы
+func (c HTTPClient) GetAllBinaries(session domain.Session, cursor string) ([]domain.Binary, string, error) {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return withCursor(req, cursor).Get("binary/all")
+	})
 
 	if err != nil {
-		return []domain.Binary{}, err
+		return []domain.Binary{}, "", err
 	}
 
 	statusCode := resp.StatusCode()
@@ -390,32 +1323,34 @@ func (c HTTPClient) GetAllBinaries(session domain.Session) ([]domain.Binary, err
 	if statusCode == http.StatusInternalServerError {
 		err := c.parseErrorResponse(resp.Body())
 
-		return []domain.Binary{}, err
+		return []domain.Binary{}, "", err
 	}
 
 	if statusCode == http.StatusOK {
 		respData := getAllBinariesResponse{}
 		err = json.Unmarshal(resp.Body(), &respData)
 		if err != nil {
-			return []domain.Binary{}, err
+			return []domain.Binary{}, "", err
 		}
 
-		return respData.Data.Binaries, nil
+		bins, err := binariesFromResponse(respData.Data.Binaries)
+
+		return bins, respData.Data.NextCursor, err
 	}
 
 	c.log.Error(resp.RawResponse)
 
-	return []domain.Binary{}, domain.ErrClientConnectionError
+	return []domain.Binary{}, "", domain.ErrClientConnectionError
 }
 
-// GetAllCredentials - Получает все расшифрованные логины и пароли пользователя
-func (c HTTPClient) GetAllCredentials(session domain.Session) (creds []domain.Credentials, err error) {
-	resp, err := c.client.R().
-		SetHeader("Authorization", session.Token).
-		Get("credentials/all")
+// GetAllCredentials - Получает страницу расшифрованных логинов и паролей пользователя и курсор следующей страницы
+func (c HTTPClient) GetAllCredentials(session domain.Session, cursor string) (creds []domain.Credentials, next string, err error) {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return withCursor(req, cursor).Get("credentials/all")
+	})
 
 	if err != nil {
-		return creds, err
+		return creds, next, err
 	}
 
 	statusCode := resp.StatusCode()
@@ -423,32 +1358,32 @@ func (c HTTPClient) GetAllCredentials(session domain.Session) (creds []domain.Cr
 	if statusCode == http.StatusInternalServerError {
 		err = c.parseErrorResponse(resp.Body())
 
-		return creds, err
+		return creds, next, err
 	}
 
 	if statusCode == http.StatusOK {
 		respData := getAllCredentialsResponse{}
 		err = json.Unmarshal(resp.Body(), &respData)
 		if err != nil {
-			return creds, err
+			return creds, next, err
 		}
 
-		return respData.Data.Credentials, nil
+		return respData.Data.Credentials, respData.Data.NextCursor, nil
 	}
 
 	c.log.Error(resp.RawResponse)
 
-	return creds, domain.ErrClientConnectionError
+	return creds, next, domain.ErrClientConnectionError
 }
 
-// GetAllBankCards - Получает все расшифрованные банковские карты пользователя
-func (c HTTPClient) GetAllBankCards(session domain.Session) (cards []domain.BankCard, err error) {
-	resp, err := c.client.R().
-		SetHeader("Authorization", session.Token).
-		Get("bank_card/all")
+// GetAllBankCards - Получает страницу расшифрованных банковских карт пользователя и курсор следующей страницы
+func (c HTTPClient) GetAllBankCards(session domain.Session, cursor string) (cards []domain.BankCard, next string, err error) {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return withCursor(req, cursor).Get("bank_card/all")
+	})
 
 	if err != nil {
-		return cards, err
+		return cards, next, err
 	}
 
 	statusCode := resp.StatusCode()
@@ -456,38 +1391,145 @@ func (c HTTPClient) GetAllBankCards(session domain.Session) (cards []domain.Bank
 	if statusCode == http.StatusInternalServerError {
 		err = c.parseErrorResponse(resp.Body())
 
-		return cards, err
+		return cards, next, err
 	}
 
 	if statusCode == http.StatusOK {
 		respData := getAllBankCardsResponse{}
 		err = json.Unmarshal(resp.Body(), &respData)
 		if err != nil {
-			return cards, err
+			return cards, next, err
+		}
+
+		return respData.Data.BankCards, respData.Data.NextCursor, nil
+	}
+
+	c.log.Error(resp.RawResponse)
+
+	return cards, next, domain.ErrClientConnectionError
+}
+
+// GetAllOTPs - Получает страницу расшифрованных секретов одноразовых паролей пользователя и курсор следующей страницы
+func (c HTTPClient) GetAllOTPs(session domain.Session, cursor string) (otps []domain.OTP, next string, err error) {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return withCursor(req, cursor).Get("otp/all")
+	})
+
+	if err != nil {
+		return otps, next, err
+	}
+
+	statusCode := resp.StatusCode()
+
+	if statusCode == http.StatusInternalServerError {
+		err = c.parseErrorResponse(resp.Body())
+
+		return otps, next, err
+	}
+
+	if statusCode == http.StatusOK {
+		respData := getAllOTPsResponse{}
+		err = json.Unmarshal(resp.Body(), &respData)
+		if err != nil {
+			return otps, next, err
+		}
+
+		return respData.Data.OTPs, respData.Data.NextCursor, nil
+	}
+
+	c.log.Error(resp.RawResponse)
+
+	return otps, next, domain.ErrClientConnectionError
+}
+
+// GetAllSSHKeys - Получает страницу расшифрованных SSH ключей пользователя и курсор следующей страницы
+func (c HTTPClient) GetAllSSHKeys(session domain.Session, cursor string) (keys []domain.SSHKey, next string, err error) {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return withCursor(req, cursor).Get("ssh_key/all")
+	})
+
+	if err != nil {
+		return keys, next, err
+	}
+
+	statusCode := resp.StatusCode()
+
+	if statusCode == http.StatusInternalServerError {
+		err = c.parseErrorResponse(resp.Body())
+
+		return keys, next, err
+	}
+
+	if statusCode == http.StatusOK {
+		respData := getAllSSHKeysResponse{}
+		err = json.Unmarshal(resp.Body(), &respData)
+		if err != nil {
+			return keys, next, err
+		}
+		keys, err = sshKeysFromResponse(respData.Data.SSHKeys)
+		if err != nil {
+			return keys, next, err
+		}
+
+		return keys, respData.Data.NextCursor, nil
+	}
+
+	c.log.Error(resp.RawResponse)
+
+	return keys, next, domain.ErrClientConnectionError
+}
+
+// GetAllItems - Получает страницу расшифрованных данных по шаблону пользователя и курсор следующей страницы
+func (c HTTPClient) GetAllItems(session domain.Session, cursor string) (items []domain.Item, next string, err error) {
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return withCursor(req, cursor).Get("item/all")
+	})
+
+	if err != nil {
+		return items, next, err
+	}
+
+	statusCode := resp.StatusCode()
+
+	if statusCode == http.StatusInternalServerError {
+		err = c.parseErrorResponse(resp.Body())
+
+		return items, next, err
+	}
+
+	if statusCode == http.StatusOK {
+		respData := getAllItemsResponse{}
+		err = json.Unmarshal(resp.Body(), &respData)
+		if err != nil {
+			return items, next, err
 		}
 
-		return respData.Data.BankCards, nil
+		return respData.Data.Items, respData.Data.NextCursor, nil
 	}
 
 	c.log.Error(resp.RawResponse)
 
-	return cards, domain.ErrClientConnectionError
+	return items, next, domain.ErrClientConnectionError
 }
 
-// GetAll - Получает все расшифрованные данные пользователя
-func (c HTTPClient) GetAll(session domain.Session) (
+// GetAll - Получает страницу всех расшифрованных данных пользователя и курсор следующей страницы
+func (c HTTPClient) GetAll(session domain.Session, cursor string) (
 	texts []domain.Text,
 	bankCards []domain.BankCard,
 	binaries []domain.Binary,
 	credentials []domain.Credentials,
+	otps []domain.OTP,
+	sshKeys []domain.SSHKey,
+	items []domain.Item,
+	next string,
 	err error,
 ) {
-	resp, err := c.client.R().
-		SetHeader("Authorization", session.Token).
-		Get("all")
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return withCursor(req, cursor).Get("all")
+	})
 
 	if err != nil {
-		return texts, bankCards, binaries, credentials, err
+		return texts, bankCards, binaries, credentials, otps, sshKeys, items, next, err
 	}
 
 	statusCode := resp.StatusCode()
@@ -495,22 +1537,90 @@ func (c HTTPClient) GetAll(session domain.Session) (
 	if statusCode == http.StatusInternalServerError {
 		err = c.parseErrorResponse(resp.Body())
 
-		return texts, bankCards, binaries, credentials, err
+		return texts, bankCards, binaries, credentials, otps, sshKeys, items, next, err
 	}
 
 	if statusCode == http.StatusOK {
 		respData := getAllResponse{}
 		err = json.Unmarshal(resp.Body(), &respData)
 		if err != nil {
-			return texts, bankCards, binaries, credentials, err
+			return texts, bankCards, binaries, credentials, otps, sshKeys, items, next, err
 		}
 
 		data := respData.Data
+		binaries, err = binariesFromResponse(data.Binaries)
+		if err != nil {
+			return texts, bankCards, binaries, credentials, otps, sshKeys, items, next, err
+		}
+
+		sshKeys, err = sshKeysFromResponse(data.SSHKeys)
+		if err != nil {
+			return texts, bankCards, binaries, credentials, otps, sshKeys, items, next, err
+		}
+
+		return data.Texts, data.BankCards, binaries, data.Credentials, data.OTPs, sshKeys, data.Items, data.NextCursor, nil
+	}
+
+	c.log.Error(resp.RawResponse)
+
+	return texts, bankCards, binaries, credentials, otps, sshKeys, items, next, domain.ErrClientConnectionError
+}
+
+// GetChanges - Получает страницу расшифрованных изменений данных пользователя после ревизии since,
+// если после ревизии страницы есть еще изменения, признак More истинен
+func (c HTTPClient) GetChanges(session domain.Session, since int64) (domain.Changes, error) {
+	changes := domain.Changes{}
+	resp, err := c.authorized(session, func(req *resty.Request) (*resty.Response, error) {
+		return req.
+			SetQueryParam("since", strconv.FormatInt(since, 10)).
+			Get("changes")
+	})
+
+	if err != nil {
+		return changes, err
+	}
+
+	statusCode := resp.StatusCode()
+
+	if statusCode == http.StatusInternalServerError {
+		err = c.parseErrorResponse(resp.Body())
+
+		return changes, err
+	}
+
+	if statusCode == http.StatusOK {
+		respData := getChangesResponse{}
+		err = json.Unmarshal(resp.Body(), &respData)
+		if err != nil {
+			return changes, err
+		}
 
-		return data.Texts, data.BankCards, data.Binaries, data.Credentials, nil
+		data := respData.Data
+		changes.Revision = data.Revision
+		changes.More = data.More
+		changes.Texts = data.Texts
+		changes.Binaries, err = binariesFromResponse(data.Binaries)
+		if err != nil {
+			return changes, err
+		}
+		changes.Credentials = data.Credentials
+		changes.BankCards = data.BankCards
+		changes.OTPs = data.OTPs
+		changes.SSHKeys, err = sshKeysFromResponse(data.SSHKeys)
+		if err != nil {
+			return changes, err
+		}
+		changes.Items = data.Items
+		changes.Folders = foldersFromResponse(data.Folders)
+		changes.Tags = data.Tags
+		changes.Templates = data.Templates
+		changes.Labels = labelsFromResponse(data.Labels)
+		changes.Deleted = data.Deleted
+
+		return changes, nil
 	}
 
 	c.log.Error(resp.RawResponse)
 
-	return texts, bankCards, binaries, credentials, domain.ErrClientConnectionError
+	return changes, domain.ErrClientConnectionError
 }
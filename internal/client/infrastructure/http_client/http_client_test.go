@@ -1,13 +1,18 @@
 package httpclient
 
 import (
+	"bytes"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"io"
 	"math/big"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,19 +22,55 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
 )
 
 const registerPath = "/auth/register"
 const loginPath = "/auth/login"
+const preLoginPath = "/auth/prelogin"
 const textPath = "/text/"
 const textCreatePath = textPath + "create"
 const textAllPath = textPath + "all"
 const binaryAllPath = "/binary/all"
 const credentialsAllPath = "/credentials/all"
 const bankCardsAllPath = "/bank_card/all"
+const otpAllPath = "/otp/all"
+const sshKeyAllPath = "/ssh_key/all"
+const itemAllPath = "/item/all"
 const allPath = "/all"
+const changesPath = "/changes"
+const refreshPath = "/auth/refresh"
+const refreshTokenValue = "refreshTokenValue"
+
+type memorySessionRepository struct {
+	session *domain.Session
+}
+
+func (r *memorySessionRepository) Save(session domain.Session) error {
+	r.session = &session
+
+	return nil
+}
+
+func (r *memorySessionRepository) Get() (*domain.Session, error) {
+	if r.session == nil {
+		return nil, domain.ErrEntityNotFound
+	}
+
+	return r.session, nil
+}
+
+func (r *memorySessionRepository) Delete() error {
+	r.session = nil
+
+	return nil
+}
 
 func newClient(url string) *HTTPClient {
+	return newClientWithSessions(url, &memorySessionRepository{})
+}
+
+func newClientWithSessions(url string, sessions domain.SessionRepositoryInterface) *HTTPClient {
 	log := logrus.New()
 	cert := x509.Certificate{
 		SerialNumber: big.NewInt(1),
@@ -48,13 +89,14 @@ func newClient(url string) *HTTPClient {
 		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
 	}
 
-	return New(log, cert.Raw, time.Second, url)
+	return New(log, cert.Raw, time.Second, url, sessions)
 }
 
 func newSession() domain.Session {
 	return domain.Session{
-		UserID: uuid.New(),
-		Token:  "tokenValue",
+		UserID:       uuid.New(),
+		Token:        "tokenValue",
+		RefreshToken: refreshTokenValue,
 	}
 }
 
@@ -111,6 +153,7 @@ func TestRegisterSuccess(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == registerPath {
 			w.Header().Set("Authorization", tokenValue)
+			w.Header().Set(refreshTokenHeader, refreshTokenValue)
 			w.WriteHeader(http.StatusOK)
 		}
 	}))
@@ -118,9 +161,10 @@ func TestRegisterSuccess(t *testing.T) {
 
 	client := newClient(server.URL)
 
-	token, err := client.Register("login", "password")
+	token, refreshToken, err := client.Register("login", "password", false, nil)
 	require.NoError(t, err)
 	assert.Equal(t, token, tokenValue)
+	assert.Equal(t, refreshToken, refreshTokenValue)
 }
 
 func TestRegisterInternalServerError(t *testing.T) {
@@ -136,7 +180,7 @@ func TestRegisterInternalServerError(t *testing.T) {
 
 	client := newClient(server.URL)
 
-	_, err := client.Register("login", "password")
+	_, _, err := client.Register("login", "password", false, nil)
 	require.Error(t, err)
 }
 
@@ -150,14 +194,14 @@ func TestRegisterLoginIsTaken(t *testing.T) {
 
 	client := newClient(server.URL)
 
-	_, err := client.Register("login", "password")
+	_, _, err := client.Register("login", "password", false, nil)
 	require.Error(t, err)
 }
 
 func TestRegisterWrongURL(t *testing.T) {
 	client := newClient("wrongurl.com")
 
-	_, err := client.Register("login", "password")
+	_, _, err := client.Register("login", "password", false, nil)
 	require.Error(t, err)
 }
 
@@ -166,6 +210,7 @@ func TestLoginSuccess(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == loginPath {
 			w.Header().Set("Authorization", tokenValue)
+			w.Header().Set(refreshTokenHeader, refreshTokenValue)
 			w.WriteHeader(http.StatusOK)
 		}
 	}))
@@ -173,15 +218,135 @@ func TestLoginSuccess(t *testing.T) {
 
 	client := newClient(server.URL)
 
-	token, err := client.Login("login", "password")
+	token, refreshToken, err := client.Login("login", "password")
 	require.NoError(t, err)
 	assert.Equal(t, token, tokenValue)
+	assert.Equal(t, refreshToken, refreshTokenValue)
+}
+
+func TestLoginTwoFactorRequired(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case loginPath:
+			w.Header().Set(challengeHeader, "challengeValue")
+			w.WriteHeader(http.StatusAccepted)
+		case "/auth/2fa/login":
+			var payload map[string]string
+			err := json.NewDecoder(r.Body).Decode(&payload)
+			require.NoError(t, err)
+			if payload["challenge"] != "challengeValue" || payload["code"] != "123456" {
+				w.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+			w.Header().Set("Authorization", "tokenValue")
+			w.Header().Set(refreshTokenHeader, refreshTokenValue)
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+
+	_, _, err := client.Login("login", "password")
+	var twoFactorRequired *domain.TwoFactorRequiredError
+	require.ErrorAs(t, err, &twoFactorRequired)
+	assert.Equal(t, "challengeValue", twoFactorRequired.Challenge)
+
+	_, _, err = client.TwoFactorLogin(twoFactorRequired.Challenge, "000000")
+	require.ErrorIs(t, err, domain.ErrInvalidTwoFactorCode)
+
+	token, refreshToken, err := client.TwoFactorLogin(twoFactorRequired.Challenge, "123456")
+	require.NoError(t, err)
+	assert.Equal(t, "tokenValue", token)
+	assert.Equal(t, refreshTokenValue, refreshToken)
+}
+
+func TestEnrollAndVerifyTwoFactor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body []byte
+		var err error
+		switch r.URL.Path {
+		case "/auth/2fa/enroll":
+			body, err = json.Marshal(map[string]any{
+				"status": true,
+				"data":   map[string]any{"secret": "JBSWY3DPEHPK3PXP", "uri": "otpauth://totp/GophKeeper:login"},
+			})
+		case "/auth/2fa/verify":
+			body, err = json.Marshal(map[string]any{
+				"status": true,
+				"data":   map[string]any{"recovery_codes": []string{"abcde-fghij"}},
+			})
+		case "/auth/2fa/disable":
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(body)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	secret, uri, err := client.EnrollTwoFactor(session)
+	require.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", secret)
+	assert.Equal(t, "otpauth://totp/GophKeeper:login", uri)
+
+	codes, err := client.VerifyTwoFactor(session, "123456")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"abcde-fghij"}, codes)
+
+	err = client.DisableTwoFactor(session, "123456")
+	require.ErrorIs(t, err, domain.ErrInvalidTwoFactorCode)
+}
+
+func TestPreLoginSuccess(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == preLoginPath {
+			body, err := json.Marshal(map[string]any{
+				"status": true,
+				"data":   map[string]any{"e2e": true, "salt": salt},
+			})
+			require.NoError(t, err)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, err = w.Write(body)
+			require.NoError(t, err)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+
+	e2e, gotSalt, err := client.PreLogin("login")
+	require.NoError(t, err)
+	assert.True(t, e2e)
+	assert.Equal(t, salt, gotSalt)
+}
+
+func TestPreLoginInternalServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+
+	_, _, err := client.PreLogin("login")
+	require.ErrorIs(t, err, domain.ErrClientConnectionError)
 }
 
 func TestLoginWrongURL(t *testing.T) {
 	client := newClient("wrongurl.com")
 
-	_, err := client.Login("login", "password")
+	_, _, err := client.Login("login", "password")
 	require.Error(t, err)
 }
 
@@ -195,7 +360,7 @@ func TestLoginInvalidCredentials(t *testing.T) {
 
 	client := newClient(server.URL)
 
-	_, err := client.Login("login", "password")
+	_, _, err := client.Login("login", "password")
 	require.Error(t, err)
 }
 
@@ -212,7 +377,7 @@ func TestLoginInternalServerError(t *testing.T) {
 
 	client := newClient(server.URL)
 
-	_, err := client.Login("login", "password")
+	_, _, err := client.Login("login", "password")
 	require.Error(t, err)
 }
 
@@ -278,8 +443,15 @@ func TestCreateTextInvalidLocation(t *testing.T) {
 
 func TestCreateBinarySuccess(t *testing.T) {
 	id := uuid.New()
+	var metadata binaryMetadataPayload
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/binary/create" {
+			data, err := base64.StdEncoding.DecodeString(r.Header.Get("X-Binary-Metadata"))
+			if err != nil || json.Unmarshal(data, &metadata) != nil {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
 			w.Header().Set("Location", id.String())
 			w.WriteHeader(http.StatusCreated)
 		}
@@ -289,9 +461,20 @@ func TestCreateBinarySuccess(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	uid, err := client.CreateBinary(session, []byte("content"))
+	bin := domain.Binary{
+		Content:  []byte("content"),
+		Name:     "content.txt",
+		MimeType: "text/plain; charset=utf-8",
+		SHA256:   "ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73",
+		Meta:     "meta",
+	}
+	uid, err := client.CreateBinary(session, bin)
 	require.NoError(t, err)
 	assert.Equal(t, uid, id)
+	assert.Equal(t, bin.Name, metadata.Name)
+	assert.Equal(t, bin.MimeType, metadata.MimeType)
+	assert.Equal(t, bin.SHA256, metadata.SHA256)
+	assert.Equal(t, bin.Meta, metadata.Meta)
 }
 
 func TestCreateBinaryInvalidLocation(t *testing.T) {
@@ -306,7 +489,7 @@ func TestCreateBinaryInvalidLocation(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	_, err := client.CreateBinary(session, []byte("content"))
+	_, err := client.CreateBinary(session, domain.Binary{Content: []byte("content")})
 	require.Error(t, err)
 }
 
@@ -314,10 +497,273 @@ func TestCreateBinaryWrongURL(t *testing.T) {
 	client := newClient("wrongurl.com")
 	session := newSession()
 
-	_, err := client.CreateBinary(session, []byte("content"))
+	_, err := client.CreateBinary(session, domain.Binary{Content: []byte("content")})
 	require.Error(t, err)
 }
 
+func TestUploadBinarySuccess(t *testing.T) {
+	id := uuid.New()
+	content := []byte("0123456789abcdefghij!")
+	chunks := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/binary/upload":
+			var payload map[string]int
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload["size"] != len(content) {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+			w.Header().Set("Location", id.String())
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPut:
+			body, err := io.ReadAll(r.Body)
+			if err != nil || r.Header.Get("Content-Type") != "application/octet-stream" {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+			chunks[r.URL.Path] = body
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	reported := []int64{}
+	var started uuid.UUID
+	uid, err := client.UploadBinary(
+		session, uuid.Nil, bytes.NewReader(content), int64(len(content)), 8,
+		func(uploadID uuid.UUID) error {
+			started = uploadID
+
+			return nil
+		},
+		func(done int64) {
+			reported = append(reported, done)
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, id, uid)
+	assert.Equal(t, id, started)
+	assert.Equal(t, []int64{8, 16, 21}, reported)
+	prefix := "/binary/upload/" + id.String() + "/"
+	assert.Equal(t, content[:8], chunks[prefix+"0"])
+	assert.Equal(t, content[8:16], chunks[prefix+"1"])
+	assert.Equal(t, content[16:], chunks[prefix+"2"])
+}
+
+// Проверяем, что загрузка продолжается в существующей сессии и принятые сервером части не отправляются повторно
+func TestUploadBinaryResume(t *testing.T) {
+	id := uuid.New()
+	content := []byte("0123456789abcdefghij!")
+	chunks := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/binary/upload/"+id.String():
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status": true, "data": {"size": 21, "chunk_size": 8, "received": [0, 2]}}`))
+		case r.Method == http.MethodPut:
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+			chunks[r.URL.Path] = body
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	reported := []int64{}
+	started := false
+	uid, err := client.UploadBinary(
+		session, id, bytes.NewReader(content), int64(len(content)), 8,
+		func(uuid.UUID) error {
+			started = true
+
+			return nil
+		},
+		func(done int64) {
+			reported = append(reported, done)
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, id, uid)
+	assert.False(t, started)
+	assert.Equal(t, []int64{8, 16, 21}, reported)
+	assert.Equal(t, map[string][]byte{"/binary/upload/" + id.String() + "/1": content[8:16]}, chunks)
+}
+
+// Проверяем, что при истекшей сессии загрузка начинается заново в новой сессии
+func TestUploadBinaryResumeExpired(t *testing.T) {
+	expired := uuid.New()
+	id := uuid.New()
+	var sent int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/binary/upload/"+expired.String():
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Path == "/binary/upload":
+			w.Header().Set("Location", id.String())
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/binary/upload/"+id.String()+"/"):
+			sent++
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	var started uuid.UUID
+	uid, err := client.UploadBinary(
+		session, expired, bytes.NewReader([]byte("content")), 7, 4,
+		func(uploadID uuid.UUID) error {
+			started = uploadID
+
+			return nil
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, id, uid)
+	assert.Equal(t, id, started)
+	assert.Equal(t, 2, sent)
+}
+
+func TestCommitBinaryUploadSuccess(t *testing.T) {
+	id := uuid.New()
+	var metadata binaryMetadataPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/binary/upload/"+id.String()+"/commit" {
+			data, err := base64.StdEncoding.DecodeString(r.Header.Get("X-Binary-Metadata"))
+			if err != nil || json.Unmarshal(data, &metadata) != nil {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+			w.Header().Set("Location", id.String())
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	uid, err := client.CommitBinaryUpload(session, id, domain.Binary{Name: "large.iso", SHA256: "abc"})
+	require.NoError(t, err)
+	assert.Equal(t, id, uid)
+	assert.Equal(t, "large.iso", metadata.Name)
+	assert.Equal(t, "abc", metadata.SHA256)
+}
+
+func TestUploadBinaryChunkRejected(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/binary/upload" {
+			w.Header().Set("Location", id.String())
+			w.WriteHeader(http.StatusCreated)
+
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	_, err := client.UploadBinary(session, uuid.Nil, bytes.NewReader([]byte("content")), 7, 4, nil, nil)
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+}
+
+func TestUploadBinaryShortSource(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", id.String())
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	_, err := client.UploadBinary(session, uuid.Nil, bytes.NewReader([]byte("short")), 100, 8, nil, nil)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestDownloadBinarySuccess(t *testing.T) {
+	id := uuid.New()
+	content := []byte("my secret binary message")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case refreshPath:
+			w.Header().Set("Authorization", "newTokenValue")
+			w.Header().Set(refreshTokenHeader, "newRefreshTokenValue")
+			w.WriteHeader(http.StatusOK)
+		case "/binary/" + id.String() + "/content":
+			if r.Header.Get("Authorization") != "newTokenValue" {
+				w.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(content); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	var dest bytes.Buffer
+	var done int64
+	err := client.DownloadBinary(session, domain.Binary{ID: id}, &dest, func(n int64) { done = n })
+	require.NoError(t, err)
+	assert.Equal(t, content, dest.Bytes())
+	assert.Equal(t, int64(len(content)), done)
+}
+
+func TestDownloadBinaryNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	var dest bytes.Buffer
+	err := client.DownloadBinary(session, domain.Binary{ID: uuid.New()}, &dest, nil)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	assert.Empty(t, dest.Bytes())
+}
+
+func TestDownloadBinaryWrongURL(t *testing.T) {
+	client := newClient("wrongurl.com")
+	session := newSession()
+
+	var dest bytes.Buffer
+	err := client.DownloadBinary(session, domain.Binary{ID: uuid.New()}, &dest, nil)
+	require.ErrorIs(t, err, domain.ErrServerUnavailable)
+}
+
 func TestCreateCredentialsSuccess(t *testing.T) {
 	id := uuid.New()
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -418,7 +864,7 @@ func TestUpdateNotFound(t *testing.T) {
 		Content: "content",
 	}
 
-	err := client.UpdateText(session, text)
+	_, err := client.UpdateText(session, text)
 	require.Error(t, err)
 }
 
@@ -438,7 +884,7 @@ func TestUpdateBadRequest(t *testing.T) {
 		Content: "content",
 	}
 
-	err := client.UpdateText(session, text)
+	_, err := client.UpdateText(session, text)
 	require.Error(t, err)
 }
 
@@ -459,7 +905,7 @@ func TestUpdateInvalidLocation(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	err := client.UpdateText(session, text)
+	_, err := client.UpdateText(session, text)
 	require.Error(t, err)
 }
 
@@ -480,8 +926,61 @@ func TestUpdateTextSuccess(t *testing.T) {
 		Content: "content",
 	}
 
-	err := client.UpdateText(session, text)
+	_, err := client.UpdateText(session, text)
+	require.NoError(t, err)
+}
+
+func TestUpdateTextVersion(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == textPath+id.String() {
+			assert.Equal(t, `"2"`, r.Header.Get("If-Match"))
+			w.Header().Set("ETag", `"3"`)
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+	text := domain.Text{
+		ID:      id,
+		Content: "content",
+		Version: 2,
+	}
+
+	updated, err := client.UpdateText(session, text)
 	require.NoError(t, err)
+	assert.Equal(t, int64(3), updated.Version)
+	assert.Equal(t, text.Content, updated.Content)
+}
+
+func TestUpdateTextVersionConflict(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == textPath+id.String() {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("ETag", `"5"`)
+			w.WriteHeader(http.StatusConflict)
+			body := fmt.Sprintf(`{"status":false,"message":"version conflict","data":{"id":"%s","content":"theirs","version":5}}`, id)
+			_, err := w.Write([]byte(body))
+			require.NoError(t, err)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+	text := domain.Text{
+		ID:      id,
+		Content: "mine",
+		Version: 2,
+	}
+
+	theirs, err := client.UpdateText(session, text)
+	require.ErrorIs(t, err, domain.ErrVersionConflict)
+	assert.Equal(t, "theirs", theirs.Content)
+	assert.Equal(t, int64(5), theirs.Version)
 }
 
 func TestUpdateTextWrongURL(t *testing.T) {
@@ -492,7 +991,7 @@ func TestUpdateTextWrongURL(t *testing.T) {
 		Content: "content",
 	}
 
-	err := client.UpdateText(session, text)
+	_, err := client.UpdateText(session, text)
 	require.Error(t, err)
 }
 
@@ -513,7 +1012,7 @@ func TestUpdateBinarySuccess(t *testing.T) {
 		Content: []byte("content"),
 	}
 
-	err := client.UpdateBinary(session, bin)
+	_, err := client.UpdateBinary(session, bin)
 	require.NoError(t, err)
 }
 
@@ -525,7 +1024,7 @@ func TestUpdateBinaryWrongURL(t *testing.T) {
 		Content: []byte("content"),
 	}
 
-	err := client.UpdateBinary(session, bin)
+	_, err := client.UpdateBinary(session, bin)
 	require.Error(t, err)
 }
 
@@ -548,7 +1047,7 @@ func TestUpdateCredentialsSuccess(t *testing.T) {
 		Password: "password",
 	}
 
-	err := client.UpdateCredentials(session, &cred)
+	_, err := client.UpdateCredentials(session, &cred)
 	require.NoError(t, err)
 }
 
@@ -562,7 +1061,7 @@ func TestUpdateCredentialsWrongURL(t *testing.T) {
 		Password: "password",
 	}
 
-	err := client.UpdateCredentials(session, &cred)
+	_, err := client.UpdateCredentials(session, &cred)
 	require.Error(t, err)
 }
 
@@ -586,7 +1085,7 @@ func TestUpdateBankCardSuccess(t *testing.T) {
 		CardHolder: "card_holder",
 	}
 
-	err := client.UpdateBankCard(session, &card)
+	_, err := client.UpdateBankCard(session, &card)
 	require.NoError(t, err)
 }
 
@@ -601,7 +1100,7 @@ func TestUpdateBankCardWrongURL(t *testing.T) {
 		CardHolder: "card_holder",
 	}
 
-	err := client.UpdateBankCard(session, &card)
+	_, err := client.UpdateBankCard(session, &card)
 	require.Error(t, err)
 }
 
@@ -634,28 +1133,27 @@ func TestGetAllTextsSuccess(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	data, err := client.GetAllTexts(session)
+	data, _, err := client.GetAllTexts(session, "")
 	require.NoError(t, err)
 	assert.Equal(t, len(data), 2)
 }
 
-func TestGetAllTextsWrongURL(t *testing.T) {
-	client := newClient("wrongurl.com")
-	session := newSession()
-
-	_, err := client.GetAllTexts(session)
-	require.Error(t, err)
-}
-
-func TestGetAllTextsInternalServerError(t *testing.T) {
+func TestGetAllTextsCursor(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == textAllPath {
-			response := errorResponse{Message: "error :("}
+			response := getAllTextsResponse{}
+			if r.URL.Query().Get("cursor") == "" {
+				response.Data.Texts = []domain.Text{{ID: uuid.New(), Content: "first"}}
+				response.Data.NextCursor = "next"
+			} else {
+				assert.Equal(t, "next", r.URL.Query().Get("cursor"))
+				response.Data.Texts = []domain.Text{{ID: uuid.New(), Content: "second"}}
+			}
 			respData, err := json.Marshal(response)
 			if err != nil {
 				return
 			}
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusOK)
 			if _, err = w.Write(respData); err != nil {
 				return
 			}
@@ -666,12 +1164,50 @@ func TestGetAllTextsInternalServerError(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	_, err := client.GetAllTexts(session)
-	require.Error(t, err)
+	data, next, err := client.GetAllTexts(session, "")
+	require.NoError(t, err)
+	assert.Equal(t, "first", data[0].Content)
+	assert.Equal(t, "next", next)
+
+	data, next, err = client.GetAllTexts(session, next)
+	require.NoError(t, err)
+	assert.Equal(t, "second", data[0].Content)
+	assert.Empty(t, next)
 }
 
-func TestGetAllTextsBadRequest(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+func TestGetAllTextsWrongURL(t *testing.T) {
+	client := newClient("wrongurl.com")
+	session := newSession()
+
+	_, _, err := client.GetAllTexts(session, "")
+	require.Error(t, err)
+}
+
+func TestGetAllTextsInternalServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == textAllPath {
+			response := errorResponse{Message: "error :("}
+			respData, err := json.Marshal(response)
+			if err != nil {
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+			if _, err = w.Write(respData); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	_, _, err := client.GetAllTexts(session, "")
+	require.Error(t, err)
+}
+
+func TestGetAllTextsBadRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == textAllPath {
 			w.WriteHeader(http.StatusBadRequest)
 		}
@@ -681,7 +1217,7 @@ func TestGetAllTextsBadRequest(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	_, err := client.GetAllTexts(session)
+	_, _, err := client.GetAllTexts(session, "")
 	require.Error(t, err)
 }
 
@@ -689,14 +1225,18 @@ func TestGetAllBinariesSuccess(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == binaryAllPath {
 			response := getAllBinariesResponse{}
-			response.Data.Binaries = []domain.Binary{
+			response.Data.Binaries = []binaryResponse{
 				{
-					ID:      uuid.New(),
-					Content: []byte("content"),
+					ID:       uuid.NewString(),
+					Name:     "first.txt",
+					MimeType: "text/plain; charset=utf-8",
+					Size:     7,
 				},
 				{
-					ID:      uuid.New(),
-					Content: []byte("content"),
+					ID:      uuid.NewString(),
+					Name:    "second.bin",
+					Size:    1 << 30,
+					Chunked: true,
 				},
 			}
 			respData, err := json.Marshal(response)
@@ -714,9 +1254,13 @@ func TestGetAllBinariesSuccess(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	data, err := client.GetAllBinaries(session)
+	data, _, err := client.GetAllBinaries(session, "")
 	require.NoError(t, err)
 	assert.Equal(t, len(data), 2)
+	assert.Equal(t, "first.txt", data[0].Name)
+	assert.Equal(t, "text/plain; charset=utf-8", data[0].MimeType)
+	assert.Equal(t, int64(7), data[0].Size)
+	assert.True(t, data[1].Chunked)
 }
 
 func TestGetAllBinariesInternalServerError(t *testing.T) {
@@ -738,7 +1282,7 @@ func TestGetAllBinariesInternalServerError(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	_, err := client.GetAllBinaries(session)
+	_, _, err := client.GetAllBinaries(session, "")
 	require.Error(t, err)
 }
 
@@ -753,7 +1297,7 @@ func TestGetAllBinariesBadRequest(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	_, err := client.GetAllBinaries(session)
+	_, _, err := client.GetAllBinaries(session, "")
 	require.Error(t, err)
 }
 
@@ -761,7 +1305,7 @@ func TestGetAllBinariesWrongURL(t *testing.T) {
 	client := newClient("wrongurl.com")
 	session := newSession()
 
-	_, err := client.GetAllBinaries(session)
+	_, _, err := client.GetAllBinaries(session, "")
 	require.Error(t, err)
 }
 
@@ -798,7 +1342,7 @@ func TestGetAllCredentialsSuccess(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	data, err := client.GetAllCredentials(session)
+	data, _, err := client.GetAllCredentials(session, "")
 	require.NoError(t, err)
 	assert.Equal(t, len(data), 2)
 }
@@ -822,7 +1366,7 @@ func TestGetAllCredentialsInternalServerError(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	_, err := client.GetAllCredentials(session)
+	_, _, err := client.GetAllCredentials(session, "")
 	require.Error(t, err)
 }
 
@@ -837,7 +1381,7 @@ func TestGetAllCredentialsBadRequest(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	_, err := client.GetAllCredentials(session)
+	_, _, err := client.GetAllCredentials(session, "")
 	require.Error(t, err)
 }
 
@@ -845,7 +1389,7 @@ func TestGetAllCredentialsWrongURL(t *testing.T) {
 	client := newClient("wrongurl.com")
 	session := newSession()
 
-	_, err := client.GetAllCredentials(session)
+	_, _, err := client.GetAllCredentials(session, "")
 	require.Error(t, err)
 }
 
@@ -884,7 +1428,7 @@ func TestGetAllBankCardsSuccess(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	data, err := client.GetAllBankCards(session)
+	data, _, err := client.GetAllBankCards(session, "")
 	require.NoError(t, err)
 	assert.Equal(t, len(data), 2)
 }
@@ -908,7 +1452,7 @@ func TestGetAllBankCardsInternalServerError(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	_, err := client.GetAllBankCards(session)
+	_, _, err := client.GetAllBankCards(session, "")
 	require.Error(t, err)
 }
 
@@ -923,7 +1467,7 @@ func TestGetAllBankCardsBadRequest(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	_, err := client.GetAllBankCards(session)
+	_, _, err := client.GetAllBankCards(session, "")
 	require.Error(t, err)
 }
 
@@ -931,7 +1475,7 @@ func TestGetAllBankCardsWrongURL(t *testing.T) {
 	client := newClient("wrongurl.com")
 	session := newSession()
 
-	_, err := client.GetAllBankCards(session)
+	_, _, err := client.GetAllBankCards(session, "")
 	require.Error(t, err)
 }
 
@@ -979,14 +1523,40 @@ func TestGetAllBankSuccess(t *testing.T) {
 					Password: "password",
 				},
 			}
-			response.Data.Binaries = []domain.Binary{
+			response.Data.Binaries = []binaryResponse{
 				{
-					ID:      uuid.New(),
-					Content: []byte("content"),
+					ID:   uuid.NewString(),
+					Name: "first.txt",
 				},
 				{
-					ID:      uuid.New(),
-					Content: []byte("content"),
+					ID:   uuid.NewString(),
+					Name: "second.txt",
+				},
+			}
+			response.Data.OTPs = []domain.OTP{
+				{
+					ID:        uuid.New(),
+					Issuer:    "Example",
+					Secret:    "JBSWY3DPEHPK3PXP",
+					Algorithm: "SHA1",
+					Digits:    "6",
+					Period:    "30",
+				},
+			}
+			response.Data.SSHKeys = []sshKeyResponse{
+				{
+					ID:          uuid.NewString(),
+					PrivateKey:  "private",
+					PublicKey:   "ssh-ed25519 AAAA",
+					Fingerprint: "SHA256:fingerprint",
+				},
+			}
+			response.Data.Items = []domain.Item{
+				{
+					ID:       uuid.New(),
+					Name:     "Office",
+					Template: "wifi",
+					Fields:   []item.Field{{Name: "ssid", Type: item.TypeString, Value: "Office"}},
 				},
 			}
 			respData, err := json.Marshal(response)
@@ -1004,12 +1574,18 @@ func TestGetAllBankSuccess(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	texts, bankCards, binaries, credentials, err := client.GetAll(session)
+	texts, bankCards, binaries, credentials, otps, sshKeys, items, _, err := client.GetAll(session, "")
 	require.NoError(t, err)
 	assert.Equal(t, len(texts), 2)
 	assert.Equal(t, len(bankCards), 2)
 	assert.Equal(t, len(binaries), 2)
 	assert.Equal(t, len(credentials), 2)
+	require.Len(t, otps, 1)
+	assert.Equal(t, "6", otps[0].Digits)
+	require.Len(t, sshKeys, 1)
+	assert.Equal(t, "SHA256:fingerprint", sshKeys[0].Fingerprint)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Office", items[0].Fields[0].Value)
 }
 
 func TestGetAllInternalServerError(t *testing.T) {
@@ -1031,7 +1607,7 @@ func TestGetAllInternalServerError(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	_, _, _, _, err := client.GetAll(session) // nolint: dogsled
+	_, _, _, _, _, _, _, _, err := client.GetAll(session, "") // nolint: dogsled
 	require.Error(t, err)
 }
 
@@ -1046,7 +1622,7 @@ func TestGetAllBadRequest(t *testing.T) {
 	client := newClient(server.URL)
 	session := newSession()
 
-	_, _, _, _, err := client.GetAll(session) // nolint: dogsled
+	_, _, _, _, _, _, _, _, err := client.GetAll(session, "") // nolint: dogsled
 	require.Error(t, err)
 }
 
@@ -1054,6 +1630,679 @@ func TestGetWrongURL(t *testing.T) {
 	client := newClient("wrongurl.com")
 	session := newSession()
 
-	_, _, _, _, err := client.GetAll(session) // nolint: dogsled
+	_, _, _, _, _, _, _, _, err := client.GetAll(session, "") // nolint: dogsled
+	require.Error(t, err)
+}
+
+func TestDeleteNotFound(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == textPath+id.String() && r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	err := client.DeleteText(session, id)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestDeleteBadRequest(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == textPath+id.String() && r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	err := client.DeleteText(session, id)
+	require.ErrorIs(t, err, domain.ErrBadRequest)
+}
+
+func TestDeleteInternalServerError(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == textPath+id.String() && r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	err := client.DeleteText(session, id)
+	require.ErrorIs(t, err, domain.ErrClientConnectionError)
+}
+
+func TestDeleteTextSuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == textPath+id.String() && r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	err := client.DeleteText(session, id)
+	require.NoError(t, err)
+}
+
+func TestDeleteTextWrongURL(t *testing.T) {
+	client := newClient("wrongurl.com")
+	session := newSession()
+
+	err := client.DeleteText(session, uuid.New())
+	require.Error(t, err)
+}
+
+func TestDeleteBinarySuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/binary/"+id.String() && r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	err := client.DeleteBinary(session, id)
+	require.NoError(t, err)
+}
+
+func TestDeleteCredentialsSuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/credentials/"+id.String() && r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	err := client.DeleteCredentials(session, id)
+	require.NoError(t, err)
+}
+
+func TestDeleteBankCardSuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/bank_card/"+id.String() && r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	err := client.DeleteBankCard(session, id)
+	require.NoError(t, err)
+}
+
+func TestCreateOTPSuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/otp/create" {
+			payload := otpPayload{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload.Digits != "6" {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+			w.Header().Set("Location", id.String())
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+	otp := domain.OTP{Secret: "JBSWY3DPEHPK3PXP", Algorithm: "SHA1", Digits: "6", Period: "30"}
+
+	uid, err := client.CreateOTP(session, otp)
+	require.NoError(t, err)
+	assert.Equal(t, uid, id)
+}
+
+func TestUpdateOTPSuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/otp/"+id.String() {
+			w.Header().Set("ETag", `"3"`)
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+	otp := domain.OTP{ID: id, Secret: "JBSWY3DPEHPK3PXP", Algorithm: "SHA1", Digits: "6", Period: "30", Version: 2}
+
+	updated, err := client.UpdateOTP(session, &otp)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), updated.Version)
+}
+
+func TestGetAllOTPsSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == otpAllPath {
+			response := getAllOTPsResponse{}
+			response.Data.OTPs = []domain.OTP{
+				{
+					ID:        uuid.New(),
+					Issuer:    "Example",
+					Account:   "alice",
+					Secret:    "JBSWY3DPEHPK3PXP",
+					Algorithm: "SHA1",
+					Digits:    "6",
+					Period:    "30",
+				},
+			}
+			respData, err := json.Marshal(response)
+			if err != nil {
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err = w.Write(respData); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	data, _, err := client.GetAllOTPs(session, "")
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	assert.Equal(t, "Example:alice", data[0].Title())
+}
+
+func TestDeleteOTPSuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/otp/"+id.String() && r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	err := client.DeleteOTP(session, id)
+	require.NoError(t, err)
+}
+
+func TestCreateSSHKeySuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ssh_key/create" {
+			payload := sshKeyPayload{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload.PrivateKey != "private" {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+			w.Header().Set("Location", id.String())
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+	key := domain.SSHKey{PrivateKey: "private", PublicKey: "ssh-ed25519 AAAA", Fingerprint: "SHA256:fingerprint"}
+
+	uid, err := client.CreateSSHKey(session, key)
+	require.NoError(t, err)
+	assert.Equal(t, uid, id)
+}
+
+func TestGetAllSSHKeysSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == sshKeyAllPath {
+			response := getAllSSHKeysResponse{}
+			response.Data.SSHKeys = []sshKeyResponse{
+				{
+					ID:          uuid.NewString(),
+					PrivateKey:  "private",
+					PublicKey:   "ssh-ed25519 AAAA",
+					Comment:     "octocat@github",
+					Fingerprint: "SHA256:fingerprint",
+					Version:     2,
+				},
+			}
+			respData, err := json.Marshal(response)
+			if err != nil {
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err = w.Write(respData); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	data, _, err := client.GetAllSSHKeys(session, "")
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	assert.Equal(t, "private", data[0].PrivateKey)
+	assert.Equal(t, "octocat@github", data[0].Title())
+	assert.Equal(t, int64(2), data[0].Version)
+}
+
+func TestDeleteSSHKeySuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ssh_key/"+id.String() && r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	err := client.DeleteSSHKey(session, id)
+	require.NoError(t, err)
+}
+
+func TestCreateItemSuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/item/create" {
+			payload := itemPayload{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || len(payload.Fields) != 2 {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+			w.Header().Set("Location", id.String())
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+	obj := domain.Item{
+		Name:     "Office",
+		Template: "wifi",
+		Fields: []item.Field{
+			{Name: "ssid", Type: item.TypeString, Value: "Office"},
+			{Name: "password", Type: item.TypeSecret, Value: "secret"},
+		},
+	}
+
+	uid, err := client.CreateItem(session, obj)
+	require.NoError(t, err)
+	assert.Equal(t, uid, id)
+}
+
+func TestGetAllItemsSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == itemAllPath {
+			response := getAllItemsResponse{}
+			response.Data.Items = []domain.Item{
+				{
+					ID:       uuid.New(),
+					Name:     "Office",
+					Template: "wifi",
+					Fields: []item.Field{
+						{Name: "ssid", Type: item.TypeString, Value: "Office"},
+						{Name: "password", Type: item.TypeSecret, Value: "secret"},
+					},
+					Version: 2,
+				},
+			}
+			respData, err := json.Marshal(response)
+			if err != nil {
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err = w.Write(respData); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	data, _, err := client.GetAllItems(session, "")
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	assert.Equal(t, "wifi", data[0].Template)
+	assert.Equal(t, item.Field{Name: "password", Type: item.TypeSecret, Value: "secret"}, data[0].Fields[1])
+	assert.Equal(t, int64(2), data[0].Version)
+}
+
+func TestDeleteItemSuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/item/"+id.String() && r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	err := client.DeleteItem(session, id)
+	require.NoError(t, err)
+}
+
+func TestRefreshSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == refreshPath {
+			w.Header().Set("Authorization", "newTokenValue")
+			w.Header().Set(refreshTokenHeader, "newRefreshTokenValue")
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+
+	token, refreshToken, err := client.Refresh(refreshTokenValue)
+	require.NoError(t, err)
+	assert.Equal(t, "newTokenValue", token)
+	assert.Equal(t, "newRefreshTokenValue", refreshToken)
+}
+
+func TestRefreshUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == refreshPath {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+
+	_, _, err := client.Refresh(refreshTokenValue)
+	require.ErrorIs(t, err, domain.ErrUnauthorized)
+}
+
+func TestRefreshInternalServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == refreshPath {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+
+	_, _, err := client.Refresh(refreshTokenValue)
+	require.ErrorIs(t, err, domain.ErrClientConnectionError)
+}
+
+func TestAutoRefreshOnUnauthorized(t *testing.T) {
+	refreshCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case refreshPath:
+			refreshCalls++
+			w.Header().Set("Authorization", "newTokenValue")
+			w.Header().Set(refreshTokenHeader, "newRefreshTokenValue")
+			w.WriteHeader(http.StatusOK)
+		case textPath + "all":
+			if r.Header.Get("Authorization") != "newTokenValue" {
+				w.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write([]byte(`{"status": true, "data": {"texts": []}}`)); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	sessions := &memorySessionRepository{}
+	client := newClientWithSessions(server.URL, sessions)
+	session := newSession()
+
+	_, _, err := client.GetAllTexts(session, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshCalls)
+
+	stored, err := sessions.Get()
+	require.NoError(t, err)
+	assert.Equal(t, session.UserID, stored.UserID)
+	assert.Equal(t, "newTokenValue", stored.Token)
+	assert.Equal(t, "newRefreshTokenValue", stored.RefreshToken)
+
+	// Устаревшая сессия должна подхватить уже обновленный токен без повторного обновления
+	_, _, err = client.GetAllTexts(session, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshCalls)
+}
+
+func TestAutoRefreshFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+
+	_, _, err := client.GetAllTexts(newSession(), "")
+	require.ErrorIs(t, err, domain.ErrClientConnectionError)
+}
+
+func TestServerUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	client := newClient(url)
+
+	_, err := client.CreateText(newSession(), "content")
+	require.ErrorIs(t, err, domain.ErrServerUnavailable)
+
+	_, _, err = client.GetAllTexts(newSession(), "")
+	require.ErrorIs(t, err, domain.ErrServerUnavailable)
+
+	_, _, err = client.Login("login", "password")
+	require.ErrorIs(t, err, domain.ErrServerUnavailable)
+}
+
+func TestGetChangesSuccess(t *testing.T) {
+	deletedID := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == changesPath && r.URL.Query().Get("since") == "10" {
+			response := getChangesResponse{}
+			response.Data.Revision = 15
+			response.Data.More = true
+			response.Data.Texts = []domain.Text{
+				{
+					ID:      uuid.New(),
+					Content: "content",
+				},
+			}
+			response.Data.Deleted = []domain.Tombstone{
+				{
+					ID:   deletedID,
+					Kind: domain.BinaryKind,
+				},
+			}
+			respData, err := json.Marshal(response)
+			if err != nil {
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err = w.Write(respData); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	changes, err := client.GetChanges(session, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), changes.Revision)
+	assert.True(t, changes.More)
+	assert.Len(t, changes.Texts, 1)
+	assert.Len(t, changes.Deleted, 1)
+	assert.Equal(t, deletedID, changes.Deleted[0].ID)
+	assert.Equal(t, domain.BinaryKind, changes.Deleted[0].Kind)
+}
+
+func TestGetChangesInternalServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == changesPath {
+			response := errorResponse{Message: "error :("}
+			respData, err := json.Marshal(response)
+			if err != nil {
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+			if _, err = w.Write(respData); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	_, err := client.GetChanges(session, 0)
 	require.Error(t, err)
 }
+
+func TestGetCredentialsSuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/credentials/"+id.String() && r.Method == http.MethodGet {
+			response := getCredentialsResponse{}
+			response.Data = domain.Credentials{
+				ID:       id,
+				Name:     "name",
+				Login:    "login",
+				Password: "password",
+				Version:  3,
+			}
+			respData, err := json.Marshal(response)
+			if err != nil {
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err = w.Write(respData); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	cred, err := client.GetCredentials(session, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, cred.ID)
+	assert.Equal(t, "password", cred.Password)
+	assert.Equal(t, int64(3), cred.Version)
+}
+
+func TestGetBinarySuccess(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/binary/"+id.String() && r.Method == http.MethodGet {
+			response := getBinaryResponse{}
+			response.Data = binaryResponse{
+				ID:      id.String(),
+				Name:    "file.txt",
+				Size:    10,
+				Version: 1,
+			}
+			respData, err := json.Marshal(response)
+			if err != nil {
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err = w.Write(respData); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	bin, err := client.GetBinary(session, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, bin.ID)
+	assert.Equal(t, "file.txt", bin.Name)
+	assert.Empty(t, bin.Content)
+}
+
+func TestGetNotFound(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == textPath+id.String() && r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	_, err := client.GetText(session, id)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestGetInternalServerError(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/bank_card/"+id.String() && r.Method == http.MethodGet {
+			response := errorResponse{Message: "error :("}
+			respData, err := json.Marshal(response)
+			if err != nil {
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+			if _, err = w.Write(respData); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL)
+	session := newSession()
+
+	_, err := client.GetBankCard(session, id)
+	require.EqualError(t, err, "error :(")
+}
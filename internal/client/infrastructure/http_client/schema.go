@@ -1,9 +1,13 @@
 package httpclient
 
 import (
+	"encoding/base64"
 	"encoding/json"
 
+	"github.com/google/uuid"
+
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
 )
 
 type credentialsPayload struct {
@@ -52,39 +56,454 @@ func bankCardToJSON(number, validThru, cvv, cardHolder, meta string) ([]byte, er
 	return data, nil
 }
 
+type otpPayload struct {
+	Issuer    string `json:"issuer"`
+	Account   string `json:"account"`
+	Secret    string `json:"secret"`
+	Algorithm string `json:"algorithm"`
+	Digits    string `json:"digits"`
+	Period    string `json:"period"`
+	Meta      string `json:"meta"`
+}
+
+func otpToJSON(otp domain.OTP) ([]byte, error) {
+	payload := otpPayload{
+		Issuer:    otp.Issuer,
+		Account:   otp.Account,
+		Secret:    otp.Secret,
+		Algorithm: otp.Algorithm,
+		Digits:    otp.Digits,
+		Period:    otp.Period,
+		Meta:      otp.Meta,
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return data, nil
+}
+
+type sshKeyPayload struct {
+	PrivateKey  string `json:"private_key"`
+	PublicKey   string `json:"public_key"`
+	Comment     string `json:"comment"`
+	Fingerprint string `json:"fingerprint"`
+	Meta        string `json:"meta"`
+}
+
+func sshKeyToJSON(key domain.SSHKey) ([]byte, error) {
+	payload := sshKeyPayload{
+		PrivateKey:  key.PrivateKey,
+		PublicKey:   key.PublicKey,
+		Comment:     key.Comment,
+		Fingerprint: key.Fingerprint,
+		Meta:        key.Meta,
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return data, nil
+}
+
+type itemPayload struct {
+	Name     string       `json:"name"`
+	Template string       `json:"template"`
+	Fields   []item.Field `json:"fields"`
+	Meta     string       `json:"meta"`
+}
+
+func itemToJSON(obj domain.Item) ([]byte, error) {
+	payload := itemPayload{
+		Name:     obj.Name,
+		Template: obj.Template,
+		Fields:   obj.Fields,
+		Meta:     obj.Meta,
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return data, nil
+}
+
+type folderPayload struct {
+	Name     string    `json:"name"`
+	ParentID uuid.UUID `json:"parent_id"`
+}
+
+func folderToJSON(folder domain.Folder) ([]byte, error) {
+	payload := folderPayload{
+		Name:     folder.Name,
+		ParentID: folder.ParentID,
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return data, nil
+}
+
+type tagPayload struct {
+	Name string `json:"name"`
+}
+
+func tagToJSON(name string) ([]byte, error) {
+	data, err := json.Marshal(tagPayload{Name: name})
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return data, nil
+}
+
+type templatePayload struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Fields      []item.FieldSpec `json:"fields"`
+}
+
+func templateToJSON(tmpl domain.ItemTemplate) ([]byte, error) {
+	payload := templatePayload{
+		Name:        tmpl.Name,
+		Description: tmpl.Description,
+		Fields:      tmpl.Fields,
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return data, nil
+}
+
+type labelsPayload struct {
+	Kind     domain.OperationKind `json:"kind"`
+	FolderID uuid.UUID            `json:"folder_id"`
+	TagIDs   []uuid.UUID          `json:"tag_ids"`
+}
+
+func labelsToJSON(labels domain.Labels) ([]byte, error) {
+	payload := labelsPayload{
+		Kind:     labels.Kind,
+		FolderID: labels.FolderID,
+		TagIDs:   labels.TagIDs,
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return data, nil
+}
+
+type binaryMetadataPayload struct {
+	Name     string `json:"name"`
+	MimeType string `json:"mime_type"`
+	SHA256   string `json:"sha256"`
+	Meta     string `json:"meta"`
+}
+
+// binaryMetadataToHeader - Возвращает метаданные бинарных данных для заголовка X-Binary-Metadata: JSON в base64
+func binaryMetadataToHeader(bin domain.Binary) (string, error) {
+	metadata := binaryMetadataPayload{
+		Name:     bin.Name,
+		MimeType: bin.MimeType,
+		SHA256:   bin.SHA256,
+		Meta:     bin.Meta,
+	}
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+type binaryResponse struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	MimeType string `json:"mime_type"`
+	Size     int64  `json:"size"`
+	SHA256   string `json:"sha256"`
+	Meta     string `json:"meta"`
+	Content  []byte `json:"content"`
+	Chunked  bool   `json:"chunked"`
+	Version  int64  `json:"version"`
+}
+
+func (b binaryResponse) toDomain() (domain.Binary, error) {
+	binID, err := uuid.Parse(b.ID)
+	if err != nil {
+		return domain.Binary{}, err
+	}
+
+	return domain.Binary{
+		ID:       binID,
+		Content:  b.Content,
+		Name:     b.Name,
+		MimeType: b.MimeType,
+		Size:     b.Size,
+		SHA256:   b.SHA256,
+		Meta:     b.Meta,
+		Chunked:  b.Chunked,
+		Version:  b.Version,
+	}, nil
+}
+
+func binariesFromResponse(items []binaryResponse) ([]domain.Binary, error) {
+	bins := make([]domain.Binary, 0, len(items))
+	for _, v := range items {
+		bin, err := v.toDomain()
+		if err != nil {
+			return []domain.Binary{}, err
+		}
+		bins = append(bins, bin)
+	}
+
+	return bins, nil
+}
+
+type sshKeyResponse struct {
+	ID          string `json:"id"`
+	PrivateKey  string `json:"private_key"`
+	PublicKey   string `json:"public_key"`
+	Comment     string `json:"comment"`
+	Fingerprint string `json:"fingerprint"`
+	Meta        string `json:"meta"`
+	Version     int64  `json:"version"`
+}
+
+func (k sshKeyResponse) toDomain() (domain.SSHKey, error) {
+	keyID, err := uuid.Parse(k.ID)
+	if err != nil {
+		return domain.SSHKey{}, err
+	}
+
+	return domain.SSHKey{
+		ID:          keyID,
+		PrivateKey:  k.PrivateKey,
+		PublicKey:   k.PublicKey,
+		Comment:     k.Comment,
+		Fingerprint: k.Fingerprint,
+		Meta:        k.Meta,
+		Version:     k.Version,
+	}, nil
+}
+
+func sshKeysFromResponse(items []sshKeyResponse) ([]domain.SSHKey, error) {
+	keys := make([]domain.SSHKey, 0, len(items))
+	for _, v := range items {
+		key, err := v.toDomain()
+		if err != nil {
+			return []domain.SSHKey{}, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+type folderResponse struct {
+	ID       uuid.UUID `json:"id"`
+	ParentID uuid.UUID `json:"parent_id"`
+	Name     string    `json:"name"`
+}
+
+type labelsResponse struct {
+	EntityID uuid.UUID            `json:"entity_id"`
+	Kind     domain.OperationKind `json:"kind"`
+	FolderID uuid.UUID            `json:"folder_id"`
+	TagIDs   []uuid.UUID          `json:"tag_ids"`
+}
+
+func foldersFromResponse(items []folderResponse) []domain.Folder {
+	folders := make([]domain.Folder, 0, len(items))
+	for _, v := range items {
+		folders = append(folders, domain.Folder{ID: v.ID, ParentID: v.ParentID, Name: v.Name})
+	}
+
+	return folders
+}
+
+func labelsFromResponse(items []labelsResponse) []domain.Labels {
+	labels := make([]domain.Labels, 0, len(items))
+	for _, v := range items {
+		labels = append(labels, domain.Labels{EntityID: v.EntityID, Kind: v.Kind, FolderID: v.FolderID, TagIDs: v.TagIDs})
+	}
+
+	return labels
+}
+
 type errorResponse struct {
 	Message string `json:"message"`
 }
 
+type preLoginResponse struct {
+	Data struct {
+		E2E  bool   `json:"e2e"`
+		Salt []byte `json:"salt"`
+	} `json:"data"`
+}
+
+type twoFactorEnrollResponse struct {
+	Data struct {
+		Secret string `json:"secret"`
+		URI    string `json:"uri"`
+	} `json:"data"`
+}
+
+type twoFactorVerifyResponse struct {
+	Data struct {
+		RecoveryCodes []string `json:"recovery_codes"`
+	} `json:"data"`
+}
+
+type getTextResponse struct {
+	Data domain.Text `json:"data"`
+}
+
+type getBinaryResponse struct {
+	Data binaryResponse `json:"data"`
+}
+
+type getCredentialsResponse struct {
+	Data domain.Credentials `json:"data"`
+}
+
+type getBankCardResponse struct {
+	Data domain.BankCard `json:"data"`
+}
+
+type getOTPResponse struct {
+	Data domain.OTP `json:"data"`
+}
+
+type getSSHKeyResponse struct {
+	Data sshKeyResponse `json:"data"`
+}
+
+type getItemResponse struct {
+	Data domain.Item `json:"data"`
+}
+
 type getAllTextsResponse struct {
 	Data struct {
-		Texts []domain.Text `json:"texts"`
+		Texts      []domain.Text `json:"texts"`
+		NextCursor string        `json:"next_cursor"`
 	} `json:"data"`
 }
 
 type getAllBinariesResponse struct {
 	Data struct {
-		Binaries []domain.Binary `json:"binaries"`
+		Binaries   []binaryResponse `json:"binaries"`
+		NextCursor string           `json:"next_cursor"`
 	} `json:"data"`
 }
 
 type getAllCredentialsResponse struct {
 	Data struct {
 		Credentials []domain.Credentials `json:"credentials"`
+		NextCursor  string               `json:"next_cursor"`
 	} `json:"data"`
 }
 
 type getAllBankCardsResponse struct {
 	Data struct {
-		BankCards []domain.BankCard `json:"bank_cards"`
+		BankCards  []domain.BankCard `json:"bank_cards"`
+		NextCursor string            `json:"next_cursor"`
+	} `json:"data"`
+}
+
+type getAllOTPsResponse struct {
+	Data struct {
+		OTPs       []domain.OTP `json:"otps"`
+		NextCursor string       `json:"next_cursor"`
+	} `json:"data"`
+}
+
+type getAllSSHKeysResponse struct {
+	Data struct {
+		SSHKeys    []sshKeyResponse `json:"ssh_keys"`
+		NextCursor string           `json:"next_cursor"`
+	} `json:"data"`
+}
+
+type getAllItemsResponse struct {
+	Data struct {
+		Items      []domain.Item `json:"items"`
+		NextCursor string        `json:"next_cursor"`
 	} `json:"data"`
 }
 
 type getAllResponse struct {
 	Data struct {
 		Texts       []domain.Text        `json:"texts"`
-		Binaries    []domain.Binary      `json:"binaries"`
+		Binaries    []binaryResponse     `json:"binaries"`
 		Credentials []domain.Credentials `json:"credentials"`
 		BankCards   []domain.BankCard    `json:"bank_cards"`
+		OTPs        []domain.OTP         `json:"otps"`
+		SSHKeys     []sshKeyResponse     `json:"ssh_keys"`
+		Items       []domain.Item        `json:"items"`
+		NextCursor  string               `json:"next_cursor"`
 	} `json:"data"`
 }
+
+type getChangesResponse struct {
+	Data struct {
+		Revision    int64                 `json:"revision"`
+		More        bool                  `json:"more"`
+		Texts       []domain.Text         `json:"texts"`
+		Binaries    []binaryResponse      `json:"binaries"`
+		Credentials []domain.Credentials  `json:"credentials"`
+		BankCards   []domain.BankCard     `json:"bank_cards"`
+		OTPs        []domain.OTP          `json:"otps"`
+		SSHKeys     []sshKeyResponse      `json:"ssh_keys"`
+		Items       []domain.Item         `json:"items"`
+		Folders     []folderResponse      `json:"folders"`
+		Tags        []domain.Tag          `json:"tags"`
+		Templates   []domain.ItemTemplate `json:"templates"`
+		Labels      []labelsResponse      `json:"labels"`
+		Deleted     []domain.Tombstone    `json:"deleted"`
+	} `json:"data"`
+}
+
+type updateTextConflictResponse struct {
+	Data domain.Text `json:"data"`
+}
+
+type binaryUploadResponse struct {
+	Data struct {
+		Size      int64   `json:"size"`
+		ChunkSize int     `json:"chunk_size"`
+		Received  []int64 `json:"received"`
+	} `json:"data"`
+}
+
+type updateBinaryConflictResponse struct {
+	Data binaryResponse `json:"data"`
+}
+
+type updateCredentialsConflictResponse struct {
+	Data domain.Credentials `json:"data"`
+}
+
+type updateBankCardConflictResponse struct {
+	Data domain.BankCard `json:"data"`
+}
+
+type updateOTPConflictResponse struct {
+	Data domain.OTP `json:"data"`
+}
+
+type updateSSHKeyConflictResponse struct {
+	Data sshKeyResponse `json:"data"`
+}
+
+type updateItemConflictResponse struct {
+	Data domain.Item `json:"data"`
+}
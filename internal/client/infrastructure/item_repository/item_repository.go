@@ -0,0 +1,355 @@
+// Package itemrepository содержит имплементацию интерфейса ItemRepositoryInterface
+package itemrepository
+
+import (
+	"encoding/json"
+	"errors"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const bucketName = "Item"
+
+// ItemRepository - Имплементация репозитория для данных по шаблону
+type ItemRepository struct {
+	// DB - Интерфейс базы данных bbolt
+	DB *bolt.DB
+	// Crypto - Инстанс сервиса шифрования
+	Crypto domain.CryptoServiceInterface
+	Tx     *bolt.Tx
+	log    *logrus.Logger
+}
+
+// Create - Сохраняет новые данные по шаблону
+func (r ItemRepository) Create(
+	userID uuid.UUID,
+	obj *domain.Item,
+) error {
+	buf, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := r.Crypto.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.DB.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = tx.Rollback()
+	}()
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	err = bkt.Put([]byte(obj.ID.String()), encrypted)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// Update - Сохраняет существующие данные по шаблону
+func (r ItemRepository) Update(
+	userID uuid.UUID,
+	obj *domain.Item,
+) error {
+	buf, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := r.Crypto.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+
+	err = r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+		err = bkt.Put([]byte(obj.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Get - Возвращает данные по шаблону по идентификатору данных и пользователя, если они существуют
+func (r ItemRepository) Get(userID, itemID uuid.UUID) (domain.Item, error) {
+	var obj domain.Item
+	var raw []byte
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+		raw = bkt.Get([]byte(itemID.String()))
+
+		return nil
+	})
+
+	if err != nil {
+		return obj, err
+	}
+
+	if raw == nil {
+		return obj, domain.ErrEntityNotFound
+	}
+
+	decrypted, err := r.Crypto.Decrypt(raw)
+	if err != nil {
+		return obj, err
+	}
+
+	err = json.Unmarshal(decrypted, &obj)
+	if err != nil {
+		return obj, err
+	}
+
+	return obj, nil
+}
+
+// GetAll - возвращает все данные по шаблону для пользователя
+func (r ItemRepository) GetAll(userID uuid.UUID) ([]domain.Item, error) {
+	result := []domain.Item{}
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return nil
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return nil
+		}
+
+		err := bkt.ForEach(func(_, v []byte) error {
+			var obj domain.Item
+			decrypted, err := r.Crypto.Decrypt(v)
+			if err != nil {
+				return err
+			}
+			err = json.Unmarshal(decrypted, &obj)
+			if err != nil {
+				return err
+			}
+			result = append(result, obj)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// ReplaceAll - Заменяет все локальные данные по шаблону пользователя на новые
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r ItemRepository) ReplaceAll(
+	userID uuid.UUID,
+	items []domain.Item,
+) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	err = root.DeleteBucket([]byte(bucketName))
+	if err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+		return err
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range items {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.Crypto.Encrypt(buf)
+		if err != nil {
+			return err
+		}
+
+		err = bkt.Put([]byte(v.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// ApplyChanges - Сохраняет созданные или обновленные на сервере данные по шаблону и удаляет удаленные
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r ItemRepository) ApplyChanges(
+	userID uuid.UUID,
+	items []domain.Item,
+	deletedIDs []uuid.UUID,
+) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range items {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.Crypto.Encrypt(buf)
+		if err != nil {
+			return err
+		}
+
+		err = bkt.Put([]byte(v.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range deletedIDs {
+		err = bkt.Delete([]byte(id.String()))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Delete - Удаляет данные по шаблону по идентификатору данных и пользователя
+func (r ItemRepository) Delete(userID, itemID uuid.UUID) error {
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		key := []byte(itemID.String())
+		if bkt.Get(key) == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		return bkt.Delete(key)
+	})
+}
+
+// New - Возвращает инстанс репозитория ItemRepository
+func New(
+	db *bolt.DB,
+	crypto domain.CryptoServiceInterface,
+	log *logrus.Logger,
+) *ItemRepository {
+	return &ItemRepository{
+		DB:     db,
+		Crypto: crypto,
+		log:    log,
+	}
+}
@@ -0,0 +1,195 @@
+// Package journalrepository содержит имплементацию интерфейса JournalRepositoryInterface
+package journalrepository
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const bucketName = "Journal"
+
+// JournalRepository - Имплементация журнала изменений, выполненных без связи с сервером
+type JournalRepository struct {
+	// DB - Интерфейс базы данных bbolt
+	DB *bolt.DB
+	// Crypto - Инстанс сервиса шифрования
+	Crypto domain.CryptoServiceInterface
+	log    *logrus.Logger
+}
+
+func itob(v uint64) []byte {
+	b := make([]byte, 8) //nolint: gomnd
+	binary.BigEndian.PutUint64(b, v)
+
+	return b
+}
+
+func (r JournalRepository) encode(op domain.Operation) ([]byte, error) {
+	buf, err := json.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Crypto.Encrypt(buf)
+}
+
+func (r JournalRepository) decode(raw []byte) (domain.Operation, error) {
+	var op domain.Operation
+	decrypted, err := r.Crypto.Decrypt(raw)
+	if err != nil {
+		return op, err
+	}
+	err = json.Unmarshal(decrypted, &op)
+
+	return op, err
+}
+
+// Append - Добавляет операцию в конец журнала
+func (r JournalRepository) Append(userID uuid.UUID, op domain.Operation) error {
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+		if err != nil {
+			return err
+		}
+
+		// Ключи упорядочены по возрастанию, поэтому операции воспроизводятся в порядке добавления
+		op.ID, err = bkt.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.encode(op)
+		if err != nil {
+			return err
+		}
+
+		return bkt.Put(itob(op.ID), encrypted)
+	})
+}
+
+// GetAll - Возвращает все операции пользователя в порядке добавления
+func (r JournalRepository) GetAll(userID uuid.UUID) ([]domain.Operation, error) {
+	result := []domain.Operation{}
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return nil
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return nil
+		}
+
+		return bkt.ForEach(func(_, v []byte) error {
+			op, err := r.decode(v)
+			if err != nil {
+				return err
+			}
+			result = append(result, op)
+
+			return nil
+		})
+	})
+
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Delete - Удаляет операцию из журнала
+func (r JournalRepository) Delete(userID uuid.UUID, opID uint64) error {
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		key := itob(opID)
+		if bkt.Get(key) == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		return bkt.Delete(key)
+	})
+}
+
+// ReplaceEntityID - Заменяет локальный идентификатор данных на выданный сервером во всех операциях пользователя
+func (r JournalRepository) ReplaceEntityID(userID, oldID, newID uuid.UUID) error {
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return nil
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return nil
+		}
+
+		replaced := map[string][]byte{}
+		err := bkt.ForEach(func(k, v []byte) error {
+			op, err := r.decode(v)
+			if err != nil {
+				return err
+			}
+			if op.EntityID != oldID {
+				return nil
+			}
+
+			op.EntityID = newID
+			encrypted, err := r.encode(op)
+			if err != nil {
+				return err
+			}
+			replaced[string(k)] = encrypted
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// Изменять бакет во время итерации по нему нельзя, поэтому записываем после обхода
+		for k, v := range replaced {
+			if err := bkt.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// New - Возвращает инстанс репозитория JournalRepository
+func New(
+	db *bolt.DB,
+	crypto domain.CryptoServiceInterface,
+	log *logrus.Logger,
+) *JournalRepository {
+	return &JournalRepository{
+		DB:     db,
+		Crypto: crypto,
+		log:    log,
+	}
+}
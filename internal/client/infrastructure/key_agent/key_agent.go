@@ -0,0 +1,272 @@
+// Package keyagent содержит агент, хранящий ключ локального хранилища в памяти отдельного процесса,
+// и имплементацию интерфейса KeyCacheInterface для обращения к нему через unix-сокет
+package keyagent
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const (
+	// Command - Имя скрытой команды запуска агента
+	Command = "agent"
+
+	cmdGet     = "GET"
+	cmdLock    = "LOCK"
+	replyReady = "READY"
+
+	ioTimeout      = 5 * time.Second
+	socketFileMode = 0600
+	stateFileMode  = 0600
+	socketName     = "agent.sock"
+	socketDir      = "gophkeeper-agent-"
+)
+
+var (
+	errAgentNotStarted = errors.New("key agent was not started")
+	errForeignPeer     = errors.New("key agent peer belongs to another user")
+)
+
+func handle(conn net.Conn, key []byte) bool {
+	if err := conn.SetDeadline(time.Now().Add(ioTimeout)); err != nil {
+		return false
+	}
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return false
+	}
+	switch strings.TrimSpace(line) {
+	case cmdGet:
+		_, _ = fmt.Fprintln(conn, hex.EncodeToString(key))
+	case cmdLock:
+		return true
+	}
+
+	return false
+}
+
+// Serve - Отдает ключ по запросу до истечения ttl или до команды блокировки.
+// Подключения процессов других пользователей закрываются без ответа
+func Serve(listener net.Listener, key []byte, ttl time.Duration) error {
+	timer := time.AfterFunc(ttl, func() {
+		_ = listener.Close()
+	})
+	defer timer.Stop()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+
+			return err
+		}
+		if err := checkPeer(conn); err != nil {
+			_ = conn.Close()
+
+			continue
+		}
+		// При блокировке сокет закрывается до ответа клиенту,
+		// чтобы новый агент мог сразу занять его адрес
+		if handle(conn, key) {
+			_ = listener.Close()
+		}
+		_ = conn.Close()
+	}
+}
+
+// Main - Точка входа процесса агента. Читает ключ из stdin, начинает слушать сокет и сообщает о готовности в stdout.
+// Сокет создается в закрытом каталоге, который клиент создал для этого агента, после остановки каталог удаляется
+func Main(args []string) error {
+	var socketPath string
+	var ttl time.Duration
+
+	flags := flag.NewFlagSet(Command, flag.ContinueOnError)
+	flags.StringVar(&socketPath, "socket", "", "unix socket path")
+	flags.DurationVar(&ttl, "ttl", 0, "key lifetime")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	// Агент должен пережить закрытие терминала, из которого было выполнено разблокирование
+	signal.Ignore(syscall.SIGHUP)
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return err
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(line))
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return err
+	}
+	if err = os.Chmod(socketPath, socketFileMode); err != nil {
+		_ = listener.Close()
+
+		return err
+	}
+
+	fmt.Println(replyReady)
+	// Родительский процесс завершится сразу после запуска агента
+	_ = os.Stdout.Close()
+
+	err = Serve(listener, key, ttl)
+	// Закрытие слушателя удаляет файл сокета, поэтому каталог уже пуст
+	_ = os.Remove(filepath.Dir(socketPath))
+
+	return err
+}
+
+// KeyCache - Имплементация кэша ключа локального хранилища через агент
+type KeyCache struct {
+	// StatePath - Путь до файла, в котором записан путь до unix-сокета запущенного агента
+	StatePath string
+	// Executable - Путь до исполняемого файла клиента, используется для запуска агента
+	Executable string
+}
+
+// socketPath - Возвращает путь до unix-сокета запущенного агента, ErrVaultLocked если агент не запускался
+func (c KeyCache) socketPath() (string, error) {
+	raw, err := os.ReadFile(c.StatePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", domain.ErrVaultLocked
+	} else if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(raw)), nil
+}
+
+func (c KeyCache) send(command string) (string, error) {
+	socketPath, err := c.socketPath()
+	if err != nil {
+		return "", err
+	}
+	conn, err := net.DialTimeout("unix", socketPath, ioTimeout)
+	if err != nil {
+		return "", domain.ErrVaultLocked
+	}
+	defer conn.Close()
+
+	if err = conn.SetDeadline(time.Now().Add(ioTimeout)); err != nil {
+		return "", err
+	}
+	if _, err = fmt.Fprintln(conn, command); err != nil {
+		return "", err
+	}
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(line), nil
+}
+
+// Get - Возвращает ключ локального хранилища, ErrVaultLocked если агент не запущен
+func (c KeyCache) Get() ([]byte, error) {
+	reply, err := c.send(cmdGet)
+	if errors.Is(err, io.EOF) {
+		return nil, domain.ErrVaultLocked
+	} else if err != nil {
+		return nil, err
+	}
+
+	return hex.DecodeString(reply)
+}
+
+// Put - Запускает новый агент с ключом локального хранилища на время ttl.
+// Сокет агента создается в новом каталоге с правами 0700 в $XDG_RUNTIME_DIR, если он задан,
+// иначе во временном каталоге системы. Имя каталога случайное, поэтому другой пользователь системы
+// не может заранее занять путь сокета или подключиться к нему до смены прав
+func (c KeyCache) Put(key []byte, ttl time.Duration) error {
+	if err := c.Clear(); err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp(os.Getenv("XDG_RUNTIME_DIR"), socketDir)
+	if err != nil {
+		return err
+	}
+	socketPath := filepath.Join(dir, socketName)
+	if err = c.start(socketPath, key, ttl); err != nil {
+		_ = os.RemoveAll(dir)
+
+		return err
+	}
+
+	return os.WriteFile(c.StatePath, []byte(socketPath), stateFileMode)
+}
+
+// start - Запускает процесс агента на сокете socketPath и дожидается его готовности
+func (c KeyCache) start(socketPath string, key []byte, ttl time.Duration) error {
+	cmd := exec.Command(c.Executable, Command, "-socket", socketPath, "-ttl", ttl.String()) //nolint: gosec
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(stdin, hex.EncodeToString(key))
+	if err != nil {
+		return err
+	}
+	if err = stdin.Close(); err != nil {
+		return err
+	}
+
+	line, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil || strings.TrimSpace(line) != replyReady {
+		_ = cmd.Wait()
+
+		return errAgentNotStarted
+	}
+
+	return cmd.Process.Release()
+}
+
+// Clear - Останавливает агент, если он запущен
+func (c KeyCache) Clear() error {
+	_, err := c.send(cmdLock)
+	if err != nil && !errors.Is(err, domain.ErrVaultLocked) && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	if err = os.Remove(c.StatePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// New - Возвращает инстанс кэша ключа локального хранилища
+func New(statePath, executable string) *KeyCache {
+	return &KeyCache{
+		StatePath:  statePath,
+		Executable: executable,
+	}
+}
@@ -0,0 +1,106 @@
+package keyagent
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// TestMain - Тестовый бинарник запускается как процесс агента при вызове KeyCache.Put
+func TestMain(m *testing.M) {
+	if len(os.Args) > 1 && os.Args[1] == Command {
+		if err := Main(os.Args[2:]); err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func serve(t *testing.T, socketPath string, key []byte, ttl time.Duration) chan error {
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	done := make(chan error, 1)
+	go func() {
+		done <- Serve(listener, key, ttl)
+	}()
+
+	return done
+}
+
+func newKeyCache(t *testing.T, socketPath string) *KeyCache {
+	statePath := filepath.Join(t.TempDir(), "agent.state")
+	err := os.WriteFile(statePath, []byte(socketPath), stateFileMode)
+	require.NoError(t, err)
+
+	return New(statePath, "")
+}
+
+func TestKeyCacheGetAndClear(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "agent.sock")
+	key := []byte("1234567812345678")
+	done := serve(t, socketPath, key, time.Minute)
+	keyCache := newKeyCache(t, socketPath)
+
+	cached, err := keyCache.Get()
+	require.NoError(t, err)
+	assert.Equal(t, key, cached)
+
+	err = keyCache.Clear()
+	require.NoError(t, err)
+	require.NoError(t, <-done)
+	assert.NoFileExists(t, keyCache.StatePath)
+
+	_, err = keyCache.Get()
+	require.ErrorIs(t, err, domain.ErrVaultLocked)
+	err = keyCache.Clear()
+	require.NoError(t, err)
+}
+
+func TestKeyCacheExpired(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "agent.sock")
+	done := serve(t, socketPath, []byte("1234567812345678"), time.Millisecond)
+	require.NoError(t, <-done)
+
+	_, err := newKeyCache(t, socketPath).Get()
+	require.ErrorIs(t, err, domain.ErrVaultLocked)
+}
+
+func TestKeyCachePutPrivateSocket(t *testing.T) {
+	runtimeDir := t.TempDir()
+	t.Setenv("XDG_RUNTIME_DIR", runtimeDir)
+	executable, err := os.Executable()
+	require.NoError(t, err)
+	keyCache := New(filepath.Join(t.TempDir(), "agent.state"), executable)
+	key := []byte("1234567812345678")
+
+	err = keyCache.Put(key, time.Minute)
+	require.NoError(t, err)
+
+	socketPath, err := keyCache.socketPath()
+	require.NoError(t, err)
+	dir := filepath.Dir(socketPath)
+	assert.Equal(t, runtimeDir, filepath.Dir(dir))
+	info, err := os.Stat(dir)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+
+	cached, err := keyCache.Get()
+	require.NoError(t, err)
+	assert.Equal(t, key, cached)
+
+	err = keyCache.Clear()
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(dir)
+
+		return os.IsNotExist(err)
+	}, time.Second, 10*time.Millisecond)
+}
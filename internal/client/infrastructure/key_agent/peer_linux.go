@@ -0,0 +1,37 @@
+package keyagent
+
+import (
+	"net"
+	"os"
+	"syscall"
+)
+
+// checkPeer - Проверяет по SO_PEERCRED, что к сокету подключился процесс того же пользователя, что и агент
+func checkPeer(conn net.Conn) error {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return errForeignPeer
+	}
+	raw, err := unixConn.SyscallConn()
+	if err != nil {
+		return err
+	}
+
+	var cred *syscall.Ucred
+	var credErr error
+	err = raw.Control(func(fd uintptr) {
+		cred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	})
+	if err != nil {
+		return err
+	}
+	if credErr != nil {
+		return credErr
+	}
+
+	if int(cred.Uid) != os.Getuid() {
+		return errForeignPeer
+	}
+
+	return nil
+}
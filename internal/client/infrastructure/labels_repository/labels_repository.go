@@ -0,0 +1,355 @@
+// Package labelsrepository содержит имплементацию интерфейса LabelsRepositoryInterface
+package labelsrepository
+
+import (
+	"encoding/json"
+	"errors"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const bucketName = "Labels"
+
+// LabelsRepository - Имплементация репозитория для папок и меток данных
+type LabelsRepository struct {
+	// DB - Интерфейс базы данных bbolt
+	DB *bolt.DB
+	// Crypto - Инстанс сервиса шифрования
+	Crypto domain.CryptoServiceInterface
+	Tx     *bolt.Tx
+	log    *logrus.Logger
+}
+
+// Create - Сохраняет папку и метки новых данных
+func (r LabelsRepository) Create(
+	userID uuid.UUID,
+	labels domain.Labels,
+) error {
+	buf, err := json.Marshal(labels)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := r.Crypto.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.DB.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = tx.Rollback()
+	}()
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	err = bkt.Put([]byte(labels.EntityID.String()), encrypted)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// Update - Сохраняет папку и метки существующих данных
+func (r LabelsRepository) Update(
+	userID uuid.UUID,
+	labels domain.Labels,
+) error {
+	buf, err := json.Marshal(labels)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := r.Crypto.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+
+	err = r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+		err = bkt.Put([]byte(labels.EntityID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Get - Возвращает папку и метки данных по идентификатору данных и пользователя, если они сохранены
+func (r LabelsRepository) Get(userID, entityID uuid.UUID) (domain.Labels, error) {
+	var labels domain.Labels
+	var raw []byte
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+		raw = bkt.Get([]byte(entityID.String()))
+
+		return nil
+	})
+
+	if err != nil {
+		return labels, err
+	}
+
+	if raw == nil {
+		return labels, domain.ErrEntityNotFound
+	}
+
+	decrypted, err := r.Crypto.Decrypt(raw)
+	if err != nil {
+		return labels, err
+	}
+
+	err = json.Unmarshal(decrypted, &labels)
+	if err != nil {
+		return labels, err
+	}
+
+	return labels, nil
+}
+
+// GetAll - возвращает папки и метки всех данных пользователя
+func (r LabelsRepository) GetAll(userID uuid.UUID) ([]domain.Labels, error) {
+	result := []domain.Labels{}
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return nil
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return nil
+		}
+
+		err := bkt.ForEach(func(_, v []byte) error {
+			var labels domain.Labels
+			decrypted, err := r.Crypto.Decrypt(v)
+			if err != nil {
+				return err
+			}
+			err = json.Unmarshal(decrypted, &labels)
+			if err != nil {
+				return err
+			}
+			result = append(result, labels)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// ReplaceAll - Заменяет папки и метки всех локальных данных пользователя на новые
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r LabelsRepository) ReplaceAll(
+	userID uuid.UUID,
+	values []domain.Labels,
+) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	err = root.DeleteBucket([]byte(bucketName))
+	if err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+		return err
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range values {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.Crypto.Encrypt(buf)
+		if err != nil {
+			return err
+		}
+
+		err = bkt.Put([]byte(v.EntityID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// ApplyChanges - Сохраняет созданные или обновленные на сервере папки и метки данных и удаляет удаленные
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r LabelsRepository) ApplyChanges(
+	userID uuid.UUID,
+	values []domain.Labels,
+	deletedIDs []uuid.UUID,
+) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range values {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.Crypto.Encrypt(buf)
+		if err != nil {
+			return err
+		}
+
+		err = bkt.Put([]byte(v.EntityID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range deletedIDs {
+		err = bkt.Delete([]byte(id.String()))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Delete - Удаляет папку и метки данных по идентификатору данных и пользователя
+func (r LabelsRepository) Delete(userID, entityID uuid.UUID) error {
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		key := []byte(entityID.String())
+		if bkt.Get(key) == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		return bkt.Delete(key)
+	})
+}
+
+// New - Возвращает инстанс репозитория LabelsRepository
+func New(
+	db *bolt.DB,
+	crypto domain.CryptoServiceInterface,
+	log *logrus.Logger,
+) *LabelsRepository {
+	return &LabelsRepository{
+		DB:     db,
+		Crypto: crypto,
+		log:    log,
+	}
+}
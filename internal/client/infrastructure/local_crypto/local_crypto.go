@@ -0,0 +1,65 @@
+// Package localcrypto содержит сервис шифрования локального хранилища ключом, выведенным из мастер-пароля
+package localcrypto
+
+import (
+	"sync"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
+)
+
+// LocalCrypto - Сервис шифрования локального хранилища.
+// Ключ запрашивается из кэша при первом обращении, если хранилище заблокировано, возвращается ErrVaultLocked
+type LocalCrypto struct {
+	// KeyCache - Кэш ключа локального хранилища
+	KeyCache domain.KeyCacheInterface
+	service  *crypto.CryptoService
+	mu       sync.Mutex
+}
+
+func (c *LocalCrypto) open() (*crypto.CryptoService, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.service != nil {
+		return c.service, nil
+	}
+
+	key, err := c.KeyCache.Get()
+	if err != nil {
+		return nil, err
+	}
+	c.service, err = crypto.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.service, nil
+}
+
+// Encrypt - Зашифровывает данные ключом локального хранилища
+func (c *LocalCrypto) Encrypt(value []byte) ([]byte, error) {
+	service, err := c.open()
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return service.Encrypt(value)
+}
+
+// Decrypt - Расшифровывает данные ключом локального хранилища
+func (c *LocalCrypto) Decrypt(value []byte) ([]byte, error) {
+	service, err := c.open()
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return service.Decrypt(value)
+}
+
+// New - Возвращает инстанс сервиса шифрования локального хранилища
+func New(keyCache domain.KeyCacheInterface) *LocalCrypto {
+	return &LocalCrypto{
+		KeyCache: keyCache,
+	}
+}
@@ -0,0 +1,355 @@
+// Package otprepository содержит имплементацию интерфейса OTPRepositoryInterface
+package otprepository
+
+import (
+	"encoding/json"
+	"errors"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const bucketName = "OTP"
+
+// OTPRepository - Имплементация репозитория для секретов одноразовых паролей
+type OTPRepository struct {
+	// DB - Интерфейс базы данных bbolt
+	DB *bolt.DB
+	// Crypto - Инстанс сервиса шифрования
+	Crypto domain.CryptoServiceInterface
+	Tx     *bolt.Tx
+	log    *logrus.Logger
+}
+
+// Create - Сохраняет новый секрет одноразовых паролей
+func (r OTPRepository) Create(
+	userID uuid.UUID,
+	otp *domain.OTP,
+) error {
+	buf, err := json.Marshal(otp)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := r.Crypto.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.DB.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = tx.Rollback()
+	}()
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	err = bkt.Put([]byte(otp.ID.String()), encrypted)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// Update - Сохраняет существующий секрет одноразовых паролей
+func (r OTPRepository) Update(
+	userID uuid.UUID,
+	otp *domain.OTP,
+) error {
+	buf, err := json.Marshal(otp)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := r.Crypto.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+
+	err = r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+		err = bkt.Put([]byte(otp.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Get - Возвращает секрет одноразовых паролей по идентификатору данных и пользователя, если он существует
+func (r OTPRepository) Get(userID, otpID uuid.UUID) (domain.OTP, error) {
+	var otp domain.OTP
+	var raw []byte
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+		raw = bkt.Get([]byte(otpID.String()))
+
+		return nil
+	})
+
+	if err != nil {
+		return otp, err
+	}
+
+	if raw == nil {
+		return otp, domain.ErrEntityNotFound
+	}
+
+	decrypted, err := r.Crypto.Decrypt(raw)
+	if err != nil {
+		return otp, err
+	}
+
+	err = json.Unmarshal(decrypted, &otp)
+	if err != nil {
+		return otp, err
+	}
+
+	return otp, nil
+}
+
+// GetAll - возвращает все секреты одноразовых паролей для пользователя
+func (r OTPRepository) GetAll(userID uuid.UUID) ([]domain.OTP, error) {
+	result := []domain.OTP{}
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return nil
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return nil
+		}
+
+		err := bkt.ForEach(func(_, v []byte) error {
+			var otp domain.OTP
+			decrypted, err := r.Crypto.Decrypt(v)
+			if err != nil {
+				return err
+			}
+			err = json.Unmarshal(decrypted, &otp)
+			if err != nil {
+				return err
+			}
+			result = append(result, otp)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// ReplaceAll - Заменяет все локальные секреты одноразовых паролей пользователя на новые
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r OTPRepository) ReplaceAll(
+	userID uuid.UUID,
+	otps []domain.OTP,
+) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	err = root.DeleteBucket([]byte(bucketName))
+	if err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+		return err
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range otps {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.Crypto.Encrypt(buf)
+		if err != nil {
+			return err
+		}
+
+		err = bkt.Put([]byte(v.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// ApplyChanges - Сохраняет созданные или обновленные на сервере секреты одноразовых паролей и удаляет удаленные
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r OTPRepository) ApplyChanges(
+	userID uuid.UUID,
+	otps []domain.OTP,
+	deletedIDs []uuid.UUID,
+) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range otps {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.Crypto.Encrypt(buf)
+		if err != nil {
+			return err
+		}
+
+		err = bkt.Put([]byte(v.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range deletedIDs {
+		err = bkt.Delete([]byte(id.String()))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Delete - Удаляет секрет одноразовых паролей по идентификатору данных и пользователя
+func (r OTPRepository) Delete(userID, otpID uuid.UUID) error {
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		key := []byte(otpID.String())
+		if bkt.Get(key) == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		return bkt.Delete(key)
+	})
+}
+
+// New - Возвращает инстанс репозитория OTPRepository
+func New(
+	db *bolt.DB,
+	crypto domain.CryptoServiceInterface,
+	log *logrus.Logger,
+) *OTPRepository {
+	return &OTPRepository{
+		DB:     db,
+		Crypto: crypto,
+		log:    log,
+	}
+}
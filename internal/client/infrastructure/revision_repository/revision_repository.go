@@ -0,0 +1,104 @@
+// Package revisionrepository содержит имплементацию интерфейса RevisionRepositoryInterface
+package revisionrepository
+
+import (
+	"encoding/binary"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const bucketName = "Sync"
+const revisionKey = "revision"
+
+// RevisionRepository - Имплементация репозитория последней полученной от сервера ревизии данных
+type RevisionRepository struct {
+	// DB - Интерфейс базы данных bbolt
+	DB  *bolt.DB
+	Tx  *bolt.Tx
+	log *logrus.Logger
+}
+
+// Get - Возвращает последнюю полученную ревизию пользователя, 0 если синхронизации еще не было
+func (r RevisionRepository) Get(userID uuid.UUID) (int64, error) {
+	var revision int64
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return nil
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return nil
+		}
+
+		raw := bkt.Get([]byte(revisionKey))
+		if raw != nil {
+			revision = int64(binary.BigEndian.Uint64(raw))
+		}
+
+		return nil
+	})
+
+	return revision, err
+}
+
+// Save - Сохраняет последнюю полученную ревизию пользователя
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r RevisionRepository) Save(userID uuid.UUID, revision int64) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	raw := make([]byte, 8) //nolint: gomnd
+	binary.BigEndian.PutUint64(raw, uint64(revision))
+	err = bkt.Put([]byte(revisionKey), raw)
+	if err != nil {
+		return err
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// New - Возвращает инстанс репозитория RevisionRepository
+func New(
+	db *bolt.DB,
+	log *logrus.Logger,
+) *RevisionRepository {
+	return &RevisionRepository{
+		DB:  db,
+		log: log,
+	}
+}
@@ -0,0 +1,355 @@
+// Package sshkeyrepository содержит имплементацию интерфейса SSHKeyRepositoryInterface
+package sshkeyrepository
+
+import (
+	"encoding/json"
+	"errors"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const bucketName = "SSHKey"
+
+// SSHKeyRepository - Имплементация репозитория для SSH ключей
+type SSHKeyRepository struct {
+	// DB - Интерфейс базы данных bbolt
+	DB *bolt.DB
+	// Crypto - Инстанс сервиса шифрования
+	Crypto domain.CryptoServiceInterface
+	Tx     *bolt.Tx
+	log    *logrus.Logger
+}
+
+// Create - Сохраняет новый SSH ключ
+func (r SSHKeyRepository) Create(
+	userID uuid.UUID,
+	sshKey *domain.SSHKey,
+) error {
+	buf, err := json.Marshal(sshKey)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := r.Crypto.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.DB.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = tx.Rollback()
+	}()
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	err = bkt.Put([]byte(sshKey.ID.String()), encrypted)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// Update - Сохраняет существующий SSH ключ
+func (r SSHKeyRepository) Update(
+	userID uuid.UUID,
+	sshKey *domain.SSHKey,
+) error {
+	buf, err := json.Marshal(sshKey)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := r.Crypto.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+
+	err = r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+		err = bkt.Put([]byte(sshKey.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Get - Возвращает SSH ключ по идентификатору данных и пользователя, если он существует
+func (r SSHKeyRepository) Get(userID, keyID uuid.UUID) (domain.SSHKey, error) {
+	var sshKey domain.SSHKey
+	var raw []byte
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+		raw = bkt.Get([]byte(keyID.String()))
+
+		return nil
+	})
+
+	if err != nil {
+		return sshKey, err
+	}
+
+	if raw == nil {
+		return sshKey, domain.ErrEntityNotFound
+	}
+
+	decrypted, err := r.Crypto.Decrypt(raw)
+	if err != nil {
+		return sshKey, err
+	}
+
+	err = json.Unmarshal(decrypted, &sshKey)
+	if err != nil {
+		return sshKey, err
+	}
+
+	return sshKey, nil
+}
+
+// GetAll - возвращает все SSH ключи для пользователя
+func (r SSHKeyRepository) GetAll(userID uuid.UUID) ([]domain.SSHKey, error) {
+	result := []domain.SSHKey{}
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return nil
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return nil
+		}
+
+		err := bkt.ForEach(func(_, v []byte) error {
+			var sshKey domain.SSHKey
+			decrypted, err := r.Crypto.Decrypt(v)
+			if err != nil {
+				return err
+			}
+			err = json.Unmarshal(decrypted, &sshKey)
+			if err != nil {
+				return err
+			}
+			result = append(result, sshKey)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// ReplaceAll - Заменяет все локальные SSH ключи пользователя на новые
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r SSHKeyRepository) ReplaceAll(
+	userID uuid.UUID,
+	keys []domain.SSHKey,
+) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	err = root.DeleteBucket([]byte(bucketName))
+	if err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+		return err
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range keys {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.Crypto.Encrypt(buf)
+		if err != nil {
+			return err
+		}
+
+		err = bkt.Put([]byte(v.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// ApplyChanges - Сохраняет созданные или обновленные на сервере SSH ключи и удаляет удаленные
+// Реализована работа в пределах одной транзакции в Unit Of Work
+func (r SSHKeyRepository) ApplyChanges(
+	userID uuid.UUID,
+	keys []domain.SSHKey,
+	deletedIDs []uuid.UUID,
+) (err error) {
+	managed := false
+	var tx *bolt.Tx
+	if r.Tx == nil {
+		tx, err = r.DB.Begin(true)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback() // nolint: errcheck
+	} else {
+		tx = r.Tx
+		managed = true
+	}
+
+	root := tx.Bucket([]byte(userID.String()))
+	if root == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range keys {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		encrypted, err := r.Crypto.Encrypt(buf)
+		if err != nil {
+			return err
+		}
+
+		err = bkt.Put([]byte(v.ID.String()), encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range deletedIDs {
+		err = bkt.Delete([]byte(id.String()))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !managed {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Delete - Удаляет SSH ключ по идентификатору данных и пользователя
+func (r SSHKeyRepository) Delete(userID, keyID uuid.UUID) error {
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		key := []byte(keyID.String())
+		if bkt.Get(key) == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		return bkt.Delete(key)
+	})
+}
+
+// New - Возвращает инстанс репозитория SSHKeyRepository
+func New(
+	db *bolt.DB,
+	crypto domain.CryptoServiceInterface,
+	log *logrus.Logger,
+) *SSHKeyRepository {
+	return &SSHKeyRepository{
+		DB:     db,
+		Crypto: crypto,
+		log:    log,
+	}
+}
@@ -16,6 +16,14 @@ import (
 	cardrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/bank_card_repository"
 	binrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/binary_repository"
 	credrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/credentials_repository"
+	folderrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/folder_repository"
+	itemrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/item_repository"
+	labelsrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/labels_repository"
+	otprepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/otp_repository"
+	revrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/revision_repository"
+	sshrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/ssh_key_repository"
+	tagrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/tag_repository"
+	tmplrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/template_repository"
 	txtrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/text_repository"
 )
 
@@ -27,6 +35,14 @@ type UnitOfWork struct {
 	binaryRepository      binrepo.BinaryRepository
 	credentialsRepository credrepo.CredentialsRepository
 	bankCardRepository    cardrepo.BankCardRepository
+	otpRepository         otprepo.OTPRepository
+	sshKeyRepository      sshrepo.SSHKeyRepository
+	itemRepository        itemrepo.ItemRepository
+	folderRepository      folderrepo.FolderRepository
+	tagRepository         tagrepo.TagRepository
+	templateRepository    tmplrepo.TemplateRepository
+	labelsRepository      labelsrepo.LabelsRepository
+	revisionRepository    revrepo.RevisionRepository
 	tx                    *bolt.Tx
 	log                   *logrus.Logger
 }
@@ -49,6 +65,14 @@ func (uow *UnitOfWork) setTx(tx *bolt.Tx) {
 	uow.binaryRepository.Tx = tx
 	uow.credentialsRepository.Tx = tx
 	uow.bankCardRepository.Tx = tx
+	uow.otpRepository.Tx = tx
+	uow.sshKeyRepository.Tx = tx
+	uow.itemRepository.Tx = tx
+	uow.folderRepository.Tx = tx
+	uow.tagRepository.Tx = tx
+	uow.templateRepository.Tx = tx
+	uow.labelsRepository.Tx = tx
+	uow.revisionRepository.Tx = tx
 }
 
 // Commit - Выполняет коммит транзакции
@@ -103,6 +127,46 @@ func (uow *UnitOfWork) BankCardRepository() domain.BankCardRepositoryInterface {
 	return uow.bankCardRepository
 }
 
+// OTPRepository - Возвращает OTPRepository для работы в пределах транзакции
+func (uow *UnitOfWork) OTPRepository() domain.OTPRepositoryInterface {
+	return uow.otpRepository
+}
+
+// SSHKeyRepository - Возвращает SSHKeyRepository для работы в пределах транзакции
+func (uow *UnitOfWork) SSHKeyRepository() domain.SSHKeyRepositoryInterface {
+	return uow.sshKeyRepository
+}
+
+// ItemRepository - Возвращает ItemRepository для работы в пределах транзакции
+func (uow *UnitOfWork) ItemRepository() domain.ItemRepositoryInterface {
+	return uow.itemRepository
+}
+
+// FolderRepository - Возвращает FolderRepository для работы в пределах транзакции
+func (uow *UnitOfWork) FolderRepository() domain.FolderRepositoryInterface {
+	return uow.folderRepository
+}
+
+// TagRepository - Возвращает TagRepository для работы в пределах транзакции
+func (uow *UnitOfWork) TagRepository() domain.TagRepositoryInterface {
+	return uow.tagRepository
+}
+
+// TemplateRepository - Возвращает TemplateRepository для работы в пределах транзакции
+func (uow *UnitOfWork) TemplateRepository() domain.TemplateRepositoryInterface {
+	return uow.templateRepository
+}
+
+// LabelsRepository - Возвращает LabelsRepository для работы в пределах транзакции
+func (uow *UnitOfWork) LabelsRepository() domain.LabelsRepositoryInterface {
+	return uow.labelsRepository
+}
+
+// RevisionRepository - Возвращает RevisionRepository для работы в пределах транзакции
+func (uow *UnitOfWork) RevisionRepository() domain.RevisionRepositoryInterface {
+	return uow.revisionRepository
+}
+
 // New - Возвращает инстанс UnitOfWork
 func New(
 	db *bolt.DB,
@@ -111,6 +175,14 @@ func New(
 	binaryRepository binrepo.BinaryRepository,
 	credentialsRepository credrepo.CredentialsRepository,
 	bankCardRepository cardrepo.BankCardRepository,
+	otpRepository otprepo.OTPRepository,
+	sshKeyRepository sshrepo.SSHKeyRepository,
+	itemRepository itemrepo.ItemRepository,
+	folderRepository folderrepo.FolderRepository,
+	tagRepository tagrepo.TagRepository,
+	templateRepository tmplrepo.TemplateRepository,
+	labelsRepository labelsrepo.LabelsRepository,
+	revisionRepository revrepo.RevisionRepository,
 ) *UnitOfWork {
 	return &UnitOfWork{
 		db:                    db,
@@ -119,5 +191,13 @@ func New(
 		binaryRepository:      binaryRepository,
 		credentialsRepository: credentialsRepository,
 		bankCardRepository:    bankCardRepository,
+		otpRepository:         otpRepository,
+		sshKeyRepository:      sshKeyRepository,
+		itemRepository:        itemRepository,
+		folderRepository:      folderRepository,
+		tagRepository:         tagRepository,
+		templateRepository:    templateRepository,
+		labelsRepository:      labelsRepository,
+		revisionRepository:    revisionRepository,
 	}
 }
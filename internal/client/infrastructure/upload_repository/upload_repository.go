@@ -0,0 +1,116 @@
+// Package uploadrepository содержит имплементацию интерфейса UploadRepositoryInterface
+package uploadrepository
+
+import (
+	"encoding/json"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const bucketName = "Upload"
+
+// UploadRepository - Имплементация репозитория незавершенных загрузок бинарных данных частями
+type UploadRepository struct {
+	// DB - Интерфейс базы данных bbolt
+	DB *bolt.DB
+	// Crypto - Инстанс сервиса шифрования
+	Crypto domain.CryptoServiceInterface
+	log    *logrus.Logger
+}
+
+// Save - Сохраняет незавершенную загрузку по контрольной сумме загружаемого содержимого
+func (r UploadRepository) Save(userID uuid.UUID, upload domain.PendingUpload) error {
+	buf, err := json.Marshal(upload)
+	if err != nil {
+		return err
+	}
+	encrypted, err := r.Crypto.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt, err := root.CreateBucketIfNotExists([]byte(bucketName))
+		if err != nil {
+			return err
+		}
+
+		return bkt.Put([]byte(upload.Fingerprint), encrypted)
+	})
+}
+
+// Get - Возвращает незавершенную загрузку по контрольной сумме загружаемого содержимого, если она существует
+func (r UploadRepository) Get(userID uuid.UUID, fingerprint string) (domain.PendingUpload, error) {
+	var upload domain.PendingUpload
+	var raw []byte
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return domain.ErrEntityNotFound
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return domain.ErrEntityNotFound
+		}
+		raw = bkt.Get([]byte(fingerprint))
+		if raw == nil {
+			return domain.ErrEntityNotFound
+		}
+		raw = append([]byte{}, raw...)
+
+		return nil
+	})
+	if err != nil {
+		return upload, err
+	}
+
+	decrypted, err := r.Crypto.Decrypt(raw)
+	if err != nil {
+		return upload, err
+	}
+	err = json.Unmarshal(decrypted, &upload)
+
+	return upload, err
+}
+
+// Delete - Удаляет незавершенную загрузку, отсутствие загрузки не считается ошибкой
+func (r UploadRepository) Delete(userID uuid.UUID, fingerprint string) error {
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(userID.String()))
+		if root == nil {
+			return nil
+		}
+
+		bkt := root.Bucket([]byte(bucketName))
+		if bkt == nil {
+			return nil
+		}
+
+		return bkt.Delete([]byte(fingerprint))
+	})
+}
+
+// New - Возвращает инстанс репозитория UploadRepository
+func New(
+	db *bolt.DB,
+	crypto domain.CryptoServiceInterface,
+	log *logrus.Logger,
+) *UploadRepository {
+	return &UploadRepository{
+		DB:     db,
+		Crypto: crypto,
+		log:    log,
+	}
+}
@@ -0,0 +1,151 @@
+package vaultclient
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
+)
+
+const (
+	// frameLengthSize - Размер префикса с длиной шифротекста кадра
+	frameLengthSize = 4
+	// frameIndexSize - Размер номера кадра, шифруется вместе с содержимым
+	frameIndexSize = 8
+	// frameOverhead - Размер кадра сверх размера открытого текста части
+	frameOverhead = frameLengthSize + frameIndexSize + crypto.Overhead
+	// maxFrameSize - Максимальная длина шифротекста кадра, сервер не принимает части больше 16 МиБ
+	maxFrameSize = 16 << 20
+)
+
+var errInvalidFrame = errors.New("binary frame is invalid or out of order")
+
+// encryptedSize - Возвращает размер данных после разбиения на кадры по chunkSize байт открытого текста
+func encryptedSize(size int64, chunkSize int) int64 {
+	chunks := (size + int64(chunkSize) - 1) / int64(chunkSize)
+
+	return size + chunks*frameOverhead
+}
+
+// plainSize - Возвращает размер открытого текста для encrypted байт кадров, полученных из частей по chunkSize байт
+func plainSize(encrypted int64, chunkSize int) int64 {
+	frame := int64(chunkSize + frameOverhead)
+	frames := (encrypted + frame - 1) / frame
+
+	return encrypted - frames*frameOverhead
+}
+
+// sealFrame - Шифрует часть вместе с ее номером, поэтому части нельзя переставить
+func (v vault) sealFrame(index uint64, content []byte) ([]byte, error) {
+	plain := make([]byte, frameIndexSize, frameIndexSize+len(content))
+	binary.BigEndian.PutUint64(plain, index)
+	encrypted, err := v.crypto.Encrypt(append(plain, content...))
+	if err != nil {
+		return nil, err
+	}
+	frame := make([]byte, frameLengthSize, frameLengthSize+len(encrypted))
+	binary.BigEndian.PutUint32(frame, uint32(len(encrypted)))
+
+	return append(frame, encrypted...), nil
+}
+
+// openFrame - Расшифровывает шифротекст кадра и проверяет его номер
+func (v vault) openFrame(index uint64, encrypted []byte) ([]byte, error) {
+	plain, err := v.crypto.Decrypt(encrypted)
+	if err != nil {
+		return nil, err
+	}
+	if len(plain) < frameIndexSize || binary.BigEndian.Uint64(plain) != index {
+		return nil, errInvalidFrame
+	}
+
+	return plain[frameIndexSize:], nil
+}
+
+// frameReader - Читает открытый текст из source частями и отдает зашифрованные кадры
+type frameReader struct {
+	v         vault
+	source    io.Reader
+	chunkSize int
+	remaining int64
+	index     uint64
+	pending   []byte
+}
+
+// Read - Отдает зашифрованные кадры, очередная часть читается и шифруется по мере необходимости
+func (r *frameReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.remaining == 0 {
+			return 0, io.EOF
+		}
+		length := int64(r.chunkSize)
+		if r.remaining < length {
+			length = r.remaining
+		}
+		content := make([]byte, length)
+		if _, err := io.ReadFull(r.source, content); err != nil {
+			return 0, err
+		}
+		frame, err := r.v.sealFrame(r.index, content)
+		if err != nil {
+			return 0, err
+		}
+		r.pending = frame
+		r.remaining -= length
+		r.index++
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+
+	return n, nil
+}
+
+// frameWriter - Собирает зашифрованные кадры из потока и записывает открытый текст в dest
+type frameWriter struct {
+	v        vault
+	dest     io.Writer
+	progress func(int64)
+	index    uint64
+	written  int64
+	buf      []byte
+}
+
+// Write - Накапливает поток и расшифровывает каждый полностью полученный кадр
+func (w *frameWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= frameLengthSize {
+		length := binary.BigEndian.Uint32(w.buf)
+		if length > maxFrameSize {
+			return 0, errInvalidFrame
+		}
+		end := frameLengthSize + int(length)
+		if len(w.buf) < end {
+			break
+		}
+		content, err := w.v.openFrame(w.index, w.buf[frameLengthSize:end])
+		if err != nil {
+			return 0, err
+		}
+		if _, err = w.dest.Write(content); err != nil {
+			return 0, err
+		}
+		w.index++
+		w.written += int64(len(content))
+		if w.progress != nil {
+			w.progress(w.written)
+		}
+		w.buf = w.buf[end:]
+	}
+
+	return len(p), nil
+}
+
+// finish - Проверяет, что поток не оборвался посреди кадра
+func (w *frameWriter) finish() error {
+	if len(w.buf) != 0 {
+		return errInvalidFrame
+	}
+
+	return nil
+}
@@ -0,0 +1,1079 @@
+// Package vaultclient содержит декоратор клиента GophKeeper для сквозного шифрования данных
+package vaultclient
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
+)
+
+// VaultClient - Декоратор GophKeeperClientInterface для сквозного шифрования.
+// Шифрует каждое поле ключом хранилища сессии перед отправкой на сервер
+// и расшифровывает данные, полученные с сервера.
+// Если в сессии нет ключа хранилища, запросы передаются без изменений
+type VaultClient struct {
+	domain.GophKeeperClientInterface
+}
+
+// vault - Шифрование полей ключом хранилища
+type vault struct {
+	crypto *crypto.CryptoService
+}
+
+// open - Возвращает шифрование для сессии, без ключа хранилища шифрование отключено
+func open(session domain.Session) (vault, error) {
+	if len(session.VaultKey) == 0 {
+		return vault{}, nil
+	}
+	cryptoService, err := crypto.New(session.VaultKey)
+	if err != nil {
+		return vault{}, err
+	}
+
+	return vault{crypto: cryptoService}, nil
+}
+
+// enabled - Возвращает истину, если используется сквозное шифрование
+func (v vault) enabled() bool {
+	return v.crypto != nil
+}
+
+func (v vault) encryptBytes(value []byte) ([]byte, error) {
+	return v.crypto.Encrypt(value)
+}
+
+func (v vault) decryptBytes(value []byte) ([]byte, error) {
+	return v.crypto.Decrypt(value)
+}
+
+// encryptString - Шифрует строку, результат кодируется в base64
+func (v vault) encryptString(value string) (string, error) {
+	encrypted, err := v.crypto.Encrypt([]byte(value))
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// decryptString - Расшифровывает строку в base64, отсутствующее значение остается пустым
+func (v vault) decryptString(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	encrypted, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+	decrypted, err := v.crypto.Decrypt(encrypted)
+	if err != nil {
+		return "", err
+	}
+
+	return string(decrypted), nil
+}
+
+// encryptStrings - Шифрует значения по указателям на месте
+func (v vault) encryptStrings(values ...*string) error {
+	for _, value := range values {
+		encrypted, err := v.encryptString(*value)
+		if err != nil {
+			return err
+		}
+		*value = encrypted
+	}
+
+	return nil
+}
+
+// decryptStrings - Расшифровывает значения по указателям на месте
+func (v vault) decryptStrings(values ...*string) error {
+	for _, value := range values {
+		decrypted, err := v.decryptString(*value)
+		if err != nil {
+			return err
+		}
+		*value = decrypted
+	}
+
+	return nil
+}
+
+func (v vault) decryptTexts(texts []domain.Text) error {
+	for i := range texts {
+		if err := v.decryptStrings(&texts[i].Content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encryptBinaryMetadata - Шифрует метаданные бинарных данных на месте
+func (v vault) encryptBinaryMetadata(bin *domain.Binary) error {
+	return v.encryptStrings(&bin.Name, &bin.MimeType, &bin.SHA256, &bin.Meta)
+}
+
+func (v vault) decryptBinaries(bins []domain.Binary) error {
+	for i := range bins {
+		if err := v.decryptStrings(&bins[i].Name, &bins[i].MimeType, &bins[i].SHA256, &bins[i].Meta); err != nil {
+			return err
+		}
+		// Размер на сервере учитывает служебные данные шифрования: кадров для данных, загруженных частями,
+		// и одного шифротекста для данных, загруженных одним запросом
+		switch {
+		case bins[i].Chunked:
+			bins[i].Size = plainSize(bins[i].Size, domain.BinaryChunkSize)
+		case len(bins[i].Content) == 0:
+			bins[i].Size = max(bins[i].Size-crypto.Overhead, 0)
+		default:
+			content, err := v.decryptBytes(bins[i].Content)
+			if err != nil {
+				return err
+			}
+			bins[i].Content = content
+			bins[i].Size = int64(len(content))
+		}
+	}
+
+	return nil
+}
+
+func (v vault) decryptCredentials(creds []domain.Credentials) error {
+	for i := range creds {
+		c := &creds[i]
+		if err := v.decryptStrings(&c.Name, &c.Login, &c.Password, &c.Meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v vault) decryptBankCards(cards []domain.BankCard) error {
+	for i := range cards {
+		c := &cards[i]
+		if err := v.decryptStrings(&c.Number, &c.ValidThru, &c.CVV, &c.CardHolder, &c.Meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encryptOTP - Шифрует секрет одноразовых паролей на месте
+func (v vault) encryptOTP(otp *domain.OTP) error {
+	return v.encryptStrings(&otp.Issuer, &otp.Account, &otp.Secret, &otp.Algorithm, &otp.Digits, &otp.Period, &otp.Meta)
+}
+
+func (v vault) decryptOTPs(otps []domain.OTP) error {
+	for i := range otps {
+		o := &otps[i]
+		if err := v.decryptStrings(&o.Issuer, &o.Account, &o.Secret, &o.Algorithm, &o.Digits, &o.Period, &o.Meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encryptSSHKey - Шифрует SSH ключ на месте
+func (v vault) encryptSSHKey(key *domain.SSHKey) error {
+	return v.encryptStrings(&key.PrivateKey, &key.PublicKey, &key.Comment, &key.Fingerprint, &key.Meta)
+}
+
+func (v vault) decryptSSHKeys(keys []domain.SSHKey) error {
+	for i := range keys {
+		k := &keys[i]
+		if err := v.decryptStrings(&k.PrivateKey, &k.PublicKey, &k.Comment, &k.Fingerprint, &k.Meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// itemStrings - Возвращает указатели на все строковые значения данных по шаблону, включая имена и типы полей
+func itemStrings(obj *domain.Item) []*string {
+	values := []*string{&obj.Name, &obj.Template, &obj.Meta}
+	for i := range obj.Fields {
+		f := &obj.Fields[i]
+		values = append(values, &f.Name, &f.Type, &f.Value)
+	}
+
+	return values
+}
+
+// encryptItem - Шифрует данные по шаблону на месте, поля копируются, чтобы не изменить данные вызывающего
+func (v vault) encryptItem(obj *domain.Item) error {
+	obj.Fields = slices.Clone(obj.Fields)
+
+	return v.encryptStrings(itemStrings(obj)...)
+}
+
+func (v vault) decryptItems(items []domain.Item) error {
+	for i := range items {
+		if err := v.decryptStrings(itemStrings(&items[i])...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v vault) decryptFolders(folders []domain.Folder) error {
+	for i := range folders {
+		if err := v.decryptStrings(&folders[i].Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func templateStrings(tmpl *domain.ItemTemplate) []*string {
+	values := []*string{&tmpl.Name, &tmpl.Description}
+	for i := range tmpl.Fields {
+		f := &tmpl.Fields[i]
+		values = append(values, &f.Name, &f.Type)
+	}
+
+	return values
+}
+
+func (v vault) decryptTemplates(templates []domain.ItemTemplate) error {
+	for i := range templates {
+		if err := v.decryptStrings(templateStrings(&templates[i])...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v vault) decryptTags(tags []domain.Tag) error {
+	for i := range tags {
+		if err := v.decryptStrings(&tags[i].Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateText - Шифрует и создает текст, возвращает идентификатор ресурса от сервера
+func (c VaultClient) CreateText(session domain.Session, content string) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if v.enabled() {
+		if err := v.encryptStrings(&content); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return c.GophKeeperClientInterface.CreateText(session, content)
+}
+
+// UpdateText - Шифрует и обновляет существующий текст, возвращает его с новой версией.
+// При конфликте версий возвращает расшифрованную актуальную копию с сервера и ErrVersionConflict
+func (c VaultClient) UpdateText(session domain.Session, text domain.Text) (domain.Text, error) {
+	v, err := open(session)
+	if err != nil {
+		return text, err
+	}
+	if !v.enabled() {
+		return c.GophKeeperClientInterface.UpdateText(session, text)
+	}
+
+	encrypted := text
+	if err := v.encryptStrings(&encrypted.Content); err != nil {
+		return text, err
+	}
+	updated, err := c.GophKeeperClientInterface.UpdateText(session, encrypted)
+	if err != nil {
+		if updated.ID == uuid.Nil {
+			return updated, err
+		}
+		texts := []domain.Text{updated}
+		if decryptErr := v.decryptTexts(texts); decryptErr != nil {
+			return updated, decryptErr
+		}
+
+		return texts[0], err
+	}
+	text.Version = updated.Version
+
+	return text, nil
+}
+
+// GetAllTexts - Получает и расшифровывает страницу текстов пользователя
+func (c VaultClient) GetAllTexts(session domain.Session, cursor string) ([]domain.Text, string, error) {
+	texts, next, err := c.GophKeeperClientInterface.GetAllTexts(session, cursor)
+	if err != nil {
+		return texts, next, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return texts, next, err
+	}
+
+	return texts, next, v.decryptTexts(texts)
+}
+
+// GetText - Получает текст по идентификатору, при сквозном шифровании расшифровывает его
+func (c VaultClient) GetText(session domain.Session, textID uuid.UUID) (domain.Text, error) {
+	text, err := c.GophKeeperClientInterface.GetText(session, textID)
+	if err != nil {
+		return text, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return text, err
+	}
+	items := []domain.Text{text}
+	if err = v.decryptTexts(items); err != nil {
+		return domain.Text{}, err
+	}
+
+	return items[0], nil
+}
+
+// CreateBinary - Шифрует и создает бинарные данные вместе с метаданными, возвращает идентификатор ресурса от сервера
+func (c VaultClient) CreateBinary(session domain.Session, bin domain.Binary) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if v.enabled() {
+		bin.Content, err = v.encryptBytes(bin.Content)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if err = v.encryptBinaryMetadata(&bin); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return c.GophKeeperClientInterface.CreateBinary(session, bin)
+}
+
+// UpdateBinary - Шифрует и обновляет существующие бинарные данные, возвращает их с новой версией.
+// При конфликте версий возвращает расшифрованную актуальную копию с сервера и ErrVersionConflict
+func (c VaultClient) UpdateBinary(session domain.Session, bin domain.Binary) (domain.Binary, error) {
+	v, err := open(session)
+	if err != nil {
+		return bin, err
+	}
+	if !v.enabled() {
+		return c.GophKeeperClientInterface.UpdateBinary(session, bin)
+	}
+
+	encrypted := bin
+	encrypted.Content, err = v.encryptBytes(bin.Content)
+	if err != nil {
+		return bin, err
+	}
+	if err = v.encryptBinaryMetadata(&encrypted); err != nil {
+		return bin, err
+	}
+	updated, err := c.GophKeeperClientInterface.UpdateBinary(session, encrypted)
+	if err != nil {
+		if updated.ID == uuid.Nil {
+			return updated, err
+		}
+		bins := []domain.Binary{updated}
+		if decryptErr := v.decryptBinaries(bins); decryptErr != nil {
+			return updated, decryptErr
+		}
+
+		return bins[0], err
+	}
+	bin.Version = updated.Version
+
+	return bin, nil
+}
+
+// GetAllBinaries - Получает и расшифровывает страницу метаданных бинарных данных пользователя
+func (c VaultClient) GetAllBinaries(session domain.Session, cursor string) ([]domain.Binary, string, error) {
+	bins, next, err := c.GophKeeperClientInterface.GetAllBinaries(session, cursor)
+	if err != nil {
+		return bins, next, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return bins, next, err
+	}
+
+	return bins, next, v.decryptBinaries(bins)
+}
+
+// GetBinary - Получает метаданные бинарных данных по идентификатору, при сквозном шифровании расшифровывает их
+func (c VaultClient) GetBinary(session domain.Session, binID uuid.UUID) (domain.Binary, error) {
+	bin, err := c.GophKeeperClientInterface.GetBinary(session, binID)
+	if err != nil {
+		return bin, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return bin, err
+	}
+	items := []domain.Binary{bin}
+	if err = v.decryptBinaries(items); err != nil {
+		return domain.Binary{}, err
+	}
+
+	return items[0], nil
+}
+
+// UploadBinary - Загружает бинарные данные частями, при сквозном шифровании каждая часть
+// шифруется отдельным кадром вместе со своим номером. Кадры шифруются независимо,
+// поэтому при продолжении загрузки новые кадры совместимы с уже принятыми сервером
+func (c VaultClient) UploadBinary(
+	session domain.Session,
+	resumeID uuid.UUID,
+	source io.Reader,
+	size int64,
+	chunkSize int,
+	started func(uuid.UUID) error,
+	progress func(int64),
+) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !v.enabled() {
+		return c.GophKeeperClientInterface.UploadBinary(session, resumeID, source, size, chunkSize, started, progress)
+	}
+
+	frames := &frameReader{v: v, source: source, chunkSize: chunkSize, remaining: size}
+	var encryptedProgress func(int64)
+	if progress != nil {
+		encryptedProgress = func(done int64) {
+			progress(plainSize(done, chunkSize))
+		}
+	}
+
+	return c.GophKeeperClientInterface.UploadBinary(
+		session, resumeID, frames, encryptedSize(size, chunkSize), chunkSize+frameOverhead, started, encryptedProgress,
+	)
+}
+
+// CommitBinaryUpload - Шифрует метаданные и завершает загрузку частями, возвращает идентификатор ресурса от сервера
+func (c VaultClient) CommitBinaryUpload(session domain.Session, uploadID uuid.UUID, bin domain.Binary) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if v.enabled() {
+		if err = v.encryptBinaryMetadata(&bin); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return c.GophKeeperClientInterface.CommitBinaryUpload(session, uploadID, bin)
+}
+
+// DownloadBinary - Скачивает бинарные данные, при сквозном шифровании расшифровывает кадры по мере получения.
+// Данные, загруженные одним запросом, зашифрованы целиком и не превышают domain.BinaryInlineLimit,
+// поэтому они скачиваются в память и расшифровываются после получения
+func (c VaultClient) DownloadBinary(session domain.Session, bin domain.Binary, dest io.Writer, progress func(int64)) error {
+	v, err := open(session)
+	if err != nil {
+		return err
+	}
+	if !v.enabled() {
+		return c.GophKeeperClientInterface.DownloadBinary(session, bin, dest, progress)
+	}
+
+	if !bin.Chunked {
+		encrypted := &bytes.Buffer{}
+		if err = c.GophKeeperClientInterface.DownloadBinary(session, bin, encrypted, nil); err != nil {
+			return err
+		}
+		var content []byte
+		content, err = v.decryptBytes(encrypted.Bytes())
+		if err != nil {
+			return err
+		}
+		if _, err = dest.Write(content); err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(int64(len(content)))
+		}
+
+		return nil
+	}
+
+	frames := &frameWriter{v: v, dest: dest, progress: progress}
+	if err = c.GophKeeperClientInterface.DownloadBinary(session, bin, frames, nil); err != nil {
+		return err
+	}
+
+	return frames.finish()
+}
+
+// CreateCredentials - Шифрует и создает пару логин и пароль, возвращает идентификатор ресурса от сервера
+func (c VaultClient) CreateCredentials(session domain.Session, name, login, password, meta string) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if v.enabled() {
+		if err := v.encryptStrings(&name, &login, &password, &meta); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return c.GophKeeperClientInterface.CreateCredentials(session, name, login, password, meta)
+}
+
+// UpdateCredentials - Шифрует и обновляет существующую пару логина и пароля, возвращает ее с новой версией.
+// При конфликте версий возвращает расшифрованную актуальную копию с сервера и ErrVersionConflict
+func (c VaultClient) UpdateCredentials(session domain.Session, cred *domain.Credentials) (*domain.Credentials, error) {
+	v, err := open(session)
+	if err != nil {
+		return cred, err
+	}
+	if !v.enabled() {
+		return c.GophKeeperClientInterface.UpdateCredentials(session, cred)
+	}
+
+	encrypted := *cred
+	if err := v.encryptStrings(&encrypted.Name, &encrypted.Login, &encrypted.Password, &encrypted.Meta); err != nil {
+		return cred, err
+	}
+	updated, err := c.GophKeeperClientInterface.UpdateCredentials(session, &encrypted)
+	if err != nil {
+		if updated == nil || updated.ID == uuid.Nil {
+			return updated, err
+		}
+		creds := []domain.Credentials{*updated}
+		if decryptErr := v.decryptCredentials(creds); decryptErr != nil {
+			return updated, decryptErr
+		}
+
+		return &creds[0], err
+	}
+	result := *cred
+	result.Version = updated.Version
+
+	return &result, nil
+}
+
+// GetAllCredentials - Получает и расшифровывает страницу логинов и паролей пользователя
+func (c VaultClient) GetAllCredentials(session domain.Session, cursor string) ([]domain.Credentials, string, error) {
+	creds, next, err := c.GophKeeperClientInterface.GetAllCredentials(session, cursor)
+	if err != nil {
+		return creds, next, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return creds, next, err
+	}
+
+	return creds, next, v.decryptCredentials(creds)
+}
+
+// GetCredentials - Получает логин и пароль по идентификатору, при сквозном шифровании расшифровывает их
+func (c VaultClient) GetCredentials(session domain.Session, credID uuid.UUID) (domain.Credentials, error) {
+	cred, err := c.GophKeeperClientInterface.GetCredentials(session, credID)
+	if err != nil {
+		return cred, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return cred, err
+	}
+	items := []domain.Credentials{cred}
+	if err = v.decryptCredentials(items); err != nil {
+		return domain.Credentials{}, err
+	}
+
+	return items[0], nil
+}
+
+// CreateBankCard - Шифрует и создает банковскую карту, возвращает идентификатор ресурса от сервера
+func (c VaultClient) CreateBankCard(session domain.Session, number, validThru, cvv, cardHolder, meta string) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if v.enabled() {
+		if err := v.encryptStrings(&number, &validThru, &cvv, &cardHolder, &meta); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return c.GophKeeperClientInterface.CreateBankCard(session, number, validThru, cvv, cardHolder, meta)
+}
+
+// UpdateBankCard - Шифрует и обновляет существующую банковскую карту, возвращает ее с новой версией.
+// При конфликте версий возвращает расшифрованную актуальную копию с сервера и ErrVersionConflict
+func (c VaultClient) UpdateBankCard(session domain.Session, card *domain.BankCard) (*domain.BankCard, error) {
+	v, err := open(session)
+	if err != nil {
+		return card, err
+	}
+	if !v.enabled() {
+		return c.GophKeeperClientInterface.UpdateBankCard(session, card)
+	}
+
+	encrypted := *card
+	err = v.encryptStrings(&encrypted.Number, &encrypted.ValidThru, &encrypted.CVV, &encrypted.CardHolder, &encrypted.Meta)
+	if err != nil {
+		return card, err
+	}
+	updated, err := c.GophKeeperClientInterface.UpdateBankCard(session, &encrypted)
+	if err != nil {
+		if updated == nil || updated.ID == uuid.Nil {
+			return updated, err
+		}
+		cards := []domain.BankCard{*updated}
+		if decryptErr := v.decryptBankCards(cards); decryptErr != nil {
+			return updated, decryptErr
+		}
+
+		return &cards[0], err
+	}
+	result := *card
+	result.Version = updated.Version
+
+	return &result, nil
+}
+
+// GetAllBankCards - Получает и расшифровывает страницу банковских карт пользователя
+func (c VaultClient) GetAllBankCards(session domain.Session, cursor string) ([]domain.BankCard, string, error) {
+	cards, next, err := c.GophKeeperClientInterface.GetAllBankCards(session, cursor)
+	if err != nil {
+		return cards, next, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return cards, next, err
+	}
+
+	return cards, next, v.decryptBankCards(cards)
+}
+
+// GetBankCard - Получает банковскую карту по идентификатору, при сквозном шифровании расшифровывает ее
+func (c VaultClient) GetBankCard(session domain.Session, cardID uuid.UUID) (domain.BankCard, error) {
+	card, err := c.GophKeeperClientInterface.GetBankCard(session, cardID)
+	if err != nil {
+		return card, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return card, err
+	}
+	items := []domain.BankCard{card}
+	if err = v.decryptBankCards(items); err != nil {
+		return domain.BankCard{}, err
+	}
+
+	return items[0], nil
+}
+
+// CreateOTP - Шифрует и создает секрет одноразовых паролей, возвращает идентификатор ресурса от сервера
+func (c VaultClient) CreateOTP(session domain.Session, otp domain.OTP) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if v.enabled() {
+		if err := v.encryptOTP(&otp); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return c.GophKeeperClientInterface.CreateOTP(session, otp)
+}
+
+// UpdateOTP - Шифрует и обновляет существующий секрет одноразовых паролей, возвращает его с новой версией.
+// При конфликте версий возвращает расшифрованную актуальную копию с сервера и ErrVersionConflict
+func (c VaultClient) UpdateOTP(session domain.Session, otp *domain.OTP) (*domain.OTP, error) {
+	v, err := open(session)
+	if err != nil {
+		return otp, err
+	}
+	if !v.enabled() {
+		return c.GophKeeperClientInterface.UpdateOTP(session, otp)
+	}
+
+	encrypted := *otp
+	if err = v.encryptOTP(&encrypted); err != nil {
+		return otp, err
+	}
+	updated, err := c.GophKeeperClientInterface.UpdateOTP(session, &encrypted)
+	if err != nil {
+		if updated == nil || updated.ID == uuid.Nil {
+			return updated, err
+		}
+		otps := []domain.OTP{*updated}
+		if decryptErr := v.decryptOTPs(otps); decryptErr != nil {
+			return updated, decryptErr
+		}
+
+		return &otps[0], err
+	}
+	result := *otp
+	result.Version = updated.Version
+
+	return &result, nil
+}
+
+// GetAllOTPs - Получает и расшифровывает страницу секретов одноразовых паролей пользователя
+func (c VaultClient) GetAllOTPs(session domain.Session, cursor string) ([]domain.OTP, string, error) {
+	otps, next, err := c.GophKeeperClientInterface.GetAllOTPs(session, cursor)
+	if err != nil {
+		return otps, next, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return otps, next, err
+	}
+
+	return otps, next, v.decryptOTPs(otps)
+}
+
+// GetOTP - Получает секрет одноразовых паролей по идентификатору, при сквозном шифровании расшифровывает его
+func (c VaultClient) GetOTP(session domain.Session, otpID uuid.UUID) (domain.OTP, error) {
+	otp, err := c.GophKeeperClientInterface.GetOTP(session, otpID)
+	if err != nil {
+		return otp, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return otp, err
+	}
+	items := []domain.OTP{otp}
+	if err = v.decryptOTPs(items); err != nil {
+		return domain.OTP{}, err
+	}
+
+	return items[0], nil
+}
+
+// CreateSSHKey - Шифрует и создает SSH ключ, возвращает идентификатор ресурса от сервера
+func (c VaultClient) CreateSSHKey(session domain.Session, key domain.SSHKey) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if v.enabled() {
+		if err := v.encryptSSHKey(&key); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return c.GophKeeperClientInterface.CreateSSHKey(session, key)
+}
+
+// UpdateSSHKey - Шифрует и обновляет существующий SSH ключ, возвращает его с новой версией.
+// При конфликте версий возвращает расшифрованную актуальную копию с сервера и ErrVersionConflict
+func (c VaultClient) UpdateSSHKey(session domain.Session, key *domain.SSHKey) (*domain.SSHKey, error) {
+	v, err := open(session)
+	if err != nil {
+		return key, err
+	}
+	if !v.enabled() {
+		return c.GophKeeperClientInterface.UpdateSSHKey(session, key)
+	}
+
+	encrypted := *key
+	if err = v.encryptSSHKey(&encrypted); err != nil {
+		return key, err
+	}
+	updated, err := c.GophKeeperClientInterface.UpdateSSHKey(session, &encrypted)
+	if err != nil {
+		if updated == nil || updated.ID == uuid.Nil {
+			return updated, err
+		}
+		keys := []domain.SSHKey{*updated}
+		if decryptErr := v.decryptSSHKeys(keys); decryptErr != nil {
+			return updated, decryptErr
+		}
+
+		return &keys[0], err
+	}
+	result := *key
+	result.Version = updated.Version
+
+	return &result, nil
+}
+
+// GetAllSSHKeys - Получает и расшифровывает страницу SSH ключей пользователя
+func (c VaultClient) GetAllSSHKeys(session domain.Session, cursor string) ([]domain.SSHKey, string, error) {
+	keys, next, err := c.GophKeeperClientInterface.GetAllSSHKeys(session, cursor)
+	if err != nil {
+		return keys, next, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return keys, next, err
+	}
+
+	return keys, next, v.decryptSSHKeys(keys)
+}
+
+// GetSSHKey - Получает SSH ключ по идентификатору, при сквозном шифровании расшифровывает его
+func (c VaultClient) GetSSHKey(session domain.Session, keyID uuid.UUID) (domain.SSHKey, error) {
+	key, err := c.GophKeeperClientInterface.GetSSHKey(session, keyID)
+	if err != nil {
+		return key, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return key, err
+	}
+	items := []domain.SSHKey{key}
+	if err = v.decryptSSHKeys(items); err != nil {
+		return domain.SSHKey{}, err
+	}
+
+	return items[0], nil
+}
+
+// CreateItem - Шифрует и создает данные по шаблону, возвращает идентификатор ресурса от сервера
+func (c VaultClient) CreateItem(session domain.Session, obj domain.Item) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if v.enabled() {
+		if err := v.encryptItem(&obj); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return c.GophKeeperClientInterface.CreateItem(session, obj)
+}
+
+// UpdateItem - Шифрует и обновляет существующие данные по шаблону, возвращает их с новой версией.
+// При конфликте версий возвращает расшифрованную актуальную копию с сервера и ErrVersionConflict
+func (c VaultClient) UpdateItem(session domain.Session, obj *domain.Item) (*domain.Item, error) {
+	v, err := open(session)
+	if err != nil {
+		return obj, err
+	}
+	if !v.enabled() {
+		return c.GophKeeperClientInterface.UpdateItem(session, obj)
+	}
+
+	encrypted := *obj
+	if err = v.encryptItem(&encrypted); err != nil {
+		return obj, err
+	}
+	updated, err := c.GophKeeperClientInterface.UpdateItem(session, &encrypted)
+	if err != nil {
+		if updated == nil || updated.ID == uuid.Nil {
+			return updated, err
+		}
+		items := []domain.Item{*updated}
+		if decryptErr := v.decryptItems(items); decryptErr != nil {
+			return updated, decryptErr
+		}
+
+		return &items[0], err
+	}
+	result := *obj
+	result.Version = updated.Version
+
+	return &result, nil
+}
+
+// GetAllItems - Получает и расшифровывает страницу данных по шаблону пользователя
+func (c VaultClient) GetAllItems(session domain.Session, cursor string) ([]domain.Item, string, error) {
+	items, next, err := c.GophKeeperClientInterface.GetAllItems(session, cursor)
+	if err != nil {
+		return items, next, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return items, next, err
+	}
+
+	return items, next, v.decryptItems(items)
+}
+
+// GetItem - Получает данные по шаблону по идентификатору, при сквозном шифровании расшифровывает их
+func (c VaultClient) GetItem(session domain.Session, itemID uuid.UUID) (domain.Item, error) {
+	obj, err := c.GophKeeperClientInterface.GetItem(session, itemID)
+	if err != nil {
+		return obj, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return obj, err
+	}
+	items := []domain.Item{obj}
+	if err = v.decryptItems(items); err != nil {
+		return domain.Item{}, err
+	}
+
+	return items[0], nil
+}
+
+// GetAll - Получает и расшифровывает страницу всех данных пользователя
+func (c VaultClient) GetAll(
+	session domain.Session,
+	cursor string,
+) (
+	[]domain.Text, []domain.BankCard, []domain.Binary, []domain.Credentials, []domain.OTP, []domain.SSHKey, []domain.Item,
+	string, error,
+) {
+	texts, cards, bins, creds, otps, keys, items, next, err := c.GophKeeperClientInterface.GetAll(session, cursor)
+	if err != nil {
+		return texts, cards, bins, creds, otps, keys, items, next, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return texts, cards, bins, creds, otps, keys, items, next, err
+	}
+	if err := v.decryptTexts(texts); err != nil {
+		return texts, cards, bins, creds, otps, keys, items, next, err
+	}
+	if err := v.decryptBankCards(cards); err != nil {
+		return texts, cards, bins, creds, otps, keys, items, next, err
+	}
+	if err := v.decryptBinaries(bins); err != nil {
+		return texts, cards, bins, creds, otps, keys, items, next, err
+	}
+	if err := v.decryptCredentials(creds); err != nil {
+		return texts, cards, bins, creds, otps, keys, items, next, err
+	}
+
+	if err := v.decryptOTPs(otps); err != nil {
+		return texts, cards, bins, creds, otps, keys, items, next, err
+	}
+
+	if err := v.decryptSSHKeys(keys); err != nil {
+		return texts, cards, bins, creds, otps, keys, items, next, err
+	}
+
+	return texts, cards, bins, creds, otps, keys, items, next, v.decryptItems(items)
+}
+
+// GetChanges - Получает и расшифровывает изменения данных пользователя после ревизии since
+func (c VaultClient) GetChanges(session domain.Session, since int64) (domain.Changes, error) {
+	changes, err := c.GophKeeperClientInterface.GetChanges(session, since)
+	if err != nil {
+		return changes, err
+	}
+	v, err := open(session)
+	if err != nil || !v.enabled() {
+		return changes, err
+	}
+	if err := v.decryptTexts(changes.Texts); err != nil {
+		return changes, err
+	}
+	if err := v.decryptBinaries(changes.Binaries); err != nil {
+		return changes, err
+	}
+	if err := v.decryptCredentials(changes.Credentials); err != nil {
+		return changes, err
+	}
+
+	if err := v.decryptBankCards(changes.BankCards); err != nil {
+		return changes, err
+	}
+
+	if err := v.decryptOTPs(changes.OTPs); err != nil {
+		return changes, err
+	}
+
+	if err := v.decryptSSHKeys(changes.SSHKeys); err != nil {
+		return changes, err
+	}
+
+	if err := v.decryptItems(changes.Items); err != nil {
+		return changes, err
+	}
+
+	if err := v.decryptFolders(changes.Folders); err != nil {
+		return changes, err
+	}
+
+	if err := v.decryptTags(changes.Tags); err != nil {
+		return changes, err
+	}
+
+	return changes, v.decryptTemplates(changes.Templates)
+}
+
+// CreateFolder - Шифрует имя и создает папку, возвращает идентификатор ресурса от сервера
+func (c VaultClient) CreateFolder(session domain.Session, folder domain.Folder) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if v.enabled() {
+		if err := v.encryptStrings(&folder.Name); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return c.GophKeeperClientInterface.CreateFolder(session, folder)
+}
+
+// UpdateFolder - Шифрует имя и переименовывает или перемещает существующую папку
+func (c VaultClient) UpdateFolder(session domain.Session, folder domain.Folder) error {
+	v, err := open(session)
+	if err != nil {
+		return err
+	}
+	if v.enabled() {
+		if err := v.encryptStrings(&folder.Name); err != nil {
+			return err
+		}
+	}
+
+	return c.GophKeeperClientInterface.UpdateFolder(session, folder)
+}
+
+// CreateTag - Шифрует имя и создает метку, возвращает идентификатор ресурса от сервера
+func (c VaultClient) CreateTag(session domain.Session, name string) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if v.enabled() {
+		if err := v.encryptStrings(&name); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return c.GophKeeperClientInterface.CreateTag(session, name)
+}
+
+// CreateTemplate - Шифрует имя, описание и поля шаблона и создает его, возвращает идентификатор ресурса от сервера.
+// Признак обязательности поля остается виден серверу
+func (c VaultClient) CreateTemplate(session domain.Session, tmpl domain.ItemTemplate) (uuid.UUID, error) {
+	v, err := open(session)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if v.enabled() {
+		tmpl.Fields = slices.Clone(tmpl.Fields)
+		if err := v.encryptStrings(templateStrings(&tmpl)...); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return c.GophKeeperClientInterface.CreateTemplate(session, tmpl)
+}
+
+// New - Возвращает декоратор клиента со сквозным шифрованием
+func New(client domain.GophKeeperClientInterface) *VaultClient {
+	return &VaultClient{
+		GophKeeperClientInterface: client,
+	}
+}
@@ -0,0 +1,193 @@
+// Package vaultheaderrepository содержит имплементацию интерфейса VaultHeaderRepositoryInterface
+package vaultheaderrepository
+
+import (
+	"errors"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const bucketName = "Header"
+const saltKey = "Salt"
+const verifierKey = "Verifier"
+
+// plainBuckets - Бакеты с открытыми данными, которые не перешифровываются: ревизия синхронизации
+var plainBuckets = map[string]bool{"Sync": true}
+
+// journalBucket - Бакет журнала изменений, выполненных без связи с сервером
+const journalBucket = "Journal"
+
+// errUnknownKey - Данные хранилища зашифрованы неизвестным ключом
+var errUnknownKey = errors.New("local storage is encrypted with unknown key")
+
+// VaultHeaderRepository - Имплементация репозитория заголовка локального хранилища.
+// Заголовок хранится в открытом виде, так как нужен для вывода ключа шифрования остальных данных
+type VaultHeaderRepository struct {
+	// DB - Инстанс базы данных bbolt
+	DB  *bolt.DB
+	log *logrus.Logger
+}
+
+// Get - Возвращает заголовок локального хранилища, если он существует
+func (r VaultHeaderRepository) Get() (*domain.VaultHeader, error) {
+	var header domain.VaultHeader
+
+	err := r.DB.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucketName))
+		if b == nil {
+			return domain.ErrEntityNotFound
+		}
+		salt := b.Get([]byte(saltKey))
+		verifier := b.Get([]byte(verifierKey))
+		if salt == nil || verifier == nil {
+			return domain.ErrEntityNotFound
+		}
+		header.Salt = append([]byte{}, salt...)
+		header.Verifier = append([]byte{}, verifier...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &header, nil
+}
+
+// reencrypt - Перешифровывает значения бакета и вложенных бакетов из ключа previous ключом current
+func reencrypt(b *bolt.Bucket, previous, current domain.CryptoServiceInterface) error {
+	nested := [][]byte{}
+	values := map[string][]byte{}
+	err := b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			nested = append(nested, append([]byte{}, k...))
+
+			return nil
+		}
+		decrypted, err := previous.Decrypt(v)
+		if err != nil {
+			return errUnknownKey
+		}
+		values[string(k)], err = current.Encrypt(decrypted)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for k, v := range values {
+		if err = b.Put([]byte(k), v); err != nil {
+			return err
+		}
+	}
+	for _, name := range nested {
+		if plainBuckets[string(name)] {
+			continue
+		}
+		if err = reencrypt(b.Bucket(name), previous, current); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasJournal - Проверяет, есть ли в хранилище неотправленные изменения
+func hasJournal(b *bolt.Bucket) bool {
+	found := false
+	_ = b.ForEach(func(k, v []byte) error {
+		if v != nil || found {
+			return nil
+		}
+		nested := b.Bucket(k)
+		if string(k) == journalBucket && nested.Stats().KeyN > 0 {
+			found = true
+		} else {
+			found = hasJournal(nested)
+		}
+
+		return nil
+	})
+
+	return found
+}
+
+func rootBuckets(tx *bolt.Tx) [][]byte {
+	names := [][]byte{}
+	_ = tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+		if string(name) != bucketName {
+			names = append(names, append([]byte{}, name...))
+		}
+
+		return nil
+	})
+
+	return names
+}
+
+func putHeader(tx *bolt.Tx, header domain.VaultHeader) error {
+	b, err := tx.CreateBucketIfNotExists([]byte(bucketName))
+	if err != nil {
+		return err
+	}
+	if err = b.Put([]byte(saltKey), header.Salt); err != nil {
+		return err
+	}
+
+	return b.Put([]byte(verifierKey), header.Verifier)
+}
+
+// Init - Сохраняет новый заголовок хранилища и перешифровывает существующие данные из ключа previous ключом current
+// в одной транзакции. Если данные не расшифровываются ключом previous, они удаляются, так как прочитать их невозможно,
+// но только при отсутствии неотправленных изменений, иначе возвращается ErrVaultNotMigrated
+func (r VaultHeaderRepository) Init(header domain.VaultHeader, previous, current domain.CryptoServiceInterface) error {
+	err := r.DB.Update(func(tx *bolt.Tx) error {
+		for _, name := range rootBuckets(tx) {
+			if plainBuckets[string(name)] {
+				continue
+			}
+			if err := reencrypt(tx.Bucket(name), previous, current); err != nil {
+				return err
+			}
+		}
+
+		return putHeader(tx, header)
+	})
+	if !errors.Is(err, errUnknownKey) {
+		return err
+	}
+
+	r.log.Warn(err)
+
+	return r.DB.Update(func(tx *bolt.Tx) error {
+		names := rootBuckets(tx)
+		for _, name := range names {
+			if hasJournal(tx.Bucket(name)) {
+				return domain.ErrVaultNotMigrated
+			}
+		}
+		for _, name := range names {
+			if err := tx.DeleteBucket(name); err != nil {
+				return err
+			}
+		}
+
+		return putHeader(tx, header)
+	})
+}
+
+// New - Возвращает инстанс репозитория VaultHeaderRepository
+func New(
+	db *bolt.DB,
+	log *logrus.Logger,
+) *VaultHeaderRepository {
+	return &VaultHeaderRepository{
+		DB:  db,
+		log: log,
+	}
+}
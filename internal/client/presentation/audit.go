@@ -0,0 +1,183 @@
+package presentation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/hibp"
+)
+
+// defaultExpiringDays - Количество дней до истечения срока действия карты, начиная с которого карта попадает в отчет
+const defaultExpiringDays = 30
+
+// auditIssues - Возвращает описание проблем пароля для таблицы
+func auditIssues(v domain.CredentialsAudit) string {
+	issues := []string{}
+	if v.Weak {
+		issues = append(issues, "weak")
+	}
+	if v.ReuseGroup != 0 {
+		issues = append(issues, fmt.Sprintf("reused #%d", v.ReuseGroup))
+	}
+	if v.Breaches > 0 {
+		issues = append(issues, fmt.Sprintf("breached %d times", v.Breaches))
+	}
+	if len(issues) == 0 {
+		return "-"
+	}
+
+	return strings.Join(issues, ", ")
+}
+
+// cardStatus - Возвращает описание срока действия карты для таблицы
+func cardStatus(v domain.BankCardAudit) string {
+	if v.Expired {
+		return "expired"
+	}
+
+	return fmt.Sprintf("expires in %d days", v.DaysLeft)
+}
+
+// printAuditReport - Выводит отчет в виде таблиц паролей и карт
+func printAuditReport(w io.Writer, report domain.AuditReport) error {
+	weak := 0
+	for _, v := range report.Credentials {
+		if v.Weak {
+			weak++
+		}
+	}
+
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "ID\tNAME\tLOGIN\tSCORE\tCRACK TIME\tISSUES")
+	for _, v := range report.Credentials {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%d/4\t%s\t%s\n", v.ID, v.Name, v.Login, v.Score, v.CrackTime, auditIssues(v))
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "%d credentials checked, %d weak, %d passwords reused", len(report.Credentials), weak, len(report.Reused))
+	if report.BreachesChecked {
+		fmt.Fprintf(w, ", %d breached", len(report.Breached))
+	}
+	fmt.Fprint(w, "\n\n")
+
+	if len(report.BankCards) == 0 {
+		fmt.Fprintln(w, "no expired or expiring bank cards")
+
+		return nil
+	}
+	table = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "ID\tNUMBER\tCARD HOLDER\tVALID THRU\tSTATUS")
+	for _, v := range report.BankCards {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\n", v.ID, v.Number, v.CardHolder, v.ValidThru, cardStatus(v))
+	}
+
+	return table.Flush()
+}
+
+func audit() cli.Command {
+	var format, breachesPath string
+	var days int64
+
+	return cli.Command{
+		Name:  "audit",
+		Usage: "report weak, reused and breached passwords and expired or expiring bank cards",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "format",
+				Aliases:     []string{"f"},
+				Usage:       "(optional) output format: table or json",
+				Value:       "table",
+				Destination: &format,
+			},
+			&cli.IntFlag{
+				Name:        "days",
+				Aliases:     []string{"d"},
+				Usage:       "(optional) report bank cards expiring within this number of days",
+				Value:       defaultExpiringDays,
+				Destination: &days,
+			},
+			&cli.StringFlag{
+				Name:        "breaches",
+				Aliases:     []string{"b"},
+				Usage:       "(optional) check passwords against a local Have I Been Pwned sorted SHA-1 list, range file or directory of range files",
+				Destination: &breachesPath,
+			},
+		},
+		Action: func(_ context.Context, _ *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			if format != "table" && format != "json" {
+				fmt.Println("invalid format: ", format, ", expected table or json")
+
+				return nil
+			}
+
+			if days < 0 {
+				fmt.Println("invalid days value: ", days)
+
+				return nil
+			}
+
+			// Интерфейс остается nil, если набор утекших паролей не задан
+			var breaches domain.BreachDatasetInterface
+			if breachesPath != "" {
+				dataset, err := hibp.Open(breachesPath)
+				if err != nil {
+					fmt.Println(err)
+
+					return nil
+				}
+				defer dataset.Close()
+				breaches = dataset
+			}
+
+			report, err := app.Audit.Do(*currentSession, time.Duration(days)*24*time.Hour, breaches)
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			if format == "json" {
+				s, err := json.MarshalIndent(report, "", "\t")
+				if err != nil {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+				fmt.Println(string(s))
+
+				return nil
+			}
+
+			if err = printAuditReport(os.Stdout, report); err != nil {
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,260 @@
+package presentation
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/client/importers"
+	"github.com/Nickolasll/goph-keeper/internal/vaultfile"
+)
+
+var errPassphraseMismatch = errors.New("passphrases do not match")
+
+// passphraseFlag - Флаг парольной фразы резервной копии, без флага фраза запрашивается интерактивно
+func passphraseFlag(destination *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name:        "passphrase",
+		Aliases:     []string{"p"},
+		Usage:       "(optional) backup passphrase, requested interactively if not set",
+		Destination: destination,
+	}
+}
+
+// promptPassphrase - Возвращает парольную фразу из флага или запрашивает ее интерактивно.
+// Если confirm истинно, фраза запрашивается повторно для проверки
+func promptPassphrase(passphrase string, confirm bool) (string, error) {
+	if passphrase != "" {
+		return passphrase, nil
+	}
+	reader := bufio.NewReader(input)
+	read := func(prompt string) (string, error) {
+		line, err := readSecret(reader, prompt)
+		if err != nil {
+			return "", vaultfile.ErrEmptyPassphrase
+		}
+
+		return line, nil
+	}
+
+	passphrase, err := read("backup passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", vaultfile.ErrEmptyPassphrase
+	}
+	if !confirm {
+		return passphrase, nil
+	}
+	repeated, err := read("repeat passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if repeated != passphrase {
+		return "", errPassphraseMismatch
+	}
+
+	return passphrase, nil
+}
+
+// formatItemsCount - Возвращает количество данных по типам
+func formatItemsCount(c domain.VaultItemsCount) string {
+	return fmt.Sprintf(
+		"%d texts, %d binaries, %d credentials, %d bank cards, %d otps, %d ssh keys, %d items",
+		c.Texts, c.Binaries, c.Credentials, c.BankCards, c.OTPs, c.SSHKeys, c.Items,
+	)
+}
+
+// isBackupUserError - Ошибки, которые выводятся пользователю без завершения с ошибкой
+func isBackupUserError(err error) bool {
+	return errors.Is(err, vaultfile.ErrEmptyPassphrase) ||
+		errors.Is(err, vaultfile.ErrWrongPassphrase) ||
+		errors.Is(err, vaultfile.ErrInvalidFormat) ||
+		errors.Is(err, vaultfile.ErrUnsupportedVersion) ||
+		errors.Is(err, vaultfile.ErrCorrupted) ||
+		errors.Is(err, domain.ErrInvalidBackupRecord) ||
+		errors.Is(err, errPassphraseMismatch)
+}
+
+// exportVault - Команда export: без подкоманды сохраняет все данные в зашифрованную резервную копию
+func exportVault(subcommands ...*cli.Command) cli.Command {
+	var out, passphrase string
+
+	return cli.Command{
+		Name:     "export",
+		Usage:    "save all data to an encrypted backup file or save binary content to file with the original name",
+		Commands: subcommands,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "out",
+				Aliases:     []string{"o"},
+				Usage:       "backup file path, the file must not exist",
+				Destination: &out,
+			},
+			passphraseFlag(&passphrase),
+		},
+		Action: func(_ context.Context, _ *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			if out == "" {
+				fmt.Println("invalid input: please pass the backup file path with --out")
+
+				return nil
+			}
+
+			secret, err := promptPassphrase(passphrase, true)
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+
+			file, err := os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600) //nolint: gosec
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+			count, err := app.ExportVault.Do(*currentSession, secret, file)
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				// Незавершенная резервная копия удаляется
+				if removeErr := os.Remove(out); removeErr != nil {
+					log.Error(removeErr)
+				}
+				if errors.Is(err, domain.ErrInvalidToken) || errors.Is(err, domain.ErrUnauthorized) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else if isBackupUserError(err) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			fmt.Println("exported", formatItemsCount(count), "to", out)
+
+			return nil
+		},
+	}
+}
+
+// importVault - Команда import: восстанавливает резервную копию или импортирует файл экспорта
+// другого менеджера паролей, если задан формат
+func importVault() cli.Command {
+	var passphrase, format string
+	var dryRun bool
+	var rate int64
+
+	return cli.Command{
+		Name: "import",
+		Usage: "recreate data from an encrypted backup file or import an export of another password manager, " +
+			"duplicates of existing data are skipped",
+		ArgsUsage: "[path-to-file]",
+		Flags: []cli.Flag{
+			passphraseFlag(&passphrase),
+			&cli.StringFlag{
+				Name:        "format",
+				Aliases:     []string{"f"},
+				Usage:       "(optional) password manager export format: " + strings.Join(importers.Formats(), ", "),
+				Destination: &format,
+			},
+			&cli.BoolFlag{
+				Name:        "dry-run",
+				Aliases:     []string{"n"},
+				Usage:       "(optional) show what would be imported from a password manager export without creating data",
+				Destination: &dryRun,
+			},
+			&cli.IntFlag{
+				Name:        "rate",
+				Usage:       "(optional) maximum number of items created per second when importing a password manager export",
+				Value:       defaultImportRate,
+				Destination: &rate,
+			},
+		},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			path := cmd.Args().First()
+			if path == "" {
+				fmt.Println("invalid input: please pass the backup file path")
+
+				return nil
+			}
+
+			file, err := os.Open(path)
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+			defer file.Close()
+
+			if format != "" {
+				return importItems(file, format, dryRun, rate)
+			}
+
+			secret, err := promptPassphrase(passphrase, false)
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+
+			created, skipped, err := app.ImportVault.Do(*currentSession, secret, file)
+
+			return printImportResult(created, skipped, err)
+		},
+	}
+}
+
+// printImportResult - Выводит количество созданных и пропущенных данных или ошибку импорта
+func printImportResult(created, skipped domain.VaultItemsCount, err error) error {
+	if err != nil {
+		if created.Total() > 0 {
+			fmt.Println("imported before the error:", formatItemsCount(created))
+		}
+		if errors.Is(err, domain.ErrInvalidToken) || errors.Is(err, domain.ErrUnauthorized) {
+			fmt.Println("unauthorized")
+
+			return nil
+		} else if isBackupUserError(err) || errors.Is(err, domain.ErrBadRequest) {
+			fmt.Println(err)
+
+			return nil
+		} else {
+			log.Error(err)
+
+			return cli.Exit(err, 1)
+		}
+	}
+
+	fmt.Println("imported", formatItemsCount(created))
+	if skipped.Total() > 0 {
+		fmt.Println("skipped duplicates:", formatItemsCount(skipped))
+	}
+
+	return nil
+}
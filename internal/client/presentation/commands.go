@@ -5,12 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"text/tabwriter"
 
 	"github.com/google/uuid"
 	"github.com/urfave/cli/v3"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/passgen"
 )
 
 func parseID(id string) (uuid.UUID, error) {
@@ -23,15 +27,24 @@ func parseID(id string) (uuid.UUID, error) {
 }
 
 func registration() cli.Command {
+	var e2e bool
+
 	return cli.Command{
 		Name:      "register",
 		Usage:     "register new user via username and password",
 		ArgsUsage: "[username] [password]",
 		Aliases:   []string{"r"},
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:        "e2e",
+				Usage:       "(optional) encrypt data on the client with a key derived from the password, the server never sees plaintext",
+				Destination: &e2e,
+			},
+		},
 		Action: func(_ context.Context, cmd *cli.Command) error {
 			login := cmd.Args().Get(0)
 			password := cmd.Args().Get(1)
-			session, err := app.Registration.Do(login, password)
+			session, err := app.Registration.Do(login, password, e2e)
 			if err != nil {
 				if errors.Is(err, domain.ErrLoginConflict) {
 					fmt.Println("user with this login already exists: ", login)
@@ -52,17 +65,21 @@ func registration() cli.Command {
 }
 
 func login() cli.Command {
+	var code string
+
 	return cli.Command{
 		Name:      "login",
-		Usage:     "sign in via username and password",
+		Usage:     "sign in via username and password, two-factor code is requested if enabled",
 		ArgsUsage: "[username] [password]",
 		Aliases:   []string{"l"},
+		Flags:     []cli.Flag{codeFlag(&code)},
 		Action: func(_ context.Context, cmd *cli.Command) error {
 			login := cmd.Args().Get(0)
 			password := cmd.Args().Get(1)
-			session, err := app.Login.Do(login, password)
+			session, err := app.Login.Do(login, password, promptCode(code))
 			if err != nil {
-				if errors.Is(err, domain.ErrUnauthorized) {
+				if errors.Is(err, domain.ErrUnauthorized) || errors.Is(err, domain.ErrInvalidTwoFactorCode) ||
+					errors.Is(err, errNoCode) {
 					fmt.Println(err)
 
 					return nil
@@ -89,11 +106,15 @@ func login() cli.Command {
 }
 
 func createText() cli.Command {
+	var folder string
+	var tags []string
+
 	return cli.Command{
 		Name:      "text",
 		Usage:     "create new text content",
 		ArgsUsage: "[content]",
 		Aliases:   []string{"t"},
+		Flags:     createLabelFlags(&folder, &tags),
 		Action: func(_ context.Context, cmd *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -102,7 +123,7 @@ func createText() cli.Command {
 			}
 
 			content := cmd.Args().First()
-			err := app.CreateText.Do(*currentSession, content)
+			textID, err := app.CreateText.Do(*currentSession, content)
 			if err != nil {
 				if errors.Is(err, domain.ErrBadRequest) {
 					fmt.Println(err)
@@ -116,18 +137,25 @@ func createText() cli.Command {
 			}
 
 			fmt.Println("text created successfully")
+			_, err = applyLabels(cmd, domain.TextKind, "text", textID, folder, tags)
 
-			return nil
+			return err
 		},
 	}
 }
 
 func updateText() cli.Command {
+	var resolve, folder string
+	var tags []string
+
 	return cli.Command{
 		Name:      "text",
-		Usage:     "update existing text via id and new content string",
+		Usage:     "update existing text via id and new content string, content may be omitted to change only folder or tags",
 		ArgsUsage: "[id] [content]",
 		Aliases:   []string{"t"},
+		Flags: append([]cli.Flag{
+			resolveFlag(&resolve),
+		}, updateLabelFlags(&folder, &tags)...),
 		Action: func(_ context.Context, cmd *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -145,22 +173,36 @@ func updateText() cli.Command {
 				return nil
 			}
 
-			err = app.UpdateText.Do(*currentSession, textID, content)
-			if err != nil {
-				if errors.Is(err, domain.ErrEntityNotFound) {
-					fmt.Println("text not found, id: ", textID)
+			if !validResolveStrategy(resolve) {
+				fmt.Println("invalid resolve strategy: ", resolve)
 
-					return nil
-				} else if errors.Is(err, domain.ErrBadRequest) {
-					fmt.Println(err)
-
-					return nil
-				} else {
-					log.Error(err)
+				return nil
+			}
 
-					return cli.Exit(err, 1)
+			if cmd.Args().Len() > 1 || !labelsSet(cmd) {
+				err = app.UpdateText.Do(*currentSession, textID, content)
+				if err != nil {
+					if handled, err := handleUpdateConflict(err, resolve, "text"); handled {
+						return err
+					}
+					if errors.Is(err, domain.ErrEntityNotFound) {
+						fmt.Println("text not found, id: ", textID)
+
+						return nil
+					} else if errors.Is(err, domain.ErrBadRequest) {
+						fmt.Println(err)
+
+						return nil
+					} else {
+						log.Error(err)
+
+						return cli.Exit(err, 1)
+					}
 				}
 			}
+			if ok, err := applyLabels(cmd, domain.TextKind, "text", textID, folder, tags); !ok {
+				return err
+			}
 			fmt.Println("text updated successfully")
 
 			return nil
@@ -169,10 +211,14 @@ func updateText() cli.Command {
 }
 
 func showText() cli.Command {
+	var folder string
+	var tags []string
+
 	return cli.Command{
 		Name:    "texts",
 		Usage:   "shows current user text data",
 		Aliases: []string{"t"},
+		Flags:   showLabelFlags(&folder, &tags),
 		Action: func(_ context.Context, _ *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -194,6 +240,11 @@ func showText() cli.Command {
 				}
 			}
 
+			text, err = filterLabels(text, func(v domain.Text) uuid.UUID { return v.ID }, folder, tags)
+			if err != nil {
+				return printFilterError(err)
+			}
+
 			s, err := json.MarshalIndent(text, "", "\t")
 			if err != nil {
 				log.Error(err)
@@ -225,6 +276,10 @@ func syncText() cli.Command {
 				if errors.Is(err, domain.ErrInvalidToken) {
 					fmt.Println("unauthorized")
 
+					return nil
+				} else if errors.Is(err, domain.ErrPendingOperations) {
+					fmt.Println(err)
+
 					return nil
 				} else {
 					log.Error(err)
@@ -239,12 +294,71 @@ func syncText() cli.Command {
 	}
 }
 
+func deleteText() cli.Command {
+	return cli.Command{
+		Name:      "text",
+		Usage:     "delete existing text via id",
+		ArgsUsage: "[id]",
+		Aliases:   []string{"t"},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			id := cmd.Args().First()
+			textID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid text id: ", id)
+
+				return nil
+			}
+
+			err = app.DeleteText.Do(*currentSession, textID)
+			if err != nil {
+				if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("text not found, id: ", textID)
+
+					return nil
+				} else if errors.Is(err, domain.ErrBadRequest) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("text deleted successfully")
+
+			return nil
+		},
+	}
+}
+
+func metaFlag(destination *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name:        "meta",
+		Aliases:     []string{"m"},
+		Usage:       "(optional) arbitrary note stored with the binary",
+		Destination: destination,
+	}
+}
+
 func createBinary() cli.Command {
+	var meta, folder string
+	var tags []string
+
 	return cli.Command{
 		Name:      "binary",
 		Usage:     "create new binary content",
 		ArgsUsage: "[path-to-file]",
 		Aliases:   []string{"b"},
+		Flags: append([]cli.Flag{
+			metaFlag(&meta),
+		}, createLabelFlags(&folder, &tags)...),
 		Action: func(_ context.Context, cmd *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -253,14 +367,34 @@ func createBinary() cli.Command {
 			}
 
 			contentPath := cmd.Args().First()
-			content, err := os.ReadFile(contentPath) //nolint: gosec
+			file, err := os.Open(contentPath) //nolint: gosec
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+			defer func() {
+				if closeErr := file.Close(); closeErr != nil {
+					log.Error(closeErr)
+				}
+			}()
+			info, err := file.Stat()
 			if err != nil {
 				fmt.Println(err)
 
 				return nil
 			}
 
-			err = app.CreateBinary.Do(*currentSession, content)
+			bar := newProgressBar(info.Size())
+			binID, err := app.CreateBinary.Do(
+				*currentSession,
+				filepath.Base(contentPath),
+				meta,
+				file,
+				info.Size(),
+				bar.update,
+			)
+			bar.finish()
 			if err != nil {
 				if errors.Is(err, domain.ErrBadRequest) {
 					fmt.Println(err)
@@ -274,18 +408,26 @@ func createBinary() cli.Command {
 			}
 
 			fmt.Println("binary created successfully")
+			_, err = applyLabels(cmd, domain.BinaryKind, "binary", binID, folder, tags)
 
-			return nil
+			return err
 		},
 	}
 }
 
 func updateBinary() cli.Command {
+	var resolve, meta, folder string
+	var tags []string
+
 	return cli.Command{
 		Name:      "binary",
-		Usage:     "update existing binary via id and data",
+		Usage:     "update existing binary via id and data, the file may be omitted to change only folder or tags",
 		ArgsUsage: "[id] [path-to-file]",
 		Aliases:   []string{"b"},
+		Flags: append([]cli.Flag{
+			resolveFlag(&resolve),
+			metaFlag(&meta),
+		}, updateLabelFlags(&folder, &tags)...),
 		Action: func(_ context.Context, cmd *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -303,29 +445,43 @@ func updateBinary() cli.Command {
 				return nil
 			}
 
-			content, err := os.ReadFile(contentPath) //nolint: gosec
-			if err != nil {
-				fmt.Println(err)
+			if !validResolveStrategy(resolve) {
+				fmt.Println("invalid resolve strategy: ", resolve)
 
 				return nil
 			}
 
-			err = app.UpdateBinary.Do(*currentSession, binID, content)
-			if err != nil {
-				if errors.Is(err, domain.ErrEntityNotFound) {
-					fmt.Println("binary not found, id: ", binID)
-
-					return nil
-				} else if errors.Is(err, domain.ErrBadRequest) {
+			if contentPath != "" || !labelsSet(cmd) {
+				content, err := os.ReadFile(contentPath) //nolint: gosec
+				if err != nil {
 					fmt.Println(err)
 
 					return nil
-				} else {
-					log.Error(err)
+				}
 
-					return cli.Exit(err, 1)
+				err = app.UpdateBinary.Do(*currentSession, binID, filepath.Base(contentPath), content, meta)
+				if err != nil {
+					if handled, err := handleUpdateConflict(err, resolve, "binary"); handled {
+						return err
+					}
+					if errors.Is(err, domain.ErrEntityNotFound) {
+						fmt.Println("binary not found, id: ", binID)
+
+						return nil
+					} else if errors.Is(err, domain.ErrBadRequest) {
+						fmt.Println(err)
+
+						return nil
+					} else {
+						log.Error(err)
+
+						return cli.Exit(err, 1)
+					}
 				}
 			}
+			if ok, err := applyLabels(cmd, domain.BinaryKind, "binary", binID, folder, tags); !ok {
+				return err
+			}
 			fmt.Println("binary updated successfully")
 
 			return nil
@@ -334,10 +490,14 @@ func updateBinary() cli.Command {
 }
 
 func showBinary() cli.Command {
+	var folder string
+	var tags []string
+
 	return cli.Command{
 		Name:    "binaries",
 		Usage:   "shows current user binary data",
 		Aliases: []string{"b"},
+		Flags:   showLabelFlags(&folder, &tags),
 		Action: func(_ context.Context, _ *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -345,7 +505,7 @@ func showBinary() cli.Command {
 				return nil
 			}
 
-			text, err := app.ShowBinary.Do(*currentSession)
+			binaries, err := app.ShowBinary.Do(*currentSession)
 
 			if err != nil {
 				if errors.Is(err, domain.ErrInvalidToken) {
@@ -359,19 +519,182 @@ func showBinary() cli.Command {
 				}
 			}
 
-			s, err := json.MarshalIndent(text, "", "\t")
+			binaries, err = filterLabels(binaries, func(v domain.Binary) uuid.UUID { return v.ID }, folder, tags)
 			if err != nil {
+				return printFilterError(err)
+			}
+
+			if err := printBinaries(os.Stdout, binaries); err != nil {
 				log.Error(err)
 
 				return cli.Exit(err, 1)
 			}
-			fmt.Print(string(s))
 
 			return nil
 		},
 	}
 }
 
+// printBinaries - Выводит метаданные бинарных данных в виде таблицы, содержимое не выводится
+func printBinaries(w io.Writer, binaries []domain.Binary) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "ID\tNAME\tTYPE\tSIZE\tSHA-256\tMETA")
+	for _, bin := range binaries {
+		fmt.Fprintf(
+			table,
+			"%s\t%s\t%s\t%s\t%s\t%s\n",
+			bin.ID,
+			bin.Name,
+			bin.MimeType,
+			formatSize(bin.Size),
+			bin.SHA256,
+			bin.Meta,
+		)
+	}
+
+	return table.Flush()
+}
+
+// saveBinary - Сохраняет содержимое бинарных данных в новый файл, существующий файл не перезаписывается
+func saveBinary(binID uuid.UUID, path string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600) //nolint: gosec
+	if err != nil {
+		fmt.Println(err)
+
+		return nil
+	}
+
+	var bar *progressBar
+	err = app.DownloadBinary.Do(*currentSession, binID, file, func(done, total int64) {
+		if bar == nil {
+			bar = newProgressBar(total)
+		}
+		bar.update(done)
+	})
+	if bar != nil {
+		bar.finish()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Недокачанный файл удаляется
+		if removeErr := os.Remove(path); removeErr != nil {
+			log.Error(removeErr)
+		}
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			fmt.Println("binary not found, id: ", binID)
+
+			return nil
+		} else if errors.Is(err, domain.ErrInvalidToken) || errors.Is(err, domain.ErrUnauthorized) {
+			fmt.Println("unauthorized")
+
+			return nil
+		} else {
+			log.Error(err)
+
+			return cli.Exit(err, 1)
+		}
+	}
+	fmt.Println("binary saved to", path)
+
+	return nil
+}
+
+func downloadBinary() cli.Command {
+	return cli.Command{
+		Name:      "binary",
+		Usage:     "save binary content to file via id",
+		ArgsUsage: "[id] [path-to-file]",
+		Aliases:   []string{"b"},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			id := cmd.Args().Get(0)
+			path := cmd.Args().Get(1)
+
+			binID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid binary id: ", id)
+
+				return nil
+			}
+
+			return saveBinary(binID, path)
+		},
+	}
+}
+
+// exportFileName - Имя файла для экспорта, пути в сохраненном имени отбрасываются.
+// Если исходное имя неизвестно, используется идентификатор бинарных данных
+func exportFileName(bin domain.Binary) string {
+	name := filepath.Base(bin.Name)
+	switch name {
+	case "", ".", "..", string(filepath.Separator):
+		return bin.ID.String()
+	}
+
+	return name
+}
+
+func exportBinary() cli.Command {
+	var dir string
+
+	return cli.Command{
+		Name:      "binary",
+		Usage:     "save binary content to file with the original name via id",
+		ArgsUsage: "[id]",
+		Aliases:   []string{"b"},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "dir",
+				Aliases:     []string{"d"},
+				Usage:       "(optional) directory to save the file to",
+				Value:       ".",
+				Destination: &dir,
+			},
+		},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			id := cmd.Args().First()
+			binID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid binary id: ", id)
+
+				return nil
+			}
+
+			bin, err := app.GetBinary.Do(*currentSession, binID)
+			if err != nil {
+				if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("binary not found, id: ", binID)
+
+					return nil
+				} else if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			return saveBinary(binID, filepath.Join(dir, exportFileName(bin)))
+		},
+	}
+}
+
 func syncBinary() cli.Command {
 	return cli.Command{
 		Name:    "binaries",
@@ -390,6 +713,10 @@ func syncBinary() cli.Command {
 				if errors.Is(err, domain.ErrInvalidToken) {
 					fmt.Println("unauthorized")
 
+					return nil
+				} else if errors.Is(err, domain.ErrPendingOperations) {
+					fmt.Println(err)
+
 					return nil
 				} else {
 					log.Error(err)
@@ -404,15 +731,62 @@ func syncBinary() cli.Command {
 	}
 }
 
+func deleteBinary() cli.Command {
+	return cli.Command{
+		Name:      "binary",
+		Usage:     "delete existing binary via id",
+		ArgsUsage: "[id]",
+		Aliases:   []string{"b"},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			id := cmd.Args().First()
+			binID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid binary id: ", id)
+
+				return nil
+			}
+
+			err = app.DeleteBinary.Do(*currentSession, binID)
+			if err != nil {
+				if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("binary not found, id: ", binID)
+
+					return nil
+				} else if errors.Is(err, domain.ErrBadRequest) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("binary deleted successfully")
+
+			return nil
+		},
+	}
+}
+
 func createCredentials() cli.Command {
-	var meta string
+	var meta, folder string
+	var tags []string
+	var generatePassword bool
+	var generator generatorFlags
 
 	return cli.Command{
 		Name:      "credentials",
-		Usage:     "create new credentials",
+		Usage:     "create new credentials, pass --generate instead of the password to generate it",
 		ArgsUsage: "[name] [login] [password]",
 		Aliases:   []string{"c"},
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
 				Name:        "meta",
 				Aliases:     []string{"m"},
@@ -420,7 +794,13 @@ func createCredentials() cli.Command {
 				DefaultText: "",
 				Destination: &meta,
 			},
-		},
+			&cli.BoolFlag{
+				Name:        "generate",
+				Aliases:     []string{"g"},
+				Usage:       "(optional) generate a password, see gophkeeper generate for the generator flags",
+				Destination: &generatePassword,
+			},
+		}, append(generator.flags(), createLabelFlags(&folder, &tags)...)...),
 		Action: func(_ context.Context, cmd *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -432,7 +812,24 @@ func createCredentials() cli.Command {
 			login := cmd.Args().Get(1)
 			password := cmd.Args().Get(2)
 
-			err := app.CreateCredentials.Do(*currentSession, name, login, password, meta)
+			var secret passgen.Secret
+			if generatePassword {
+				if password != "" {
+					fmt.Println("invalid input: pass either a password or --generate")
+
+					return nil
+				}
+				var err error
+				secret, err = generator.generate()
+				if err != nil {
+					fmt.Println(err)
+
+					return nil
+				}
+				password = secret.Value()
+			}
+
+			credID, err := app.CreateCredentials.Do(*currentSession, name, login, password, meta)
 			if err != nil {
 				if errors.Is(err, domain.ErrBadRequest) {
 					fmt.Println(err)
@@ -446,21 +843,30 @@ func createCredentials() cli.Command {
 			}
 
 			fmt.Println("credentials created successfully")
+			if generatePassword {
+				printEntropy(secret)
+				fmt.Println("use copy credentials to put the generated password to the clipboard")
+			}
+			_, err = applyLabels(cmd, domain.CredentialsKind, "credentials", credID, folder, tags)
 
-			return nil
+			return err
 		},
 	}
 }
 
 func updateCredentials() cli.Command {
-	var name, login, password, meta string
+	var resolve, name, login, password, meta, folder string
+	var tags []string
+	var generatePassword bool
+	var generator generatorFlags
 
 	return cli.Command{
 		Name:      "credentials",
 		Usage:     "update existing credentials via id and flags",
 		ArgsUsage: "[id]",
 		Aliases:   []string{"c"},
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
+			resolveFlag(&resolve),
 			&cli.StringFlag{
 				Name:        "name",
 				Aliases:     []string{"n"},
@@ -482,6 +888,12 @@ func updateCredentials() cli.Command {
 				DefaultText: "",
 				Destination: &password,
 			},
+			&cli.BoolFlag{
+				Name:        "generate",
+				Aliases:     []string{"g"},
+				Usage:       "(optional) replace the password with a generated one, see gophkeeper generate for the generator flags",
+				Destination: &generatePassword,
+			},
 			&cli.StringFlag{
 				Name:        "meta",
 				Aliases:     []string{"m"},
@@ -489,7 +901,7 @@ func updateCredentials() cli.Command {
 				DefaultText: "",
 				Destination: &meta,
 			},
-		},
+		}, append(generator.flags(), updateLabelFlags(&folder, &tags)...)...),
 		Action: func(_ context.Context, cmd *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -511,23 +923,56 @@ func updateCredentials() cli.Command {
 				return nil
 			}
 
-			err = app.UpdateCredentials.Do(*currentSession, credID, name, login, password, meta)
-			if err != nil {
-				if errors.Is(err, domain.ErrEntityNotFound) {
-					fmt.Println("credentials not found, id: ", credID)
+			if !validResolveStrategy(resolve) {
+				fmt.Println("invalid resolve strategy: ", resolve)
+
+				return nil
+			}
+
+			var secret passgen.Secret
+			if generatePassword {
+				if password != "" {
+					fmt.Println("invalid input: pass either --password or --generate")
 
 					return nil
-				} else if errors.Is(err, domain.ErrBadRequest) {
+				}
+				secret, err = generator.generate()
+				if err != nil {
 					fmt.Println(err)
 
 					return nil
-				} else {
-					log.Error(err)
+				}
+				password = secret.Value()
+			}
 
-					return cli.Exit(err, 1)
+			if name != "" || login != "" || password != "" || meta != "" || !labelsSet(cmd) {
+				err = app.UpdateCredentials.Do(*currentSession, credID, name, login, password, meta)
+				if err != nil {
+					if handled, err := handleUpdateConflict(err, resolve, "credentials"); handled {
+						return err
+					}
+					if errors.Is(err, domain.ErrEntityNotFound) {
+						fmt.Println("credentials not found, id: ", credID)
+
+						return nil
+					} else if errors.Is(err, domain.ErrBadRequest) {
+						fmt.Println(err)
+
+						return nil
+					} else {
+						log.Error(err)
+
+						return cli.Exit(err, 1)
+					}
 				}
 			}
+			if ok, err := applyLabels(cmd, domain.CredentialsKind, "credentials", credID, folder, tags); !ok {
+				return err
+			}
 			fmt.Println("credentials updated successfully")
+			if generatePassword {
+				printEntropy(secret)
+			}
 
 			return nil
 		},
@@ -535,10 +980,26 @@ func updateCredentials() cli.Command {
 }
 
 func showCredentials() cli.Command {
+	var id, folder string
+	var tags []string
+	var remote bool
+
 	return cli.Command{
 		Name:    "credentials",
 		Usage:   "shows current user credentials data",
 		Aliases: []string{"c"},
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{
+				Name:        "id",
+				Usage:       "(optional) show only credentials with this id",
+				Destination: &id,
+			},
+			&cli.BoolFlag{
+				Name:        "remote",
+				Usage:       "(optional) fetch fresh credentials with --id from the server and update the local copy",
+				Destination: &remote,
+			},
+		}, showLabelFlags(&folder, &tags)...),
 		Action: func(_ context.Context, _ *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -546,12 +1007,43 @@ func showCredentials() cli.Command {
 				return nil
 			}
 
-			text, err := app.ShowCredentials.Do(*currentSession)
+			if id == "" && remote {
+				fmt.Println("--remote requires --id")
+
+				return nil
+			}
+
+			var text any
+			var err error
+			if id == "" {
+				var creds []domain.Credentials
+				creds, err = app.ShowCredentials.Do(*currentSession)
+				if err == nil {
+					creds, err = filterLabels(creds, func(v domain.Credentials) uuid.UUID { return v.ID }, folder, tags)
+					if errors.Is(err, domain.ErrFolderNotFound) {
+						return printFilterError(err)
+					}
+				}
+				text = creds
+			} else {
+				var credID uuid.UUID
+				credID, err = parseID(id)
+				if err != nil {
+					fmt.Println(err, "invalid credentials id: ", id)
+
+					return nil
+				}
+				text, err = app.GetCredentials.Do(*currentSession, credID, remote)
+			}
 
 			if err != nil {
-				if errors.Is(err, domain.ErrInvalidToken) {
+				if errors.Is(err, domain.ErrInvalidToken) || errors.Is(err, domain.ErrUnauthorized) {
 					fmt.Println("unauthorized")
 
+					return nil
+				} else if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("credentials not found, id: ", id)
+
 					return nil
 				} else {
 					log.Error(err)
@@ -591,6 +1083,10 @@ func syncCredentials() cli.Command {
 				if errors.Is(err, domain.ErrInvalidToken) {
 					fmt.Println("unauthorized")
 
+					return nil
+				} else if errors.Is(err, domain.ErrPendingOperations) {
+					fmt.Println(err)
+
 					return nil
 				} else {
 					log.Error(err)
@@ -605,15 +1101,60 @@ func syncCredentials() cli.Command {
 	}
 }
 
+func deleteCredentials() cli.Command {
+	return cli.Command{
+		Name:      "credentials",
+		Usage:     "delete existing credentials via id",
+		ArgsUsage: "[id]",
+		Aliases:   []string{"c"},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			id := cmd.Args().First()
+			credID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid credentials id: ", id)
+
+				return nil
+			}
+
+			err = app.DeleteCredentials.Do(*currentSession, credID)
+			if err != nil {
+				if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("credentials not found, id: ", credID)
+
+					return nil
+				} else if errors.Is(err, domain.ErrBadRequest) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("credentials deleted successfully")
+
+			return nil
+		},
+	}
+}
+
 func createBankCard() cli.Command {
-	var meta string
+	var meta, folder string
+	var tags []string
 
 	return cli.Command{
 		Name:      "bank-card",
 		Usage:     "create new bank-card",
 		ArgsUsage: "[number] [valid-thru] [cvv] [(optional) card-holder]",
 		Aliases:   []string{"bc"},
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
 				Name:        "meta",
 				Aliases:     []string{"m"},
@@ -621,7 +1162,7 @@ func createBankCard() cli.Command {
 				DefaultText: "",
 				Destination: &meta,
 			},
-		},
+		}, createLabelFlags(&folder, &tags)...),
 		Action: func(_ context.Context, cmd *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -658,7 +1199,7 @@ func createBankCard() cli.Command {
 				return nil
 			}
 
-			err := app.CreateBankCard.Do(*currentSession, number, validThru, cvv, cardHolder, meta)
+			cardID, err := app.CreateBankCard.Do(*currentSession, number, validThru, cvv, cardHolder, meta)
 			if err != nil {
 				if errors.Is(err, domain.ErrBadRequest) {
 					fmt.Println(err)
@@ -672,21 +1213,24 @@ func createBankCard() cli.Command {
 			}
 
 			fmt.Println("bank-card created successfully")
+			_, err = applyLabels(cmd, domain.BankCardKind, "bank-card", cardID, folder, tags)
 
-			return nil
+			return err
 		},
 	}
 }
 
 func updateBankCard() cli.Command {
-	var number, validThru, cvv, cardHolder, meta string
+	var resolve, number, validThru, cvv, cardHolder, meta, folder string
+	var tags []string
 
 	return cli.Command{
 		Name:      "bank-card",
 		Usage:     "update existing bank-card via id and flags",
 		ArgsUsage: "[id]",
 		Aliases:   []string{"bc"},
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
+			resolveFlag(&resolve),
 			&cli.StringFlag{
 				Name:        "number",
 				Usage:       "number value to update",
@@ -718,7 +1262,7 @@ func updateBankCard() cli.Command {
 				DefaultText: "",
 				Destination: &meta,
 			},
-		},
+		}, updateLabelFlags(&folder, &tags)...),
 		Action: func(_ context.Context, cmd *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -761,22 +1305,37 @@ func updateBankCard() cli.Command {
 				return nil
 			}
 
-			err = app.UpdateBankCard.Do(*currentSession, cardID, number, validThru, cvv, cardHolder, meta)
-			if err != nil {
-				if errors.Is(err, domain.ErrEntityNotFound) {
-					fmt.Println("bank-card not found, id: ", cardID)
+			if !validResolveStrategy(resolve) {
+				fmt.Println("invalid resolve strategy: ", resolve)
 
-					return nil
-				} else if errors.Is(err, domain.ErrBadRequest) {
-					fmt.Println(err)
-
-					return nil
-				} else {
-					log.Error(err)
+				return nil
+			}
 
-					return cli.Exit(err, 1)
+			changed := number != "" || validThru != "" || cvv != "" || cardHolder != "" || meta != ""
+			if changed || !labelsSet(cmd) {
+				err = app.UpdateBankCard.Do(*currentSession, cardID, number, validThru, cvv, cardHolder, meta)
+				if err != nil {
+					if handled, err := handleUpdateConflict(err, resolve, "bank-card"); handled {
+						return err
+					}
+					if errors.Is(err, domain.ErrEntityNotFound) {
+						fmt.Println("bank-card not found, id: ", cardID)
+
+						return nil
+					} else if errors.Is(err, domain.ErrBadRequest) {
+						fmt.Println(err)
+
+						return nil
+					} else {
+						log.Error(err)
+
+						return cli.Exit(err, 1)
+					}
 				}
 			}
+			if ok, err := applyLabels(cmd, domain.BankCardKind, "bank-card", cardID, folder, tags); !ok {
+				return err
+			}
 			fmt.Println("bank-card updated successfully")
 
 			return nil
@@ -785,10 +1344,14 @@ func updateBankCard() cli.Command {
 }
 
 func showBankCards() cli.Command {
+	var folder string
+	var tags []string
+
 	return cli.Command{
 		Name:    "bank-cards",
 		Usage:   "shows current user bank-cards",
 		Aliases: []string{"bc"},
+		Flags:   showLabelFlags(&folder, &tags),
 		Action: func(_ context.Context, _ *cli.Command) error {
 			if currentSession == nil {
 				fmt.Println("unauthorized")
@@ -810,6 +1373,11 @@ func showBankCards() cli.Command {
 				}
 			}
 
+			text, err = filterLabels(text, func(v domain.BankCard) uuid.UUID { return v.ID }, folder, tags)
+			if err != nil {
+				return printFilterError(err)
+			}
+
 			s, err := json.MarshalIndent(text, "", "\t")
 			if err != nil {
 				log.Error(err)
@@ -841,6 +1409,10 @@ func syncBankCards() cli.Command {
 				if errors.Is(err, domain.ErrInvalidToken) {
 					fmt.Println("unauthorized")
 
+					return nil
+				} else if errors.Is(err, domain.ErrPendingOperations) {
+					fmt.Println(err)
+
 					return nil
 				} else {
 					log.Error(err)
@@ -855,6 +1427,50 @@ func syncBankCards() cli.Command {
 	}
 }
 
+func deleteBankCard() cli.Command {
+	return cli.Command{
+		Name:      "bank-card",
+		Usage:     "delete existing bank-card via id",
+		ArgsUsage: "[id]",
+		Aliases:   []string{"bc"},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			id := cmd.Args().First()
+			cardID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid bank-card id: ", id)
+
+				return nil
+			}
+
+			err = app.DeleteBankCard.Do(*currentSession, cardID)
+			if err != nil {
+				if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("bank-card not found, id: ", cardID)
+
+					return nil
+				} else if errors.Is(err, domain.ErrBadRequest) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("bank-card deleted successfully")
+
+			return nil
+		},
+	}
+}
+
 func syncAll() cli.Command {
 	return cli.Command{
 		Name:    "all",
@@ -873,6 +1489,10 @@ func syncAll() cli.Command {
 				if errors.Is(err, domain.ErrInvalidToken) {
 					fmt.Println("unauthorized")
 
+					return nil
+				} else if errors.Is(err, domain.ErrPendingOperations) {
+					fmt.Println(err)
+
 					return nil
 				} else {
 					log.Error(err)
@@ -886,3 +1506,66 @@ func syncAll() cli.Command {
 		},
 	}
 }
+
+func syncPush() cli.Command {
+	var resolve string
+
+	return cli.Command{
+		Name:    "push",
+		Usage:   "push local changes made without server connection",
+		Aliases: []string{"p"},
+		Flags: []cli.Flag{
+			resolveFlag(&resolve),
+		},
+		Action: func(_ context.Context, _ *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			if !validResolveStrategy(resolve) {
+				fmt.Println("invalid resolve strategy: ", resolve)
+
+				return nil
+			}
+
+			total := 0
+			for {
+				pushed, err := app.SyncPush.Do(*currentSession)
+				total += pushed
+
+				var conflict *domain.Conflict
+				if errors.As(err, &conflict) {
+					// Разрешенный конфликт заменяет операцию из журнала, отправка продолжается со следующей операции
+					err = resolveConflict(conflict, resolve)
+					if errors.Is(err, errResolveCanceled) {
+						fmt.Println(err)
+						fmt.Printf("%d changes pushed, conflicting change is kept until resolved\n", total)
+
+						return nil
+					} else if err == nil {
+						total++
+
+						continue
+					}
+				}
+
+				if err != nil {
+					if errors.Is(err, domain.ErrServerUnavailable) {
+						fmt.Printf("server is unavailable, %d changes pushed, try again later\n", total)
+
+						return nil
+					} else {
+						log.Error(err)
+
+						return cli.Exit(err, 1)
+					}
+				}
+				fmt.Printf("%d changes pushed successfully\n", total)
+
+				return nil
+			}
+		},
+	}
+}
@@ -0,0 +1,205 @@
+package presentation
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const (
+	resolveMine   = "mine"
+	resolveTheirs = "theirs"
+	resolveMerge  = "merge"
+)
+
+var input io.Reader = os.Stdin
+
+var errResolveCanceled = errors.New("conflict resolution canceled")
+
+// SetInput - Устанавливает источник ввода для интерактивных диалогов
+func SetInput(r io.Reader) {
+	input = r
+}
+
+func resolveFlag(destination *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name:        "resolve",
+		Aliases:     []string{"r"},
+		Usage:       "(optional) conflict resolution strategy: mine, theirs or merge",
+		DefaultText: "",
+		Destination: destination,
+	}
+}
+
+func validResolveStrategy(strategy string) bool {
+	switch strategy {
+	case "", resolveMine, resolveTheirs, resolveMerge:
+		return true
+	}
+
+	return false
+}
+
+// formatConflictValue - Содержимое бинарных данных выводится в виде размера и SHA-256,
+// закрытый SSH ключ - в виде размера
+func formatConflictValue(kind domain.OperationKind, field, value string) string {
+	switch {
+	case kind == domain.BinaryKind && field == "content":
+		return fmt.Sprintf("<%d bytes, sha256 %x>", len(value), sha256.Sum256([]byte(value)))
+	case kind == domain.SSHKeyKind && field == "private_key":
+		return fmt.Sprintf("<%d bytes>", len(value))
+	}
+
+	return value
+}
+
+func printConflict(conflict *domain.Conflict) {
+	fmt.Printf("%s was changed on another device, server version: %d\n", conflict.Kind, conflict.Version)
+	for _, field := range conflict.Fields {
+		if field.Mine == field.Theirs {
+			continue
+		}
+		fmt.Printf("%s:\n", field.Name)
+		fmt.Println("  base:  ", formatConflictValue(conflict.Kind, field.Name, field.Base))
+		fmt.Println("  mine:  ", formatConflictValue(conflict.Kind, field.Name, field.Mine))
+		fmt.Println("  theirs:", formatConflictValue(conflict.Kind, field.Name, field.Theirs))
+	}
+}
+
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+		return "", errResolveCanceled
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func askStrategy(reader *bufio.Reader, kind domain.OperationKind) (string, error) {
+	for {
+		if kind == domain.BinaryKind {
+			fmt.Print("keep [m]ine or [t]heirs version: ")
+		} else {
+			fmt.Print("keep [m]ine, [t]heirs or [e]dit merged version: ")
+		}
+		answer, err := readLine(reader)
+		if err != nil {
+			return "", err
+		}
+		switch strings.ToLower(strings.TrimSpace(answer)) {
+		case "m", resolveMine:
+			return resolveMine, nil
+		case "t", resolveTheirs:
+			return resolveTheirs, nil
+		case "e", resolveMerge:
+			if kind != domain.BinaryKind {
+				return resolveMerge, nil
+			}
+		}
+	}
+}
+
+// mergeValues - Трехстороннее слияние: изменения только с одной стороны применяются автоматически,
+// для полей, измененных с обеих сторон, значение запрашивается у пользователя
+func mergeValues(reader *bufio.Reader, conflict *domain.Conflict) ([]string, error) {
+	values := make([]string, 0, len(conflict.Fields))
+	for _, field := range conflict.Fields {
+		switch {
+		case field.Mine == field.Theirs || field.Theirs == field.Base:
+			values = append(values, field.Mine)
+		case field.Mine == field.Base:
+			values = append(values, field.Theirs)
+		default:
+			fmt.Printf("%s ([m]ine, [t]heirs or =new value): ", field.Name)
+			answer, err := readLine(reader)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case strings.HasPrefix(answer, "="):
+				values = append(values, strings.TrimPrefix(answer, "="))
+			case strings.TrimSpace(answer) == "t":
+				values = append(values, field.Theirs)
+			default:
+				values = append(values, field.Mine)
+			}
+		}
+	}
+
+	return values, nil
+}
+
+func conflictValues(reader *bufio.Reader, conflict *domain.Conflict, strategy string) ([]string, error) {
+	values := make([]string, 0, len(conflict.Fields))
+	switch strategy {
+	case resolveMine:
+		for _, field := range conflict.Fields {
+			values = append(values, field.Mine)
+		}
+	case resolveTheirs:
+		for _, field := range conflict.Fields {
+			values = append(values, field.Theirs)
+		}
+	default:
+		return mergeValues(reader, conflict)
+	}
+
+	return values, nil
+}
+
+// resolveConflict - Выводит трехстороннее сравнение и сохраняет выбранную пользователем версию данных.
+// Если стратегия не задана, она запрашивается у пользователя
+func resolveConflict(conflict *domain.Conflict, strategy string) error {
+	reader := bufio.NewReader(input)
+	for {
+		printConflict(conflict)
+
+		current := strategy
+		if current == "" || (current == resolveMerge && conflict.Kind == domain.BinaryKind) {
+			var err error
+			current, err = askStrategy(reader, conflict.Kind)
+			if err != nil {
+				return err
+			}
+		}
+
+		values, err := conflictValues(reader, conflict, current)
+		if err != nil {
+			return err
+		}
+
+		err = app.ResolveConflict.Do(*currentSession, conflict, values)
+		if !errors.As(err, &conflict) {
+			return err
+		}
+	}
+}
+
+func handleUpdateConflict(err error, strategy, name string) (bool, error) {
+	var conflict *domain.Conflict
+	if !errors.As(err, &conflict) {
+		return false, nil
+	}
+
+	err = resolveConflict(conflict, strategy)
+	if errors.Is(err, errResolveCanceled) {
+		fmt.Println(err)
+
+		return true, nil
+	} else if err != nil {
+		log.Error(err)
+
+		return true, cli.Exit(err, 1)
+	}
+	fmt.Println(name, "updated successfully")
+
+	return true, nil
+}
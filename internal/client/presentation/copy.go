@@ -0,0 +1,131 @@
+package presentation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// timeoutFlag - Флаг времени, через которое буфер обмена очищается
+func timeoutFlag(defaultTimeout time.Duration, destination *time.Duration) *cli.DurationFlag {
+	return &cli.DurationFlag{
+		Name:        "timeout",
+		Aliases:     []string{"t"},
+		Usage:       "(optional) clear the clipboard after this time if it still holds the secret, 0 disables clearing",
+		Value:       defaultTimeout,
+		Destination: destination,
+	}
+}
+
+// copyAction - Общая логика команд копирования: проверка сессии и идентификатора, вывод результата
+func copyAction(
+	kind string,
+	fields string,
+	timeout *time.Duration,
+	do func(id uuid.UUID) error,
+) cli.ActionFunc {
+	return func(_ context.Context, cmd *cli.Command) error {
+		if currentSession == nil {
+			fmt.Println("unauthorized")
+
+			return nil
+		}
+
+		id := cmd.Args().First()
+		entityID, err := parseID(id)
+		if err != nil {
+			fmt.Println(err, "invalid "+kind+" id: ", id)
+
+			return nil
+		}
+
+		err = do(entityID)
+		if err != nil {
+			if errors.Is(err, domain.ErrInvalidToken) {
+				fmt.Println("unauthorized")
+
+				return nil
+			} else if errors.Is(err, domain.ErrEntityNotFound) {
+				fmt.Println(kind+" not found, id: ", id)
+
+				return nil
+			} else if errors.Is(err, domain.ErrUnknownField) {
+				fmt.Println(err, "expected", fields)
+
+				return nil
+			} else if errors.Is(err, domain.ErrClipboardClearUnsupported) {
+				fmt.Println("copied to clipboard,", err)
+
+				return nil
+			} else {
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+		}
+
+		if *timeout > 0 {
+			fmt.Println("copied to clipboard, it will be cleared in", *timeout)
+		} else {
+			fmt.Println("copied to clipboard")
+		}
+
+		return nil
+	}
+}
+
+func copyCredentials(defaultTimeout time.Duration) cli.Command {
+	var field string
+	var timeout time.Duration
+
+	return cli.Command{
+		Name:      "credentials",
+		Usage:     "copy login or password to the clipboard without printing it",
+		ArgsUsage: "[id]",
+		Aliases:   []string{"c"},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "field",
+				Aliases:     []string{"f"},
+				Usage:       "(optional) field to copy: login or password",
+				Value:       "password",
+				Destination: &field,
+			},
+			timeoutFlag(defaultTimeout, &timeout),
+		},
+		Action: copyAction("credentials", "login or password", &timeout, func(id uuid.UUID) error {
+			return app.CopyCredentials.Do(*currentSession, id, field, timeout)
+		}),
+	}
+}
+
+func copyBankCard(defaultTimeout time.Duration) cli.Command {
+	var field string
+	var timeout time.Duration
+
+	return cli.Command{
+		Name:      "bank-card",
+		Usage:     "copy bank card number, cvv, valid thru or card holder to the clipboard without printing it",
+		ArgsUsage: "[id]",
+		Aliases:   []string{"bc"},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "field",
+				Aliases:     []string{"f"},
+				Usage:       "(optional) field to copy: number, cvv, valid-thru or card-holder",
+				Value:       "number",
+				Destination: &field,
+			},
+			timeoutFlag(defaultTimeout, &timeout),
+		},
+		Action: copyAction("bank card", "number, cvv, valid-thru or card-holder", &timeout, func(id uuid.UUID) error {
+			return app.CopyBankCard.Do(*currentSession, id, field, timeout)
+		}),
+	}
+}
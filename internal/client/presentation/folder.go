@@ -0,0 +1,185 @@
+package presentation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// printFolderContent - Выводит путь папки и таблицу ее содержимого, вложенные папки выводятся первыми
+func printFolderContent(w io.Writer, content domain.FolderContent) error {
+	fmt.Fprintln(w, "/"+content.Path)
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "KIND\tID\tTITLE\tTAGS")
+	for _, v := range content.Folders {
+		fmt.Fprintf(table, "%s\t%s\t%s/\t\n", domain.FolderKind, v.ID, v.Name)
+	}
+	for _, v := range content.Entries {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\n", v.Kind, v.ID, v.Title, strings.Join(v.Tags, ", "))
+	}
+
+	return table.Flush()
+}
+
+func listFolder() cli.Command {
+	return cli.Command{
+		Name:      "ls",
+		Usage:     "list subfolders and data of the folder, the root folder by default",
+		ArgsUsage: "[(optional) path]",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			content, err := app.ListFolder.Do(*currentSession, cmd.Args().First())
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else if errors.Is(err, domain.ErrFolderNotFound) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			if err := printFolderContent(os.Stdout, content); err != nil {
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+}
+
+func makeFolder() cli.Command {
+	var parents bool
+
+	return cli.Command{
+		Name:      "mk",
+		Usage:     "create new folder, requires connection to the server",
+		ArgsUsage: "[path]",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:        "parents",
+				Aliases:     []string{"p"},
+				Usage:       "(optional) create missing parent folders, an existing folder is not an error",
+				Destination: &parents,
+			},
+		},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			_, err := app.CreateFolder.Do(*currentSession, cmd.Args().First(), parents)
+			if err != nil {
+				if isLabelsUserError(err) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("folder created successfully")
+
+			return nil
+		},
+	}
+}
+
+func moveFolder() cli.Command {
+	return cli.Command{
+		Name: "mv",
+		Usage: "move the folder into an existing target folder or rename it to the target path, " +
+			"requires connection to the server",
+		ArgsUsage: "[path] [target]",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			if cmd.Args().Len() != 2 { //nolint: gomnd
+				fmt.Println("invalid input: please pass the folder path and the target path")
+
+				return nil
+			}
+
+			err := app.MoveFolder.Do(*currentSession, cmd.Args().Get(0), cmd.Args().Get(1))
+			if err != nil {
+				if isLabelsUserError(err) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("folder moved successfully")
+
+			return nil
+		},
+	}
+}
+
+func deleteFolder() cli.Command {
+	return cli.Command{
+		Name: "rm",
+		Usage: "delete the folder, its subfolders and data are moved to the parent folder, " +
+			"requires connection to the server",
+		ArgsUsage: "[path]",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			err := app.DeleteFolder.Do(*currentSession, cmd.Args().First())
+			if err != nil {
+				if isLabelsUserError(err) {
+					fmt.Println(err)
+
+					return nil
+				} else if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("folder not found on the server, run sync all")
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("folder deleted successfully")
+
+			return nil
+		},
+	}
+}
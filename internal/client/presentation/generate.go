@@ -0,0 +1,123 @@
+package presentation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/passgen"
+)
+
+// generatorFlags - Значения флагов генератора паролей, общие для generate, create и update credentials.
+// Сгенерированный пароль передается только через passgen.Secret и никогда не пишется в лог
+type generatorFlags struct {
+	length           int64
+	noLower          bool
+	noUpper          bool
+	noDigits         bool
+	noSymbols        bool
+	excludeAmbiguous bool
+	passphrase       bool
+	words            int64
+	separator        string
+}
+
+// flags - Возвращает флаги генератора. Короткие псевдонимы не задаются,
+// чтобы не пересекаться с флагами команд create и update credentials
+func (g *generatorFlags) flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{
+			Name:        "length",
+			Usage:       fmt.Sprintf("(optional) password length from %d to %d", passgen.MinLength, passgen.MaxLength),
+			Value:       passgen.DefaultLength,
+			Destination: &g.length,
+		},
+		&cli.BoolFlag{
+			Name:        "no-lower",
+			Usage:       "(optional) do not use lowercase letters",
+			Destination: &g.noLower,
+		},
+		&cli.BoolFlag{
+			Name:        "no-upper",
+			Usage:       "(optional) do not use uppercase letters",
+			Destination: &g.noUpper,
+		},
+		&cli.BoolFlag{
+			Name:        "no-digits",
+			Usage:       "(optional) do not use digits",
+			Destination: &g.noDigits,
+		},
+		&cli.BoolFlag{
+			Name:        "no-symbols",
+			Usage:       "(optional) do not use symbols",
+			Destination: &g.noSymbols,
+		},
+		&cli.BoolFlag{
+			Name:        "exclude-ambiguous",
+			Usage:       "(optional) exclude look-alike characters Il1|O0o",
+			Destination: &g.excludeAmbiguous,
+		},
+		&cli.BoolFlag{
+			Name:        "passphrase",
+			Usage:       "(optional) generate a diceware passphrase from the embedded wordlist instead of a password",
+			Destination: &g.passphrase,
+		},
+		&cli.IntFlag{
+			Name:        "words",
+			Usage:       fmt.Sprintf("(optional) passphrase words count from %d to %d", passgen.MinWords, passgen.MaxWords),
+			Value:       passgen.DefaultWords,
+			Destination: &g.words,
+		},
+		&cli.StringFlag{
+			Name:        "separator",
+			Usage:       "(optional) passphrase words separator",
+			Value:       passgen.DefaultSeparator,
+			Destination: &g.separator,
+		},
+	}
+}
+
+// generate - Генерирует пароль или фразу по значениям флагов
+func (g *generatorFlags) generate() (passgen.Secret, error) {
+	if g.passphrase {
+		return passgen.Passphrase(int(g.words), g.separator)
+	}
+
+	return passgen.Password(passgen.Options{
+		Length:           int(g.length),
+		Lower:            !g.noLower,
+		Upper:            !g.noUpper,
+		Digits:           !g.noDigits,
+		Symbols:          !g.noSymbols,
+		ExcludeAmbiguous: g.excludeAmbiguous,
+	})
+}
+
+// printEntropy - Выводит оценку энтропии сгенерированного пароля
+func printEntropy(secret passgen.Secret) {
+	fmt.Printf("entropy: ~%.0f bits\n", secret.Entropy)
+}
+
+func generate() cli.Command {
+	var generator generatorFlags
+
+	return cli.Command{
+		Name:  "generate",
+		Usage: "generate a random password or a diceware passphrase",
+		Flags: generator.flags(),
+		Action: func(_ context.Context, _ *cli.Command) error {
+			secret, err := generator.generate()
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+
+			fmt.Println(secret.Value())
+			printEntropy(secret)
+
+			return nil
+		},
+	}
+}
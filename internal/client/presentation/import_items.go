@@ -0,0 +1,117 @@
+package presentation
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/importers"
+)
+
+const (
+	// defaultImportRate - Количество данных, создаваемых в секунду при импорте из другого менеджера паролей
+	defaultImportRate = 10
+	// previewTextLength - Количество символов заметки, которое выводится при предварительном просмотре
+	previewTextLength = 40
+)
+
+// previewText - Возвращает первую строку заметки, обрезанную до previewTextLength символов
+func previewText(content string) string {
+	line, _, _ := strings.Cut(content, "\n")
+	if runes := []rune(line); len(runes) > previewTextLength {
+		return string(runes[:previewTextLength]) + "..."
+	}
+
+	return line
+}
+
+// previewCardNumber - Возвращает последние цифры номера карты
+func previewCardNumber(number string) string {
+	if len(number) <= 4 {
+		return number
+	}
+
+	return "**** " + number[len(number)-4:]
+}
+
+// printImportPreview - Выводит данные, которые будут импортированы, без паролей, номеров карт и CVV
+func printImportPreview(w io.Writer, items importers.Result) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "KIND\tNAME\tLOGIN")
+	for _, v := range items.Texts {
+		fmt.Fprintf(table, "text\t%s\t-\n", previewText(v.Content))
+	}
+	for _, v := range items.Credentials {
+		fmt.Fprintf(table, "credentials\t%s\t%s\n", v.Name, v.Login)
+	}
+	for _, v := range items.BankCards {
+		fmt.Fprintf(table, "bank card\t%s\t%s\n", previewCardNumber(v.Number), v.CardHolder)
+	}
+
+	return table.Flush()
+}
+
+// printSkippedRows - Выводит строки файла, которые не удалось импортировать.
+// Для записей, отклоненных сервером, номер строки неизвестен и не выводится
+func printSkippedRows(skipped []importers.Skipped) {
+	for _, v := range skipped {
+		if v.Row == 0 {
+			fmt.Printf("skipped %q: %s\n", v.Name, v.Reason)
+		} else if v.Name != "" {
+			fmt.Printf("skipped row %d (%s): %s\n", v.Row, v.Name, v.Reason)
+		} else {
+			fmt.Printf("skipped row %d: %s\n", v.Row, v.Reason)
+		}
+	}
+}
+
+// importItems - Разбирает файл экспорта другого менеджера паролей и создает данные
+// или только выводит их при dryRun
+func importItems(source io.Reader, format string, dryRun bool, rate int64) error {
+	if rate <= 0 {
+		fmt.Println("invalid rate value: ", rate)
+
+		return nil
+	}
+
+	items, err := importers.Parse(format, source)
+	if err != nil {
+		if errors.Is(err, importers.ErrUnknownFormat) ||
+			errors.Is(err, importers.ErrInvalidFile) ||
+			errors.Is(err, importers.ErrEncryptedExport) {
+			fmt.Println(err)
+
+			return nil
+		}
+		log.Error(err)
+
+		return cli.Exit(err, 1)
+	}
+
+	if dryRun {
+		if err = printImportPreview(os.Stdout, items); err != nil {
+			log.Error(err)
+
+			return cli.Exit(err, 1)
+		}
+		fmt.Println("would import", formatItemsCount(items.Count()))
+		printSkippedRows(items.Skipped)
+
+		return nil
+	}
+
+	created, skipped, rejected, err := app.ImportItems.Do(*currentSession, items, time.Second/time.Duration(rate))
+	result := printImportResult(created, skipped, err)
+	if err == nil {
+		printSkippedRows(items.Skipped)
+		printSkippedRows(rejected)
+	}
+
+	return result
+}
@@ -0,0 +1,407 @@
+package presentation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+)
+
+const maskedValue = "******"
+
+// isItemUserError - Ошибки описания полей, которые выводятся пользователю без завершения с ошибкой
+func isItemUserError(err error) bool {
+	return errors.Is(err, item.ErrInvalidField) ||
+		errors.Is(err, item.ErrUnknownTemplate) ||
+		errors.Is(err, domain.ErrBadRequest)
+}
+
+// parseItemFields - Разбирает значения флага field: "name=value" задает значение поля шаблона,
+// "name:type=value" описывает поле с типом, которого может не быть в шаблоне
+func parseItemFields(raw []string) (map[string]string, []item.Field, error) {
+	values := map[string]string{}
+	extra := []item.Field{}
+	for _, v := range raw {
+		left, value, found := strings.Cut(v, "=")
+		if !found {
+			return nil, nil, fmt.Errorf("%w: %q must be name=value or name:type=value", item.ErrInvalidField, v)
+		}
+		name, fieldType, typed := strings.Cut(left, ":")
+		if typed {
+			extra = append(extra, item.Field{Name: name, Type: fieldType, Value: value})
+		} else {
+			values[name] = value
+		}
+	}
+
+	return values, extra, nil
+}
+
+// maskItems - Скрывает значения полей типа secret
+func maskItems(items []domain.Item) []domain.Item {
+	masked := make([]domain.Item, 0, len(items))
+	for _, v := range items {
+		v.Fields = slices.Clone(v.Fields)
+		for i := range v.Fields {
+			if v.Fields[i].Type == item.TypeSecret && v.Fields[i].Value != "" {
+				v.Fields[i].Value = maskedValue
+			}
+		}
+		masked = append(masked, v)
+	}
+
+	return masked
+}
+
+// printItemTemplates - Выводит шаблоны с их полями, обязательные поля отмечены звездочкой
+func printItemTemplates(w io.Writer, templates []item.Template) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "TEMPLATE\tFIELDS\tDESCRIPTION")
+	for _, t := range templates {
+		fields := make([]string, 0, len(t.Fields))
+		for _, spec := range t.Fields {
+			field := spec.Name + ":" + spec.Type
+			if spec.Required {
+				field += "*"
+			}
+			fields = append(fields, field)
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\n", t.Name, strings.Join(fields, ", "), t.Description)
+	}
+
+	return table.Flush()
+}
+
+func fieldFlag(destination *[]string, usage string) *cli.StringSliceFlag {
+	return &cli.StringSliceFlag{
+		Name:        "field",
+		Aliases:     []string{"f"},
+		Usage:       usage,
+		Destination: destination,
+	}
+}
+
+func createItem() cli.Command {
+	var template, name, meta, folder string
+	var fields, tags []string
+
+	return cli.Command{
+		Name:  "item",
+		Usage: "create new item with typed fields, optionally from a template, see item template ls",
+		// Значения полей могут содержать запятые, поэтому повторяемые флаги не разделяются по запятой
+		DisableSliceFlagSeparator: true,
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{
+				Name:        "template",
+				Aliases:     []string{"t"},
+				Usage:       "(optional) template name",
+				Value:       item.Custom,
+				Destination: &template,
+			},
+			&cli.StringFlag{
+				Name:        "name",
+				Aliases:     []string{"n"},
+				Usage:       "item name",
+				Destination: &name,
+				Required:    true,
+			},
+			fieldFlag(
+				&fields,
+				"field as name=value for a template field or name:type=value for any field, "+
+					"types: "+strings.Join(item.Types, ", ")+", can be repeated",
+			),
+			&cli.StringFlag{
+				Name:        "meta",
+				Aliases:     []string{"m"},
+				Usage:       "(optional) meta value",
+				Destination: &meta,
+			},
+		}, createLabelFlags(&folder, &tags)...),
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			values, extra, err := parseItemFields(fields)
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+
+			itemID, err := app.CreateItem.Do(*currentSession, name, template, values, extra, meta)
+			if err != nil {
+				if isItemUserError(err) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			fmt.Println("item created successfully")
+			_, err = applyLabels(cmd, domain.ItemKind, "item", itemID, folder, tags)
+
+			return err
+		},
+	}
+}
+
+func updateItem() cli.Command {
+	var resolve, name, meta, folder string
+	var fields, remove, tags []string
+
+	return cli.Command{
+		Name:      "item",
+		Usage:     "update existing item via id and flags",
+		ArgsUsage: "[id]",
+		// Значения полей могут содержать запятые, поэтому повторяемые флаги не разделяются по запятой
+		DisableSliceFlagSeparator: true,
+		Flags: append([]cli.Flag{
+			resolveFlag(&resolve),
+			&cli.StringFlag{
+				Name:        "name",
+				Aliases:     []string{"n"},
+				Usage:       "name value to update",
+				Destination: &name,
+			},
+			fieldFlag(
+				&fields,
+				"field to update as name=value or name:type=value to change the type or add a field, can be repeated",
+			),
+			&cli.StringSliceFlag{
+				Name:        "remove-field",
+				Usage:       "name of the field to remove, template required fields can not be removed, can be repeated",
+				Destination: &remove,
+			},
+			&cli.StringFlag{
+				Name:        "meta",
+				Aliases:     []string{"m"},
+				Usage:       "meta value to update",
+				Destination: &meta,
+			},
+		}, updateLabelFlags(&folder, &tags)...),
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+			id := cmd.Args().First()
+
+			itemID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid item id: ", id)
+
+				return nil
+			}
+
+			changed := name != "" || len(fields) != 0 || len(remove) != 0 || meta != ""
+			if !changed && !labelsSet(cmd) {
+				fmt.Println("invalid input: please pass at least one attribute " +
+					"(name, field, remove-field, meta, folder, tag) to update")
+
+				return nil
+			}
+
+			if !validResolveStrategy(resolve) {
+				fmt.Println("invalid resolve strategy: ", resolve)
+
+				return nil
+			}
+
+			values, extra, err := parseItemFields(fields)
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+
+			if changed {
+				err = app.UpdateItem.Do(*currentSession, itemID, name, values, extra, remove, meta)
+				if err != nil {
+					if handled, err := handleUpdateConflict(err, resolve, "item"); handled {
+						return err
+					}
+					if errors.Is(err, domain.ErrEntityNotFound) {
+						fmt.Println("item not found, id: ", itemID)
+
+						return nil
+					} else if isItemUserError(err) {
+						fmt.Println(err)
+
+						return nil
+					} else {
+						log.Error(err)
+
+						return cli.Exit(err, 1)
+					}
+				}
+			}
+			if ok, err := applyLabels(cmd, domain.ItemKind, "item", itemID, folder, tags); !ok {
+				return err
+			}
+			fmt.Println("item updated successfully")
+
+			return nil
+		},
+	}
+}
+
+func showItems() cli.Command {
+	var folder string
+	var tags []string
+	var reveal bool
+
+	return cli.Command{
+		Name:  "items",
+		Usage: "shows current user items, secret field values are hidden",
+		Flags: append([]cli.Flag{
+			&cli.BoolFlag{
+				Name:        "reveal",
+				Usage:       "(optional) show secret field values",
+				Destination: &reveal,
+			},
+		}, showLabelFlags(&folder, &tags)...),
+		Action: func(_ context.Context, _ *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			items, err := app.ShowItems.Do(*currentSession)
+
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			items, err = filterLabels(items, func(v domain.Item) uuid.UUID { return v.ID }, folder, tags)
+			if err != nil {
+				return printFilterError(err)
+			}
+
+			if !reveal {
+				items = maskItems(items)
+			}
+			s, err := json.MarshalIndent(items, "", "\t")
+			if err != nil {
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+			fmt.Print(string(s))
+
+			return nil
+		},
+	}
+}
+
+func showItemTemplates() cli.Command {
+	return cli.Command{
+		Name:   "item-templates",
+		Usage:  "shows built-in and user item templates and their fields, required fields are marked with *",
+		Action: showTemplates,
+	}
+}
+
+func syncItems() cli.Command {
+	return cli.Command{
+		Name:  "items",
+		Usage: "override current user items from remote",
+		Action: func(_ context.Context, _ *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			err := app.SyncItems.Do(*currentSession)
+
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else if errors.Is(err, domain.ErrPendingOperations) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("items syncronized successfully")
+
+			return nil
+		},
+	}
+}
+
+func deleteItem() cli.Command {
+	return cli.Command{
+		Name:      "item",
+		Usage:     "delete existing item via id",
+		ArgsUsage: "[id]",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			id := cmd.Args().First()
+			itemID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid item id: ", id)
+
+				return nil
+			}
+
+			err = app.DeleteItem.Do(*currentSession, itemID)
+			if err != nil {
+				if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("item not found, id: ", itemID)
+
+					return nil
+				} else if errors.Is(err, domain.ErrBadRequest) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("item deleted successfully")
+
+			return nil
+		},
+	}
+}
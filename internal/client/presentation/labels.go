@@ -0,0 +1,133 @@
+package presentation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// isLabelsUserError - Ошибки папок и меток, которые выводятся пользователю без завершения с ошибкой.
+// Отсутствующие метки создаются на сервере, поэтому без связи с сервером назначить новую метку нельзя
+func isLabelsUserError(err error) bool {
+	return errors.Is(err, domain.ErrFolderNotFound) ||
+		errors.Is(err, domain.ErrFolderCycle) ||
+		errors.Is(err, domain.ErrBadRequest) ||
+		errors.Is(err, domain.ErrServerUnavailable)
+}
+
+func labelFlags(folder *string, tags *[]string, folderUsage, tagUsage string) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "folder",
+			Usage:       folderUsage,
+			Destination: folder,
+		},
+		&cli.StringSliceFlag{
+			Name:        "tag",
+			Usage:       tagUsage,
+			Destination: tags,
+		},
+	}
+}
+
+func createLabelFlags(folder *string, tags *[]string) []cli.Flag {
+	return labelFlags(
+		folder,
+		tags,
+		"(optional) path of an existing folder to put the data into, see folder mk",
+		"(optional) tag name, missing tags are created, can be repeated",
+	)
+}
+
+func updateLabelFlags(folder *string, tags *[]string) []cli.Flag {
+	return labelFlags(
+		folder,
+		tags,
+		"path of an existing folder to move the data into, / moves it to the root",
+		"tag name replacing current tags, can be repeated, an empty value removes all tags",
+	)
+}
+
+func showLabelFlags(folder *string, tags *[]string) []cli.Flag {
+	return labelFlags(
+		folder,
+		tags,
+		"(optional) show only data in the folder and its subfolders",
+		"(optional) show only data with the tag, can be repeated",
+	)
+}
+
+// labelsSet - Проверяет, переданы ли флаги папки или меток
+func labelsSet(cmd *cli.Command) bool {
+	return cmd.IsSet("folder") || cmd.IsSet("tag")
+}
+
+// applyLabels - Перемещает данные в папку и назначает им метки из флагов folder и tag.
+// Метки заменяются, только если флаг tag передан. Возвращает ложь, если изменения не сохранены,
+// ошибки пользователя выводятся без завершения с ошибкой
+func applyLabels(
+	cmd *cli.Command,
+	kind domain.OperationKind,
+	name string,
+	entityID uuid.UUID,
+	folder string,
+	tags []string,
+) (bool, error) {
+	if !cmd.IsSet("tag") {
+		tags = nil
+	} else if tags == nil {
+		tags = []string{}
+	}
+
+	err := app.SaveLabels.Do(*currentSession, kind, entityID, folder, tags)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			fmt.Println(name, "not found, id: ", entityID)
+
+			return false, nil
+		} else if isLabelsUserError(err) {
+			fmt.Println(err)
+
+			return false, nil
+		} else {
+			log.Error(err)
+
+			return false, cli.Exit(err, 1)
+		}
+	}
+
+	return true, nil
+}
+
+// filterLabels - Оставляет данные, которые лежат в папке folder и имеют все метки tags
+func filterLabels[T any](values []T, id func(T) uuid.UUID, folder string, tags []string) ([]T, error) {
+	matched, err := app.FilterLabels.Do(*currentSession, folder, tags)
+	if err != nil || matched == nil {
+		return values, err
+	}
+
+	result := []T{}
+	for _, v := range values {
+		if matched[id(v)] {
+			result = append(result, v)
+		}
+	}
+
+	return result, nil
+}
+
+// printFilterError - Выводит ошибку отбора по папке и меткам
+func printFilterError(err error) error {
+	if errors.Is(err, domain.ErrFolderNotFound) {
+		fmt.Println(err)
+
+		return nil
+	}
+	log.Error(err)
+
+	return cli.Exit(err, 1)
+}
@@ -0,0 +1,427 @@
+package presentation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/totp"
+)
+
+// otpKeyFlags - Флаги параметров генерации кодов, общие для создания и обновления.
+// defaults - параметры, которые используются, если флаг не задан
+func otpKeyFlags(issuer, account, algorithm *string, digits, period *int64, defaults totp.Key) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "issuer",
+			Aliases:     []string{"i"},
+			Usage:       "(optional) service name",
+			Destination: issuer,
+		},
+		&cli.StringFlag{
+			Name:        "account",
+			Aliases:     []string{"a"},
+			Usage:       "(optional) account name in the service",
+			Destination: account,
+		},
+		&cli.StringFlag{
+			Name:        "algorithm",
+			Usage:       "HMAC algorithm: SHA1, SHA256 or SHA512",
+			Value:       defaults.Algorithm,
+			Destination: algorithm,
+		},
+		&cli.IntFlag{
+			Name:        "digits",
+			Usage:       "number of digits in a code",
+			Value:       int64(defaults.Digits),
+			Destination: digits,
+		},
+		&cli.IntFlag{
+			Name:        "period",
+			Usage:       "code lifetime in seconds",
+			Value:       int64(defaults.Period / time.Second),
+			Destination: period,
+		},
+	}
+}
+
+func createOTP() cli.Command {
+	var uri, issuer, account, algorithm, meta, folder string
+	var tags []string
+	var digits, period int64
+
+	return cli.Command{
+		Name:      "otp",
+		Usage:     "create new one-time password secret from a base32 secret or an otpauth:// URI",
+		ArgsUsage: "[secret]",
+		Flags: append(
+			otpKeyFlags(&issuer, &account, &algorithm, &digits, &period, totp.NewKey("", "", "")),
+			append([]cli.Flag{
+				&cli.StringFlag{
+					Name:        "uri",
+					Aliases:     []string{"u"},
+					Usage:       "(optional) otpauth:// URI from a QR code, replaces the secret and other flags",
+					Destination: &uri,
+				},
+				&cli.StringFlag{
+					Name:        "meta",
+					Aliases:     []string{"m"},
+					Usage:       "(optional) meta value",
+					Destination: &meta,
+				},
+			}, createLabelFlags(&folder, &tags)...)...,
+		),
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			var key totp.Key
+			if uri != "" {
+				var err error
+				key, err = totp.ParseURI(uri)
+				if err != nil {
+					fmt.Println(err)
+
+					return nil
+				}
+			} else {
+				secret := cmd.Args().First()
+				if secret == "" {
+					fmt.Println("invalid input: please pass the secret or the otpauth:// URI with --uri")
+
+					return nil
+				}
+				key = totp.NewKey(issuer, account, secret)
+				key.Algorithm = algorithm
+				key.Digits = int(digits)
+				key.Period = time.Duration(period) * time.Second
+			}
+
+			otpID, err := app.CreateOTP.Do(*currentSession, key, meta)
+			if err != nil {
+				if errors.Is(err, totp.ErrInvalidKey) || errors.Is(err, domain.ErrBadRequest) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			fmt.Println("otp created successfully")
+			_, err = applyLabels(cmd, domain.OTPKind, "otp", otpID, folder, tags)
+
+			return err
+		},
+	}
+}
+
+func updateOTP() cli.Command {
+	var resolve, issuer, account, secret, algorithm, meta, folder string
+	var tags []string
+	var digits, period int64
+
+	return cli.Command{
+		Name:      "otp",
+		Usage:     "update existing one-time password secret via id and flags",
+		ArgsUsage: "[id]",
+		Flags: append(
+			otpKeyFlags(&issuer, &account, &algorithm, &digits, &period, totp.Key{}),
+			append([]cli.Flag{
+				resolveFlag(&resolve),
+				&cli.StringFlag{
+					Name:        "secret",
+					Aliases:     []string{"s"},
+					Usage:       "base32 secret value to update",
+					Destination: &secret,
+				},
+				&cli.StringFlag{
+					Name:        "meta",
+					Aliases:     []string{"m"},
+					Usage:       "meta value to update",
+					Destination: &meta,
+				},
+			}, updateLabelFlags(&folder, &tags)...)...,
+		),
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+			id := cmd.Args().First()
+
+			otpID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid otp id: ", id)
+
+				return nil
+			}
+
+			if cmd.NumFlags() == 0 {
+				fmt.Println("invalid input: please pass at least one attribute " +
+					"(issuer, account, secret, algorithm, digits, period, meta) to update")
+
+				return nil
+			}
+
+			if !validResolveStrategy(resolve) {
+				fmt.Println("invalid resolve strategy: ", resolve)
+
+				return nil
+			}
+
+			changed := issuer != "" || account != "" || secret != "" || algorithm != "" ||
+				digits != 0 || period != 0 || meta != ""
+			if changed || !labelsSet(cmd) {
+				err = app.UpdateOTP.Do(*currentSession, otpID, issuer, account, secret, algorithm, int(digits), int(period), meta)
+				if err != nil {
+					if handled, err := handleUpdateConflict(err, resolve, "otp"); handled {
+						return err
+					}
+					if errors.Is(err, domain.ErrEntityNotFound) {
+						fmt.Println("otp not found, id: ", otpID)
+
+						return nil
+					} else if errors.Is(err, totp.ErrInvalidKey) || errors.Is(err, domain.ErrBadRequest) {
+						fmt.Println(err)
+
+						return nil
+					} else {
+						log.Error(err)
+
+						return cli.Exit(err, 1)
+					}
+				}
+			}
+			if ok, err := applyLabels(cmd, domain.OTPKind, "otp", otpID, folder, tags); !ok {
+				return err
+			}
+			fmt.Println("otp updated successfully")
+
+			return nil
+		},
+	}
+}
+
+func showOTPs() cli.Command {
+	var folder string
+	var tags []string
+
+	return cli.Command{
+		Name:  "otps",
+		Usage: "shows current user one-time password secrets",
+		Flags: showLabelFlags(&folder, &tags),
+		Action: func(_ context.Context, _ *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			otps, err := app.ShowOTPs.Do(*currentSession)
+
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			otps, err = filterLabels(otps, func(v domain.OTP) uuid.UUID { return v.ID }, folder, tags)
+			if err != nil {
+				return printFilterError(err)
+			}
+
+			s, err := json.MarshalIndent(otps, "", "\t")
+			if err != nil {
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+			fmt.Print(string(s))
+
+			return nil
+		},
+	}
+}
+
+func syncOTPs() cli.Command {
+	return cli.Command{
+		Name:  "otps",
+		Usage: "override current user one-time password secrets from remote",
+		Action: func(_ context.Context, _ *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			err := app.SyncOTPs.Do(*currentSession)
+
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else if errors.Is(err, domain.ErrPendingOperations) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("otps syncronized successfully")
+
+			return nil
+		},
+	}
+}
+
+func deleteOTP() cli.Command {
+	return cli.Command{
+		Name:      "otp",
+		Usage:     "delete existing one-time password secret via id",
+		ArgsUsage: "[id]",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			id := cmd.Args().First()
+			otpID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid otp id: ", id)
+
+				return nil
+			}
+
+			err = app.DeleteOTP.Do(*currentSession, otpID)
+			if err != nil {
+				if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("otp not found, id: ", otpID)
+
+					return nil
+				} else if errors.Is(err, domain.ErrBadRequest) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("otp deleted successfully")
+
+			return nil
+		},
+	}
+}
+
+// printOTPCodes - Выводит таблицу секретов с текущими кодами, секрет с неверными параметрами выводится без кода
+func printOTPCodes(w io.Writer, otps []domain.OTP, now time.Time) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "ID\tNAME\tCODE\tREMAINING")
+	for _, v := range otps {
+		code, remaining := "-", "-"
+		if key, err := v.Key(); err == nil {
+			if code, err = key.Code(now); err == nil {
+				remaining = key.Remaining(now).String()
+			}
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\n", v.ID, v.Title(), code, remaining)
+	}
+
+	return table.Flush()
+}
+
+// otpCode - Команда otp: выводит текущий код и оставшееся время действия, без идентификатора выводит коды всех секретов
+func otpCode() cli.Command {
+	return cli.Command{
+		Name:      "otp",
+		Usage:     "print the current one-time password and the seconds remaining, all codes without id",
+		ArgsUsage: "[(optional) id]",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			now := time.Now()
+			id := cmd.Args().First()
+			if id == "" {
+				otps, err := app.ShowOTPs.Do(*currentSession)
+				if err != nil {
+					if errors.Is(err, domain.ErrInvalidToken) {
+						fmt.Println("unauthorized")
+
+						return nil
+					}
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+
+				return printOTPCodes(os.Stdout, otps, now)
+			}
+
+			otpID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid otp id: ", id)
+
+				return nil
+			}
+
+			otp, code, remaining, err := app.OTPCode.Do(*currentSession, otpID, now)
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("otp not found, id: ", otpID)
+
+					return nil
+				} else if errors.Is(err, totp.ErrInvalidKey) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			fmt.Printf("%s %s (%d seconds remaining)\n", otp.Title(), code, int(remaining.Seconds()))
+
+			return nil
+		},
+	}
+}
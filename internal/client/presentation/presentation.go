@@ -1,6 +1,8 @@
 package presentation
 
 import (
+	"time"
+
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v3"
 
@@ -20,6 +22,8 @@ func New(
 	_app *application.Application,
 	_log *logrus.Logger,
 	_sessionRepository domain.SessionRepositoryInterface,
+	unlockTimeout time.Duration,
+	clipboardTimeout time.Duration,
 ) *cli.Command {
 	var err error
 	app = _app
@@ -35,79 +39,217 @@ func New(
 
 	cmdRegistration := registration()
 	cmdLogin := login()
+	cmdEnrollTwoFactor := enrollTwoFactor()
+	cmdDisableTwoFactor := disableTwoFactor()
 
 	cmdCreateText := createText()
 	cmdUpdateText := updateText()
 	cmdShowText := showText()
 	cmdSyncText := syncText()
+	cmdDeleteText := deleteText()
 
 	cmdCreateBinary := createBinary()
 	cmdUpdateBinary := updateBinary()
 	cmdShowBinary := showBinary()
 	cmdSyncBinary := syncBinary()
+	cmdDeleteBinary := deleteBinary()
+	cmdDownloadBinary := downloadBinary()
+	cmdExportBinary := exportBinary()
 
 	cmdCreateCredentials := createCredentials()
 	cmdUpdateCredentials := updateCredentials()
 	cmdShowCredentials := showCredentials()
 	cmdSyncCredentials := syncCredentials()
+	cmdDeleteCredentials := deleteCredentials()
 
 	cmdCreateBankCard := createBankCard()
 	cmdUpdateBankCard := updateBankCard()
 	cmdShowBankCard := showBankCards()
 	cmdSyncBankCards := syncBankCards()
+	cmdDeleteBankCard := deleteBankCard()
+
+	cmdCreateOTP := createOTP()
+	cmdUpdateOTP := updateOTP()
+	cmdShowOTPs := showOTPs()
+	cmdSyncOTPs := syncOTPs()
+	cmdDeleteOTP := deleteOTP()
+	cmdOTPCode := otpCode()
+
+	cmdCreateSSHKey := createSSHKey()
+	cmdUpdateSSHKey := updateSSHKey()
+	cmdShowSSHKeys := showSSHKeys()
+	cmdSyncSSHKeys := syncSSHKeys()
+	cmdDeleteSSHKey := deleteSSHKey()
+	cmdSSHAgent := sshAgent()
+
+	cmdCreateItem := createItem()
+	cmdUpdateItem := updateItem()
+	cmdShowItems := showItems()
+	cmdShowItemTemplates := showItemTemplates()
+	cmdSyncItems := syncItems()
+	cmdDeleteItem := deleteItem()
+
+	cmdAddTemplate := addTemplate()
+	cmdListTemplates := listTemplates()
+	cmdDeleteTemplate := deleteTemplate()
+
+	cmdListFolder := listFolder()
+	cmdMakeFolder := makeFolder()
+	cmdMoveFolder := moveFolder()
+	cmdDeleteFolder := deleteFolder()
 
 	cmdSyncAll := syncAll()
+	cmdSyncPush := syncPush()
+
+	cmdSearch := search()
+	cmdGenerate := generate()
+	cmdAudit := audit()
+
+	cmdExportVault := exportVault(&cmdExportBinary)
+	cmdImportVault := importVault()
+
+	cmdCopyCredentials := copyCredentials(clipboardTimeout)
+	cmdCopyBankCard := copyBankCard(clipboardTimeout)
+
+	cmdUnlock := unlock(unlockTimeout)
+	cmdLock := lock()
 
 	cmd := cli.Command{
 		Name:                  "gophkeeper",
 		Version:               version + ", build at: " + buildDate,
 		Usage:                 "Password and user data manager",
 		EnableShellCompletion: true,
+		Before:                checkLocked,
 		Commands: []*cli.Command{
+			&cmdUnlock,
+			&cmdLock,
 			&cmdRegistration,
 			&cmdLogin,
+			&cmdSearch,
+			&cmdGenerate,
+			&cmdAudit,
+			&cmdOTPCode,
+			&cmdSSHAgent,
+			{
+				Name:  "2fa",
+				Usage: "enable or disable two-factor authentication",
+				Commands: []*cli.Command{
+					&cmdEnrollTwoFactor,
+					&cmdDisableTwoFactor,
+				},
+			},
 			{
 				Name:    "create",
-				Usage:   "create text, binary, credentials or bank-cards",
+				Usage:   "create text, binary, credentials, bank-cards, otp, ssh-key or item",
 				Aliases: []string{"c"},
 				Commands: []*cli.Command{
 					&cmdCreateText,
 					&cmdCreateBinary,
 					&cmdCreateCredentials,
 					&cmdCreateBankCard,
+					&cmdCreateOTP,
+					&cmdCreateSSHKey,
+					&cmdCreateItem,
 				},
 			},
 			{
 				Name:    "update",
-				Usage:   "update text, binary, credentials or bank-cards",
+				Usage:   "update text, binary, credentials, bank-cards, otp, ssh-key or item",
 				Aliases: []string{"u"},
 				Commands: []*cli.Command{
 					&cmdUpdateText,
 					&cmdUpdateBinary,
 					&cmdUpdateCredentials,
 					&cmdUpdateBankCard,
+					&cmdUpdateOTP,
+					&cmdUpdateSSHKey,
+					&cmdUpdateItem,
 				},
 			},
 			{
 				Name:    "show",
-				Usage:   "show local texts, binaries, credentials or bank-cards",
+				Usage:   "show local texts, binaries, credentials, bank-cards, otps, ssh-keys, items or item templates",
 				Aliases: []string{"s"},
 				Commands: []*cli.Command{
 					&cmdShowText,
 					&cmdShowBinary,
 					&cmdShowCredentials,
 					&cmdShowBankCard,
+					&cmdShowOTPs,
+					&cmdShowSSHKeys,
+					&cmdShowItems,
+					&cmdShowItemTemplates,
+				},
+			},
+			{
+				Name:    "delete",
+				Usage:   "delete text, binary, credentials, bank-cards, otp, ssh-key or item",
+				Aliases: []string{"d"},
+				Commands: []*cli.Command{
+					&cmdDeleteText,
+					&cmdDeleteBinary,
+					&cmdDeleteCredentials,
+					&cmdDeleteBankCard,
+					&cmdDeleteOTP,
+					&cmdDeleteSSHKey,
+					&cmdDeleteItem,
+				},
+			},
+			{
+				Name:  "item",
+				Usage: "manage item templates",
+				Commands: []*cli.Command{
+					{
+						Name:  "template",
+						Usage: "list, create or delete item templates",
+						Commands: []*cli.Command{
+							&cmdAddTemplate,
+							&cmdListTemplates,
+							&cmdDeleteTemplate,
+						},
+					},
+				},
+			},
+			{
+				Name:  "folder",
+				Usage: "list, create, move or delete folders",
+				Commands: []*cli.Command{
+					&cmdListFolder,
+					&cmdMakeFolder,
+					&cmdMoveFolder,
+					&cmdDeleteFolder,
+				},
+			},
+			{
+				Name:  "copy",
+				Usage: "copy a credentials or bank-card secret to the clipboard and clear it after a timeout",
+				Commands: []*cli.Command{
+					&cmdCopyCredentials,
+					&cmdCopyBankCard,
+				},
+			},
+			{
+				Name:  "download",
+				Usage: "save binary content to file",
+				Commands: []*cli.Command{
+					&cmdDownloadBinary,
 				},
 			},
+			&cmdExportVault,
+			&cmdImportVault,
 			{
 				Name:  "sync",
-				Usage: "manual override local data for text, binary, credentials or bank-cards",
+				Usage: "manual override local data for text, binary, credentials, bank-cards, otps, ssh-keys or items or push local changes",
 				Commands: []*cli.Command{
 					&cmdSyncText,
 					&cmdSyncBinary,
 					&cmdSyncCredentials,
 					&cmdSyncBankCards,
+					&cmdSyncOTPs,
+					&cmdSyncSSHKeys,
+					&cmdSyncItems,
 					&cmdSyncAll,
+					&cmdSyncPush,
 				},
 			},
 		},
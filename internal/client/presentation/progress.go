@@ -0,0 +1,80 @@
+package presentation
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// progressWidth - Ширина полосы прогресса в символах
+const progressWidth = 30
+
+// progressOutput - Вывод полосы прогресса, отделен от основного вывода команд
+var progressOutput io.Writer = os.Stderr
+
+// progressBar - Полоса прогресса передачи данных, перерисовывается в одной строке
+type progressBar struct {
+	total   int64
+	percent int
+	drawn   bool
+}
+
+func newProgressBar(total int64) *progressBar {
+	return &progressBar{total: total, percent: -1}
+}
+
+// update - Перерисовывает полосу, если процент выполнения изменился
+func (p *progressBar) update(done int64) {
+	percent := 100
+	if p.total > 0 && done < p.total {
+		percent = int(done * 100 / p.total)
+	}
+	if percent == p.percent {
+		return
+	}
+	p.percent = percent
+	p.drawn = true
+	filled := percent * progressWidth / 100
+	fmt.Fprintf(
+		progressOutput,
+		"\r[%s%s] %3d%% %s / %s",
+		strings.Repeat("#", filled),
+		strings.Repeat(".", progressWidth-filled),
+		percent,
+		formatSize(done),
+		formatSize(p.total),
+	)
+}
+
+// finish - Завершает строку полосы прогресса
+func (p *progressBar) finish() {
+	if p.drawn {
+		fmt.Fprintln(progressOutput)
+	}
+}
+
+// formatSize - Возвращает размер в удобных для чтения единицах
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	value := float64(size)
+	suffixes := []string{"KiB", "MiB", "GiB", "TiB"}
+	suffix := ""
+	for _, s := range suffixes {
+		value /= unit
+		suffix = s
+		if value < unit {
+			break
+		}
+	}
+
+	return fmt.Sprintf("%.1f %s", value, suffix)
+}
+
+// SetProgressOutput - Устанавливает вывод полосы прогресса
+func SetProgressOutput(w io.Writer) {
+	progressOutput = w
+}
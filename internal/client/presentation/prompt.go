@@ -0,0 +1,31 @@
+package presentation
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// readSecret - Запрашивает секрет без отображения вводимых символов, если ввод выполняется с терминала,
+// иначе читает строку из источника ввода, например из перенаправленного stdin
+func readSecret(reader *bufio.Reader, prompt string) (string, error) {
+	fmt.Print(prompt)
+	if file, ok := input.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+		secret, err := term.ReadPassword(int(file.Fd()))
+		fmt.Println()
+
+		return string(secret), err
+	}
+
+	line, err := reader.ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+		return "", io.EOF
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,114 @@
+package presentation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// searchKinds - Значения флага --kind и соответствующие им типы данных
+var searchKinds = map[string]domain.OperationKind{
+	"text":        domain.TextKind,
+	"binary":      domain.BinaryKind,
+	"credentials": domain.CredentialsKind,
+	"bank-card":   domain.BankCardKind,
+}
+
+// parseSearchKinds - Преобразует значения флага --kind в типы данных
+func parseSearchKinds(values []string) ([]domain.OperationKind, error) {
+	kinds := []domain.OperationKind{}
+	for _, v := range values {
+		kind, ok := searchKinds[v]
+		if !ok {
+			return kinds, fmt.Errorf("unknown kind %q, expected text, binary, credentials or bank-card", v)
+		}
+		kinds = append(kinds, kind)
+	}
+
+	return kinds, nil
+}
+
+// printSearchResults - Выводит найденные данные в виде таблицы в порядке убывания оценки
+func printSearchResults(w io.Writer, results []domain.SearchResult) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "KIND\tID\tTITLE\tMATCHED")
+	for _, v := range results {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\n", v.Kind, v.ID, v.Title, v.Field)
+	}
+
+	return table.Flush()
+}
+
+func search() cli.Command {
+	var kinds []string
+
+	return cli.Command{
+		Name:      "search",
+		Usage:     "fuzzy search local data by name, login, meta, file name or text content",
+		ArgsUsage: "[query]",
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:        "kind",
+				Aliases:     []string{"k"},
+				Usage:       "(optional) search only this kind of data: text, binary, credentials or bank-card, can be repeated",
+				Destination: &kinds,
+			},
+		},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			query := strings.Join(cmd.Args().Slice(), " ")
+			if strings.TrimSpace(query) == "" {
+				fmt.Println("search query is required")
+
+				return nil
+			}
+
+			selected, err := parseSearchKinds(kinds)
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+
+			results, err := app.Search.Do(*currentSession, query, selected)
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			if len(results) == 0 {
+				fmt.Println("nothing found")
+
+				return nil
+			}
+
+			if err := printSearchResults(os.Stdout, results); err != nil {
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+}
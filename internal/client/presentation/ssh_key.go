@@ -0,0 +1,477 @@
+package presentation
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/sshkey"
+)
+
+const (
+	sshAgentSocketFileMode = 0600
+	sshAgentSocketName     = "agent.sock"
+)
+
+// isSSHKeyUserError - Ошибки разбора ключа, которые выводятся пользователю без завершения с ошибкой
+func isSSHKeyUserError(err error) bool {
+	return errors.Is(err, sshkey.ErrInvalidKey) ||
+		errors.Is(err, sshkey.ErrPassphraseRequired) ||
+		errors.Is(err, sshkey.ErrWrongPassphrase) ||
+		errors.Is(err, domain.ErrBadRequest)
+}
+
+// readPrivateKey - Читает закрытый ключ из файла
+func readPrivateKey(path string) (string, error) {
+	content, err := os.ReadFile(path) //nolint: gosec
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+func createSSHKey() cli.Command {
+	var passphrase, comment, meta, folder string
+	var tags []string
+
+	return cli.Command{
+		Name:      "ssh-key",
+		Usage:     "create new ssh key from a private key file, the public key and the fingerprint are derived from it",
+		ArgsUsage: "[path-to-private-key]",
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{
+				Name:        "passphrase",
+				Aliases:     []string{"p"},
+				Usage:       "(optional) passphrase of a protected private key in the legacy PEM format, it is not stored",
+				Destination: &passphrase,
+			},
+			&cli.StringFlag{
+				Name:        "comment",
+				Aliases:     []string{"c"},
+				Usage:       "(optional) key comment, for example user@host",
+				Destination: &comment,
+			},
+			&cli.StringFlag{
+				Name:        "meta",
+				Aliases:     []string{"m"},
+				Usage:       "(optional) meta value",
+				Destination: &meta,
+			},
+		}, createLabelFlags(&folder, &tags)...),
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			path := cmd.Args().First()
+			if path == "" {
+				fmt.Println("invalid input: please pass the private key file path")
+
+				return nil
+			}
+			privateKey, err := readPrivateKey(path)
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+
+			keyID, err := app.CreateSSHKey.Do(*currentSession, privateKey, passphrase, comment, meta)
+			if err != nil {
+				if isSSHKeyUserError(err) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			fmt.Println("ssh key created successfully")
+			_, err = applyLabels(cmd, domain.SSHKeyKind, "ssh key", keyID, folder, tags)
+
+			return err
+		},
+	}
+}
+
+func updateSSHKey() cli.Command {
+	var resolve, file, passphrase, comment, meta, folder string
+	var tags []string
+
+	return cli.Command{
+		Name:      "ssh-key",
+		Usage:     "update existing ssh key via id and flags",
+		ArgsUsage: "[id]",
+		Flags: append([]cli.Flag{
+			resolveFlag(&resolve),
+			&cli.StringFlag{
+				Name:        "file",
+				Aliases:     []string{"f"},
+				Usage:       "private key file path to replace the key",
+				Destination: &file,
+			},
+			&cli.StringFlag{
+				Name:        "passphrase",
+				Aliases:     []string{"p"},
+				Usage:       "(optional) passphrase of a protected private key in the legacy PEM format, it is not stored",
+				Destination: &passphrase,
+			},
+			&cli.StringFlag{
+				Name:        "comment",
+				Aliases:     []string{"c"},
+				Usage:       "comment value to update",
+				Destination: &comment,
+			},
+			&cli.StringFlag{
+				Name:        "meta",
+				Aliases:     []string{"m"},
+				Usage:       "meta value to update",
+				Destination: &meta,
+			},
+		}, updateLabelFlags(&folder, &tags)...),
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+			id := cmd.Args().First()
+
+			keyID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid ssh key id: ", id)
+
+				return nil
+			}
+
+			changed := file != "" || comment != "" || meta != ""
+			if !changed && !labelsSet(cmd) {
+				fmt.Println("invalid input: please pass at least one attribute (file, comment, meta, folder, tag) to update")
+
+				return nil
+			}
+
+			if !validResolveStrategy(resolve) {
+				fmt.Println("invalid resolve strategy: ", resolve)
+
+				return nil
+			}
+
+			privateKey := ""
+			if file != "" {
+				privateKey, err = readPrivateKey(file)
+				if err != nil {
+					fmt.Println(err)
+
+					return nil
+				}
+			}
+
+			if changed {
+				err = app.UpdateSSHKey.Do(*currentSession, keyID, privateKey, passphrase, comment, meta)
+				if err != nil {
+					if handled, err := handleUpdateConflict(err, resolve, "ssh key"); handled {
+						return err
+					}
+					if errors.Is(err, domain.ErrEntityNotFound) {
+						fmt.Println("ssh key not found, id: ", keyID)
+
+						return nil
+					} else if isSSHKeyUserError(err) {
+						fmt.Println(err)
+
+						return nil
+					} else {
+						log.Error(err)
+
+						return cli.Exit(err, 1)
+					}
+				}
+			}
+			if ok, err := applyLabels(cmd, domain.SSHKeyKind, "ssh key", keyID, folder, tags); !ok {
+				return err
+			}
+			fmt.Println("ssh key updated successfully")
+
+			return nil
+		},
+	}
+}
+
+func showSSHKeys() cli.Command {
+	var folder string
+	var tags []string
+
+	return cli.Command{
+		Name:  "ssh-keys",
+		Usage: "shows current user ssh keys",
+		Flags: showLabelFlags(&folder, &tags),
+		Action: func(_ context.Context, _ *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			keys, err := app.ShowSSHKeys.Do(*currentSession)
+
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+
+			keys, err = filterLabels(keys, func(v domain.SSHKey) uuid.UUID { return v.ID }, folder, tags)
+			if err != nil {
+				return printFilterError(err)
+			}
+
+			s, err := json.MarshalIndent(keys, "", "\t")
+			if err != nil {
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+			fmt.Print(string(s))
+
+			return nil
+		},
+	}
+}
+
+func syncSSHKeys() cli.Command {
+	return cli.Command{
+		Name:  "ssh-keys",
+		Usage: "override current user ssh keys from remote",
+		Action: func(_ context.Context, _ *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			err := app.SyncSSHKeys.Do(*currentSession)
+
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				} else if errors.Is(err, domain.ErrPendingOperations) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("ssh keys syncronized successfully")
+
+			return nil
+		},
+	}
+}
+
+func deleteSSHKey() cli.Command {
+	return cli.Command{
+		Name:      "ssh-key",
+		Usage:     "delete existing ssh key via id",
+		ArgsUsage: "[id]",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			id := cmd.Args().First()
+			keyID, err := parseID(id)
+			if err != nil {
+				fmt.Println(err, "invalid ssh key id: ", id)
+
+				return nil
+			}
+
+			err = app.DeleteSSHKey.Do(*currentSession, keyID)
+			if err != nil {
+				if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("ssh key not found, id: ", keyID)
+
+					return nil
+				} else if errors.Is(err, domain.ErrBadRequest) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("ssh key deleted successfully")
+
+			return nil
+		},
+	}
+}
+
+// loadAgentKeys - Расшифровывает ключи хранилища и добавляет их в агент.
+// Для защищенных ключей запрашивается парольная фраза, пустая фраза пропускает ключ
+func loadAgentKeys(a *sshkey.Agent, keys []domain.SSHKey, reader *bufio.Reader) int {
+	loaded := 0
+	for _, v := range keys {
+		raw, err := sshkey.PrivateKey(v.PrivateKey, "")
+		if errors.Is(err, sshkey.ErrPassphraseRequired) {
+			fmt.Printf("passphrase for %s (empty to skip): ", v.Title())
+			passphrase, _ := readLine(reader)
+			if passphrase == "" {
+				continue
+			}
+			raw, err = sshkey.PrivateKey(v.PrivateKey, passphrase)
+		}
+		if err == nil {
+			err = a.AddKey(raw, v.Title())
+		}
+		if err != nil {
+			fmt.Println("skip ssh key", v.ID, ":", err)
+
+			continue
+		}
+		loaded++
+	}
+
+	return loaded
+}
+
+// sshAgentDir - Создает каталог для сокета агента по умолчанию с правами 0700 в $XDG_RUNTIME_DIR,
+// если он задан, иначе во временном каталоге системы. Имя каталога случайное, поэтому другой пользователь
+// системы не может заранее занять путь сокета или подключиться к нему до смены прав
+func sshAgentDir() (string, error) {
+	return os.MkdirTemp(os.Getenv("XDG_RUNTIME_DIR"), "gophkeeper-ssh-agent-")
+}
+
+// sshAgent - Команда ssh-agent: выдает подписи ключами из хранилища по протоколу ssh-agent, пока ее не остановят.
+// Ключи расшифровываются только в памяти процесса, каждая подпись подтверждается пользователем
+func sshAgent() cli.Command {
+	var socket string
+
+	return cli.Command{
+		Name:  "ssh-agent",
+		Usage: "serve ssh keys to ssh clients over SSH_AUTH_SOCK, each signature must be confirmed, stop with Ctrl+C",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "socket",
+				Aliases:     []string{"s"},
+				Usage:       "(optional) unix socket path, by default the socket is created in a new private directory",
+				Destination: &socket,
+			},
+		},
+		Action: func(ctx context.Context, _ *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			keys, err := app.ShowSSHKeys.Do(*currentSession)
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidToken) {
+					fmt.Println("unauthorized")
+
+					return nil
+				}
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+
+			reader := bufio.NewReader(input)
+			agent := sshkey.NewAgent(func(comment, fingerprint string) bool {
+				fmt.Printf("allow signature with %s (%s)? [y/N]: ", comment, fingerprint)
+				// Конец ввода считается отказом
+				answer, _ := readLine(reader)
+
+				return strings.EqualFold(strings.TrimSpace(answer), "y")
+			})
+			if loadAgentKeys(agent, keys, reader) == 0 {
+				fmt.Println("no ssh keys to serve")
+
+				return nil
+			}
+
+			path := socket
+			if path == "" {
+				dir, err := sshAgentDir()
+				if err != nil {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+				defer func() {
+					if removeErr := os.RemoveAll(dir); removeErr != nil {
+						log.Error(removeErr)
+					}
+				}()
+				path = filepath.Join(dir, sshAgentSocketName)
+			} else if info, err := os.Lstat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+				// Сокет остался от предыдущего запуска агента, другие файлы не удаляются
+				_ = os.Remove(path)
+			}
+			listener, err := net.Listen("unix", path)
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+			if err = os.Chmod(path, sshAgentSocketFileMode); err != nil {
+				_ = listener.Close()
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			go func() {
+				<-ctx.Done()
+				_ = listener.Close()
+			}()
+
+			fmt.Printf("SSH_AUTH_SOCK=%s; export SSH_AUTH_SOCK;\n", path)
+			if err = agent.Serve(listener); err != nil {
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+			fmt.Println("ssh agent stopped")
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,156 @@
+package presentation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+)
+
+// isTemplateUserError - Ошибки работы с шаблонами, которые выводятся пользователю без завершения с ошибкой
+func isTemplateUserError(err error) bool {
+	return isItemUserError(err) || errors.Is(err, domain.ErrServerUnavailable)
+}
+
+// parseFieldSpecs - Разбирает значения флага field вида "name:type", звездочка в конце отмечает обязательное поле
+func parseFieldSpecs(raw []string) ([]item.FieldSpec, error) {
+	specs := make([]item.FieldSpec, 0, len(raw))
+	for _, v := range raw {
+		spec, required := strings.CutSuffix(v, "*")
+		name, fieldType, found := strings.Cut(spec, ":")
+		if !found {
+			return nil, fmt.Errorf("%w: %q must be name:type or name:type*", item.ErrInvalidField, v)
+		}
+		specs = append(specs, item.FieldSpec{Name: name, Type: fieldType, Required: required})
+	}
+
+	return specs, nil
+}
+
+// showTemplates - Выводит встроенные и пользовательские шаблоны, используется командами item template ls
+// и show item-templates
+func showTemplates(_ context.Context, _ *cli.Command) error {
+	if currentSession == nil {
+		fmt.Println("unauthorized")
+
+		return nil
+	}
+
+	templates, err := app.ShowTemplates.Do(*currentSession)
+	if err != nil {
+		log.Error(err)
+
+		return cli.Exit(err, 1)
+	}
+	if err := printItemTemplates(os.Stdout, templates); err != nil {
+		log.Error(err)
+
+		return cli.Exit(err, 1)
+	}
+
+	return nil
+}
+
+func addTemplate() cli.Command {
+	var description string
+	var fields []string
+
+	return cli.Command{
+		Name:      "add",
+		Usage:     "create new item template, requires connection to the server",
+		ArgsUsage: "[name]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "description",
+				Aliases:     []string{"d"},
+				Usage:       "(optional) template description",
+				Destination: &description,
+			},
+			fieldFlag(
+				&fields,
+				"template field as name:type, a trailing * marks the field as required, "+
+					"types: "+strings.Join(item.Types, ", "),
+			),
+		},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			specs, err := parseFieldSpecs(fields)
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+
+			_, err = app.CreateTemplate.Do(*currentSession, cmd.Args().First(), description, specs)
+			if err != nil {
+				if isTemplateUserError(err) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("template created successfully")
+
+			return nil
+		},
+	}
+}
+
+func listTemplates() cli.Command {
+	return cli.Command{
+		Name:   "ls",
+		Usage:  "shows built-in and user item templates and their fields, required fields are marked with *",
+		Action: showTemplates,
+	}
+}
+
+func deleteTemplate() cli.Command {
+	return cli.Command{
+		Name: "rm",
+		Usage: "delete the user item template, items created from it keep their fields, " +
+			"requires connection to the server",
+		ArgsUsage: "[name]",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			err := app.DeleteTemplate.Do(*currentSession, cmd.Args().First())
+			if err != nil {
+				if isTemplateUserError(err) {
+					fmt.Println(err)
+
+					return nil
+				} else if errors.Is(err, domain.ErrEntityNotFound) {
+					fmt.Println("template not found on the server, run sync all")
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			fmt.Println("template deleted successfully")
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,255 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+func createAuditData(userID uuid.UUID) (weak, reused, strong, expired, expiring uuid.UUID, err error) {
+	weak = uuid.New()
+	reused = uuid.New()
+	strong = uuid.New()
+	creds := []domain.Credentials{
+		{ID: weak, Name: "forum", Login: "octocat", Password: "password"},
+		{ID: reused, Name: "mail", Login: "octocat", Password: "password"},
+		{ID: strong, Name: "bank", Login: "octocat", Password: "v7#Qm2!xLp9$Rz4&Wk"},
+	}
+	for i := range creds {
+		if err = credentialsRepository.Create(userID, &creds[i]); err != nil {
+			return weak, reused, strong, expired, expiring, err
+		}
+	}
+
+	expired = uuid.New()
+	expiring = uuid.New()
+	cards := []domain.BankCard{
+		{ID: expired, Number: "1234567812345678", ValidThru: "01/20", CVV: "123", CardHolder: "Old Card"},
+		{ID: expiring, Number: "8765432187654321", ValidThru: time.Now().Format("01/06"), CVV: "321"},
+		{ID: uuid.New(), Number: "1111222233334444", ValidThru: "12/99", CVV: "111"},
+	}
+	for i := range cards {
+		if err = bankCardRepository.Create(userID, &cards[i]); err != nil {
+			return weak, reused, strong, expired, expiring, err
+		}
+	}
+
+	return weak, reused, strong, expired, expiring, nil
+}
+
+func TestAuditJSON(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+	weak, reused, strong, expired, expiring, err := createAuditData(userID)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"audit",
+		"--format",
+		"json",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, out, "password")
+	assert.NotContains(t, out, "v7#Qm2!xLp9$Rz4&Wk")
+	assert.NotContains(t, out, "1234567812345678")
+
+	report := domain.AuditReport{}
+	err = json.Unmarshal([]byte(out), &report)
+	require.NoError(t, err)
+
+	require.Len(t, report.Credentials, 3)
+	assert.Equal(t, strong, report.Credentials[2].ID)
+	assert.False(t, report.Credentials[2].Weak)
+	assert.Equal(t, 0, report.Credentials[2].ReuseGroup)
+	for _, v := range report.Credentials[:2] {
+		assert.True(t, v.Weak)
+		assert.Equal(t, 1, v.ReuseGroup)
+	}
+	require.Len(t, report.Reused, 1)
+	assert.ElementsMatch(t, []uuid.UUID{weak, reused}, report.Reused[0])
+
+	require.Len(t, report.BankCards, 2)
+	assert.Equal(t, expired, report.BankCards[0].ID)
+	assert.True(t, report.BankCards[0].Expired)
+	assert.Equal(t, "**** 5678", report.BankCards[0].Number)
+	assert.Equal(t, expiring, report.BankCards[1].ID)
+	assert.False(t, report.BankCards[1].Expired)
+}
+
+func TestAuditTable(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+	_, _, strong, expired, _, err := createAuditData(userID)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"audit",
+		"--days",
+		"0",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 8)
+	assert.Contains(t, lines[1], "weak, reused #1")
+	assert.Contains(t, lines[3], strong.String())
+	assert.Contains(t, lines[3], "-")
+	assert.Equal(t, "3 credentials checked, 2 weak, 1 passwords reused", lines[4])
+	assert.Contains(t, lines[7], expired.String())
+	assert.Contains(t, lines[7], "expired")
+}
+
+func TestAuditBreaches(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+	weak, reused, _, _, _, err := createAuditData(userID)
+	require.NoError(t, err)
+
+	// Файл диапазона для SHA-1 строки "password"
+	dir := t.TempDir()
+	err = os.WriteFile(filepath.Join(dir, "5BAA6.txt"), []byte("1E4C9B93F3F0682250B6CF8331B7EE68FD8:9659365\r\n"), 0o600)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"audit",
+		"--format",
+		"json",
+		"--breaches",
+		dir,
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+
+	report := domain.AuditReport{}
+	err = json.Unmarshal([]byte(out), &report)
+	require.NoError(t, err)
+
+	assert.True(t, report.BreachesChecked)
+	assert.ElementsMatch(t, []uuid.UUID{weak, reused}, report.Breached)
+	for _, v := range report.Credentials {
+		if v.ID == weak || v.ID == reused {
+			assert.Equal(t, 9659365, v.Breaches)
+		} else {
+			assert.Equal(t, 0, v.Breaches)
+		}
+	}
+}
+
+func TestAuditBreachesInvalidFile(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "breaches.txt")
+	err = os.WriteFile(path, []byte("not a hash list\n"), 0o600)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"audit",
+		"--breaches",
+		path,
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "invalid breaches file format")
+}
+
+func TestAuditInvalidFormat(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"audit",
+		"--format",
+		"xml",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "invalid format")
+}
+
+func TestAuditUnauthorized(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"audit",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "unauthorized")
+}
@@ -0,0 +1,275 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/client/presentation"
+)
+
+func createBackupData(userID uuid.UUID) error {
+	err := textRepository.Create(userID, domain.Text{ID: uuid.New(), Content: "my secret note"})
+	if err != nil {
+		return err
+	}
+	err = binaryRepository.Create(userID, domain.Binary{
+		ID:      uuid.New(),
+		Name:    "report.pdf",
+		Content: []byte("local content"),
+		Version: domain.InitialVersion,
+	})
+	if err != nil {
+		return err
+	}
+	err = credentialsRepository.Create(userID, &domain.Credentials{
+		ID:       uuid.New(),
+		Name:     "GitHub",
+		Login:    "octocat",
+		Password: "secret-password",
+		Meta:     "work",
+	})
+	if err != nil {
+		return err
+	}
+
+	err = bankCardRepository.Create(userID, &domain.BankCard{
+		ID:         uuid.New(),
+		Number:     "4111111111111111",
+		ValidThru:  "12/30",
+		CVV:        "123",
+		CardHolder: "IVAN IVANOV",
+	})
+	if err != nil {
+		return err
+	}
+
+	err = otpRepository.Create(userID, &domain.OTP{
+		ID:        uuid.New(),
+		Issuer:    "GitHub",
+		Account:   "octocat",
+		Secret:    "JBSWY3DPEHPK3PXP",
+		Algorithm: "SHA1",
+		Digits:    "6",
+		Period:    "30",
+	})
+	if err != nil {
+		return err
+	}
+
+	key := newSSHKey()
+	err = sshKeyRepository.Create(userID, &key)
+	if err != nil {
+		return err
+	}
+
+	obj := newItem()
+
+	return itemRepository.Create(userID, &obj)
+}
+
+func exportBackup(t *testing.T, passphrase string) string {
+	t.Helper()
+
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+	err = createBackupData(userID)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "vault.gkx")
+	args := []string{"gophkeeper", "export", "--out", path, "--passphrase", passphrase}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "exported 1 texts, 1 binaries, 1 credentials, 1 bank cards, 1 otps, 1 ssh keys, 1 items to "+path)
+
+	return path
+}
+
+func TestExportImportVault(t *testing.T) {
+	path := exportBackup(t, "backup secret")
+
+	cmd, err := setup(FakeHTTPClient{Response: uuid.New()})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "import", "--passphrase", "backup secret", path}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "imported 1 texts, 1 binaries, 1 credentials, 1 bank cards, 1 otps, 1 ssh keys, 1 items")
+	assert.NotContains(t, out, "skipped duplicates")
+
+	texts, err := textRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, texts, 1)
+	assert.Equal(t, "my secret note", texts[0].Content)
+
+	bins, err := binaryRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, bins, 1)
+	assert.Equal(t, "report.pdf", bins[0].Name)
+	assert.Equal(t, []byte("local content"), bins[0].Content)
+
+	creds, err := credentialsRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	assert.Equal(t, "octocat", creds[0].Login)
+	assert.Equal(t, "secret-password", creds[0].Password)
+	assert.Equal(t, "work", creds[0].Meta)
+
+	cards, err := bankCardRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+	assert.Equal(t, "4111111111111111", cards[0].Number)
+	assert.Equal(t, "123", cards[0].CVV)
+
+	otps, err := otpRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, otps, 1)
+	assert.Equal(t, "GitHub:octocat", otps[0].Title())
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", otps[0].Secret)
+
+	sshKeys, err := sshKeyRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, sshKeys, 1)
+	assert.Equal(t, sshPrivateKey, sshKeys[0].PrivateKey)
+	assert.Equal(t, "octocat@github", sshKeys[0].Comment)
+
+	items, err := itemRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "wifi", items[0].Template)
+	assert.Equal(t, newItem().Fields, items[0].Fields)
+
+	// Повторный импорт не создает дубликаты
+	out, err = runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "imported 0 texts, 0 binaries, 0 credentials, 0 bank cards, 0 otps, 0 ssh keys, 0 items")
+	assert.Contains(t, out, "skipped duplicates: 1 texts, 1 binaries, 1 credentials, 1 bank cards, 1 otps, 1 ssh keys, 1 items")
+
+	creds, err = credentialsRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Len(t, creds, 1)
+}
+
+func TestImportVaultWrongPassphrase(t *testing.T) {
+	path := exportBackup(t, "backup secret")
+
+	cmd, err := setup(FakeHTTPClient{Response: uuid.New()})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "import", "--passphrase", "wrong secret", path}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "wrong passphrase")
+
+	texts, err := textRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Empty(t, texts)
+}
+
+func TestImportVaultInvalidFile(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "import", "--passphrase", "backup secret", "binary_file_for_test"}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "not a gophkeeper vault file")
+}
+
+func TestExportVaultFileExists(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "vault.gkx")
+	err = os.WriteFile(path, []byte("do not overwrite"), 0o600)
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "export", "--out", path, "--passphrase", "backup secret"}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "file exists")
+
+	content, err := os.ReadFile(path) //nolint: gosec
+	require.NoError(t, err)
+	assert.Equal(t, "do not overwrite", string(content))
+}
+
+// Проверяем интерактивный ввод парольной фразы с подтверждением
+func TestExportVaultPassphraseMismatch(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		presentation.SetInput(os.Stdin)
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+	presentation.SetInput(strings.NewReader("first\nsecond\n"))
+
+	path := filepath.Join(t.TempDir(), "vault.gkx")
+	args := []string{"gophkeeper", "export", "--out", path}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "passphrases do not match")
+	assert.NoFileExists(t, path)
+}
+
+func TestExportVaultUnauthorized(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	path := filepath.Join(t.TempDir(), "vault.gkx")
+	args := []string{"gophkeeper", "export", "--out", path, "--passphrase", "backup secret"}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "unauthorized")
+	assert.NoFileExists(t, path)
+}
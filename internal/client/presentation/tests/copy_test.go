@@ -0,0 +1,277 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+func TestCopyCredentialsPasswordSuccess(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	cred := domain.Credentials{
+		ID:       uuid.New(),
+		Name:     "www.example.com",
+		Login:    "login",
+		Password: "secret-password",
+	}
+	err = credentialsRepository.Create(userID, &cred)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"copy",
+		"credentials",
+		cred.ID.String(),
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"secret-password"}, clipboard.Writes)
+	assert.Equal(t, 30*time.Second, clipboard.ClearAfter)
+	assert.NotContains(t, out, "secret-password")
+	assert.Contains(t, out, "copied to clipboard")
+}
+
+func TestCopyCredentialsLoginWithTimeout(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	cred := domain.Credentials{
+		ID:       uuid.New(),
+		Name:     "www.example.com",
+		Login:    "login",
+		Password: "secret-password",
+	}
+	err = credentialsRepository.Create(userID, &cred)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"copy",
+		"credentials",
+		"--field",
+		"login",
+		"--timeout",
+		"5s",
+		cred.ID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"login"}, clipboard.Writes)
+	assert.Equal(t, 5*time.Second, clipboard.ClearAfter)
+}
+
+func TestCopyCredentialsWithoutClear(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	cred := domain.Credentials{ID: uuid.New(), Name: "name", Login: "login", Password: "password"}
+	err = credentialsRepository.Create(userID, &cred)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"copy",
+		"credentials",
+		"--timeout",
+		"0",
+		cred.ID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"password"}, clipboard.Writes)
+	assert.Zero(t, clipboard.ClearAfter)
+}
+
+func TestCopyCredentialsUnknownField(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	cred := domain.Credentials{ID: uuid.New(), Name: "name", Login: "login", Password: "password"}
+	err = credentialsRepository.Create(userID, &cred)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"copy",
+		"credentials",
+		"--field",
+		"meta",
+		cred.ID.String(),
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.Empty(t, clipboard.Writes)
+	assert.Contains(t, out, "unknown field")
+}
+
+func TestCopyCredentialsNotFound(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"copy",
+		"credentials",
+		uuid.NewString(),
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.Empty(t, clipboard.Writes)
+	assert.Contains(t, out, "credentials not found")
+}
+
+func TestCopyCredentialsNoToken(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"copy",
+		"credentials",
+		uuid.NewString(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	assert.Empty(t, clipboard.Writes)
+}
+
+func TestCopyBankCardCVVSuccess(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	card := domain.BankCard{
+		ID:         uuid.New(),
+		Number:     "4111111111111111",
+		ValidThru:  "12/30",
+		CVV:        "123",
+		CardHolder: "CARD HOLDER",
+	}
+	err = bankCardRepository.Create(userID, &card)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"copy",
+		"bank-card",
+		"--field",
+		"cvv",
+		card.ID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"123"}, clipboard.Writes)
+}
+
+func TestCopyBankCardClearUnsupported(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+	clipboard.ClearErr = domain.ErrClipboardClearUnsupported
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	card := domain.BankCard{ID: uuid.New(), Number: "4111111111111111", ValidThru: "12/30", CVV: "123"}
+	err = bankCardRepository.Create(userID, &card)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"copy",
+		"bank-card",
+		card.ID.String(),
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"4111111111111111"}, clipboard.Writes)
+	assert.Contains(t, out, "clear it manually")
+}
@@ -1,15 +1,22 @@
 package tests
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/client/presentation"
 )
 
 func TestCreateBinarySuccess(t *testing.T) {
@@ -32,6 +39,8 @@ func TestCreateBinarySuccess(t *testing.T) {
 		"gophkeeper",
 		"create",
 		"binary",
+		"--meta",
+		"test note",
 		"./binary_file_for_test",
 	}
 
@@ -43,6 +52,108 @@ func TestCreateBinarySuccess(t *testing.T) {
 	content, err := os.ReadFile("./binary_file_for_test")
 	require.NoError(t, err)
 	assert.Equal(t, bin.Content, content)
+	assert.Equal(t, "binary_file_for_test", bin.Name)
+	assert.Equal(t, http.DetectContentType(content), bin.MimeType)
+	assert.Equal(t, int64(len(content)), bin.Size)
+	sum := sha256.Sum256(content)
+	assert.Equal(t, hex.EncodeToString(sum[:]), bin.SHA256)
+	assert.Equal(t, "test note", bin.Meta)
+}
+
+// Проверяем, что большой файл загружается частями, а локально сохраняются только метаданные
+func TestCreateBinaryChunked(t *testing.T) {
+	binID := uuid.New()
+	uploaded := &bytes.Buffer{}
+	client := FakeHTTPClient{
+		Response: binID,
+		Uploaded: uploaded,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+	progress := &bytes.Buffer{}
+	presentation.SetProgressOutput(progress)
+	defer presentation.SetProgressOutput(os.Stderr)
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	content := bytes.Repeat([]byte("large binary "), domain.BinaryInlineLimit/10)
+	path := filepath.Join(t.TempDir(), "large")
+	err = os.WriteFile(path, content, 0o600)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"create",
+		"binary",
+		path,
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	assert.Equal(t, content, uploaded.Bytes())
+	assert.Contains(t, progress.String(), "100%")
+
+	bin, err := binaryRepository.Get(userID, binID)
+	require.NoError(t, err)
+	assert.True(t, bin.Chunked)
+	assert.Equal(t, "large", bin.Name)
+	assert.Equal(t, int64(len(content)), bin.Size)
+	sum := sha256.Sum256(content)
+	assert.Equal(t, hex.EncodeToString(sum[:]), bin.SHA256)
+	assert.Empty(t, bin.Content)
+	assert.Equal(t, domain.InitialVersion, bin.Version)
+}
+
+// Проверяем, что прерванная загрузка частями продолжается в той же сессии, а после завершения запись о ней удаляется
+func TestCreateBinaryChunkedResume(t *testing.T) {
+	binID := uuid.New()
+	resumed := uuid.New()
+	interrupt := true
+	client := FakeHTTPClient{
+		Response:  binID,
+		Resumed:   &resumed,
+		Interrupt: &interrupt,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	content := bytes.Repeat([]byte("large binary "), domain.BinaryInlineLimit/10)
+	path := filepath.Join(t.TempDir(), "large")
+	err = os.WriteFile(path, content, 0o600)
+	require.NoError(t, err)
+	sum := sha256.Sum256(content)
+	fingerprint := hex.EncodeToString(sum[:])
+
+	cmd.ExitErrHandler = func(_ context.Context, _ *cli.Command, _ error) {}
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "create", "binary", path})
+	require.ErrorIs(t, err, domain.ErrServerUnavailable)
+	assert.Equal(t, uuid.Nil, resumed)
+	pending, err := uploadRepository.Get(userID, fingerprint)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), pending.Size)
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "create", "binary", path})
+	require.NoError(t, err)
+	assert.Equal(t, pending.ID, resumed)
+	_, err = uploadRepository.Get(userID, fingerprint)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	bin, err := binaryRepository.Get(userID, binID)
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, bin.SHA256)
 }
 
 func TestCreateBinaryBadRequest(t *testing.T) {
@@ -92,6 +92,78 @@ func TestCreateCredentialsWithMetaSuccess(t *testing.T) {
 	assert.Equal(t, cred.Password, password)
 }
 
+func TestCreateCredentialsGenerateSuccess(t *testing.T) {
+	credID := uuid.New()
+	client := FakeHTTPClient{
+		Response: credID,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"create",
+		"credentials",
+		"--generate",
+		"--length",
+		"24",
+		"name",
+		"login",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+
+	cred, err := credentialsRepository.Get(userID, credID)
+	require.NoError(t, err)
+	assert.Len(t, cred.Password, 24)
+	assert.NotContains(t, out, cred.Password)
+	assert.Contains(t, out, "entropy: ~")
+}
+
+func TestCreateCredentialsGenerateWithPassword(t *testing.T) {
+	credID := uuid.New()
+	client := FakeHTTPClient{
+		Response: credID,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"create",
+		"credentials",
+		"--generate",
+		"name",
+		"login",
+		"password",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = credentialsRepository.Get(userID, credID)
+	assert.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
 func TestCreateCredentialsBadRequest(t *testing.T) {
 	client := FakeHTTPClient{
 		Err: domain.ErrBadRequest,
@@ -89,3 +89,40 @@ func TestCreateTextUnauthorized(t *testing.T) {
 	err = cmd.Run(context.Background(), args)
 	require.NoError(t, err)
 }
+
+func TestCreateTextServerUnavailable(t *testing.T) {
+	content := "content created offline"
+	client := FakeHTTPClient{
+		Err: domain.ErrServerUnavailable,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"create",
+		"text",
+		content,
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	ops, err := journalRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, domain.TextKind, ops[0].Kind)
+	assert.Equal(t, domain.CreateAction, ops[0].Action)
+
+	text, err := textRepository.Get(userID, ops[0].EntityID)
+	require.NoError(t, err)
+	assert.Equal(t, text.Content, content)
+}
@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+func TestDeleteBankCardSuccess(t *testing.T) {
+	cardID := uuid.New()
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	card := domain.BankCard{
+		ID:         cardID,
+		Number:     "0000 0000 0000 0000",
+		ValidThru:  "01/11",
+		CVV:        "000",
+		CardHolder: "name name",
+	}
+	err = bankCardRepository.Create(userID, &card)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"bank-card",
+		cardID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = bankCardRepository.Get(userID, cardID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestDeleteBankCardNotFound(t *testing.T) {
+	cardID := uuid.New()
+	client := FakeHTTPClient{
+		Err: domain.ErrEntityNotFound,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	card := domain.BankCard{
+		ID:         cardID,
+		Number:     "0000 0000 0000 0000",
+		ValidThru:  "01/11",
+		CVV:        "000",
+		CardHolder: "name name",
+	}
+	err = bankCardRepository.Create(userID, &card)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"bank-card",
+		cardID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = bankCardRepository.Get(userID, cardID)
+	require.NoError(t, err)
+}
+
+func TestDeleteBankCardUnauthorized(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"bank-card",
+		uuid.NewString(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
+
+func TestDeleteBankCardInvalidValue(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"bank-card",
+		"invalid value",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+func TestDeleteBinarySuccess(t *testing.T) {
+	binID := uuid.New()
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	bin := domain.Binary{
+		ID:      binID,
+		Content: []byte("content to delete"),
+	}
+	err = binaryRepository.Create(userID, bin)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"binary",
+		binID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = binaryRepository.Get(userID, binID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestDeleteBinaryNotFound(t *testing.T) {
+	binID := uuid.New()
+	client := FakeHTTPClient{
+		Err: domain.ErrEntityNotFound,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	bin := domain.Binary{
+		ID:      binID,
+		Content: []byte("content to delete"),
+	}
+	err = binaryRepository.Create(userID, bin)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"binary",
+		binID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = binaryRepository.Get(userID, binID)
+	require.NoError(t, err)
+}
+
+func TestDeleteBinaryUnauthorized(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"binary",
+		uuid.NewString(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
+
+func TestDeleteBinaryInvalidValue(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"binary",
+		"invalid value",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
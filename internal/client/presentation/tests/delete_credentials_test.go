@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+func TestDeleteCredentialsSuccess(t *testing.T) {
+	credID := uuid.New()
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	cred := domain.Credentials{
+		ID:       credID,
+		Name:     "name",
+		Login:    "login",
+		Password: "password",
+	}
+	err = credentialsRepository.Create(userID, &cred)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"credentials",
+		credID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = credentialsRepository.Get(userID, credID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestDeleteCredentialsNotFound(t *testing.T) {
+	credID := uuid.New()
+	client := FakeHTTPClient{
+		Err: domain.ErrEntityNotFound,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	cred := domain.Credentials{
+		ID:       credID,
+		Name:     "name",
+		Login:    "login",
+		Password: "password",
+	}
+	err = credentialsRepository.Create(userID, &cred)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"credentials",
+		credID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = credentialsRepository.Get(userID, credID)
+	require.NoError(t, err)
+}
+
+func TestDeleteCredentialsUnauthorized(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"credentials",
+		uuid.NewString(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
+
+func TestDeleteCredentialsInvalidValue(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"credentials",
+		"invalid value",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+func TestDeleteTextSuccess(t *testing.T) {
+	textID := uuid.New()
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	text := domain.Text{
+		ID:      textID,
+		Content: "content to delete",
+	}
+	err = textRepository.Create(userID, text)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"text",
+		textID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = textRepository.Get(userID, textID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestDeleteTextNotFound(t *testing.T) {
+	textID := uuid.New()
+	client := FakeHTTPClient{
+		Err: domain.ErrEntityNotFound,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	text := domain.Text{
+		ID:      textID,
+		Content: "content to delete",
+	}
+	err = textRepository.Create(userID, text)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"text",
+		textID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = textRepository.Get(userID, textID)
+	require.NoError(t, err)
+}
+
+func TestDeleteTextUnauthorized(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"text",
+		uuid.NewString(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
+
+func TestDeleteTextInvalidValue(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"text",
+		"invalid value",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
+
+func TestDeleteTextServerUnavailable(t *testing.T) {
+	textID := uuid.New()
+	client := FakeHTTPClient{
+		Err: domain.ErrServerUnavailable,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	err = textRepository.Create(userID, domain.Text{ID: textID, Content: "content to delete"})
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"delete",
+		"text",
+		textID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = textRepository.Get(userID, textID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+
+	ops, err := journalRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	require.Equal(t, domain.DeleteAction, ops[0].Action)
+}
@@ -0,0 +1,220 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+func TestDownloadBinaryLocal(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	bin := domain.Binary{
+		ID:      uuid.New(),
+		Content: []byte("local content"),
+		Version: domain.InitialVersion,
+	}
+	err = binaryRepository.Create(userID, bin)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "out")
+	args := []string{
+		"gophkeeper",
+		"download",
+		"binary",
+		bin.ID.String(),
+		path,
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	content, err := os.ReadFile(path) //nolint: gosec
+	require.NoError(t, err)
+	assert.Equal(t, bin.Content, content)
+}
+
+func TestDownloadBinaryChunked(t *testing.T) {
+	stream := []byte("content stored only on server")
+	client := FakeHTTPClient{
+		Stream: stream,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	bin := domain.Binary{
+		ID:      uuid.New(),
+		Size:    int64(len(stream)),
+		Chunked: true,
+		Version: domain.InitialVersion,
+	}
+	err = binaryRepository.Create(userID, bin)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "out")
+	args := []string{
+		"gophkeeper",
+		"download",
+		"binary",
+		bin.ID.String(),
+		path,
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	content, err := os.ReadFile(path) //nolint: gosec
+	require.NoError(t, err)
+	assert.Equal(t, stream, content)
+}
+
+// Проверяем, что при ошибке недокачанный файл удаляется
+func TestDownloadBinaryServerError(t *testing.T) {
+	client := FakeHTTPClient{
+		Err: domain.ErrEntityNotFound,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	bin := domain.Binary{
+		ID:      uuid.New(),
+		Size:    100,
+		Chunked: true,
+	}
+	err = binaryRepository.Create(userID, bin)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "out")
+	args := []string{
+		"gophkeeper",
+		"download",
+		"binary",
+		bin.ID.String(),
+		path,
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	_, err = os.Stat(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestDownloadBinaryNotFound(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "out")
+	args := []string{
+		"gophkeeper",
+		"download",
+		"binary",
+		uuid.NewString(),
+		path,
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	_, err = os.Stat(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// Проверяем, что существующий файл не перезаписывается
+func TestDownloadBinaryFileExists(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	bin := domain.Binary{
+		ID:      uuid.New(),
+		Content: []byte("local content"),
+	}
+	err = binaryRepository.Create(userID, bin)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "out")
+	err = os.WriteFile(path, []byte("existing"), 0o600)
+	require.NoError(t, err)
+	args := []string{
+		"gophkeeper",
+		"download",
+		"binary",
+		bin.ID.String(),
+		path,
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	content, err := os.ReadFile(path) //nolint: gosec
+	require.NoError(t, err)
+	assert.Equal(t, []byte("existing"), content)
+}
+
+func TestDownloadBinaryUnauthorized(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"download",
+		"binary",
+		uuid.NewString(),
+		filepath.Join(t.TempDir(), "out"),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
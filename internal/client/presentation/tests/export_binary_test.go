@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+func TestExportBinarySuccess(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	bin := domain.Binary{
+		ID:      uuid.New(),
+		Name:    "report.pdf",
+		Content: []byte("local content"),
+		Version: domain.InitialVersion,
+	}
+	err = binaryRepository.Create(userID, bin)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	args := []string{
+		"gophkeeper",
+		"export",
+		"binary",
+		"--dir",
+		dir,
+		bin.ID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	content, err := os.ReadFile(filepath.Join(dir, "report.pdf")) //nolint: gosec
+	require.NoError(t, err)
+	assert.Equal(t, bin.Content, content)
+}
+
+// Проверяем, что пути в сохраненном имени отбрасываются и файл не выходит за пределы каталога
+func TestExportBinaryPathInName(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	bin := domain.Binary{
+		ID:      uuid.New(),
+		Name:    "../../etc/passwd",
+		Content: []byte("local content"),
+		Version: domain.InitialVersion,
+	}
+	err = binaryRepository.Create(userID, bin)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	args := []string{
+		"gophkeeper",
+		"export",
+		"binary",
+		"--dir",
+		dir,
+		bin.ID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	content, err := os.ReadFile(filepath.Join(dir, "passwd")) //nolint: gosec
+	require.NoError(t, err)
+	assert.Equal(t, bin.Content, content)
+}
+
+// Проверяем, что без исходного имени файл называется по идентификатору
+func TestExportBinaryNoName(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	bin := domain.Binary{
+		ID:      uuid.New(),
+		Content: []byte("local content"),
+		Version: domain.InitialVersion,
+	}
+	err = binaryRepository.Create(userID, bin)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	args := []string{
+		"gophkeeper",
+		"export",
+		"binary",
+		"--dir",
+		dir,
+		bin.ID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, bin.ID.String()))
+	require.NoError(t, err)
+}
+
+func TestExportBinaryNotFound(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	args := []string{
+		"gophkeeper",
+		"export",
+		"binary",
+		"--dir",
+		dir,
+		uuid.NewString(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"time"
+)
+
+// FakeClipboard - Фейковый буфер обмена для unit тестов, запоминает записанные значения
+type FakeClipboard struct {
+	// Writes - Значения, записанные в буфер обмена, в порядке записи
+	Writes []string
+	// ClearAfter - Время, через которое была запланирована очистка, 0 если очистка не планировалась
+	ClearAfter time.Duration
+	// ClearErr - Ошибка, возвращаемая при планировании очистки
+	ClearErr error
+}
+
+// Write - Запоминает записанное значение
+func (c *FakeClipboard) Write(secret string) error {
+	c.Writes = append(c.Writes, secret)
+
+	return nil
+}
+
+// ScheduleClear - Запоминает время очистки
+func (c *FakeClipboard) ScheduleClear(_ string, after time.Duration) error {
+	if c.ClearErr != nil {
+		return c.ClearErr
+	}
+	c.ClearAfter = after
+
+	return nil
+}
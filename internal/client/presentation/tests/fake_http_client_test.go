@@ -2,6 +2,10 @@
 package tests
 
 import (
+	"bytes"
+	"io"
+	"strconv"
+
 	"github.com/google/uuid"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
@@ -12,10 +16,60 @@ type FakeHTTPClient struct {
 	Certs []byte
 	// Response - Возвращаемый ответ любого типа
 	Response any
+	// RefreshToken - Возвращаемый refresh token при входе, регистрации и обновлении токенов
+	RefreshToken string
 	// Err - Возвращаемая ошибка
 	Err error
 	// SyncAllData - Данные, возвращаемые при синхронизации
 	SyncAllData getAllResponse
+	// Theirs - Актуальная копия данных на сервере, при несовпадении версии возвращается конфликт
+	Theirs any
+	// E2E - Признак сквозного шифрования пользователя, возвращаемый при PreLogin
+	E2E bool
+	// TwoFactorCode - Код второго фактора, если задан, вход требует подтверждения этим кодом
+	TwoFactorCode string
+	// Uploaded - Если задан, в него записываются бинарные данные, загруженные частями
+	Uploaded *bytes.Buffer
+	// Resumed - Если задан, в него записывается идентификатор сессии, в которой продолжается загрузка частями
+	Resumed *uuid.UUID
+	// Interrupt - Если задан и истинен, загрузка частями один раз прерывается недоступностью сервера
+	// после создания сессии
+	Interrupt *bool
+	// Stream - Содержимое, возвращаемое при потоковом скачивании бинарных данных
+	Stream []byte
+	// PageSize - Если задан, списки из Response возвращаются страницами этого размера
+	PageSize int
+	// Pages - Если задан, в него записывается количество запрошенных страниц
+	Pages *int
+	// SavedLabels - Если задан, в него записываются папки и метки, сохраненные на сервере
+	SavedLabels *[]domain.Labels
+	// Account - Если задан, в него записываются пароль и соль, переданные при регистрации.
+	// Соль возвращается при PreLogin, а вход с другим паролем отклоняется
+	Account *fakeAccount
+}
+
+// fakeAccount - Учетная запись пользователя на фейковом сервере
+type fakeAccount struct {
+	Password string
+	Salt     []byte
+}
+
+// page - Возвращает страницу списка, начиная с курсора, и курсор следующей страницы.
+// Курсором служит индекс первого элемента страницы
+func page[T any](c FakeHTTPClient, items []T, cursor string) ([]T, string) {
+	if c.Pages != nil {
+		*c.Pages++
+	}
+	if c.PageSize == 0 {
+		return items, ""
+	}
+	start, _ := strconv.Atoi(cursor)
+	end := min(start+c.PageSize, len(items))
+	if end == len(items) {
+		return items[start:end], ""
+	}
+
+	return items[start:end], strconv.Itoa(end)
 }
 
 type getAllResponse struct {
@@ -23,24 +77,97 @@ type getAllResponse struct {
 	Binaries    []domain.Binary
 	Credentials []domain.Credentials
 	BankCards   []domain.BankCard
+	OTPs        []domain.OTP
+	SSHKeys     []domain.SSHKey
+	Items       []domain.Item
+	Folders     []domain.Folder
+	Tags        []domain.Tag
+	Templates   []domain.ItemTemplate
+	Labels      []domain.Labels
+	Deleted     []domain.Tombstone
+	Revision    int64
+}
+
+// Login - Вход по логину и паролю, возвращает токен авторизации и refresh token
+func (c FakeHTTPClient) Login(_, password string) (string, string, error) {
+	if c.Err != nil {
+		return "", "", c.Err
+	}
+	if c.Account != nil && c.Account.Password != password {
+		return "", "", domain.ErrUnauthorized
+	}
+	if c.TwoFactorCode != "" {
+		return "", "", &domain.TwoFactorRequiredError{Challenge: "challenge"}
+	}
+
+	return c.Response.(string), c.RefreshToken, nil
+}
+
+// TwoFactorLogin - Завершает вход кодом второго фактора
+func (c FakeHTTPClient) TwoFactorLogin(_, code string) (string, string, error) {
+	if code != c.TwoFactorCode {
+		return "", "", domain.ErrInvalidTwoFactorCode
+	}
+
+	return c.Response.(string), c.RefreshToken, nil
+}
+
+// EnrollTwoFactor - Начинает подключение двухфакторной аутентификации
+func (c FakeHTTPClient) EnrollTwoFactor(_ domain.Session) (string, string, error) {
+	if c.Err != nil {
+		return "", "", c.Err
+	}
+
+	return "JBSWY3DPEHPK3PXP", "otpauth://totp/GophKeeper:login?secret=JBSWY3DPEHPK3PXP", nil
+}
+
+// VerifyTwoFactor - Подтверждает подключение двухфакторной аутентификации
+func (c FakeHTTPClient) VerifyTwoFactor(_ domain.Session, code string) ([]string, error) {
+	if code != c.TwoFactorCode {
+		return nil, domain.ErrInvalidTwoFactorCode
+	}
+
+	return []string{"abcde-fghij"}, nil
 }
 
-// Login - Вход по логину и паролю, возвращает токен авторизации
-func (c FakeHTTPClient) Login(_, _ string) (string, error) {
+// DisableTwoFactor - Отключает двухфакторную аутентификацию
+func (c FakeHTTPClient) DisableTwoFactor(_ domain.Session, code string) error {
+	if code != c.TwoFactorCode {
+		return domain.ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+// Register - Регистрация по логину и паролю, возвращает токен авторизации и refresh token
+func (c FakeHTTPClient) Register(_, password string, _ bool, salt []byte) (string, string, error) {
 	if c.Err != nil {
-		return "", c.Err
+		return "", "", c.Err
+	}
+	if c.Account != nil {
+		c.Account.Password = password
+		c.Account.Salt = salt
 	}
 
-	return c.Response.(string), nil
+	return c.Response.(string), c.RefreshToken, nil
 }
 
-// Register - Регистрация по логину и паролю, возвращает токен авторизации
-func (c FakeHTTPClient) Register(_, _ string) (string, error) {
+// PreLogin - Возвращает признак сквозного шифрования и соль для вывода ключа хранилища
+func (c FakeHTTPClient) PreLogin(_ string) (bool, []byte, error) {
+	if c.Account != nil && c.Account.Salt != nil {
+		return c.E2E, c.Account.Salt, nil
+	}
+
+	return c.E2E, []byte("0123456789abcdef"), nil
+}
+
+// Refresh - Выпускает новую пару токенов по refresh token
+func (c FakeHTTPClient) Refresh(_ string) (string, string, error) {
 	if c.Err != nil {
-		return "", c.Err
+		return "", "", c.Err
 	}
 
-	return c.Response.(string), nil
+	return c.Response.(string), c.RefreshToken, nil
 }
 
 // CreateText - Создает текст, возвращает идентификатор ресурса от сервера
@@ -53,8 +180,19 @@ func (c FakeHTTPClient) CreateText(_ domain.Session, _ string) (uuid.UUID, error
 }
 
 // UpdateText - Обновляет существующий текст
-func (c FakeHTTPClient) UpdateText(_ domain.Session, _ domain.Text) error {
-	return c.Err
+func (c FakeHTTPClient) UpdateText(_ domain.Session, text domain.Text) (domain.Text, error) {
+	if c.Err != nil {
+		return text, c.Err
+	}
+	if c.Theirs != nil {
+		theirs := c.Theirs.(domain.Text)
+		if text.Version != theirs.Version {
+			return theirs, domain.ErrVersionConflict
+		}
+	}
+	text.Version++
+
+	return text, nil
 }
 
 // GetCerts - Возвращает публичный ключ для валидации и парсинга JWT
@@ -63,7 +201,7 @@ func (c FakeHTTPClient) GetCerts() ([]byte, error) {
 }
 
 // CreateBinary - Создает бинарные данные, возвращает идентификатор ресурса от сервера
-func (c FakeHTTPClient) CreateBinary(_ domain.Session, _ []byte) (uuid.UUID, error) {
+func (c FakeHTTPClient) CreateBinary(_ domain.Session, _ domain.Binary) (uuid.UUID, error) {
 	if c.Err != nil {
 		return uuid.New(), c.Err
 	}
@@ -71,9 +209,87 @@ func (c FakeHTTPClient) CreateBinary(_ domain.Session, _ []byte) (uuid.UUID, err
 	return c.Response.(uuid.UUID), nil
 }
 
+// UploadBinary - Загружает бинарные данные частями, возвращает идентификатор сессии загрузки
+func (c FakeHTTPClient) UploadBinary(
+	_ domain.Session,
+	resumeID uuid.UUID,
+	source io.Reader,
+	size int64,
+	_ int,
+	started func(uuid.UUID) error,
+	progress func(int64),
+) (uuid.UUID, error) {
+	if c.Resumed != nil {
+		*c.Resumed = resumeID
+	}
+	if c.Err != nil {
+		return uuid.Nil, c.Err
+	}
+	uploadID := resumeID
+	if uploadID == uuid.Nil {
+		uploadID = uuid.New()
+		if started != nil {
+			if err := started(uploadID); err != nil {
+				return uuid.Nil, err
+			}
+		}
+	}
+	if c.Interrupt != nil && *c.Interrupt {
+		*c.Interrupt = false
+
+		return uuid.Nil, domain.ErrServerUnavailable
+	}
+	content, err := io.ReadAll(source)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if int64(len(content)) != size {
+		return uuid.Nil, domain.ErrBadRequest
+	}
+	if c.Uploaded != nil {
+		c.Uploaded.Write(content)
+	}
+	progress(size)
+
+	return uploadID, nil
+}
+
+// CommitBinaryUpload - Завершает загрузку частями, возвращает идентификатор ресурса от сервера
+func (c FakeHTTPClient) CommitBinaryUpload(_ domain.Session, _ uuid.UUID, _ domain.Binary) (uuid.UUID, error) {
+	if c.Err != nil {
+		return uuid.Nil, c.Err
+	}
+
+	return c.Response.(uuid.UUID), nil
+}
+
+// DownloadBinary - Потоково скачивает бинарные данные
+func (c FakeHTTPClient) DownloadBinary(_ domain.Session, _ domain.Binary, dest io.Writer, progress func(int64)) error {
+	if c.Err != nil {
+		return c.Err
+	}
+	if _, err := dest.Write(c.Stream); err != nil {
+		return err
+	}
+	progress(int64(len(c.Stream)))
+
+	return nil
+}
+
 // UpdateText - Обновляет существующие бинарные данные
-func (c FakeHTTPClient) UpdateBinary(_ domain.Session, _ domain.Binary) error {
-	return c.Err
+func (c FakeHTTPClient) UpdateBinary(_ domain.Session, bin domain.Binary) (domain.Binary, error) {
+	if c.Err != nil {
+		return bin, c.Err
+	}
+	if c.Theirs != nil {
+		theirs := c.Theirs.(domain.Binary)
+		if bin.Version != theirs.Version {
+			return theirs, domain.ErrVersionConflict
+		}
+	}
+	bin.Version++
+
+	return bin, nil
 }
 
 // CreateCredentials - Создает пару логин и пароль, возвращает идентификатор ресурса от сервера
@@ -86,8 +302,20 @@ func (c FakeHTTPClient) CreateCredentials(_ domain.Session, _, _, _, _ string) (
 }
 
 // UpdateCredentials - Обновляет существующий логин и пароль
-func (c FakeHTTPClient) UpdateCredentials(_ domain.Session, _ *domain.Credentials) error {
-	return c.Err
+func (c FakeHTTPClient) UpdateCredentials(_ domain.Session, cred *domain.Credentials) (*domain.Credentials, error) {
+	if c.Err != nil {
+		return cred, c.Err
+	}
+	if c.Theirs != nil {
+		theirs := c.Theirs.(domain.Credentials)
+		if cred.Version != theirs.Version {
+			return &theirs, domain.ErrVersionConflict
+		}
+	}
+	updated := *cred
+	updated.Version++
+
+	return &updated, nil
 }
 
 // CreateCredentials - Создает новую банковскую карту, возвращает идентификатор ресурса от сервера
@@ -100,59 +328,383 @@ func (c FakeHTTPClient) CreateBankCard(_ domain.Session, _, _, _, _, _ string) (
 }
 
 // UpdateBankCard - Обновляет существующую банковскую карту
-func (c FakeHTTPClient) UpdateBankCard(_ domain.Session, _ *domain.BankCard) error {
+func (c FakeHTTPClient) UpdateBankCard(_ domain.Session, card *domain.BankCard) (*domain.BankCard, error) {
+	if c.Err != nil {
+		return card, c.Err
+	}
+	if c.Theirs != nil {
+		theirs := c.Theirs.(domain.BankCard)
+		if card.Version != theirs.Version {
+			return &theirs, domain.ErrVersionConflict
+		}
+	}
+	updated := *card
+	updated.Version++
+
+	return &updated, nil
+}
+
+// CreateOTP - Создает секрет одноразовых паролей, возвращает идентификатор ресурса от сервера
+func (c FakeHTTPClient) CreateOTP(_ domain.Session, _ domain.OTP) (uuid.UUID, error) {
+	if c.Err != nil {
+		return uuid.New(), c.Err
+	}
+
+	return c.Response.(uuid.UUID), nil
+}
+
+// UpdateOTP - Обновляет существующий секрет одноразовых паролей
+func (c FakeHTTPClient) UpdateOTP(_ domain.Session, otp *domain.OTP) (*domain.OTP, error) {
+	if c.Err != nil {
+		return otp, c.Err
+	}
+	if c.Theirs != nil {
+		theirs := c.Theirs.(domain.OTP)
+		if otp.Version != theirs.Version {
+			return &theirs, domain.ErrVersionConflict
+		}
+	}
+	updated := *otp
+	updated.Version++
+
+	return &updated, nil
+}
+
+// GetOTP - Получает расшифрованный секрет одноразовых паролей по идентификатору
+func (c FakeHTTPClient) GetOTP(_ domain.Session, _ uuid.UUID) (domain.OTP, error) {
+	if c.Err != nil {
+		return domain.OTP{}, c.Err
+	}
+
+	return c.Response.(domain.OTP), nil
+}
+
+// GetAllOTPs - Получает страницу расшифрованных секретов одноразовых паролей пользователя
+func (c FakeHTTPClient) GetAllOTPs(_ domain.Session, cursor string) ([]domain.OTP, string, error) {
+	if c.Err != nil {
+		return []domain.OTP{}, "", c.Err
+	}
+	items, next := page(c, c.Response.([]domain.OTP), cursor)
+
+	return items, next, nil
+}
+
+// DeleteOTP - Удаляет существующий секрет одноразовых паролей
+func (c FakeHTTPClient) DeleteOTP(_ domain.Session, _ uuid.UUID) error {
 	return c.Err
 }
 
-// GetAllTexts - Получает все расшифрованные тексты пользователя
-func (c FakeHTTPClient) GetAllTexts(_ domain.Session) ([]domain.Text, error) {
+// CreateSSHKey - Создает SSH ключ, возвращает идентификатор ресурса от сервера
+func (c FakeHTTPClient) CreateSSHKey(_ domain.Session, _ domain.SSHKey) (uuid.UUID, error) {
 	if c.Err != nil {
-		return []domain.Text{}, c.Err
+		return uuid.New(), c.Err
 	}
 
-	return c.Response.([]domain.Text), nil
+	return c.Response.(uuid.UUID), nil
 }
 
-// GetAllBinaries - Получает все расшифрованные бинарные данные пользователя
-func (c FakeHTTPClient) GetAllBinaries(_ domain.Session) ([]domain.Binary, error) {
+// UpdateSSHKey - Обновляет существующий SSH ключ
+func (c FakeHTTPClient) UpdateSSHKey(_ domain.Session, key *domain.SSHKey) (*domain.SSHKey, error) {
 	if c.Err != nil {
-		return []domain.Binary{}, c.Err
+		return key, c.Err
 	}
+	if c.Theirs != nil {
+		theirs := c.Theirs.(domain.SSHKey)
+		if key.Version != theirs.Version {
+			return &theirs, domain.ErrVersionConflict
+		}
+	}
+	updated := *key
+	updated.Version++
 
-	return c.Response.([]domain.Binary), nil
+	return &updated, nil
 }
 
-// GetAllCredentials - Получает все расшифрованные банковские карты пользователя
-func (c FakeHTTPClient) GetAllCredentials(_ domain.Session) ([]domain.Credentials, error) {
+// GetSSHKey - Получает расшифрованный SSH ключ по идентификатору
+func (c FakeHTTPClient) GetSSHKey(_ domain.Session, _ uuid.UUID) (domain.SSHKey, error) {
 	if c.Err != nil {
-		return []domain.Credentials{}, c.Err
+		return domain.SSHKey{}, c.Err
 	}
 
-	return c.Response.([]domain.Credentials), nil
+	return c.Response.(domain.SSHKey), nil
 }
 
-// GetAllBankCards - Получает все расшифрованные банковские карты пользователя
-func (c FakeHTTPClient) GetAllBankCards(_ domain.Session) ([]domain.BankCard, error) {
+// GetAllSSHKeys - Получает страницу расшифрованных SSH ключей пользователя
+func (c FakeHTTPClient) GetAllSSHKeys(_ domain.Session, cursor string) ([]domain.SSHKey, string, error) {
 	if c.Err != nil {
-		return []domain.BankCard{}, c.Err
+		return []domain.SSHKey{}, "", c.Err
 	}
+	items, next := page(c, c.Response.([]domain.SSHKey), cursor)
+
+	return items, next, nil
+}
 
-	return c.Response.([]domain.BankCard), nil
+// DeleteSSHKey - Удаляет существующий SSH ключ
+func (c FakeHTTPClient) DeleteSSHKey(_ domain.Session, _ uuid.UUID) error {
+	return c.Err
 }
 
-// GetAll - Получает все расшифрованные данные пользователя
-func (c FakeHTTPClient) GetAll(_ domain.Session) (
+// CreateItem - Создает произвольные данные, возвращает идентификатор ресурса от сервера
+func (c FakeHTTPClient) CreateItem(_ domain.Session, _ domain.Item) (uuid.UUID, error) {
+	if c.Err != nil {
+		return uuid.New(), c.Err
+	}
+
+	return c.Response.(uuid.UUID), nil
+}
+
+// UpdateItem - Обновляет существующие произвольные данные
+func (c FakeHTTPClient) UpdateItem(_ domain.Session, obj *domain.Item) (*domain.Item, error) {
+	if c.Err != nil {
+		return obj, c.Err
+	}
+	if c.Theirs != nil {
+		theirs := c.Theirs.(domain.Item)
+		if obj.Version != theirs.Version {
+			return &theirs, domain.ErrVersionConflict
+		}
+	}
+	updated := *obj
+	updated.Version++
+
+	return &updated, nil
+}
+
+// GetItem - Получает расшифрованные произвольные данные по идентификатору
+func (c FakeHTTPClient) GetItem(_ domain.Session, _ uuid.UUID) (domain.Item, error) {
+	if c.Err != nil {
+		return domain.Item{}, c.Err
+	}
+
+	return c.Response.(domain.Item), nil
+}
+
+// GetAllItems - Получает страницу расшифрованных произвольных данных пользователя
+func (c FakeHTTPClient) GetAllItems(_ domain.Session, cursor string) ([]domain.Item, string, error) {
+	if c.Err != nil {
+		return []domain.Item{}, "", c.Err
+	}
+	items, next := page(c, c.Response.([]domain.Item), cursor)
+
+	return items, next, nil
+}
+
+// DeleteItem - Удаляет существующие произвольные данные
+func (c FakeHTTPClient) DeleteItem(_ domain.Session, _ uuid.UUID) error {
+	return c.Err
+}
+
+// GetAllTexts - Получает страницу расшифрованных текстов пользователя
+func (c FakeHTTPClient) GetAllTexts(_ domain.Session, cursor string) ([]domain.Text, string, error) {
+	if c.Err != nil {
+		return []domain.Text{}, "", c.Err
+	}
+	items, next := page(c, c.Response.([]domain.Text), cursor)
+
+	return items, next, nil
+}
+
+// GetAllBinaries - Получает страницу расшифрованных бинарных данных пользователя
+func (c FakeHTTPClient) GetAllBinaries(_ domain.Session, cursor string) ([]domain.Binary, string, error) {
+	if c.Err != nil {
+		return []domain.Binary{}, "", c.Err
+	}
+	items, next := page(c, c.Response.([]domain.Binary), cursor)
+
+	return items, next, nil
+}
+
+// GetText - Получает расшифрованный текст по идентификатору
+func (c FakeHTTPClient) GetText(_ domain.Session, _ uuid.UUID) (domain.Text, error) {
+	if c.Err != nil {
+		return domain.Text{}, c.Err
+	}
+
+	return c.Response.(domain.Text), nil
+}
+
+// GetBinary - Получает расшифрованные метаданные бинарных данных по идентификатору
+func (c FakeHTTPClient) GetBinary(_ domain.Session, _ uuid.UUID) (domain.Binary, error) {
+	if c.Err != nil {
+		return domain.Binary{}, c.Err
+	}
+
+	return c.Response.(domain.Binary), nil
+}
+
+// GetCredentials - Получает расшифрованные логин и пароль по идентификатору
+func (c FakeHTTPClient) GetCredentials(_ domain.Session, _ uuid.UUID) (domain.Credentials, error) {
+	if c.Err != nil {
+		return domain.Credentials{}, c.Err
+	}
+
+	return c.Response.(domain.Credentials), nil
+}
+
+// GetBankCard - Получает расшифрованную банковскую карту по идентификатору
+func (c FakeHTTPClient) GetBankCard(_ domain.Session, _ uuid.UUID) (domain.BankCard, error) {
+	if c.Err != nil {
+		return domain.BankCard{}, c.Err
+	}
+
+	return c.Response.(domain.BankCard), nil
+}
+
+// GetAllCredentials - Получает страницу расшифрованных логинов и паролей пользователя
+func (c FakeHTTPClient) GetAllCredentials(_ domain.Session, cursor string) ([]domain.Credentials, string, error) {
+	if c.Err != nil {
+		return []domain.Credentials{}, "", c.Err
+	}
+	items, next := page(c, c.Response.([]domain.Credentials), cursor)
+
+	return items, next, nil
+}
+
+// GetAllBankCards - Получает страницу расшифрованных банковских карт пользователя
+func (c FakeHTTPClient) GetAllBankCards(_ domain.Session, cursor string) ([]domain.BankCard, string, error) {
+	if c.Err != nil {
+		return []domain.BankCard{}, "", c.Err
+	}
+	items, next := page(c, c.Response.([]domain.BankCard), cursor)
+
+	return items, next, nil
+}
+
+// GetAll - Получает все расшифрованные данные пользователя одной страницей
+func (c FakeHTTPClient) GetAll(_ domain.Session, _ string) (
 	texts []domain.Text,
 	bankCards []domain.BankCard,
 	binaries []domain.Binary,
 	credentials []domain.Credentials,
+	otps []domain.OTP,
+	sshKeys []domain.SSHKey,
+	items []domain.Item,
+	next string,
 	err error,
 ) {
 	if c.Err != nil {
-		return texts, bankCards, binaries, credentials, c.Err
+		return texts, bankCards, binaries, credentials, otps, sshKeys, items, next, c.Err
 	}
 
 	data := c.SyncAllData
 
-	return data.Texts, data.BankCards, data.Binaries, data.Credentials, nil
+	return data.Texts, data.BankCards, data.Binaries, data.Credentials, data.OTPs, data.SSHKeys, data.Items, next, nil
+}
+
+// DeleteText - Удаляет существующий текст
+func (c FakeHTTPClient) DeleteText(_ domain.Session, _ uuid.UUID) error {
+	return c.Err
+}
+
+// DeleteBinary - Удаляет существующие бинарные данные
+func (c FakeHTTPClient) DeleteBinary(_ domain.Session, _ uuid.UUID) error {
+	return c.Err
+}
+
+// DeleteCredentials - Удаляет существующий логин и пароль
+func (c FakeHTTPClient) DeleteCredentials(_ domain.Session, _ uuid.UUID) error {
+	return c.Err
+}
+
+// DeleteBankCard - Удаляет существующую банковскую карту
+func (c FakeHTTPClient) DeleteBankCard(_ domain.Session, _ uuid.UUID) error {
+	return c.Err
+}
+
+// GetChanges - Получает расшифрованные изменения данных пользователя после ревизии since.
+// Если задан PageSize, ревизией считается номер текстовых данных, которые возвращаются страницами,
+// а остальные данные возвращаются только на первой странице
+func (c FakeHTTPClient) GetChanges(_ domain.Session, since int64) (domain.Changes, error) {
+	if c.Err != nil {
+		return domain.Changes{}, c.Err
+	}
+	if c.Pages != nil {
+		*c.Pages++
+	}
+
+	data := c.SyncAllData
+	if c.PageSize > 0 {
+		end := min(int(since)+c.PageSize, len(data.Texts))
+		changes := domain.Changes{
+			Revision: int64(end),
+			Texts:    data.Texts[since:end],
+			More:     end < len(data.Texts),
+		}
+		if since == 0 {
+			changes.Credentials = data.Credentials
+			changes.Deleted = data.Deleted
+		}
+
+		return changes, nil
+	}
+
+	return domain.Changes{
+		Revision:    data.Revision,
+		Texts:       data.Texts,
+		Binaries:    data.Binaries,
+		Credentials: data.Credentials,
+		BankCards:   data.BankCards,
+		OTPs:        data.OTPs,
+		SSHKeys:     data.SSHKeys,
+		Items:       data.Items,
+		Folders:     data.Folders,
+		Tags:        data.Tags,
+		Templates:   data.Templates,
+		Labels:      data.Labels,
+		Deleted:     data.Deleted,
+	}, nil
+}
+
+// CreateFolder - Создает папку, возвращает новый идентификатор ресурса
+func (c FakeHTTPClient) CreateFolder(_ domain.Session, _ domain.Folder) (uuid.UUID, error) {
+	if c.Err != nil {
+		return uuid.Nil, c.Err
+	}
+
+	return uuid.New(), nil
+}
+
+// UpdateFolder - Переименовывает или перемещает существующую папку
+func (c FakeHTTPClient) UpdateFolder(_ domain.Session, _ domain.Folder) error {
+	return c.Err
+}
+
+// DeleteFolder - Удаляет папку
+func (c FakeHTTPClient) DeleteFolder(_ domain.Session, _ uuid.UUID) error {
+	return c.Err
+}
+
+// CreateTag - Создает метку, возвращает новый идентификатор ресурса
+func (c FakeHTTPClient) CreateTag(_ domain.Session, _ string) (uuid.UUID, error) {
+	if c.Err != nil {
+		return uuid.Nil, c.Err
+	}
+
+	return uuid.New(), nil
+}
+
+// CreateTemplate - Создает пользовательский шаблон данных, возвращает новый идентификатор ресурса
+func (c FakeHTTPClient) CreateTemplate(_ domain.Session, _ domain.ItemTemplate) (uuid.UUID, error) {
+	if c.Err != nil {
+		return uuid.Nil, c.Err
+	}
+
+	return uuid.New(), nil
+}
+
+// DeleteTemplate - Удаляет пользовательский шаблон данных
+func (c FakeHTTPClient) DeleteTemplate(_ domain.Session, _ uuid.UUID) error {
+	return c.Err
+}
+
+// SaveLabels - Заменяет папку и метки данных
+func (c FakeHTTPClient) SaveLabels(_ domain.Session, labels domain.Labels) error {
+	if c.Err != nil {
+		return c.Err
+	}
+	if c.SavedLabels != nil {
+		*c.SavedLabels = append(*c.SavedLabels, labels)
+	}
+
+	return nil
 }
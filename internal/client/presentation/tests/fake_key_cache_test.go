@@ -0,0 +1,39 @@
+package tests
+
+import (
+	"time"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// FakeKeyCache - Фейковый кэш ключа локального хранилища для unit тестов
+type FakeKeyCache struct {
+	// Key - Закэшированный ключ, nil если хранилище заблокировано
+	Key []byte
+	// TTL - Время, на которое был закэширован ключ
+	TTL time.Duration
+}
+
+// Get - Возвращает закэшированный ключ
+func (c *FakeKeyCache) Get() ([]byte, error) {
+	if c.Key == nil {
+		return nil, domain.ErrVaultLocked
+	}
+
+	return c.Key, nil
+}
+
+// Put - Кэширует ключ
+func (c *FakeKeyCache) Put(key []byte, ttl time.Duration) error {
+	c.Key = key
+	c.TTL = ttl
+
+	return nil
+}
+
+// Clear - Удаляет ключ из кэша
+func (c *FakeKeyCache) Clear() error {
+	c.Key = nil
+
+	return nil
+}
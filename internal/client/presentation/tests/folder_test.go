@@ -0,0 +1,348 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// folderByName - Возвращает локальную папку по имени
+func folderByName(t *testing.T, userID uuid.UUID, name string) domain.Folder {
+	t.Helper()
+	folders, err := folderRepository.GetAll(userID)
+	require.NoError(t, err)
+	for _, v := range folders {
+		if v.Name == name {
+			return v
+		}
+	}
+	require.Failf(t, "folder not found", "name: %s", name)
+
+	return domain.Folder{}
+}
+
+func TestFolderMakeMoveDelete(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	for _, args := range [][]string{
+		{"gophkeeper", "folder", "mk", "-p", "work/servers"},
+		{"gophkeeper", "folder", "mk", "personal"},
+	} {
+		err = cmd.Run(context.Background(), args)
+		require.NoError(t, err)
+	}
+	work := folderByName(t, userID, "work")
+	servers := folderByName(t, userID, "servers")
+	personal := folderByName(t, userID, "personal")
+	assert.Equal(t, uuid.Nil, work.ParentID)
+	assert.Equal(t, work.ID, servers.ParentID)
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "folder", "ls"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "personal/")
+	assert.Contains(t, out, "work/")
+	assert.NotContains(t, out, "servers/")
+
+	// Существующая папка назначения принимает перемещаемую папку
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "folder", "mv", "work/servers", "personal"})
+	require.NoError(t, err)
+	assert.Equal(t, personal.ID, folderByName(t, userID, "servers").ParentID)
+
+	// Несуществующий путь назначения переименовывает папку
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "folder", "mv", "personal/servers", "personal/hosts"})
+	require.NoError(t, err)
+	hosts := folderByName(t, userID, "hosts")
+	assert.Equal(t, servers.ID, hosts.ID)
+
+	out, err = runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "folder", "mv", "personal", "personal/hosts"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, domain.ErrFolderCycle.Error())
+
+	textID := uuid.New()
+	err = textRepository.Create(userID, domain.Text{ID: textID, Content: "in personal"})
+	require.NoError(t, err)
+	err = labelsRepository.Create(userID, domain.Labels{EntityID: textID, Kind: domain.TextKind, FolderID: personal.ID})
+	require.NoError(t, err)
+
+	// Вложенные папки и данные удаленной папки переносятся в ее родительскую папку
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "folder", "rm", "personal"})
+	require.NoError(t, err)
+	_, err = folderRepository.Get(userID, personal.ID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	assert.Equal(t, uuid.Nil, folderByName(t, userID, "hosts").ParentID)
+	labels, err := labelsRepository.Get(userID, textID)
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Nil, labels.FolderID)
+}
+
+func TestFolderMakeMissingParent(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "folder", "mk", "work/servers"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, domain.ErrFolderNotFound.Error())
+
+	folders, err := folderRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Empty(t, folders)
+}
+
+func TestFolderMakeServerUnavailable(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{Err: domain.ErrServerUnavailable})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "folder", "mk", "work"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, domain.ErrServerUnavailable.Error())
+
+	folders, err := folderRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Empty(t, folders)
+}
+
+func TestCreateTextWithFolderAndTags(t *testing.T) {
+	textID := uuid.New()
+	var saved []domain.Labels
+	cmd, err := setup(FakeHTTPClient{Response: textID, SavedLabels: &saved})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "folder", "mk", "work"})
+	require.NoError(t, err)
+	work := folderByName(t, userID, "work")
+
+	args := []string{"gophkeeper", "create", "text", "--folder", "work", "--tag", "ssh", "--tag", "prod", "content"}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	labels, err := labelsRepository.Get(userID, textID)
+	require.NoError(t, err)
+	assert.Equal(t, work.ID, labels.FolderID)
+	assert.Len(t, labels.TagIDs, 2)
+	require.Len(t, saved, 1)
+	assert.Equal(t, textID, saved[0].EntityID)
+	tags, err := tagRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Len(t, tags, 2)
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "folder", "ls", "work"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, textID.String())
+	assert.Contains(t, out, "prod, ssh")
+
+	otherID := uuid.New()
+	err = textRepository.Create(userID, domain.Text{ID: otherID, Content: "in root"})
+	require.NoError(t, err)
+
+	out, err = runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "show", "texts", "--tag", "ssh"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, textID.String())
+	assert.NotContains(t, out, otherID.String())
+
+	out, err = runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "show", "texts", "--folder", "missing"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, domain.ErrFolderNotFound.Error())
+}
+
+func TestUpdateTextLabelsOffline(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{Err: domain.ErrServerUnavailable})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	work := domain.Folder{ID: uuid.New(), Name: "work"}
+	err = folderRepository.Create(userID, work)
+	require.NoError(t, err)
+	text := domain.Text{ID: uuid.New(), Content: "content", Version: domain.InitialVersion}
+	err = textRepository.Create(userID, text)
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "update", "text", "--folder", "work", text.ID.String()}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	// Изменяются только папка и метки, версия данных не меняется
+	stored, err := textRepository.Get(userID, text.ID)
+	require.NoError(t, err)
+	assert.Equal(t, text.Version, stored.Version)
+
+	labels, err := labelsRepository.Get(userID, text.ID)
+	require.NoError(t, err)
+	assert.Equal(t, work.ID, labels.FolderID)
+
+	ops, err := journalRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, domain.LabelsKind, ops[0].Kind)
+	assert.Equal(t, text.ID, ops[0].EntityID)
+
+	// Новые метки создаются на сервере, поэтому без связи с ним не назначаются
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "update", "text", "--tag", "ssh", text.ID.String()})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, domain.ErrServerUnavailable.Error())
+}
+
+func TestSyncPushLabelsOfCreatedOffline(t *testing.T) {
+	serverID := uuid.New()
+	var saved []domain.Labels
+	cmd, err := setup(FakeHTTPClient{Response: serverID, SavedLabels: &saved})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	folderID := uuid.New()
+	localID := uuid.New()
+	err = textRepository.Create(userID, domain.Text{ID: localID, Content: "created offline"})
+	require.NoError(t, err)
+	err = labelsRepository.Create(userID, domain.Labels{EntityID: localID, Kind: domain.TextKind, FolderID: folderID})
+	require.NoError(t, err)
+	for _, op := range []domain.Operation{
+		{Kind: domain.TextKind, Action: domain.CreateAction, EntityID: localID},
+		{Kind: domain.LabelsKind, Action: domain.UpdateAction, EntityID: localID},
+	} {
+		err = journalRepository.Append(userID, op)
+		require.NoError(t, err)
+	}
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "sync", "push"})
+	require.NoError(t, err)
+
+	ops, err := journalRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+
+	// Папка и метки отправляются с идентификатором, выданным сервером
+	require.Len(t, saved, 1)
+	assert.Equal(t, serverID, saved[0].EntityID)
+	assert.Equal(t, folderID, saved[0].FolderID)
+
+	_, err = labelsRepository.Get(userID, localID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	labels, err := labelsRepository.Get(userID, serverID)
+	require.NoError(t, err)
+	assert.Equal(t, folderID, labels.FolderID)
+}
+
+func TestSyncAllIncrementalLabels(t *testing.T) {
+	work := domain.Folder{ID: uuid.New(), Name: "work"}
+	tag := domain.Tag{ID: uuid.New(), Name: "ssh"}
+	text := domain.Text{ID: uuid.New(), Content: "content"}
+	deletedText := domain.Text{ID: uuid.New(), Content: "deleted"}
+	deletedFolder := domain.Folder{ID: uuid.New(), Name: "old"}
+	client := FakeHTTPClient{
+		SyncAllData: getAllResponse{
+			Texts:   []domain.Text{text},
+			Folders: []domain.Folder{work},
+			Tags:    []domain.Tag{tag},
+			Labels: []domain.Labels{
+				{EntityID: text.ID, Kind: domain.TextKind, FolderID: work.ID, TagIDs: []uuid.UUID{tag.ID}},
+			},
+			Deleted: []domain.Tombstone{
+				{ID: deletedText.ID, Kind: domain.TextKind},
+				{ID: deletedFolder.ID, Kind: domain.FolderKind},
+			},
+			Revision: 20,
+		},
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	err = textRepository.Create(userID, deletedText)
+	require.NoError(t, err)
+	err = labelsRepository.Create(userID, domain.Labels{EntityID: deletedText.ID, Kind: domain.TextKind})
+	require.NoError(t, err)
+	err = folderRepository.Create(userID, deletedFolder)
+	require.NoError(t, err)
+	err = revisionRepository.Save(userID, 10)
+	require.NoError(t, err)
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "sync", "all"})
+	require.NoError(t, err)
+
+	folders, err := folderRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Folder{work}, folders)
+
+	labels, err := labelsRepository.Get(userID, text.ID)
+	require.NoError(t, err)
+	assert.Equal(t, work.ID, labels.FolderID)
+	assert.Equal(t, []uuid.UUID{tag.ID}, labels.TagIDs)
+
+	_, err = labelsRepository.Get(userID, deletedText.ID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "folder", "ls", "work"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, text.ID.String())
+	assert.Contains(t, out, "ssh")
+}
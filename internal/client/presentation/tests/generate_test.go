@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"generate",
+		"--length",
+		"32",
+		"--no-symbols",
+		"--exclude-ambiguous",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 2)
+	assert.Len(t, lines[0], 32)
+	assert.False(t, strings.ContainsAny(lines[0], "Il1O0o!#$%&"))
+	assert.Equal(t, "entropy: ~186 bits", lines[1])
+}
+
+func TestGeneratePassphrase(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"generate",
+		"--passphrase",
+		"--words",
+		"5",
+		"--separator",
+		" ",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 2)
+	assert.Len(t, strings.Fields(lines[0]), 5)
+	assert.Equal(t, "entropy: ~55 bits", lines[1])
+}
+
+func TestGenerateInvalidOptions(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"generate",
+		"--no-lower",
+		"--no-upper",
+		"--no-digits",
+		"--no-symbols",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "at least one character class must be enabled")
+}
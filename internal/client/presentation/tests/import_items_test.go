@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// fixture - Возвращает путь к файлу экспорта из тестовых данных пакета importers
+func fixture(name string) string {
+	return filepath.Join("..", "..", "importers", "testdata", name)
+}
+
+func TestImportBitwarden(t *testing.T) {
+	// Без связи с сервером данные получают локальные идентификаторы и попадают в журнал
+	cmd, err := setup(FakeHTTPClient{Err: domain.ErrServerUnavailable})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "import", "--format", "bitwarden-json", "--rate", "1000", fixture("bitwarden.json")}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "imported 1 texts, 0 binaries, 1 credentials, 1 bank cards")
+	assert.Contains(t, out, "skipped row 2 (Broken login): no password")
+	assert.Contains(t, out, "skipped row 5 (Passport): unsupported item type 4")
+
+	creds, err := credentialsRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	assert.Equal(t, "GitHub", creds[0].Name)
+	assert.Equal(t, "correct-horse-battery", creds[0].Password)
+
+	cards, err := bankCardRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+	assert.Equal(t, "4111 1111 1111 1111", cards[0].Number)
+	assert.Equal(t, "03/30", cards[0].ValidThru)
+
+	texts, err := textRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, texts, 1)
+
+	// Повторный импорт пропускает уже созданные данные
+	out, err = runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "skipped duplicates: 1 texts, 0 binaries, 1 credentials, 1 bank cards")
+}
+
+func TestImportDryRun(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{Err: domain.ErrServerUnavailable})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "import", "--format", "1password-csv", "--dry-run", fixture("1password.csv")}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "GitLab")
+	assert.Contains(t, out, "Bank, main")
+	assert.Contains(t, out, "would import 0 texts, 0 binaries, 2 credentials, 0 bank cards")
+	assert.Contains(t, out, "skipped row 5 (Old forum): archived")
+	assert.NotContains(t, out, "gitlab-secret")
+
+	creds, err := credentialsRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Empty(t, creds)
+}
+
+func TestImportInvalidFormat(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "import", "--format", "lastpass-csv", fixture("chrome.csv")}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "unknown import format")
+
+	args = []string{"gophkeeper", "import", "--format", "keepass-xml", fixture("chrome.csv")}
+	out, err = runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "invalid import file")
+}
+
+func TestImportRejectedByServer(t *testing.T) {
+	// Отклоненные сервером записи пропускаются, импорт остальных не прерывается
+	cmd, err := setup(FakeHTTPClient{Err: domain.ErrBadRequest})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "import", "--format", "bitwarden-json", "--rate", "1000", fixture("bitwarden.json")}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "imported 0 texts, 0 binaries, 0 credentials, 0 bank cards")
+	assert.Contains(t, out, "skipped row 2 (Broken login): no password")
+	assert.Contains(t, out, `skipped "Wi-Fi": rejected by the server: invalid input`)
+	assert.Contains(t, out, `skipped "GitHub": rejected by the server: invalid input`)
+	assert.Contains(t, out, `skipped "Visa": rejected by the server: invalid input`)
+
+	cards, err := bankCardRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Empty(t, cards)
+}
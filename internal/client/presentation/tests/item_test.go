@@ -0,0 +1,374 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+)
+
+func newItem() domain.Item {
+	return domain.Item{
+		ID:       uuid.New(),
+		Name:     "Office",
+		Template: "wifi",
+		Fields: []item.Field{
+			{Name: "ssid", Type: item.TypeString, Value: "Office"},
+			{Name: "password", Type: item.TypeSecret, Value: "wifi-secret"},
+			{Name: "security", Type: item.TypeString, Value: "WPA2"},
+		},
+		Version: domain.InitialVersion,
+	}
+}
+
+func TestCreateItemFromTemplate(t *testing.T) {
+	itemID := uuid.New()
+	cmd, err := setup(FakeHTTPClient{Response: itemID})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper", "create", "item",
+		"--template", "wifi",
+		"--name", "Office",
+		"--field", "ssid=Office",
+		"--field", "password=a=b,c",
+		"--field", "router:url=http://192.168.0.1",
+		"--meta", "work",
+	}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	obj, err := itemRepository.Get(userID, itemID)
+	require.NoError(t, err)
+	assert.Equal(t, "Office", obj.Name)
+	assert.Equal(t, "wifi", obj.Template)
+	assert.Equal(t, "work", obj.Meta)
+	// Значение разделяется по первому знаку равенства и не разделяется по запятой
+	assert.Equal(t, []item.Field{
+		{Name: "ssid", Type: item.TypeString, Value: "Office"},
+		{Name: "password", Type: item.TypeSecret, Value: "a=b,c"},
+		{Name: "security", Type: item.TypeString},
+		{Name: "router", Type: item.TypeURL, Value: "http://192.168.0.1"},
+	}, obj.Fields)
+}
+
+func TestCreateItemInvalidField(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "Invalid date",
+			args: []string{"--field", "expires:date=tomorrow"},
+			want: "invalid item field",
+		},
+		{
+			name: "Missing required field",
+			args: []string{"--template", "api-key", "--field", "service=GitHub"},
+			want: `"key" is required by template api-key`,
+		},
+		{
+			name: "Unknown template",
+			args: []string{"--template", "passport"},
+			want: "unknown item template",
+		},
+		{
+			name: "Missing binary",
+			args: []string{"--field", "scan:file=" + uuid.NewString()},
+			want: "not found",
+		},
+		{
+			name: "Untyped custom field",
+			args: []string{"--field", "pin=1234"},
+			want: "pass its type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := setup(FakeHTTPClient{Response: uuid.New()})
+			require.NoError(t, err)
+			defer func() {
+				err = teardown()
+				require.NoError(t, err)
+			}()
+
+			userID, err := createSession()
+			require.NoError(t, err)
+
+			args := append([]string{"gophkeeper", "create", "item", "--name", "Door"}, tt.args...)
+			out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+			require.NoError(t, err)
+			assert.Contains(t, out, tt.want)
+
+			items, err := itemRepository.GetAll(userID)
+			require.NoError(t, err)
+			assert.Empty(t, items)
+		})
+	}
+}
+
+func TestCreateItemFileField(t *testing.T) {
+	itemID := uuid.New()
+	cmd, err := setup(FakeHTTPClient{Response: itemID})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	bin := domain.Binary{ID: uuid.New(), Name: "license.txt", Content: []byte("license"), Version: domain.InitialVersion}
+	err = binaryRepository.Create(userID, bin)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper", "create", "item",
+		"--template", "license",
+		"--name", "IDE",
+		"--field", "product=IDE",
+		"--field", "license-key=XXXX-YYYY",
+		"--field", "file=" + bin.ID.String(),
+	}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	obj, err := itemRepository.Get(userID, itemID)
+	require.NoError(t, err)
+	assert.Equal(t, item.Field{Name: "file", Type: item.TypeFile, Value: bin.ID.String()}, obj.Fields[5])
+}
+
+func TestCreateItemOffline(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{Err: domain.ErrServerUnavailable})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "create", "item", "--name", "Door", "--field", "code:secret=1234"}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	items, err := itemRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, int64(0), items[0].Version)
+	assert.Equal(t, item.Custom, items[0].Template)
+
+	ops, err := journalRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, domain.ItemKind, ops[0].Kind)
+	assert.Equal(t, items[0].ID, ops[0].EntityID)
+}
+
+func TestUpdateItemSuccess(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	obj := newItem()
+	err = itemRepository.Create(userID, &obj)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper", "update", "item",
+		"--field", "password=new-secret",
+		"--field", "floor:number=3",
+		"--remove-field", "security",
+		obj.ID.String(),
+	}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	updated, err := itemRepository.Get(userID, obj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []item.Field{
+		{Name: "ssid", Type: item.TypeString, Value: "Office"},
+		{Name: "password", Type: item.TypeSecret, Value: "new-secret"},
+		{Name: "floor", Type: item.TypeNumber, Value: "3"},
+	}, updated.Fields)
+	assert.Equal(t, obj.Version+1, updated.Version)
+}
+
+func TestUpdateItemRemoveRequiredField(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	obj := newItem()
+	err = itemRepository.Create(userID, &obj)
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "update", "item", "--remove-field", "ssid", obj.ID.String()}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, `"ssid" is required by template wifi`)
+
+	stored, err := itemRepository.Get(userID, obj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, obj, stored)
+}
+
+func TestUpdateItemConflictMerge(t *testing.T) {
+	obj := newItem()
+	theirs := obj
+	theirs.Name = "Their office"
+	theirs.Version = 2
+	cmd, err := setup(FakeHTTPClient{Theirs: theirs})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	err = itemRepository.Create(userID, &obj)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper", "update", "item",
+		"--resolve", "merge",
+		"--field", "password=my-secret",
+		obj.ID.String(),
+	}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	merged, err := itemRepository.Get(userID, obj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Their office", merged.Name)
+	assert.Equal(t, "my-secret", merged.Fields[1].Value)
+	assert.Equal(t, int64(3), merged.Version)
+}
+
+func TestShowItemsHidesSecrets(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	obj := newItem()
+	err = itemRepository.Create(userID, &obj)
+	require.NoError(t, err)
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "show", "items"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "WPA2")
+	assert.Contains(t, out, "******")
+	assert.NotContains(t, out, "wifi-secret")
+
+	out, err = runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "show", "items", "--reveal"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "wifi-secret")
+}
+
+func TestShowItemTemplates(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "show", "item-templates"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "custom")
+	assert.Contains(t, out, "ssid:string*, password:secret, security:string")
+}
+
+func TestDeleteItemSuccess(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	obj := newItem()
+	err = itemRepository.Create(userID, &obj)
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "delete", "item", obj.ID.String()}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = itemRepository.Get(userID, obj.ID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestSyncItemsOverrideSuccess(t *testing.T) {
+	remote := []domain.Item{newItem(), newItem()}
+	cmd, err := setup(FakeHTTPClient{Response: remote})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	local := newItem()
+	err = itemRepository.Create(userID, &local)
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "sync", "items"}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	items, err := itemRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+	_, err = itemRepository.Get(userID, local.ID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
@@ -17,7 +17,8 @@ func TestLoginSuccess(t *testing.T) {
 	token, err := issueToken(userID, time.Hour)
 	require.NoError(t, err)
 	client := FakeHTTPClient{
-		Response: token,
+		Response:     token,
+		RefreshToken: "refreshToken",
 	}
 
 	cmd, err := setup(client)
@@ -40,6 +41,7 @@ func TestLoginSuccess(t *testing.T) {
 	session, err := sessionRepository.Get()
 	require.NoError(t, err)
 	assert.Equal(t, session.Token, token)
+	assert.Equal(t, session.RefreshToken, "refreshToken")
 	assert.Equal(t, session.UserID, userID)
 }
 
@@ -0,0 +1,320 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+const otpSecret = "JBSWY3DPEHPK3PXP"
+
+func newOTP() domain.OTP {
+	return domain.OTP{
+		ID:        uuid.New(),
+		Issuer:    "GitHub",
+		Account:   "octocat",
+		Secret:    otpSecret,
+		Algorithm: "SHA1",
+		Digits:    "6",
+		Period:    "30",
+		Version:   domain.InitialVersion,
+	}
+}
+
+func TestCreateOTPSuccess(t *testing.T) {
+	otpID := uuid.New()
+	cmd, err := setup(FakeHTTPClient{Response: otpID})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"create",
+		"otp",
+		"--issuer",
+		"GitHub",
+		"--account",
+		"octocat",
+		"--digits",
+		"8",
+		"--meta",
+		"work",
+		otpSecret,
+	}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	otp, err := otpRepository.Get(userID, otpID)
+	require.NoError(t, err)
+	assert.Equal(t, "GitHub", otp.Issuer)
+	assert.Equal(t, "octocat", otp.Account)
+	assert.Equal(t, otpSecret, otp.Secret)
+	assert.Equal(t, "SHA1", otp.Algorithm)
+	assert.Equal(t, "8", otp.Digits)
+	assert.Equal(t, "30", otp.Period)
+	assert.Equal(t, "work", otp.Meta)
+}
+
+func TestCreateOTPFromURISuccess(t *testing.T) {
+	otpID := uuid.New()
+	cmd, err := setup(FakeHTTPClient{Response: otpID})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	uri := "otpauth://totp/Example:alice@example.com?secret=" + otpSecret + "&issuer=Example&algorithm=SHA256&period=60"
+	args := []string{"gophkeeper", "create", "otp", "--uri", uri}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	otp, err := otpRepository.Get(userID, otpID)
+	require.NoError(t, err)
+	assert.Equal(t, "Example", otp.Issuer)
+	assert.Equal(t, "alice@example.com", otp.Account)
+	assert.Equal(t, "SHA256", otp.Algorithm)
+	assert.Equal(t, "6", otp.Digits)
+	assert.Equal(t, "60", otp.Period)
+}
+
+func TestCreateOTPInvalidSecret(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{Response: uuid.New()})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "create", "otp", "not base32!"}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "invalid one-time password parameters")
+
+	otps, err := otpRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Empty(t, otps)
+}
+
+func TestCreateOTPOffline(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{Err: domain.ErrServerUnavailable})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "create", "otp", otpSecret}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	otps, err := otpRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, otps, 1)
+	assert.Equal(t, int64(0), otps[0].Version)
+
+	ops, err := journalRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, domain.OTPKind, ops[0].Kind)
+	assert.Equal(t, otps[0].ID, ops[0].EntityID)
+}
+
+func TestUpdateOTPSuccess(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	otp := newOTP()
+	err = otpRepository.Create(userID, &otp)
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "update", "otp", "--period", "60", "--account", "hubot", otp.ID.String()}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	updated, err := otpRepository.Get(userID, otp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "60", updated.Period)
+	assert.Equal(t, "hubot", updated.Account)
+	assert.Equal(t, "GitHub", updated.Issuer)
+	assert.Equal(t, "6", updated.Digits)
+	assert.Equal(t, otp.Version+1, updated.Version)
+}
+
+func TestUpdateOTPInvalidDigits(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	otp := newOTP()
+	err = otpRepository.Create(userID, &otp)
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "update", "otp", "--digits", "12", otp.ID.String()}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "digits must be from 6 to 8")
+
+	stored, err := otpRepository.Get(userID, otp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "6", stored.Digits)
+}
+
+func TestDeleteOTPSuccess(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	otp := newOTP()
+	err = otpRepository.Create(userID, &otp)
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "delete", "otp", otp.ID.String()}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = otpRepository.Get(userID, otp.ID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestSyncOTPsOverrideSuccess(t *testing.T) {
+	remote := []domain.OTP{newOTP(), newOTP()}
+	cmd, err := setup(FakeHTTPClient{Response: remote})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	local := newOTP()
+	err = otpRepository.Create(userID, &local)
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "sync", "otps"}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	otps, err := otpRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Len(t, otps, 2)
+	_, err = otpRepository.Get(userID, local.ID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestOTPCodeSuccess(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	otp := newOTP()
+	err = otpRepository.Create(userID, &otp)
+	require.NoError(t, err)
+	key, err := otp.Key()
+	require.NoError(t, err)
+
+	before, err := key.Code(time.Now())
+	require.NoError(t, err)
+	args := []string{"gophkeeper", "otp", otp.ID.String()}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	after, err := key.Code(time.Now())
+	require.NoError(t, err)
+
+	// Код мог смениться во время выполнения команды
+	assert.True(t, strings.Contains(out, before) || strings.Contains(out, after), out)
+	assert.Contains(t, out, "GitHub:octocat")
+	assert.Contains(t, out, "seconds remaining")
+}
+
+func TestOTPCodeList(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	first := newOTP()
+	err = otpRepository.Create(userID, &first)
+	require.NoError(t, err)
+	second := newOTP()
+	second.Issuer = "GitLab"
+	err = otpRepository.Create(userID, &second)
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "otp"}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, first.ID.String())
+	assert.Contains(t, out, "GitLab:octocat")
+}
+
+func TestOTPCodeNotFound(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{"gophkeeper", "otp", uuid.NewString()}
+	out, err := runCaptured(func() error { return cmd.Run(context.Background(), args) })
+	require.NoError(t, err)
+	assert.Contains(t, out, "otp not found")
+}
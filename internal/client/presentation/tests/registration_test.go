@@ -2,6 +2,7 @@ package tests //nolint: dupl
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
 )
 
 func TestRegistrationSuccess(t *testing.T) {
@@ -17,7 +19,8 @@ func TestRegistrationSuccess(t *testing.T) {
 	token, err := issueToken(userID, time.Hour)
 	require.NoError(t, err)
 	client := FakeHTTPClient{
-		Response: token,
+		Response:     token,
+		RefreshToken: "refreshToken",
 	}
 
 	cmd, err := setup(client)
@@ -40,9 +43,94 @@ func TestRegistrationSuccess(t *testing.T) {
 	session, err := sessionRepository.Get()
 	require.NoError(t, err)
 	assert.Equal(t, session.Token, token)
+	assert.Equal(t, session.RefreshToken, "refreshToken")
 	assert.Equal(t, session.UserID, userID)
 }
 
+func TestRegistrationE2E(t *testing.T) {
+	userID := uuid.New()
+	token, err := issueToken(userID, time.Hour)
+	require.NoError(t, err)
+	client := FakeHTTPClient{
+		Response:     token,
+		RefreshToken: "refreshToken",
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"register",
+		"--e2e",
+		"test_login",
+		"test_password",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	session, err := sessionRepository.Get()
+	require.NoError(t, err)
+	assert.Equal(t, session.UserID, userID)
+	assert.Len(t, session.VaultKey, crypto.KeyLength)
+}
+
+// Проверяем, что после регистрации со сквозным шифрованием, выхода и повторного входа
+// ключ хранилища выводится из той же соли и данные с сервера расшифровываются
+func TestRegistrationE2ELoginDecrypt(t *testing.T) {
+	userID := uuid.New()
+	token, err := issueToken(userID, time.Hour)
+	require.NoError(t, err)
+	account := &fakeAccount{}
+
+	cmd, err := setup(FakeHTTPClient{Response: token, RefreshToken: "refreshToken", Account: account})
+	require.NoError(t, err)
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "register", "--e2e", "test_login", "test_password"})
+	require.NoError(t, err)
+	session, err := sessionRepository.Get()
+	require.NoError(t, err)
+	vaultKey := session.VaultKey
+	require.Len(t, account.Salt, crypto.SaltLength)
+	err = teardown()
+	require.NoError(t, err)
+
+	vault, err := crypto.New(vaultKey)
+	require.NoError(t, err)
+	encrypted, err := vault.Encrypt([]byte("my fancy content"))
+	require.NoError(t, err)
+	text := domain.Text{ID: uuid.New(), Content: base64.StdEncoding.EncodeToString(encrypted)}
+
+	client := FakeHTTPClient{
+		Response:     token,
+		RefreshToken: "refreshToken",
+		E2E:          true,
+		Account:      account,
+		SyncAllData:  getAllResponse{Texts: []domain.Text{text}},
+	}
+	cmd, err = setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "login", "test_login", "test_password"})
+	require.NoError(t, err)
+
+	session, err = sessionRepository.Get()
+	require.NoError(t, err)
+	assert.Equal(t, vaultKey, session.VaultKey)
+	texts, err := textRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, texts, 1)
+	assert.Equal(t, "my fancy content", texts[0].Content)
+}
+
 func TestRegistrationConflict(t *testing.T) {
 	client := FakeHTTPClient{
 		Err: domain.ErrLoginConflict,
@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// runCaptured - Выполняет команду и возвращает то, что она вывела в стандартный вывод
+func runCaptured(run func() error) (string, error) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return "", err
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	runErr := run()
+	os.Stdout = stdout
+	if err = writer.Close(); err != nil {
+		return "", err
+	}
+	out, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), runErr
+}
+
+func createSearchData(userID uuid.UUID) (githubID, gitlabID uuid.UUID, err error) {
+	githubID = uuid.New()
+	gitlabID = uuid.New()
+	creds := []domain.Credentials{
+		{ID: githubID, Name: "GitHub", Login: "octocat", Password: "secret-password", Meta: "work"},
+		{ID: gitlabID, Name: "my-gitlab-hub", Login: "tanuki", Password: "other-password"},
+		{ID: uuid.New(), Name: "bank", Login: "client", Password: "bank-password"},
+	}
+	for i := range creds {
+		if err = credentialsRepository.Create(userID, &creds[i]); err != nil {
+			return githubID, gitlabID, err
+		}
+	}
+	err = textRepository.Create(userID, domain.Text{ID: uuid.New(), Content: "github recovery codes\n1111 2222"})
+	if err != nil {
+		return githubID, gitlabID, err
+	}
+	card := domain.BankCard{ID: uuid.New(), Number: "4111111111111111", CVV: "123", CardHolder: "OCTO CAT", Meta: "github sponsor"}
+	err = bankCardRepository.Create(userID, &card)
+
+	return githubID, gitlabID, err
+}
+
+func TestSearchSuccess(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+	githubID, gitlabID, err := createSearchData(userID)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"search",
+		"github",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 5)
+	assert.Contains(t, lines[1], githubID.String())
+	assert.Contains(t, lines[1], "credentials")
+	assert.Contains(t, out, "text")
+	assert.Contains(t, out, "bank_card")
+	assert.Contains(t, lines[4], gitlabID.String())
+	assert.NotContains(t, out, "password")
+	assert.NotContains(t, out, "4111")
+}
+
+func TestSearchByKind(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+	_, _, err = createSearchData(userID)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"search",
+		"--kind",
+		"text",
+		"--kind",
+		"bank-card",
+		"github",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 3)
+	assert.NotContains(t, out, "credentials")
+}
+
+func TestSearchNothingFound(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+	_, _, err = createSearchData(userID)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"search",
+		"secret-password",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "nothing found\n", out)
+}
+
+func TestSearchUnknownKind(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"search",
+		"--kind",
+		"note",
+		"github",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, `unknown kind "note"`)
+}
+
+func TestSearchNoToken(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"search",
+		"github",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "unauthorized\n", out)
+}
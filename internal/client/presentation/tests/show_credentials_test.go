@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
@@ -115,3 +116,192 @@ func TestShowCredentialsExpiredToken(t *testing.T) {
 	err = cmd.Run(context.Background(), args)
 	require.NoError(t, err)
 }
+
+func TestShowCredentialsByID(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	cred := domain.Credentials{
+		ID:       uuid.New(),
+		Name:     "www.example.com",
+		Login:    "login",
+		Password: "password",
+	}
+	err = credentialsRepository.Create(userID, &cred)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"show",
+		"credentials",
+		"--id",
+		cred.ID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
+
+// Проверяем, что актуальная копия с сервера сохраняется локально
+func TestShowCredentialsRemote(t *testing.T) {
+	credID := uuid.New()
+	fresh := domain.Credentials{
+		ID:       credID,
+		Name:     "www.example.com",
+		Login:    "login",
+		Password: "new password",
+		Version:  2,
+	}
+	client := FakeHTTPClient{
+		Response: fresh,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	cred := domain.Credentials{
+		ID:       credID,
+		Name:     "www.example.com",
+		Login:    "login",
+		Password: "old password",
+		Version:  1,
+	}
+	err = credentialsRepository.Create(userID, &cred)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"show",
+		"credentials",
+		"--id",
+		credID.String(),
+		"--remote",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	local, err := credentialsRepository.Get(userID, credID)
+	require.NoError(t, err)
+	assert.Equal(t, fresh, local)
+}
+
+// Проверяем, что неотправленные изменения не перезаписываются копией с сервера
+func TestShowCredentialsRemotePendingChanges(t *testing.T) {
+	credID := uuid.New()
+	client := FakeHTTPClient{
+		Response: domain.Credentials{
+			ID:       credID,
+			Password: "server password",
+			Version:  2,
+		},
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	cred := domain.Credentials{
+		ID:       credID,
+		Password: "offline password",
+		Version:  1,
+	}
+	err = credentialsRepository.Create(userID, &cred)
+	require.NoError(t, err)
+	err = journalRepository.Append(userID, domain.Operation{
+		Kind:     domain.CredentialsKind,
+		Action:   domain.UpdateAction,
+		EntityID: credID,
+	})
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"show",
+		"credentials",
+		"--id",
+		credID.String(),
+		"--remote",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	local, err := credentialsRepository.Get(userID, credID)
+	require.NoError(t, err)
+	assert.Equal(t, "offline password", local.Password)
+}
+
+func TestShowCredentialsRemoteNotFound(t *testing.T) {
+	client := FakeHTTPClient{
+		Err: domain.ErrEntityNotFound,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"show",
+		"credentials",
+		"--id",
+		uuid.NewString(),
+		"--remote",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
+
+func TestShowCredentialsRemoteWithoutID(t *testing.T) {
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"show",
+		"credentials",
+		"--remote",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
@@ -179,6 +179,126 @@ func TestSyncAllSuccess(t *testing.T) {
 	assert.Equal(t, len(cards), 2)
 }
 
+func TestSyncAllIncrementalSuccess(t *testing.T) {
+	newText := domain.Text{
+		ID:      uuid.New(),
+		Content: "new content",
+	}
+	deletedText := domain.Text{
+		ID:      uuid.New(),
+		Content: "deleted content",
+	}
+	keptText := domain.Text{
+		ID:      uuid.New(),
+		Content: "kept content",
+	}
+	client := FakeHTTPClient{
+		SyncAllData: getAllResponse{
+			Texts: []domain.Text{newText},
+			Deleted: []domain.Tombstone{
+				{
+					ID:   deletedText.ID,
+					Kind: domain.TextKind,
+				},
+			},
+			Revision: 15,
+		},
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	err = textRepository.Create(userID, deletedText)
+	require.NoError(t, err)
+	err = textRepository.Create(userID, keptText)
+	require.NoError(t, err)
+	err = revisionRepository.Save(userID, 10)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"sync",
+		"all",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = textRepository.Get(userID, deletedText.ID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+
+	txt, err := textRepository.Get(userID, keptText.ID)
+	require.NoError(t, err)
+	assert.Equal(t, keptText.Content, txt.Content)
+
+	txt, err = textRepository.Get(userID, newText.ID)
+	require.NoError(t, err)
+	assert.Equal(t, newText.Content, txt.Content)
+
+	revision, err := revisionRepository.Get(userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), revision)
+}
+
+func TestSyncAllPagesSuccess(t *testing.T) {
+	pages := 0
+	client := FakeHTTPClient{
+		SyncAllData: getAllResponse{
+			Texts: []domain.Text{
+				{
+					ID:      uuid.New(),
+					Content: uuid.NewString(),
+				},
+				{
+					ID:      uuid.New(),
+					Content: uuid.NewString(),
+				},
+				{
+					ID:      uuid.New(),
+					Content: uuid.NewString(),
+				},
+			},
+		},
+		PageSize: 2,
+		Pages:    &pages,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"sync",
+		"all",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pages)
+
+	txt, err := textRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Equal(t, len(txt), 3)
+
+	revision, err := revisionRepository.Get(userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), revision)
+}
+
 func TestSyncAllServerError(t *testing.T) {
 	client := FakeHTTPClient{
 		Err: domain.ErrInvalidToken,
@@ -0,0 +1,262 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/client/presentation"
+)
+
+func TestSyncPushSuccess(t *testing.T) {
+	serverID := uuid.New()
+	client := FakeHTTPClient{
+		Response: serverID,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	localID := uuid.New()
+	content := "created and updated offline"
+	err = textRepository.Create(userID, domain.Text{ID: localID, Content: content})
+	require.NoError(t, err)
+	for _, action := range []domain.OperationAction{domain.CreateAction, domain.UpdateAction} {
+		err = journalRepository.Append(userID, domain.Operation{
+			Kind:     domain.TextKind,
+			Action:   action,
+			EntityID: localID,
+		})
+		require.NoError(t, err)
+	}
+
+	deletedID := uuid.New()
+	err = journalRepository.Append(userID, domain.Operation{
+		Kind:     domain.CredentialsKind,
+		Action:   domain.DeleteAction,
+		EntityID: deletedID,
+	})
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"sync",
+		"push",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	ops, err := journalRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+
+	_, err = textRepository.Get(userID, localID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+
+	text, err := textRepository.Get(userID, serverID)
+	require.NoError(t, err)
+	assert.Equal(t, content, text.Content)
+}
+
+func TestSyncPushConflict(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		theirsVersion int64
+		expected      string
+		version       int64
+		pending       int
+	}{
+		{
+			name:          "base version matches",
+			theirsVersion: 1,
+			expected:      "mine content",
+			version:       2,
+		},
+		{
+			name:          "theirs",
+			args:          []string{"--resolve", "theirs"},
+			theirsVersion: 3,
+			expected:      "theirs content",
+			version:       3,
+		},
+		{
+			name:          "mine",
+			args:          []string{"--resolve", "mine"},
+			theirsVersion: 3,
+			expected:      "mine content",
+			version:       4,
+		},
+		{
+			name:          "canceled",
+			theirsVersion: 3,
+			expected:      "mine content",
+			version:       1,
+			pending:       1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			textID := uuid.New()
+			client := FakeHTTPClient{
+				Theirs: domain.Text{
+					ID:      textID,
+					Content: "theirs content",
+					Version: tt.theirsVersion,
+				},
+			}
+
+			cmd, err := setup(client)
+			require.NoError(t, err)
+			defer func() {
+				err = teardown()
+				require.NoError(t, err)
+			}()
+			presentation.SetInput(strings.NewReader(""))
+
+			userID, err := createSession()
+			require.NoError(t, err)
+
+			err = textRepository.Create(userID, domain.Text{ID: textID, Content: "mine content", Version: 1})
+			require.NoError(t, err)
+			base, err := json.Marshal(domain.Text{ID: textID, Content: "base content", Version: 1})
+			require.NoError(t, err)
+			err = journalRepository.Append(userID, domain.Operation{
+				Kind:        domain.TextKind,
+				Action:      domain.UpdateAction,
+				EntityID:    textID,
+				BaseVersion: 1,
+				Base:        base,
+			})
+			require.NoError(t, err)
+
+			args := []string{"gophkeeper", "sync", "push"}
+			args = append(args, tt.args...)
+
+			err = cmd.Run(context.Background(), args)
+			require.NoError(t, err)
+
+			ops, err := journalRepository.GetAll(userID)
+			require.NoError(t, err)
+			assert.Len(t, ops, tt.pending)
+
+			txt, err := textRepository.Get(userID, textID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, txt.Content)
+			assert.Equal(t, tt.version, txt.Version)
+		})
+	}
+}
+
+func TestSyncPushSkipsDeletedLocally(t *testing.T) {
+	client := FakeHTTPClient{
+		Err: domain.ErrEntityNotFound,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	localID := uuid.New()
+	for _, action := range []domain.OperationAction{domain.CreateAction, domain.DeleteAction} {
+		err = journalRepository.Append(userID, domain.Operation{
+			Kind:     domain.BankCardKind,
+			Action:   action,
+			EntityID: localID,
+		})
+		require.NoError(t, err)
+	}
+
+	args := []string{
+		"gophkeeper",
+		"sync",
+		"push",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	ops, err := journalRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+}
+
+func TestSyncPushServerUnavailable(t *testing.T) {
+	client := FakeHTTPClient{
+		Err: domain.ErrServerUnavailable,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	localID := uuid.New()
+	err = binaryRepository.Create(userID, domain.Binary{ID: localID, Content: []byte("offline")})
+	require.NoError(t, err)
+	err = journalRepository.Append(userID, domain.Operation{
+		Kind:     domain.BinaryKind,
+		Action:   domain.CreateAction,
+		EntityID: localID,
+	})
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"sync",
+		"push",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	ops, err := journalRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Len(t, ops, 1)
+
+	_, err = binaryRepository.Get(userID, localID)
+	require.NoError(t, err)
+}
+
+func TestSyncPushUnauthorized(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	args := []string{
+		"gophkeeper",
+		"sync",
+		"push",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+}
@@ -144,3 +144,82 @@ func TestSyncTextUnauthorized(t *testing.T) {
 	err = cmd.Run(context.Background(), args)
 	require.NoError(t, err)
 }
+
+func TestSyncTextPendingOperations(t *testing.T) {
+	client := FakeHTTPClient{
+		Response: []domain.Text{},
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	textID := uuid.New()
+	err = textRepository.Create(userID, domain.Text{ID: textID, Content: "created offline"})
+	require.NoError(t, err)
+	err = journalRepository.Append(userID, domain.Operation{
+		Kind:     domain.TextKind,
+		Action:   domain.CreateAction,
+		EntityID: textID,
+	})
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"sync",
+		"texts",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	_, err = textRepository.Get(userID, textID)
+	require.NoError(t, err)
+}
+
+func TestSyncTextPaged(t *testing.T) {
+	texts := []domain.Text{}
+	for i := 0; i < 5; i++ {
+		texts = append(texts, domain.Text{ID: uuid.New(), Content: uuid.NewString()})
+	}
+	pages := 0
+	client := FakeHTTPClient{
+		Response: texts,
+		PageSize: 2,
+		Pages:    &pages,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	stale := domain.Text{ID: uuid.New(), Content: "stale content"}
+	err = textRepository.Create(userID, stale)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"sync",
+		"texts",
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	assert.Equal(t, 3, pages)
+
+	txt, err := textRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, texts, txt)
+}
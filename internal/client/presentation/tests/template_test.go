@@ -0,0 +1,191 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/item"
+)
+
+func TestTemplateAddListDelete(t *testing.T) {
+	itemID := uuid.New()
+	cmd, err := setup(FakeHTTPClient{Response: itemID})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper", "item", "template", "add",
+		"--description", "identity document",
+		"--field", "number:secret*",
+		"--field", "issued:date",
+		"passport",
+	}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	templates, err := templateRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Equal(t, "passport", templates[0].Name)
+	assert.Equal(t, []item.FieldSpec{
+		{Name: "number", Type: item.TypeSecret, Required: true},
+		{Name: "issued", Type: item.TypeDate},
+	}, templates[0].Fields)
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "item", "template", "ls"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "wifi")
+	assert.Contains(t, out, "number:secret*, issued:date")
+
+	// Пользовательский шаблон используется при создании данных так же, как встроенный
+	out, err = runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{
+			"gophkeeper", "create", "item", "--template", "passport", "--name", "Passport",
+		})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, `"number" is required by template passport`)
+
+	args = []string{
+		"gophkeeper", "create", "item",
+		"--template", "passport",
+		"--name", "Passport",
+		"--field", "number=4510 123456",
+	}
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+	obj, err := itemRepository.Get(userID, itemID)
+	require.NoError(t, err)
+	assert.Equal(t, "passport", obj.Template)
+	assert.Equal(t, []item.Field{
+		{Name: "number", Type: item.TypeSecret, Value: "4510 123456"},
+		{Name: "issued", Type: item.TypeDate},
+	}, obj.Fields)
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "item", "template", "rm", "passport"})
+	require.NoError(t, err)
+	templates, err = templateRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Empty(t, templates)
+}
+
+func TestTemplateAddInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "Built-in name",
+			args: []string{"--field", "ssid:string", "wifi"},
+			want: "template already exists",
+		},
+		{
+			name: "Unknown field type",
+			args: []string{"--field", "number:passport", "passport"},
+			want: "unknown type",
+		},
+		{
+			name: "Field without type",
+			args: []string{"--field", "number", "passport"},
+			want: "must be name:type",
+		},
+		{
+			name: "Empty name",
+			args: []string{"--field", "number:secret"},
+			want: "empty template name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := setup(FakeHTTPClient{})
+			require.NoError(t, err)
+			defer func() {
+				err = teardown()
+				require.NoError(t, err)
+			}()
+
+			userID, err := createSession()
+			require.NoError(t, err)
+
+			out, err := runCaptured(func() error {
+				return cmd.Run(context.Background(), append([]string{"gophkeeper", "item", "template", "add"}, tt.args...))
+			})
+			require.NoError(t, err)
+			assert.Contains(t, out, tt.want)
+
+			templates, err := templateRepository.GetAll(userID)
+			require.NoError(t, err)
+			assert.Empty(t, templates)
+		})
+	}
+}
+
+func TestTemplateDeleteBuiltIn(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "item", "template", "rm", "wifi"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "built-in template can not be deleted")
+}
+
+func TestSyncAllTemplates(t *testing.T) {
+	passport := domain.ItemTemplate{
+		ID:     uuid.New(),
+		Name:   "passport",
+		Fields: []item.FieldSpec{{Name: "number", Type: item.TypeSecret, Required: true}},
+	}
+	deleted := domain.ItemTemplate{ID: uuid.New(), Name: "old"}
+	client := FakeHTTPClient{
+		SyncAllData: getAllResponse{
+			Templates: []domain.ItemTemplate{passport},
+			Deleted:   []domain.Tombstone{{ID: deleted.ID, Kind: domain.TemplateKind}},
+			Revision:  20,
+		},
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	err = templateRepository.Create(userID, deleted)
+	require.NoError(t, err)
+	err = revisionRepository.Save(userID, 10)
+	require.NoError(t, err)
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "sync", "all"})
+	require.NoError(t, err)
+
+	templates, err := templateRepository.GetAll(userID)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ItemTemplate{passport}, templates)
+}
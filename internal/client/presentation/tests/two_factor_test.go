@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/client/presentation"
+)
+
+func TestLoginTwoFactor(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		input   string
+		success bool
+	}{
+		{
+			name:    "prompt",
+			input:   "123456\n",
+			success: true,
+		},
+		{
+			name:    "flag",
+			args:    []string{"--code", "123456"},
+			success: true,
+		},
+		{
+			name:  "wrong code",
+			input: "000000\n",
+		},
+		{
+			name: "no code",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := uuid.New()
+			token, err := issueToken(userID, time.Hour)
+			require.NoError(t, err)
+			client := FakeHTTPClient{
+				Response:      token,
+				RefreshToken:  "refreshToken",
+				TwoFactorCode: "123456",
+			}
+
+			cmd, err := setup(client)
+			require.NoError(t, err)
+			defer func() {
+				err = teardown()
+				require.NoError(t, err)
+			}()
+			presentation.SetInput(strings.NewReader(tt.input))
+
+			args := []string{"gophkeeper", "login"}
+			args = append(args, tt.args...)
+			args = append(args, "test_login", "test_password")
+			err = cmd.Run(context.Background(), args)
+			require.NoError(t, err)
+
+			session, err := sessionRepository.Get()
+			if !tt.success {
+				require.ErrorIs(t, err, domain.ErrEntityNotFound)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, token, session.Token)
+			assert.Equal(t, userID, session.UserID)
+		})
+	}
+}
+
+func TestEnrollTwoFactor(t *testing.T) {
+	client := FakeHTTPClient{
+		TwoFactorCode: "123456",
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+	presentation.SetInput(strings.NewReader("123456\n"))
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "2fa", "enroll"})
+	require.NoError(t, err)
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "2fa", "enroll", "--code", "000000"})
+	require.NoError(t, err)
+}
+
+func TestEnrollTwoFactorUnauthorized(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "2fa", "enroll"})
+	require.NoError(t, err)
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	client := FakeHTTPClient{
+		TwoFactorCode: "abcde-fghij",
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+	presentation.SetInput(strings.NewReader("abcde-fghij\n"))
+
+	_, err = createSession()
+	require.NoError(t, err)
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "2fa", "disable"})
+	require.NoError(t, err)
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "2fa", "disable", "000000"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,283 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/application"
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	jrnlrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/journal_repository"
+	localcrypto "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/local_crypto"
+	sessrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/session_repository"
+	txtrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/text_repository"
+	vaultclient "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/vault_client"
+	"github.com/Nickolasll/goph-keeper/internal/client/logger"
+	"github.com/Nickolasll/goph-keeper/internal/client/presentation"
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
+)
+
+// resetVault - Удаляет все данные локального хранилища вместе с заголовком
+func resetVault() error {
+	return db.Update(func(tx *bolt.Tx) error {
+		names := [][]byte{}
+		err := tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			names = append(names, append([]byte{}, name...))
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if err = tx.DeleteBucket(name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// unlockVault - Разблокирует хранилище, передавая мастер-пароль и его подтверждение через ввод
+func unlockVault(cmd *cli.Command, password string, args ...string) error {
+	presentation.SetInput(strings.NewReader(password + "\n" + password + "\n"))
+	defer presentation.SetInput(os.Stdin)
+
+	return cmd.Run(context.Background(), append([]string{"gophkeeper", "unlock"}, args...))
+}
+
+// storeOffline - Сохраняет текст и запись журнала о его создании, зашифровав их ключом key
+func storeOffline(key []byte, userID uuid.UUID, text domain.Text) error {
+	cryptoService, err := crypto.New(key)
+	if err != nil {
+		return err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(userID.String()))
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err = txtrepo.New(db, cryptoService, logger.New("./")).Create(userID, text); err != nil {
+		return err
+	}
+	op := domain.Operation{Kind: domain.TextKind, Action: domain.CreateAction, EntityID: text.ID}
+
+	return jrnlrepo.New(db, cryptoService, logger.New("./")).Append(userID, op)
+}
+
+func TestUnlockCreatesVault(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+	require.NoError(t, resetVault())
+
+	err = unlockVault(cmd, "master password")
+	require.NoError(t, err)
+
+	assert.Len(t, keyCache.Key, crypto.KeyLength)
+	assert.Equal(t, 15*time.Minute, keyCache.TTL)
+
+	header, err := vaultHeaderRepository.Get()
+	require.NoError(t, err)
+	assert.Equal(t, crypto.DeriveKey("master password", header.Salt), keyCache.Key)
+}
+
+func TestUnlockWrongPassword(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+	require.NoError(t, resetVault())
+
+	err = unlockVault(cmd, "master password")
+	require.NoError(t, err)
+	key := keyCache.Key
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "lock"})
+	require.NoError(t, err)
+	assert.Nil(t, keyCache.Key)
+
+	err = unlockVault(cmd, "wrong password")
+	require.NoError(t, err)
+	assert.Nil(t, keyCache.Key)
+
+	err = unlockVault(cmd, "master password", "--timeout", "1m")
+	require.NoError(t, err)
+	assert.Equal(t, key, keyCache.Key)
+	assert.Equal(t, time.Minute, keyCache.TTL)
+}
+
+// Проверяем, что данные и журнал хранилища старого формата перешифровываются ключом мастер-пароля
+func TestUnlockMigratesLegacyVault(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+	require.NoError(t, resetVault())
+
+	userID := uuid.New()
+	text := domain.Text{ID: uuid.New(), Content: "offline text"}
+	err = storeOffline([]byte("1234567812345678"), userID, text)
+	require.NoError(t, err)
+
+	err = unlockVault(cmd, "master password")
+	require.NoError(t, err)
+
+	header, err := vaultHeaderRepository.Get()
+	require.NoError(t, err)
+	assert.Equal(t, crypto.DeriveKey("master password", header.Salt), keyCache.Key)
+
+	cryptoService, err := crypto.New(keyCache.Key)
+	require.NoError(t, err)
+	migrated, err := txtrepo.New(db, cryptoService, logger.New("./")).Get(userID, text.ID)
+	require.NoError(t, err)
+	assert.Equal(t, text, migrated)
+	ops, err := jrnlrepo.New(db, cryptoService, logger.New("./")).GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, text.ID, ops[0].EntityID)
+}
+
+// Проверяем, что хранилище, зашифрованное неизвестным ключом, не удаляется, пока в нем есть неотправленные изменения
+func TestUnlockUnknownKeyWithJournal(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+	require.NoError(t, resetVault())
+
+	unknownKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	userID := uuid.New()
+	text := domain.Text{ID: uuid.New(), Content: "offline text"}
+	err = storeOffline(unknownKey, userID, text)
+	require.NoError(t, err)
+
+	output, err := runCaptured(func() error {
+		return unlockVault(cmd, "master password")
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, domain.ErrVaultNotMigrated.Error())
+	_, err = vaultHeaderRepository.Get()
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+
+	cryptoService, err := crypto.New(unknownKey)
+	require.NoError(t, err)
+	_, err = txtrepo.New(db, cryptoService, logger.New("./")).Get(userID, text.ID)
+	require.NoError(t, err)
+
+	// Без неотправленных изменений нечитаемые данные удаляются
+	err = jrnlrepo.New(db, cryptoService, logger.New("./")).Delete(userID, 1)
+	require.NoError(t, err)
+	err = unlockVault(cmd, "master password")
+	require.NoError(t, err)
+	_, err = vaultHeaderRepository.Get()
+	require.NoError(t, err)
+	_, err = txtrepo.New(db, cryptoService, logger.New("./")).Get(userID, text.ID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+// Проверяем, что при задании мастер-пароля требуется его подтверждение
+func TestUnlockPasswordMismatch(t *testing.T) {
+	cmd, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		presentation.SetInput(os.Stdin)
+		err = teardown()
+		require.NoError(t, err)
+	}()
+	require.NoError(t, resetVault())
+
+	presentation.SetInput(strings.NewReader("master password\nanother password\n"))
+	output, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "unlock"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, "master passwords do not match")
+	_, err = vaultHeaderRepository.Get()
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+
+	presentation.SetInput(strings.NewReader(""))
+	output, err = runCaptured(func() error {
+		return cmd.Run(context.Background(), []string{"gophkeeper", "unlock"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, "master password is required")
+}
+
+func TestLockedVault(t *testing.T) {
+	_, err := setup(FakeHTTPClient{})
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	lockedCache := &FakeKeyCache{}
+	localCrypto := localcrypto.New(lockedCache)
+	lockedSessionRepository := sessrepo.New(db, localCrypto, logger.New("./"))
+	err = lockedSessionRepository.Save(domain.Session{})
+	require.ErrorIs(t, err, domain.ErrVaultLocked)
+
+	app := application.New(
+		logger.New("./"),
+		vaultclient.New(FakeHTTPClient{}),
+		lockedSessionRepository,
+		textRepository,
+		jwkRepository,
+		binaryRepository,
+		credentialsRepository,
+		bankCardRepository,
+		otpRepository,
+		sshKeyRepository,
+		itemRepository,
+		folderRepository,
+		tagRepository,
+		templateRepository,
+		labelsRepository,
+		journalRepository,
+		uploadRepository,
+		nil,
+		vaultHeaderRepository,
+		lockedCache,
+		&FakeClipboard{},
+	)
+	cmd := presentation.New("v0.0.1", "01.01.1999", app, logger.New("./"), lockedSessionRepository, time.Minute, time.Minute)
+	var exitErr error
+	cmd.ExitErrHandler = func(_ context.Context, _ *cli.Command, err error) {
+		exitErr = err
+	}
+
+	err = cmd.Run(context.Background(), []string{"gophkeeper", "show", "texts"})
+	require.Error(t, err)
+	require.ErrorContains(t, exitErr, domain.ErrVaultLocked.Error())
+
+	lockedCache.Key, err = crypto.GenerateKey()
+	require.NoError(t, err)
+	encrypted, err := localCrypto.Encrypt([]byte("message"))
+	require.NoError(t, err)
+	decrypted, err := localCrypto.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "message", string(decrypted))
+}
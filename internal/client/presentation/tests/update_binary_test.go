@@ -2,6 +2,8 @@ package tests
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
 	"os"
 	"testing"
 
@@ -28,7 +30,9 @@ func TestUpdateBinarySuccess(t *testing.T) {
 
 	bin := domain.Binary{
 		ID:      binID,
+		Name:    "old.txt",
 		Content: []byte("old content"),
+		Meta:    "old note",
 	}
 	err = binaryRepository.Create(userID, bin)
 	require.NoError(t, err)
@@ -49,6 +53,66 @@ func TestUpdateBinarySuccess(t *testing.T) {
 	content, err := os.ReadFile("./binary_file_for_test")
 	require.NoError(t, err)
 	assert.Equal(t, binObj.Content, content)
+	assert.Equal(t, "binary_file_for_test", binObj.Name)
+	assert.Equal(t, int64(len(content)), binObj.Size)
+	// Без флага --meta заметка сохраняется
+	assert.Equal(t, "old note", binObj.Meta)
+}
+
+func TestUpdateBinaryConflictChecksums(t *testing.T) {
+	binID := uuid.New()
+	client := FakeHTTPClient{
+		Theirs: domain.Binary{
+			ID:      binID,
+			Name:    "theirs.txt",
+			Content: []byte("theirs content"),
+			Version: 3,
+		},
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	err = binaryRepository.Create(userID, domain.Binary{
+		ID:      binID,
+		Name:    "base.txt",
+		Content: []byte("base content"),
+		Version: 1,
+	})
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"update",
+		"binary",
+		"--resolve",
+		"theirs",
+		binID.String(),
+		"./binary_file_for_test",
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+
+	mine, err := os.ReadFile("./binary_file_for_test")
+	require.NoError(t, err)
+	for _, content := range [][]byte{[]byte("base content"), mine, []byte("theirs content")} {
+		assert.Contains(t, out, fmt.Sprintf("<%d bytes, sha256 %x>", len(content), sha256.Sum256(content)))
+	}
+	assert.NotContains(t, out, "theirs content")
+
+	binObj, err := binaryRepository.Get(userID, binID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("theirs content"), binObj.Content)
 }
 
 func TestUpdateBinaryBadRequest(t *testing.T) {
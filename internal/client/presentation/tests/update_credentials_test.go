@@ -2,6 +2,7 @@ package tests
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -63,6 +64,51 @@ func TestUpdateCredentialsSuccess(t *testing.T) {
 	assert.Equal(t, credObj.Meta, meta)
 }
 
+func TestUpdateCredentialsGenerateSuccess(t *testing.T) {
+	credID := uuid.New()
+	client := FakeHTTPClient{}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	cred := domain.Credentials{
+		ID:       credID,
+		Name:     "name",
+		Login:    "login",
+		Password: "old password",
+	}
+	err = credentialsRepository.Create(userID, &cred)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"update",
+		"credentials",
+		"--generate",
+		"--passphrase",
+		credID.String(),
+	}
+
+	out, err := runCaptured(func() error {
+		return cmd.Run(context.Background(), args)
+	})
+	require.NoError(t, err)
+
+	credObj, err := credentialsRepository.Get(userID, credID)
+	require.NoError(t, err)
+	assert.Equal(t, credObj.Login, "login")
+	assert.Len(t, strings.Split(credObj.Password, "-"), 6)
+	assert.NotContains(t, out, credObj.Password)
+	assert.Contains(t, out, "entropy: ~66 bits")
+}
+
 func TestUpdateCredentialsBadRequest(t *testing.T) {
 	client := FakeHTTPClient{
 		Err: domain.ErrBadRequest,
@@ -163,3 +209,59 @@ func TestUpdateCredentialsInvalidValue(t *testing.T) {
 	err = cmd.Run(context.Background(), args)
 	require.NoError(t, err)
 }
+
+func TestUpdateCredentialsConflictMerge(t *testing.T) {
+	credID := uuid.New()
+	client := FakeHTTPClient{
+		Theirs: domain.Credentials{
+			ID:       credID,
+			Name:     "name",
+			Login:    "their login",
+			Password: "password",
+			Version:  2,
+		},
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	cred := domain.Credentials{
+		ID:       credID,
+		Name:     "name",
+		Login:    "login",
+		Password: "password",
+		Version:  1,
+	}
+	err = credentialsRepository.Create(userID, &cred)
+	require.NoError(t, err)
+
+	args := []string{
+		"gophkeeper",
+		"update",
+		"credentials",
+		"--resolve",
+		"merge",
+		"--password",
+		"my password",
+		"--meta",
+		"my meta",
+		credID.String(),
+	}
+
+	err = cmd.Run(context.Background(), args)
+	require.NoError(t, err)
+
+	credObj, err := credentialsRepository.Get(userID, credID)
+	require.NoError(t, err)
+	assert.Equal(t, "their login", credObj.Login)
+	assert.Equal(t, "my password", credObj.Password)
+	assert.Equal(t, "my meta", credObj.Meta)
+	assert.Equal(t, int64(3), credObj.Version)
+}
@@ -2,6 +2,8 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -9,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+	"github.com/Nickolasll/goph-keeper/internal/client/presentation"
 )
 
 func TestUpdateTextSuccess(t *testing.T) {
@@ -164,3 +167,130 @@ func TestUpdateTextInvalidValue(t *testing.T) {
 	err = cmd.Run(context.Background(), args)
 	require.NoError(t, err)
 }
+
+func TestUpdateTextServerUnavailable(t *testing.T) {
+	textID := uuid.New()
+	content := "content updated offline"
+	client := FakeHTTPClient{
+		Err: domain.ErrServerUnavailable,
+	}
+
+	cmd, err := setup(client)
+	require.NoError(t, err)
+	defer func() {
+		err = teardown()
+		require.NoError(t, err)
+	}()
+
+	userID, err := createSession()
+	require.NoError(t, err)
+
+	err = textRepository.Create(userID, domain.Text{ID: textID, Content: "old content", Version: 2})
+	require.NoError(t, err)
+
+	for _, value := range []string{"first offline content", content} {
+		args := []string{
+			"gophkeeper",
+			"update",
+			"text",
+			textID.String(),
+			value,
+		}
+
+		err = cmd.Run(context.Background(), args)
+		require.NoError(t, err)
+	}
+
+	ops, err := journalRepository.GetAll(userID)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, domain.UpdateAction, ops[0].Action)
+	assert.Equal(t, textID, ops[0].EntityID)
+	assert.Equal(t, int64(2), ops[0].BaseVersion)
+
+	var base domain.Text
+	err = json.Unmarshal(ops[0].Base, &base)
+	require.NoError(t, err)
+	assert.Equal(t, "old content", base.Content)
+
+	text, err := textRepository.Get(userID, textID)
+	require.NoError(t, err)
+	assert.Equal(t, text.Content, content)
+}
+
+func TestUpdateTextConflictResolve(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		input    string
+		expected string
+		version  int64
+	}{
+		{
+			name:     "theirs",
+			args:     []string{"--resolve", "theirs"},
+			expected: "theirs content",
+			version:  3,
+		},
+		{
+			name:     "mine",
+			args:     []string{"--resolve", "mine"},
+			expected: "mine content",
+			version:  4,
+		},
+		{
+			name:     "interactive merge",
+			input:    "e\n=merged content\n",
+			expected: "merged content",
+			version:  4,
+		},
+		{
+			name:     "canceled",
+			expected: "base content",
+			version:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			textID := uuid.New()
+			client := FakeHTTPClient{
+				Theirs: domain.Text{
+					ID:      textID,
+					Content: "theirs content",
+					Version: 3,
+				},
+			}
+
+			cmd, err := setup(client)
+			require.NoError(t, err)
+			defer func() {
+				err = teardown()
+				require.NoError(t, err)
+			}()
+			presentation.SetInput(strings.NewReader(tt.input))
+
+			userID, err := createSession()
+			require.NoError(t, err)
+
+			text := domain.Text{
+				ID:      textID,
+				Content: "base content",
+				Version: 1,
+			}
+			err = textRepository.Create(userID, text)
+			require.NoError(t, err)
+
+			args := []string{"gophkeeper", "update", "text"}
+			args = append(args, tt.args...)
+			args = append(args, textID.String(), "mine content")
+
+			err = cmd.Run(context.Background(), args)
+			require.NoError(t, err)
+
+			txt, err := textRepository.Get(userID, textID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, txt.Content)
+			assert.Equal(t, tt.version, txt.Version)
+		})
+	}
+}
@@ -19,10 +19,22 @@ import (
 	cardrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/bank_card_repository"
 	binrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/binary_repository"
 	credrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/credentials_repository"
+	folderrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/folder_repository"
+	itemrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/item_repository"
+	jrnlrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/journal_repository"
 	jwkrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/jwk_repository"
+	labelsrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/labels_repository"
+	otprepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/otp_repository"
+	revrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/revision_repository"
 	sessrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/session_repository"
+	sshrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/ssh_key_repository"
+	tagrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/tag_repository"
+	tmplrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/template_repository"
 	txtrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/text_repository"
 	unitofwork "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/unit_of_work"
+	uploadrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/upload_repository"
+	vaultclient "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/vault_client"
+	vhrepo "github.com/Nickolasll/goph-keeper/internal/client/infrastructure/vault_header_repository"
 	"github.com/Nickolasll/goph-keeper/internal/client/logger"
 	"github.com/Nickolasll/goph-keeper/internal/client/presentation"
 	"github.com/Nickolasll/goph-keeper/internal/crypto"
@@ -36,6 +48,19 @@ var jwkRepository *jwkrepo.JWKRepository
 var binaryRepository *binrepo.BinaryRepository
 var credentialsRepository *credrepo.CredentialsRepository
 var bankCardRepository *cardrepo.BankCardRepository
+var otpRepository *otprepo.OTPRepository
+var sshKeyRepository *sshrepo.SSHKeyRepository
+var itemRepository *itemrepo.ItemRepository
+var folderRepository *folderrepo.FolderRepository
+var tagRepository *tagrepo.TagRepository
+var templateRepository *tmplrepo.TemplateRepository
+var labelsRepository *labelsrepo.LabelsRepository
+var journalRepository *jrnlrepo.JournalRepository
+var uploadRepository *uploadrepo.UploadRepository
+var revisionRepository *revrepo.RevisionRepository
+var vaultHeaderRepository *vhrepo.VaultHeaderRepository
+var keyCache *FakeKeyCache
+var clipboard *FakeClipboard
 
 func getJWKs() (jwk.Key, error) {
 	jwks, err := jwk.FromRaw([]byte("My secret keys"))
@@ -90,6 +115,19 @@ func setup(client FakeHTTPClient) (*cli.Command, error) { // nolint: gocritic
 	binaryRepository = binrepo.New(db, cryptoService, log)
 	credentialsRepository = credrepo.New(db, cryptoService, log)
 	bankCardRepository = cardrepo.New(db, cryptoService, log)
+	otpRepository = otprepo.New(db, cryptoService, log)
+	sshKeyRepository = sshrepo.New(db, cryptoService, log)
+	itemRepository = itemrepo.New(db, cryptoService, log)
+	folderRepository = folderrepo.New(db, cryptoService, log)
+	tagRepository = tagrepo.New(db, cryptoService, log)
+	templateRepository = tmplrepo.New(db, cryptoService, log)
+	labelsRepository = labelsrepo.New(db, cryptoService, log)
+	journalRepository = jrnlrepo.New(db, cryptoService, log)
+	uploadRepository = uploadrepo.New(db, cryptoService, log)
+	revisionRepository = revrepo.New(db, log)
+	vaultHeaderRepository = vhrepo.New(db, log)
+	keyCache = &FakeKeyCache{Key: []byte("1234567812345678")}
+	clipboard = &FakeClipboard{}
 
 	unitOfWork := unitofwork.New(
 		db,
@@ -98,21 +136,41 @@ func setup(client FakeHTTPClient) (*cli.Command, error) { // nolint: gocritic
 		*binaryRepository,
 		*credentialsRepository,
 		*bankCardRepository,
+		*otpRepository,
+		*sshKeyRepository,
+		*itemRepository,
+		*folderRepository,
+		*tagRepository,
+		*templateRepository,
+		*labelsRepository,
+		*revisionRepository,
 	)
 
 	app := application.New(
 		log,
-		client,
+		vaultclient.New(client),
 		sessionRepository,
 		textRepository,
 		jwkRepository,
 		binaryRepository,
 		credentialsRepository,
 		bankCardRepository,
+		otpRepository,
+		sshKeyRepository,
+		itemRepository,
+		folderRepository,
+		tagRepository,
+		templateRepository,
+		labelsRepository,
+		journalRepository,
+		uploadRepository,
 		unitOfWork,
+		vaultHeaderRepository,
+		keyCache,
+		clipboard,
 	)
 
-	cmd = presentation.New("v0.0.1", "01.01.1999", app, log, sessionRepository)
+	cmd = presentation.New("v0.0.1", "01.01.1999", app, log, sessionRepository, cfg.UnlockTimeout, cfg.ClipboardTimeout)
 
 	return cmd, nil
 }
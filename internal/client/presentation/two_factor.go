@@ -0,0 +1,143 @@
+package presentation
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+var errNoCode = errors.New("two-factor code was not entered")
+
+// codeFlag - Флаг кода второго фактора, без флага код запрашивается интерактивно
+func codeFlag(destination *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name:        "code",
+		Aliases:     []string{"c"},
+		Usage:       "(optional) two-factor code from authenticator app or recovery code",
+		Destination: destination,
+	}
+}
+
+// promptCode - Возвращает код из флага или запрашивает его интерактивно
+func promptCode(code string) func() (string, error) {
+	return func() (string, error) {
+		if code != "" {
+			return code, nil
+		}
+		fmt.Print("two-factor code: ")
+		line, err := bufio.NewReader(input).ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			return "", errNoCode
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			return "", errNoCode
+		}
+
+		return line, nil
+	}
+}
+
+// isTwoFactorUserError - Ошибки, которые выводятся пользователю без завершения с ошибкой
+func isTwoFactorUserError(err error) bool {
+	return errors.Is(err, domain.ErrUnauthorized) ||
+		errors.Is(err, domain.ErrInvalidTwoFactorCode) ||
+		errors.Is(err, domain.ErrTwoFactorAlreadyEnabled) ||
+		errors.Is(err, domain.ErrTwoFactorNotEnabled) ||
+		errors.Is(err, errNoCode)
+}
+
+func enrollTwoFactor() cli.Command {
+	var code string
+
+	return cli.Command{
+		Name:  "enroll",
+		Usage: "enable two-factor authentication with an authenticator app",
+		Flags: []cli.Flag{codeFlag(&code)},
+		Action: func(_ context.Context, _ *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			secret, uri, err := app.EnrollTwoFactor.Do(*currentSession)
+			if err != nil {
+				if isTwoFactorUserError(err) {
+					fmt.Println(err)
+
+					return nil
+				}
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+			fmt.Println("add the account to your authenticator app")
+			fmt.Println("secret:", secret)
+			fmt.Println("uri (QR code content):", uri)
+
+			code, err = promptCode(code)()
+			if err == nil {
+				var recoveryCodes []string
+				recoveryCodes, err = app.VerifyTwoFactor.Do(*currentSession, code)
+				if err == nil {
+					fmt.Println("two-factor authentication enabled")
+					fmt.Println("recovery codes, each can be used once instead of a code, keep them in a safe place:")
+					for _, recoveryCode := range recoveryCodes {
+						fmt.Println(" ", recoveryCode)
+					}
+
+					return nil
+				}
+			}
+			if isTwoFactorUserError(err) {
+				fmt.Println(err)
+
+				return nil
+			}
+			log.Error(err)
+
+			return cli.Exit(err, 1)
+		},
+	}
+}
+
+func disableTwoFactor() cli.Command {
+	return cli.Command{
+		Name:      "disable",
+		Usage:     "disable two-factor authentication",
+		ArgsUsage: "[code]",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			if currentSession == nil {
+				fmt.Println("unauthorized")
+
+				return nil
+			}
+
+			code, err := promptCode(cmd.Args().First())()
+			if err == nil {
+				err = app.DisableTwoFactor.Do(*currentSession, code)
+			}
+			if err != nil {
+				if isTwoFactorUserError(err) {
+					fmt.Println(err)
+
+					return nil
+				}
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+			fmt.Println("two-factor authentication disabled")
+
+			return nil
+		},
+	}
+}
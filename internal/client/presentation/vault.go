@@ -0,0 +1,129 @@
+package presentation
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/Nickolasll/goph-keeper/internal/client/domain"
+)
+
+// lockedCommands - Команды, доступные при заблокированном локальном хранилище
+var lockedCommands = map[string]bool{
+	"":         true,
+	"unlock":   true,
+	"lock":     true,
+	"generate": true,
+	"help":     true,
+	"h":        true,
+}
+
+var errMasterPasswordMismatch = errors.New("master passwords do not match")
+
+// promptMasterPassword - Запрашивает мастер-пароль без отображения вводимых символов.
+// Если confirm истинно, пароль запрашивается повторно для проверки
+func promptMasterPassword(confirm bool) (string, error) {
+	reader := bufio.NewReader(input)
+	password, err := readSecret(reader, "master password: ")
+	if err != nil || password == "" {
+		return "", nil
+	}
+	if !confirm {
+		return password, nil
+	}
+	repeated, err := readSecret(reader, "repeat master password: ")
+	if err != nil || repeated != password {
+		return "", errMasterPasswordMismatch
+	}
+
+	return password, nil
+}
+
+// checkLocked - Прерывает выполнение команды, если локальное хранилище заблокировано
+func checkLocked(_ context.Context, cmd *cli.Command) error {
+	if !lockedCommands[cmd.Args().First()] && app.Lock.IsLocked() {
+		return cli.Exit(domain.ErrVaultLocked, 1)
+	}
+
+	return nil
+}
+
+func unlock(defaultTimeout time.Duration) cli.Command {
+	var timeout time.Duration
+
+	return cli.Command{
+		Name: "unlock",
+		Usage: "unlock local storage with master password read from the terminal without echo or from stdin, " +
+			"on first run the master password is set",
+		Flags: []cli.Flag{
+			&cli.DurationFlag{
+				Name:        "timeout",
+				Aliases:     []string{"t"},
+				Usage:       "(optional) how long the local storage stays unlocked",
+				Value:       defaultTimeout,
+				Destination: &timeout,
+			},
+		},
+		Action: func(_ context.Context, _ *cli.Command) error {
+			isSet, err := app.Unlock.IsSet()
+			if err != nil {
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+			password, err := promptMasterPassword(!isSet)
+			if err != nil {
+				fmt.Println(err)
+
+				return nil
+			}
+			if password == "" {
+				fmt.Println("master password is required")
+
+				return nil
+			}
+
+			created, err := app.Unlock.Do(password, timeout)
+			if err != nil {
+				if errors.Is(err, domain.ErrWrongMasterPassword) || errors.Is(err, domain.ErrVaultNotMigrated) {
+					fmt.Println(err)
+
+					return nil
+				} else {
+					log.Error(err)
+
+					return cli.Exit(err, 1)
+				}
+			}
+			if created {
+				fmt.Println("master password was set, local storage is encrypted with it")
+			}
+			fmt.Println("local storage unlocked for", timeout)
+
+			return nil
+		},
+	}
+}
+
+func lock() cli.Command {
+	return cli.Command{
+		Name:  "lock",
+		Usage: "lock local storage before the unlock timeout expires",
+		Action: func(_ context.Context, _ *cli.Command) error {
+			err := app.Lock.Do()
+			if err != nil {
+				log.Error(err)
+
+				return cli.Exit(err, 1)
+			}
+			currentSession = nil
+			fmt.Println("local storage locked")
+
+			return nil
+		},
+	}
+}
@@ -5,8 +5,15 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"errors"
 )
 
+// ErrCiphertextTooShort - Зашифрованные данные короче nonce
+var ErrCiphertextTooShort = errors.New("ciphertext too short")
+
+// Overhead - Размер шифротекста сверх размера открытого текста: nonce и тег аутентификации GCM
+const Overhead = 12 + 16
+
 // CryptoService - Сервис для шифрования и дешифрования данных
 type CryptoService struct {
 	// SecretKey - Приватный ключ шифрования
@@ -56,6 +63,9 @@ func (c CryptoService) Decrypt(value []byte) ([]byte, error) {
 		return []byte{}, err
 	}
 	nonceSize := aesgcm.NonceSize()
+	if len(value) < nonceSize {
+		return []byte{}, ErrCiphertextTooShort
+	}
 	nonce, ciphertext := value[:nonceSize], value[nonceSize:]
 
 	result, err := aesgcm.Open(nil, nonce, ciphertext, nil)
@@ -66,6 +76,16 @@ func (c CryptoService) Decrypt(value []byte) ([]byte, error) {
 	return result, nil
 }
 
+// GenerateKey - Генерирует новый случайный ключ шифрования длиной KeyLength
+func GenerateKey() ([]byte, error) {
+	key := make([]byte, KeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
 // New инициализирует сервис, проверяет введенный ключ
 func New(secretKey []byte) (*CryptoService, error) {
 	aesblock, err := aes.NewCipher(secretKey)
@@ -53,6 +53,7 @@ func TestCrypto(t *testing.T) {
 			encr2, err := cryptoService.Encrypt([]byte(tt.want))
 			require.NoError(t, err)
 			assert.NotEqual(t, encr1, encr2)
+			assert.Len(t, encr1, len(tt.want)+Overhead)
 			decr1, err := cryptoService.Decrypt(encr1)
 			require.NoError(t, err)
 			assert.Equal(t, string(decr1), tt.want)
@@ -73,3 +74,66 @@ func TestCryptoInvalidSecretKey(t *testing.T) {
 	_, err = cryptoService.Decrypt(data)
 	require.Error(t, err)
 }
+
+func TestCryptoCiphertextTooShort(t *testing.T) {
+	cryptoService := CryptoService{
+		SecretKey: []byte("1234567812345678"),
+	}
+	_, err := cryptoService.Decrypt([]byte("short"))
+	require.ErrorIs(t, err, ErrCiphertextTooShort)
+}
+
+func TestGenerateKey(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	assert.Len(t, key, KeyLength)
+	other, err := GenerateKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+	_, err = New(key)
+	require.NoError(t, err)
+}
+
+// Проверяем, что ключи выводятся детерминированно из пароля и соли,
+// а ключ хранилища не совпадает с ключом аутентификации
+func TestDeriveKeys(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+	vaultKey, authKey := DeriveKeys("master password", salt)
+	assert.Len(t, vaultKey, KeyLength)
+	assert.Len(t, authKey, KeyLength)
+	assert.NotEqual(t, vaultKey, authKey)
+
+	sameVaultKey, sameAuthKey := DeriveKeys("master password", salt)
+	assert.Equal(t, vaultKey, sameVaultKey)
+	assert.Equal(t, authKey, sameAuthKey)
+
+	otherVaultKey, _ := DeriveKeys("master password", []byte("fedcba9876543210"))
+	assert.NotEqual(t, vaultKey, otherVaultKey)
+
+	_, err := New(vaultKey)
+	require.NoError(t, err)
+}
+
+func TestDeriveKey(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+	key := DeriveKey("master password", salt)
+	assert.Len(t, key, KeyLength)
+	assert.Equal(t, key, DeriveKey("master password", salt))
+	assert.NotEqual(t, key, DeriveKey("other password", salt))
+	assert.NotEqual(t, key, DeriveKey("master password", []byte("fedcba9876543210")))
+}
+
+func TestKDFParamsValidate(t *testing.T) {
+	assert.NoError(t, DefaultKDFParams.Validate())
+
+	invalid := []KDFParams{
+		{},
+		{Time: 0, Memory: argonMemory, Threads: argonThreads},
+		{Time: maxArgonTime + 1, Memory: argonMemory, Threads: argonThreads},
+		{Time: argonTime, Memory: maxArgonMemory + 1, Threads: argonThreads},
+		{Time: argonTime, Memory: argonMemory, Threads: 0},
+	}
+	for _, v := range invalid {
+		assert.ErrorIs(t, v.Validate(), ErrInvalidKDFParams, v)
+	}
+}
@@ -0,0 +1,79 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"errors"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// argonTime - Количество проходов Argon2id
+	argonTime = 3
+	// argonMemory - Объем памяти Argon2id в KiB
+	argonMemory = 64 * 1024
+	// argonThreads - Степень параллелизма Argon2id
+	argonThreads = 4
+	// KeyLength - Длина выводимых ключей, соответствует AES-256
+	KeyLength = 32
+	// SaltLength - Длина соли для вывода ключей из мастер-пароля
+	SaltLength = 16
+	// maxArgonTime - Максимальное количество проходов, которое принимается из внешних данных
+	maxArgonTime = 16
+	// maxArgonMemory - Максимальный объем памяти в KiB, который принимается из внешних данных
+	maxArgonMemory = 1024 * 1024
+)
+
+// ErrInvalidKDFParams - Параметры Argon2id вне допустимых границ
+var ErrInvalidKDFParams = errors.New("invalid key derivation parameters")
+
+// KDFParams - Параметры Argon2id, сохраняются вместе с данными, ключ которых выведен из пароля
+type KDFParams struct {
+	// Time - Количество проходов
+	Time uint32
+	// Memory - Объем памяти в KiB
+	Memory uint32
+	// Threads - Степень параллелизма
+	Threads uint8
+}
+
+// DefaultKDFParams - Параметры Argon2id по умолчанию
+var DefaultKDFParams = KDFParams{Time: argonTime, Memory: argonMemory, Threads: argonThreads}
+
+// Validate - Проверяет параметры, прочитанные из внешних данных, чтобы поврежденный или подделанный файл
+// не заставил клиент выделить слишком много памяти
+func (p KDFParams) Validate() error {
+	if p.Time == 0 || p.Time > maxArgonTime || p.Memory == 0 || p.Memory > maxArgonMemory || p.Threads == 0 {
+		return ErrInvalidKDFParams
+	}
+
+	return nil
+}
+
+// DeriveKey - Выводит из пароля ключ шифрования длиной KeyLength
+func (p KDFParams) DeriveKey(password string, salt []byte) []byte {
+	return argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, KeyLength)
+}
+
+// NewSalt - Возвращает случайную соль длиной SaltLength для вывода ключей из мастер-пароля
+func NewSalt() ([]byte, error) {
+	salt := make([]byte, SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return salt, nil
+}
+
+// DeriveKeys - Выводит из мастер-пароля ключ хранилища и ключ аутентификации с помощью Argon2id.
+// Ключ хранилища не покидает клиент, на сервер в качестве пароля передается только ключ аутентификации
+func DeriveKeys(password string, salt []byte) (vaultKey, authKey []byte) {
+	key := argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, 2*KeyLength)
+
+	return key[:KeyLength], key[KeyLength:]
+}
+
+// DeriveKey - Выводит из мастер-пароля ключ шифрования локального хранилища с помощью Argon2id
+func DeriveKey(password string, salt []byte) []byte {
+	return DefaultKDFParams.DeriveKey(password, salt)
+}
@@ -0,0 +1,106 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+const (
+	// keyIDMagic - Признак шифротекста с идентификатором ключа
+	keyIDMagic = "GK1"
+	// keyIDLength - Длина идентификатора ключа в байтах
+	keyIDLength = 4
+)
+
+// ErrUnknownKey - Данные зашифрованы ключом, которого нет в наборе
+var ErrUnknownKey = errors.New("data is encrypted with unknown key")
+
+// KeyRing - Набор ключей шифрования для ротации мастер-ключа.
+// Данные шифруются текущим ключом, к шифротексту добавляется префикс с идентификатором ключа,
+// поэтому во время ротации расшифровываются данные, зашифрованные как текущим, так и предыдущими ключами
+type KeyRing struct {
+	current CryptoService
+	keyID   []byte
+	keys    map[string]CryptoService
+}
+
+// KeyID - Возвращает идентификатор ключа, первые байты SHA-256 от ключа
+func KeyID(secretKey []byte) []byte {
+	sum := sha256.Sum256(secretKey)
+
+	return sum[:keyIDLength]
+}
+
+func keyPrefix(keyID []byte) []byte {
+	return append([]byte(keyIDMagic), keyID...)
+}
+
+// CurrentKeyID - Возвращает идентификатор текущего ключа в шестнадцатеричном виде
+func (k KeyRing) CurrentKeyID() string {
+	return hex.EncodeToString(k.keyID)
+}
+
+// IsCurrent - Проверяет, зашифрованы ли данные текущим ключом
+func (k KeyRing) IsCurrent(value []byte) bool {
+	return bytes.HasPrefix(value, keyPrefix(k.keyID))
+}
+
+// Encrypt - Зашифровывает данные текущим ключом и добавляет префикс с его идентификатором
+func (k KeyRing) Encrypt(value []byte) ([]byte, error) {
+	encrypted, err := k.current.Encrypt(value)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return append(keyPrefix(k.keyID), encrypted...), nil
+}
+
+// Decrypt - Расшифровывает данные ключом из префикса.
+// Данные без префикса зашифрованы до появления идентификаторов ключей, для них перебираются все ключи набора
+func (k KeyRing) Decrypt(value []byte) ([]byte, error) {
+	prefixLength := len(keyIDMagic) + keyIDLength
+	if len(value) > prefixLength && bytes.HasPrefix(value, []byte(keyIDMagic)) {
+		keyID := hex.EncodeToString(value[len(keyIDMagic):prefixLength])
+		if key, ok := k.keys[keyID]; ok {
+			result, err := key.Decrypt(value[prefixLength:])
+			if err == nil {
+				return result, nil
+			}
+		}
+	}
+
+	for _, key := range k.keys {
+		result, err := key.Decrypt(value)
+		if err == nil {
+			return result, nil
+		}
+	}
+
+	return []byte{}, ErrUnknownKey
+}
+
+// NewKeyRing - Возвращает набор ключей с текущим ключом и предыдущими ключами, которые используются только для расшифровки
+func NewKeyRing(currentKey []byte, previousKeys ...[]byte) (*KeyRing, error) {
+	current, err := New(currentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keyRing := KeyRing{
+		current: *current,
+		keyID:   KeyID(currentKey),
+		keys:    map[string]CryptoService{hex.EncodeToString(KeyID(currentKey)): *current},
+	}
+	for _, previousKey := range previousKeys {
+		var previous *CryptoService
+		previous, err = New(previousKey)
+		if err != nil {
+			return nil, err
+		}
+		keyRing.keys[hex.EncodeToString(KeyID(previousKey))] = *previous
+	}
+
+	return &keyRing, nil
+}
@@ -0,0 +1,68 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	oldKey = []byte("1234567812345678")
+	newKey = []byte("8765432187654321")
+)
+
+// Проверяем, что данные шифруются текущим ключом с префиксом его идентификатора
+func TestKeyRingEncryptDecrypt(t *testing.T) {
+	keyRing, err := NewKeyRing(newKey, oldKey)
+	require.NoError(t, err)
+
+	encrypted, err := keyRing.Encrypt([]byte("message"))
+	require.NoError(t, err)
+	assert.True(t, keyRing.IsCurrent(encrypted))
+
+	decrypted, err := keyRing.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "message", string(decrypted))
+}
+
+// Проверяем, что во время ротации расшифровываются данные, зашифрованные предыдущим ключом,
+// в том числе данные без идентификатора ключа
+func TestKeyRingRotation(t *testing.T) {
+	oldKeyRing, err := NewKeyRing(oldKey)
+	require.NoError(t, err)
+	prefixed, err := oldKeyRing.Encrypt([]byte("prefixed"))
+	require.NoError(t, err)
+
+	legacyService, err := New(oldKey)
+	require.NoError(t, err)
+	legacy, err := legacyService.Encrypt([]byte("legacy"))
+	require.NoError(t, err)
+
+	keyRing, err := NewKeyRing(newKey, oldKey)
+	require.NoError(t, err)
+	assert.False(t, keyRing.IsCurrent(prefixed))
+	assert.False(t, keyRing.IsCurrent(legacy))
+
+	decrypted, err := keyRing.Decrypt(prefixed)
+	require.NoError(t, err)
+	assert.Equal(t, "prefixed", string(decrypted))
+
+	decrypted, err = keyRing.Decrypt(legacy)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", string(decrypted))
+
+	newOnly, err := NewKeyRing(newKey)
+	require.NoError(t, err)
+	_, err = newOnly.Decrypt(prefixed)
+	require.ErrorIs(t, err, ErrUnknownKey)
+	_, err = newOnly.Decrypt(legacy)
+	require.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestKeyRingInvalidKey(t *testing.T) {
+	_, err := NewKeyRing([]byte("w"))
+	require.Error(t, err)
+	_, err = NewKeyRing(newKey, []byte("w"))
+	require.Error(t, err)
+}
@@ -0,0 +1,95 @@
+// Package fuzzy содержит нечеткое сравнение строк для поиска по локальному хранилищу
+package fuzzy
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// substringScore - Базовая оценка вхождения подстроки, всегда выше оценки подпоследовательности
+	substringScore = 1000
+	// subsequenceScore - Базовая оценка совпадения по подпоследовательности символов
+	subsequenceScore = 100
+	// prefixBonus - Надбавка за совпадение с начала строки
+	prefixBonus = 200
+	// wordBonus - Надбавка за совпадение с начала слова
+	wordBonus = 100
+	// exactBonus - Надбавка за полное совпадение строки
+	exactBonus = 300
+	// consecutiveBonus - Надбавка за каждый символ подпоследовательности, идущий сразу за предыдущим
+	consecutiveBonus = 15
+	// boundaryBonus - Надбавка за каждый символ подпоследовательности в начале слова
+	boundaryBonus = 10
+)
+
+// Score - Возвращает оценку совпадения query с target без учета регистра и признак совпадения.
+// Вхождение подстроки оценивается выше совпадения по подпоследовательности символов,
+// совпадения в начале строки и в начале слов выше совпадений в середине слова,
+// а более ранние и плотные совпадения выше поздних и разреженных
+func Score(query, target string) (int, bool) {
+	lowQuery := strings.ToLower(strings.TrimSpace(query))
+	lowTarget := strings.ToLower(target)
+	q := []rune(lowQuery)
+	t := []rune(lowTarget)
+	if len(q) == 0 || len(q) > len(t) {
+		return 0, false
+	}
+
+	if pos := strings.Index(lowTarget, lowQuery); pos >= 0 {
+		idx := utf8.RuneCountInString(lowTarget[:pos])
+		score := substringScore - min(idx, substringScore-subsequenceScore-1)
+		switch {
+		case len(q) == len(t):
+			score += exactBonus + prefixBonus
+		case idx == 0:
+			score += prefixBonus
+		case isBoundary(t, idx):
+			score += wordBonus
+		}
+
+		return score, true
+	}
+
+	return subsequence(q, t)
+}
+
+// subsequence - Оценивает совпадение символов query в target в том же порядке, но не подряд
+func subsequence(q, t []rune) (int, bool) {
+	score := subsequenceScore
+	qi := 0
+	last := -1
+	for ti := 0; ti < len(t) && qi < len(q); ti++ {
+		if t[ti] != q[qi] {
+			continue
+		}
+		switch {
+		case last >= 0 && ti == last+1:
+			score += consecutiveBonus
+		case last >= 0:
+			score -= ti - last - 1
+		default:
+			score -= ti
+		}
+		if isBoundary(t, ti) {
+			score += boundaryBonus
+		}
+		last = ti
+		qi++
+	}
+	if qi < len(q) {
+		return 0, false
+	}
+
+	return max(score, 1), true
+}
+
+// isBoundary - Проверяет, что символ с индексом i начинает слово
+func isBoundary(t []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+
+	return !unicode.IsLetter(t[i-1]) && !unicode.IsDigit(t[i-1])
+}
@@ -0,0 +1,64 @@
+package fuzzy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreNoMatch(t *testing.T) {
+	tests := []struct {
+		query  string
+		target string
+	}{
+		{query: "", target: "github"},
+		{query: "   ", target: "github"},
+		{query: "gitlab", target: "github"},
+		{query: "hubgit", target: "github"},
+		{query: "github.com", target: "github"},
+	}
+	for _, tt := range tests {
+		_, ok := Score(tt.query, tt.target)
+		assert.False(t, ok, tt.query)
+	}
+}
+
+func TestScoreCaseInsensitive(t *testing.T) {
+	lower, ok := Score("github", "github.com")
+	assert.True(t, ok)
+	upper, ok := Score("GitHub", "GITHUB.COM")
+	assert.True(t, ok)
+	assert.Equal(t, lower, upper)
+}
+
+func TestScoreRanking(t *testing.T) {
+	// Каждая следующая строка должна оцениваться ниже предыдущей
+	targets := []string{
+		"github",
+		"github.com",
+		"my github",
+		"mygithub",
+		"g-i-t-h-u-b",
+		"good intentions take hours until bedtime",
+	}
+	previous := 0
+	for i, target := range targets {
+		score, ok := Score("github", target)
+		assert.True(t, ok, target)
+		if i > 0 {
+			assert.Less(t, score, previous, target)
+		}
+		previous = score
+	}
+}
+
+func TestScoreUnicode(t *testing.T) {
+	score, ok := Score("пароль", "Мой Пароль от почты")
+	assert.True(t, ok)
+	other, ok := Score("пароль", "Мойпароль")
+	assert.True(t, ok)
+	assert.Greater(t, score, other)
+
+	_, ok = Score("пчт", "почта")
+	assert.True(t, ok)
+}
@@ -0,0 +1,260 @@
+// Package hibp содержит офлайн-проверку паролей по локальной копии базы утечек Have I Been Pwned.
+// Поиск выполняется по первым пяти символам SHA-1 (k-anonymity), файл целиком в память не загружается
+package hibp
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint: gosec // формат Have I Been Pwned использует SHA-1
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// PrefixLength - Длина префикса хеша, по которому выполняется поиск
+	PrefixLength = 5
+	// hashLength - Длина SHA-1 в шестнадцатеричной записи
+	hashLength = 40
+	// suffixLength - Длина суффикса хеша в файле диапазона
+	suffixLength = hashLength - PrefixLength
+)
+
+// hexDigits - Символы шестнадцатеричной записи хеша в верхнем регистре
+const hexDigits = "0123456789ABCDEF"
+
+// ErrInvalidFormat - Файл не является отсортированным списком хешей или файлом диапазона
+var ErrInvalidFormat = errors.New("invalid breaches file format, expected sorted SHA-1 list or range file")
+
+// source - Способ поиска суффикса хеша среди хешей с заданным префиксом
+type source interface {
+	// find - Возвращает количество утечек пароля, 0 если пароль не найден
+	find(prefix, suffix string) (int, error)
+	// Close - Освобождает ресурсы
+	Close() error
+}
+
+// Dataset - Локальный набор утекших паролей
+type Dataset struct {
+	source source
+}
+
+// Open - Открывает набор утекших паролей. Поддерживаются:
+//   - отсортированный по хешу файл со строками HASH или HASH:COUNT (формат pwned-passwords-sha1-ordered-by-hash);
+//   - файл диапазона с именем PREFIX или PREFIX.txt и строками SUFFIX:COUNT (ответ API range);
+//   - каталог файлов диапазонов, из которого открывается только файл нужного префикса.
+func Open(path string) (*Dataset, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return &Dataset{source: rangeDir{dir: path}}, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	line, err := bufio.NewReader(file).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		file.Close()
+
+		return nil, err
+	}
+	hash, _, _ := parseLine(line)
+
+	switch len(hash) {
+	case hashLength:
+		return &Dataset{source: sortedList{file: file, size: info.Size()}}, nil
+	case suffixLength:
+		file.Close()
+		prefix := rangePrefix(path)
+		if prefix == "" {
+			return nil, ErrInvalidFormat
+		}
+
+		return &Dataset{source: rangeFile{path: path, prefix: prefix}}, nil
+	default:
+		file.Close()
+
+		return nil, ErrInvalidFormat
+	}
+}
+
+// Count - Возвращает количество утечек, в которых встречается пароль, 0 если пароль не найден.
+// Если в наборе нет количества утечек, для найденного пароля возвращается 1
+func (d *Dataset) Count(password string) (int, error) {
+	sum := sha1.Sum([]byte(password)) //nolint: gosec
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	return d.source.find(hash[:PrefixLength], hash[PrefixLength:])
+}
+
+// Close - Закрывает файл набора
+func (d *Dataset) Close() error {
+	return d.source.Close()
+}
+
+// parseLine - Разбирает строку HASH[:COUNT], возвращает хеш в верхнем регистре.
+// Строки с количеством 0 дополняют ответ API range до фиксированного размера и не считаются утечкой
+func parseLine(line string) (hash string, count int, ok bool) {
+	line = strings.TrimSpace(line)
+	hash, rawCount, hasCount := strings.Cut(line, ":")
+	hash = strings.ToUpper(hash)
+	if hash == "" || strings.Trim(hash, hexDigits) != "" {
+		return "", 0, false
+	}
+	if !hasCount {
+		return hash, 1, true
+	}
+	count, err := strconv.Atoi(rawCount)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return hash, count, true
+}
+
+// rangePrefix - Возвращает префикс из имени файла диапазона или пустую строку
+func rangePrefix(path string) string {
+	name := strings.ToUpper(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+	if len(name) != PrefixLength || strings.Trim(name, hexDigits) != "" {
+		return ""
+	}
+
+	return name
+}
+
+// scanRange - Ищет суффикс в файле диапазона
+func scanRange(r io.Reader, suffix string) (int, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		hash, count, ok := parseLine(scanner.Text())
+		if ok && hash == suffix {
+			return count, nil
+		}
+	}
+
+	return 0, scanner.Err()
+}
+
+// rangeFile - Один файл диапазона, пароли с другим префиксом в нем не ищутся
+type rangeFile struct {
+	path   string
+	prefix string
+}
+
+func (r rangeFile) find(prefix, suffix string) (int, error) {
+	if prefix != r.prefix {
+		return 0, nil
+	}
+	file, err := os.Open(r.path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	return scanRange(file, suffix)
+}
+
+func (r rangeFile) Close() error {
+	return nil
+}
+
+// rangeDir - Каталог файлов диапазонов PREFIX или PREFIX.txt
+type rangeDir struct {
+	dir string
+}
+
+func (r rangeDir) find(prefix, suffix string) (int, error) {
+	for _, name := range []string{prefix, prefix + ".txt", strings.ToLower(prefix), strings.ToLower(prefix) + ".txt"} {
+		path := filepath.Join(r.dir, name)
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		return rangeFile{path: path, prefix: prefix}.find(prefix, suffix)
+	}
+
+	return 0, nil
+}
+
+func (r rangeDir) Close() error {
+	return nil
+}
+
+// sortedList - Отсортированный по хешу файл со строками HASH[:COUNT]
+type sortedList struct {
+	file *os.File
+	size int64
+}
+
+// lineFrom - Возвращает первую строку, которая начинается не раньше offset, и смещение начала этой строки
+func (s sortedList) lineFrom(offset int64) (string, int64, error) {
+	reader := bufio.NewReader(io.NewSectionReader(s.file, max(offset-1, 0), s.size))
+	start := int64(0)
+	if offset > 0 {
+		// Дочитываем строку, в середину которой попало смещение
+		skipped, err := reader.ReadString('\n')
+		if errors.Is(err, io.EOF) {
+			return "", s.size, nil
+		}
+		if err != nil {
+			return "", 0, err
+		}
+		start = offset - 1 + int64(len(skipped))
+	}
+	line, err := reader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", 0, err
+	}
+
+	return line, start, nil
+}
+
+func (s sortedList) find(prefix, suffix string) (int, error) {
+	// Бинарный поиск первой строки с хешем не меньше префикса
+	low, high := int64(0), s.size
+	for low < high {
+		middle := low + (high-low)/2
+		line, _, err := s.lineFrom(middle)
+		if err != nil {
+			return 0, err
+		}
+		hash, _, ok := parseLine(line)
+		if line == "" || (ok && hash[:min(len(hash), PrefixLength)] >= prefix) {
+			high = middle
+		} else {
+			low = middle + 1
+		}
+	}
+
+	_, start, err := s.lineFrom(low)
+	if err != nil {
+		return 0, err
+	}
+	scanner := bufio.NewScanner(io.NewSectionReader(s.file, start, s.size-start))
+	want := prefix + suffix
+	for scanner.Scan() {
+		hash, count, ok := parseLine(scanner.Text())
+		if !ok {
+			continue
+		}
+		if !strings.HasPrefix(hash, prefix) {
+			break
+		}
+		if hash == want {
+			return count, nil
+		}
+	}
+
+	return 0, scanner.Err()
+}
+
+func (s sortedList) Close() error {
+	return s.file.Close()
+}
@@ -0,0 +1,170 @@
+package hibp
+
+import (
+	"crypto/sha1" //nolint: gosec
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// passwordHash - SHA-1 строки "password"
+const passwordHash = "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8"
+
+func hashOf(value string) string {
+	sum := sha1.Sum([]byte(value)) //nolint: gosec
+
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// writeSortedList - Записывает отсортированный список хешей паролей password0..password{n-1} и "password"
+func writeSortedList(t *testing.T, n int, lineEnd string, withCount bool) string {
+	lines := []string{passwordHash + ":9659365"}
+	for i := 0; i < n; i++ {
+		line := hashOf(fmt.Sprintf("password%d", i))
+		if withCount {
+			line += fmt.Sprintf(":%d", i+1)
+		}
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+	if !withCount {
+		for i := range lines {
+			lines[i], _, _ = strings.Cut(lines[i], ":")
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "pwned-passwords-sha1-ordered-by-hash.txt")
+	err := os.WriteFile(path, []byte(strings.Join(lines, lineEnd)+lineEnd), 0o600)
+	require.NoError(t, err)
+
+	return path
+}
+
+func TestSortedList(t *testing.T) {
+	path := writeSortedList(t, 1000, "\r\n", true)
+	dataset, err := Open(path)
+	require.NoError(t, err)
+	defer dataset.Close()
+
+	count, err := dataset.Count("password")
+	require.NoError(t, err)
+	assert.Equal(t, 9659365, count)
+
+	for i := 0; i < 1000; i += 37 {
+		count, err = dataset.Count(fmt.Sprintf("password%d", i))
+		require.NoError(t, err)
+		assert.Equal(t, i+1, count, i)
+	}
+
+	count, err = dataset.Count("correct horse battery staple")
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestSortedListWithoutCount(t *testing.T) {
+	path := writeSortedList(t, 10, "\n", false)
+	dataset, err := Open(path)
+	require.NoError(t, err)
+	defer dataset.Close()
+
+	count, err := dataset.Count("password")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = dataset.Count("not breached")
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestSortedListBounds(t *testing.T) {
+	lines := []string{
+		"0000000000000000000000000000000000000000:1",
+		passwordHash + ":2",
+		"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF:3",
+	}
+	path := filepath.Join(t.TempDir(), "list.txt")
+	err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600)
+	require.NoError(t, err)
+
+	dataset, err := Open(path)
+	require.NoError(t, err)
+	defer dataset.Close()
+
+	for i, v := range []string{"00000", "5BAA6", "FFFFF"} {
+		list := dataset.source.(sortedList)
+		count, err := list.find(v, lines[i][PrefixLength:strings.Index(lines[i], ":")])
+		require.NoError(t, err)
+		assert.Equal(t, i+1, count)
+	}
+}
+
+func TestRangeFile(t *testing.T) {
+	lines := []string{
+		passwordHash[PrefixLength:] + ":9659365",
+		"0018A45C4D1DEF81644B54AB7F969B88D65:0",
+	}
+	path := filepath.Join(t.TempDir(), "5baa6.txt")
+	err := os.WriteFile(path, []byte(strings.Join(lines, "\r\n")), 0o600)
+	require.NoError(t, err)
+
+	dataset, err := Open(path)
+	require.NoError(t, err)
+	defer dataset.Close()
+
+	count, err := dataset.Count("password")
+	require.NoError(t, err)
+	assert.Equal(t, 9659365, count)
+
+	count, err = dataset.Count("other prefix")
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// Строки с количеством 0 - дополнение ответа API, а не утечка
+	count, err = dataset.source.find("5BAA6", "0018A45C4D1DEF81644B54AB7F969B88D65")
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestRangeDir(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "5BAA6"), []byte(passwordHash[PrefixLength:]+":42\n"), 0o600)
+	require.NoError(t, err)
+
+	dataset, err := Open(dir)
+	require.NoError(t, err)
+	defer dataset.Close()
+
+	count, err := dataset.Count("password")
+	require.NoError(t, err)
+	assert.Equal(t, 42, count)
+
+	count, err = dataset.Count("missing range file")
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestOpenInvalidFormat(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"garbage.txt": "not a hash list\n",
+		"empty.txt":   "",
+		"range.txt":   passwordHash[PrefixLength:] + ":1\n",
+	} {
+		path := filepath.Join(dir, name)
+		err := os.WriteFile(path, []byte(content), 0o600)
+		require.NoError(t, err)
+
+		_, err = Open(path)
+		assert.ErrorIs(t, err, ErrInvalidFormat, name)
+	}
+
+	_, err := Open(filepath.Join(dir, "missing.txt"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
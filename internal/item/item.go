@@ -0,0 +1,100 @@
+// Package item содержит типы полей и шаблоны произвольных данных, которые пользователь описывает сам:
+// данные состоят из именованных полей с типом и значением, а шаблон задает набор полей
+package item
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// TypeString - Обычная строка
+	TypeString = "string"
+	// TypeSecret - Секрет, значение скрывается при выводе
+	TypeSecret = "secret"
+	// TypeURL - Абсолютный адрес со схемой и хостом
+	TypeURL = "url"
+	// TypeDate - Дата в формате ГГГГ-ММ-ДД
+	TypeDate = "date"
+	// TypeNumber - Число
+	TypeNumber = "number"
+	// TypeFile - Ссылка на бинарные данные по идентификатору
+	TypeFile = "file"
+
+	// DateLayout - Формат значений полей типа date
+	DateLayout = "2006-01-02"
+)
+
+// Types - Поддерживаемые типы полей
+var Types = []string{TypeString, TypeSecret, TypeURL, TypeDate, TypeNumber, TypeFile}
+
+var (
+	// ErrInvalidField - Поле не соответствует своему типу или описано неверно
+	ErrInvalidField = errors.New("invalid item field")
+	// ErrUnknownTemplate - Шаблона с таким именем нет
+	ErrUnknownTemplate = errors.New("unknown item template")
+)
+
+// Field - Поле данных
+type Field struct {
+	// Name - Имя поля, уникально в пределах данных
+	Name string `json:"name"`
+	// Type - Тип поля из Types
+	Type string `json:"type"`
+	// Value - Значение поля, пустое значение допустимо для любого типа
+	Value string `json:"value"`
+}
+
+// ValidateValue - Проверяет, что непустое значение соответствует типу поля
+func ValidateValue(fieldType, value string) error {
+	if value == "" {
+		return nil
+	}
+	var err error
+	switch fieldType {
+	case TypeString, TypeSecret:
+	case TypeURL:
+		var parsed *url.URL
+		parsed, err = url.ParseRequestURI(value)
+		if err == nil && (parsed.Scheme == "" || parsed.Host == "") {
+			err = errors.New("url must contain scheme and host")
+		}
+	case TypeDate:
+		_, err = time.Parse(DateLayout, value)
+	case TypeNumber:
+		_, err = strconv.ParseFloat(value, 64)
+	case TypeFile:
+		_, err = uuid.Parse(value)
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidField, fieldType)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s value %q: %w", ErrInvalidField, fieldType, value, err)
+	}
+
+	return nil
+}
+
+// Validate - Проверяет, что у полей есть уникальные имена, известные типы и значения им соответствуют
+func Validate(fields []Field) error {
+	seen := map[string]bool{}
+	for _, v := range fields {
+		if v.Name == "" {
+			return fmt.Errorf("%w: empty name", ErrInvalidField)
+		}
+		if seen[v.Name] {
+			return fmt.Errorf("%w: duplicate name %q", ErrInvalidField, v.Name)
+		}
+		seen[v.Name] = true
+		if err := ValidateValue(v.Type, v.Value); err != nil {
+			return fmt.Errorf("field %q: %w", v.Name, err)
+		}
+	}
+
+	return nil
+}
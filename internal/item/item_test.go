@@ -0,0 +1,100 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateValue(t *testing.T) {
+	tests := []struct {
+		fieldType string
+		value     string
+		valid     bool
+	}{
+		{fieldType: TypeString, value: "anything", valid: true},
+		{fieldType: TypeSecret, value: "s3cr3t", valid: true},
+		{fieldType: TypeURL, value: "https://example.com/path", valid: true},
+		{fieldType: TypeURL, value: "example.com", valid: false},
+		{fieldType: TypeURL, value: "/relative", valid: false},
+		{fieldType: TypeDate, value: "2030-12-31", valid: true},
+		{fieldType: TypeDate, value: "31.12.2030", valid: false},
+		{fieldType: TypeNumber, value: "-1.5", valid: true},
+		{fieldType: TypeNumber, value: "ten", valid: false},
+		{fieldType: TypeFile, value: uuid.NewString(), valid: true},
+		{fieldType: TypeFile, value: "report.pdf", valid: false},
+		{fieldType: "color", value: "red", valid: false},
+		// Пустое значение допустимо для любого известного типа
+		{fieldType: TypeDate, value: "", valid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fieldType+" "+tt.value, func(t *testing.T) {
+			err := ValidateValue(tt.fieldType, tt.value)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidField)
+			}
+		})
+	}
+}
+
+func TestValidateNames(t *testing.T) {
+	err := Validate([]Field{{Name: "", Type: TypeString}})
+	require.ErrorIs(t, err, ErrInvalidField)
+
+	err = Validate([]Field{{Name: "pin", Type: TypeSecret}, {Name: "pin", Type: TypeString}})
+	require.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestTemplateBuild(t *testing.T) {
+	wifi, err := FindTemplate("wifi")
+	require.NoError(t, err)
+
+	fields, err := wifi.Build(
+		map[string]string{"ssid": "Office", "password": "secret"},
+		[]Field{{Name: "router", Type: TypeURL, Value: "http://192.168.0.1"}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []Field{
+		{Name: "ssid", Type: TypeString, Value: "Office"},
+		{Name: "password", Type: TypeSecret, Value: "secret"},
+		{Name: "security", Type: TypeString},
+		{Name: "router", Type: TypeURL, Value: "http://192.168.0.1"},
+	}, fields)
+
+	_, err = wifi.Build(map[string]string{"password": "secret"}, nil)
+	require.ErrorIs(t, err, ErrInvalidField)
+
+	_, err = wifi.Build(map[string]string{"ssid": "Office", "pin": "1234"}, nil)
+	require.ErrorIs(t, err, ErrInvalidField)
+
+	_, err = wifi.Build(map[string]string{"ssid": "Office"}, []Field{{Name: "password", Type: TypeString}})
+	require.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestFindTemplateUnknown(t *testing.T) {
+	_, err := FindTemplate("passport")
+	require.ErrorIs(t, err, ErrUnknownTemplate)
+
+	custom, err := FindTemplate(Custom)
+	require.NoError(t, err)
+	fields, err := custom.Build(nil, []Field{{Name: "seats", Type: TypeNumber, Value: "five"}})
+	require.ErrorIs(t, err, ErrInvalidField)
+	assert.Nil(t, fields)
+}
+
+func TestValidateSpecs(t *testing.T) {
+	err := ValidateSpecs([]FieldSpec{{Name: "number", Type: TypeSecret, Required: true}, {Name: "issued", Type: TypeDate}})
+	require.NoError(t, err)
+
+	for _, specs := range [][]FieldSpec{
+		{{Type: TypeString}},
+		{{Name: "number", Type: TypeString}, {Name: "number", Type: TypeSecret}},
+		{{Name: "number", Type: "passport"}},
+	} {
+		require.ErrorIs(t, ValidateSpecs(specs), ErrInvalidField)
+	}
+}
@@ -0,0 +1,136 @@
+package item
+
+import (
+	"fmt"
+	"slices"
+)
+
+// FieldSpec - Описание поля шаблона
+type FieldSpec struct {
+	// Name - Имя поля
+	Name string `json:"name"`
+	// Type - Тип поля из Types
+	Type string `json:"type"`
+	// Required - Значение поля обязательно
+	Required bool `json:"required"`
+}
+
+// Template - Шаблон данных: набор полей, которые создаются вместе с данными
+type Template struct {
+	// Name - Имя шаблона
+	Name string
+	// Description - Описание шаблона для вывода пользователю
+	Description string
+	// Fields - Поля шаблона в порядке вывода
+	Fields []FieldSpec
+}
+
+// Custom - Имя шаблона без предопределенных полей, все поля задает пользователь
+const Custom = "custom"
+
+var templates = []Template{
+	{
+		Name:        Custom,
+		Description: "no predefined fields, every field is described by the user",
+	},
+	{
+		Name:        "wifi",
+		Description: "Wi-Fi network",
+		Fields: []FieldSpec{
+			{Name: "ssid", Type: TypeString, Required: true},
+			{Name: "password", Type: TypeSecret},
+			{Name: "security", Type: TypeString},
+		},
+	},
+	{
+		Name:        "api-key",
+		Description: "API key of a service",
+		Fields: []FieldSpec{
+			{Name: "service", Type: TypeString, Required: true},
+			{Name: "key", Type: TypeSecret, Required: true},
+			{Name: "url", Type: TypeURL},
+			{Name: "expires", Type: TypeDate},
+		},
+	},
+	{
+		Name:        "license",
+		Description: "software license",
+		Fields: []FieldSpec{
+			{Name: "product", Type: TypeString, Required: true},
+			{Name: "license-key", Type: TypeSecret, Required: true},
+			{Name: "licensed-to", Type: TypeString},
+			{Name: "seats", Type: TypeNumber},
+			{Name: "expires", Type: TypeDate},
+			{Name: "file", Type: TypeFile},
+		},
+	},
+}
+
+// ValidateSpecs - Проверяет, что у описаний полей шаблона есть уникальные имена и известные типы
+func ValidateSpecs(specs []FieldSpec) error {
+	seen := map[string]bool{}
+	for _, v := range specs {
+		if v.Name == "" {
+			return fmt.Errorf("%w: empty name", ErrInvalidField)
+		}
+		if seen[v.Name] {
+			return fmt.Errorf("%w: duplicate name %q", ErrInvalidField, v.Name)
+		}
+		seen[v.Name] = true
+		if !slices.Contains(Types, v.Type) {
+			return fmt.Errorf("%w: field %q: unknown type %q", ErrInvalidField, v.Name, v.Type)
+		}
+	}
+
+	return nil
+}
+
+// Templates - Возвращает встроенные шаблоны
+func Templates() []Template {
+	return templates
+}
+
+// FindTemplate - Возвращает встроенный шаблон по имени
+func FindTemplate(name string) (Template, error) {
+	for _, v := range templates {
+		if v.Name == name {
+			return v, nil
+		}
+	}
+
+	return Template{}, fmt.Errorf("%w: %q", ErrUnknownTemplate, name)
+}
+
+// Build - Возвращает поля данных по шаблону: значения полей шаблона берутся из values,
+// поля extra, которых нет в шаблоне, добавляются после полей шаблона.
+// Значение для имени, которого нет ни в шаблоне, ни в extra, считается ошибкой
+func (t Template) Build(values map[string]string, extra []Field) ([]Field, error) {
+	fields := make([]Field, 0, len(t.Fields)+len(extra))
+	used := map[string]bool{}
+	for _, spec := range t.Fields {
+		value := values[spec.Name]
+		used[spec.Name] = true
+		if spec.Required && value == "" {
+			return nil, fmt.Errorf("%w: %q is required by template %s", ErrInvalidField, spec.Name, t.Name)
+		}
+		fields = append(fields, Field{Name: spec.Name, Type: spec.Type, Value: value})
+	}
+	for _, v := range extra {
+		if used[v.Name] {
+			return nil, fmt.Errorf("%w: %q is defined by template %s", ErrInvalidField, v.Name, t.Name)
+		}
+		used[v.Name] = true
+		fields = append(fields, v)
+	}
+	for name := range values {
+		if !used[name] {
+			return nil, fmt.Errorf("%w: template %s has no field %q, pass its type", ErrInvalidField, t.Name, name)
+		}
+	}
+
+	if err := Validate(fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
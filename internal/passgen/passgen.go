@@ -0,0 +1,209 @@
+// Package passgen содержит генератор случайных паролей и diceware-фраз с оценкой энтропии
+package passgen
+
+import (
+	"crypto/rand"
+	_ "embed"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+)
+
+const (
+	// DefaultLength - Длина пароля по умолчанию
+	DefaultLength = 20
+	// MinLength - Минимальная длина пароля
+	MinLength = 8
+	// MaxLength - Максимальная длина пароля
+	MaxLength = 1024
+	// DefaultWords - Количество слов во фразе по умолчанию
+	DefaultWords = 6
+	// MinWords - Минимальное количество слов во фразе
+	MinWords = 4
+	// MaxWords - Максимальное количество слов во фразе
+	MaxWords = 64
+	// DefaultSeparator - Разделитель слов во фразе по умолчанию
+	DefaultSeparator = "-"
+	// redacted - Значение, которое выводится вместо пароля при форматировании и сериализации
+	redacted = "[REDACTED]"
+)
+
+const (
+	lower   = "abcdefghijklmnopqrstuvwxyz"
+	upper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digits  = "0123456789"
+	symbols = "!#$%&()*+,-./:;<=>?@[]^_{|}~"
+	// ambiguous - Символы, которые легко спутать друг с другом при чтении или переписывании
+	ambiguous = "Il1|O0o"
+)
+
+var (
+	// ErrNoClasses - Не выбран ни один класс символов
+	ErrNoClasses = errors.New("at least one character class must be enabled")
+	// ErrInvalidLength - Длина пароля вне допустимого диапазона
+	ErrInvalidLength = errors.New("password length must be between 8 and 1024")
+	// ErrInvalidWords - Количество слов вне допустимого диапазона
+	ErrInvalidWords = errors.New("passphrase must contain between 4 and 64 words")
+)
+
+//go:embed wordlist.txt
+var rawWordlist string
+
+// wordlist - Английский словарь BIP-39 из 2048 слов, каждое слово добавляет 11 бит энтропии
+var wordlist = strings.Fields(rawWordlist)
+
+// Options - Параметры генерации пароля
+type Options struct {
+	// Length - Длина пароля
+	Length int
+	// Lower - Использовать строчные буквы
+	Lower bool
+	// Upper - Использовать заглавные буквы
+	Upper bool
+	// Digits - Использовать цифры
+	Digits bool
+	// Symbols - Использовать спецсимволы
+	Symbols bool
+	// ExcludeAmbiguous - Исключить похожие символы Il1|O0o
+	ExcludeAmbiguous bool
+}
+
+// DefaultOptions - Возвращает параметры по умолчанию: все классы символов и длина DefaultLength
+func DefaultOptions() Options {
+	return Options{
+		Length:  DefaultLength,
+		Lower:   true,
+		Upper:   true,
+		Digits:  true,
+		Symbols: true,
+	}
+}
+
+// classes - Возвращает наборы символов выбранных классов
+func (o Options) classes() []string {
+	selected := []string{}
+	for _, v := range []struct {
+		enabled bool
+		set     string
+	}{
+		{o.Lower, lower},
+		{o.Upper, upper},
+		{o.Digits, digits},
+		{o.Symbols, symbols},
+	} {
+		if !v.enabled {
+			continue
+		}
+		set := v.set
+		if o.ExcludeAmbiguous {
+			set = strings.Map(func(r rune) rune {
+				if strings.ContainsRune(ambiguous, r) {
+					return -1
+				}
+
+				return r
+			}, set)
+		}
+		selected = append(selected, set)
+	}
+
+	return selected
+}
+
+// Secret - Сгенерированный пароль или фраза. Значение скрыто при выводе через fmt, логгер и
+// сериализацию, получить его можно только явным вызовом Value
+type Secret struct {
+	value string
+	// Entropy - Оценка энтропии в битах для атакующего, которому известны параметры генерации
+	Entropy float64
+}
+
+// Value - Возвращает сгенерированное значение
+func (s Secret) Value() string {
+	return s.value
+}
+
+// String - Скрывает значение при форматировании
+func (s Secret) String() string {
+	return redacted
+}
+
+// GoString - Скрывает значение при форматировании через %#v
+func (s Secret) GoString() string {
+	return redacted
+}
+
+// MarshalText - Скрывает значение при сериализации
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(redacted), nil
+}
+
+// pick - Возвращает равномерно распределенное случайное число от 0 до n-1
+func pick(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(v.Int64()), nil
+}
+
+// Password - Генерирует пароль, содержащий хотя бы один символ каждого выбранного класса
+func Password(opts Options) (Secret, error) {
+	if opts.Length < MinLength || opts.Length > MaxLength {
+		return Secret{}, ErrInvalidLength
+	}
+	classes := opts.classes()
+	if len(classes) == 0 {
+		return Secret{}, ErrNoClasses
+	}
+	alphabet := strings.Join(classes, "")
+
+	password := make([]byte, opts.Length)
+	for i := range password {
+		// Первые символы берутся по одному из каждого класса, затем пароль перемешивается
+		set := alphabet
+		if i < len(classes) {
+			set = classes[i]
+		}
+		n, err := pick(len(set))
+		if err != nil {
+			return Secret{}, err
+		}
+		password[i] = set[n]
+	}
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := pick(i + 1)
+		if err != nil {
+			return Secret{}, err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return Secret{
+		value:   string(password),
+		Entropy: float64(opts.Length) * math.Log2(float64(len(alphabet))),
+	}, nil
+}
+
+// Passphrase - Генерирует diceware-фразу из words случайных слов встроенного словаря
+func Passphrase(words int, separator string) (Secret, error) {
+	if words < MinWords || words > MaxWords {
+		return Secret{}, ErrInvalidWords
+	}
+
+	selected := make([]string, words)
+	for i := range selected {
+		n, err := pick(len(wordlist))
+		if err != nil {
+			return Secret{}, err
+		}
+		selected[i] = wordlist[n]
+	}
+
+	return Secret{
+		value:   strings.Join(selected, separator),
+		Entropy: float64(words) * math.Log2(float64(len(wordlist))),
+	}, nil
+}
@@ -0,0 +1,123 @@
+package passgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordlist(t *testing.T) {
+	assert.Len(t, wordlist, 2048)
+	seen := map[string]bool{}
+	for _, w := range wordlist {
+		assert.False(t, seen[w], w)
+		seen[w] = true
+	}
+}
+
+func TestPasswordDefault(t *testing.T) {
+	secret, err := Password(DefaultOptions())
+	require.NoError(t, err)
+
+	value := secret.Value()
+	assert.Len(t, value, DefaultLength)
+	assert.True(t, strings.ContainsAny(value, lower))
+	assert.True(t, strings.ContainsAny(value, upper))
+	assert.True(t, strings.ContainsAny(value, digits))
+	assert.True(t, strings.ContainsAny(value, symbols))
+
+	alphabet := len(lower + upper + digits + symbols)
+	assert.InDelta(t, DefaultLength*math.Log2(float64(alphabet)), secret.Entropy, 0.001)
+}
+
+func TestPasswordClasses(t *testing.T) {
+	opts := Options{Length: 32, Digits: true}
+	secret, err := Password(opts)
+	require.NoError(t, err)
+
+	for _, r := range secret.Value() {
+		assert.Contains(t, digits, string(r))
+	}
+	assert.InDelta(t, 32*math.Log2(10), secret.Entropy, 0.001)
+}
+
+func TestPasswordEveryClassPresent(t *testing.T) {
+	opts := DefaultOptions()
+	opts.Length = MinLength
+	for i := 0; i < 100; i++ {
+		secret, err := Password(opts)
+		require.NoError(t, err)
+
+		value := secret.Value()
+		assert.True(t, strings.ContainsAny(value, lower), value)
+		assert.True(t, strings.ContainsAny(value, upper), value)
+		assert.True(t, strings.ContainsAny(value, digits), value)
+		assert.True(t, strings.ContainsAny(value, symbols), value)
+	}
+}
+
+func TestPasswordExcludeAmbiguous(t *testing.T) {
+	opts := DefaultOptions()
+	opts.Length = MaxLength
+	opts.ExcludeAmbiguous = true
+	secret, err := Password(opts)
+	require.NoError(t, err)
+
+	assert.False(t, strings.ContainsAny(secret.Value(), ambiguous))
+
+	alphabet := len(lower+upper+digits+symbols) - len(ambiguous)
+	assert.InDelta(t, MaxLength*math.Log2(float64(alphabet)), secret.Entropy, 0.001)
+}
+
+func TestPasswordInvalidOptions(t *testing.T) {
+	_, err := Password(Options{Length: 16})
+	assert.ErrorIs(t, err, ErrNoClasses)
+
+	opts := DefaultOptions()
+	opts.Length = MinLength - 1
+	_, err = Password(opts)
+	assert.ErrorIs(t, err, ErrInvalidLength)
+
+	opts.Length = MaxLength + 1
+	_, err = Password(opts)
+	assert.ErrorIs(t, err, ErrInvalidLength)
+}
+
+func TestPassphrase(t *testing.T) {
+	secret, err := Passphrase(DefaultWords, DefaultSeparator)
+	require.NoError(t, err)
+
+	words := strings.Split(secret.Value(), DefaultSeparator)
+	assert.Len(t, words, DefaultWords)
+	for _, w := range words {
+		assert.Contains(t, wordlist, w)
+	}
+	assert.InDelta(t, DefaultWords*11.0, secret.Entropy, 0.001)
+}
+
+func TestPassphraseInvalidWords(t *testing.T) {
+	_, err := Passphrase(MinWords-1, DefaultSeparator)
+	assert.ErrorIs(t, err, ErrInvalidWords)
+
+	_, err = Passphrase(MaxWords+1, DefaultSeparator)
+	assert.ErrorIs(t, err, ErrInvalidWords)
+}
+
+func TestSecretRedacted(t *testing.T) {
+	secret, err := Password(DefaultOptions())
+	require.NoError(t, err)
+
+	for _, format := range []string{"%s", "%v", "%+v", "%#v", "%q"} {
+		assert.NotContains(t, fmt.Sprintf(format, secret), secret.Value(), format)
+	}
+
+	out, err := json.Marshal(map[string]any{"password": secret})
+	require.NoError(t, err)
+	assert.NotContains(t, string(out), secret.Value())
+	assert.Contains(t, string(out), redacted)
+}
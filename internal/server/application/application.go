@@ -4,6 +4,7 @@ package application
 import (
 	"github.com/sirupsen/logrus"
 
+	cryptoprovider "github.com/Nickolasll/goph-keeper/internal/server/application/crypto_provider"
 	"github.com/Nickolasll/goph-keeper/internal/server/application/jose"
 	usecases "github.com/Nickolasll/goph-keeper/internal/server/application/use_cases"
 	"github.com/Nickolasll/goph-keeper/internal/server/domain"
@@ -15,31 +16,131 @@ type Application struct {
 	Registration usecases.Registration
 	// Login - Сценарий использования входа пользователя по логину и паролю
 	Login usecases.Login
+	// Refresh - Сценарий использования выпуска новой пары токенов по refresh token
+	Refresh usecases.Refresh
+	// TwoFactorLogin - Сценарий использования завершения входа кодом второго фактора
+	TwoFactorLogin usecases.TwoFactorLogin
+	// EnrollTwoFactor - Сценарий использования подключения двухфакторной аутентификации
+	EnrollTwoFactor usecases.EnrollTwoFactor
+	// VerifyTwoFactor - Сценарий использования подтверждения подключения двухфакторной аутентификации
+	VerifyTwoFactor usecases.VerifyTwoFactor
+	// DisableTwoFactor - Сценарий использования отключения двухфакторной аутентификации
+	DisableTwoFactor usecases.DisableTwoFactor
+	// PreLogin - Сценарий использования получения параметров вывода ключа хранилища перед входом
+	PreLogin usecases.PreLogin
+	// CheckE2E - Сценарий использования проверки режима сквозного шифрования пользователя
+	CheckE2E usecases.CheckE2E
 	// CreateText - Сценарий использования для создания зашифрованных текстовых данных
 	CreateText usecases.CreateText
 	// UpdateText - Сценарий использования для обновления существующих зашифрованных текстовых данных
 	UpdateText usecases.UpdateText
 	// GetAllTexts - Получение всех расшифрованных текстовых данных
 	GetAllTexts usecases.GetAllTexts
+	// GetText - Получение расшифрованных текстовых данных по идентификатору
+	GetText usecases.GetText
+	// DeleteText - Сценарий использования для удаления существующих текстовых данных
+	DeleteText usecases.DeleteText
 	// CreateText - Сценарий использования для создания зашифрованных бинарных данных
 	CreateBinary usecases.CreateBinary
 	// UpdateText - Сценарий использования для обновления существующих зашифрованных бинарных данных
 	UpdateBinary usecases.UpdateBinary
 	// GetAllBinaries - Получение всех расшифрованных бинарных данных
 	GetAllBinaries usecases.GetAllBinaries
+	// GetBinary - Получение расшифрованных метаданных бинарных данных по идентификатору
+	GetBinary usecases.GetBinary
+	// DeleteBinary - Сценарий использования для удаления существующих бинарных данных
+	DeleteBinary usecases.DeleteBinary
+	// StartBinaryUpload - Сценарий использования для начала загрузки бинарных данных частями
+	StartBinaryUpload usecases.StartBinaryUpload
+	// GetBinaryUpload - Сценарий использования для получения состояния загрузки бинарных данных частями
+	GetBinaryUpload usecases.GetBinaryUpload
+	// UploadBinaryChunk - Сценарий использования для загрузки одной части бинарных данных
+	UploadBinaryChunk usecases.UploadBinaryChunk
+	// CommitBinaryUpload - Сценарий использования для завершения загрузки бинарных данных частями
+	CommitBinaryUpload usecases.CommitBinaryUpload
+	// DeleteExpiredUploads - Сценарий использования для удаления брошенных сессий загрузки бинарных данных частями
+	DeleteExpiredUploads usecases.DeleteExpiredUploads
+	// DownloadBinary - Сценарий использования для потоковой выгрузки расшифрованных бинарных данных
+	DownloadBinary usecases.DownloadBinary
 	// CreateCredentials - Сценарий использования для создания зашифрованной пары логин и пароль
 	CreateCredentials usecases.CreateCredentials
 	// UpdateCredentials - Сценарий использования для обновления существующей зашифрованной пары логин и пароль
 	UpdateCredentials usecases.UpdateCredentials
 	// GetAllCredentials - Получение всех расшифрованных логинов и паролей
 	GetAllCredentials usecases.GetAllCredentials
+	// GetCredentials - Получение расшифрованных логина и пароля по идентификатору
+	GetCredentials usecases.GetCredentials
+	// DeleteCredentials - Сценарий использования для удаления существующей пары логин и пароль
+	DeleteCredentials usecases.DeleteCredentials
 	// CreateBankCard - Сценарий использования для создания зашифрованной банковской карты
 	CreateBankCard usecases.CreateBankCard
 	// UpdateBankCard - Сценарий использования для обновления существующей зашифрованной банковской карты
 	UpdateBankCard usecases.UpdateBankCard
 	// GetAllBankCards - Получение всех расшифрованных банковских карт
 	GetAllBankCards usecases.GetAllBankCards
-	GetAll          usecases.GetAll
+	// GetBankCard - Получение расшифрованной банковской карты по идентификатору
+	GetBankCard usecases.GetBankCard
+	// DeleteBankCard - Сценарий использования для удаления существующей банковской карты
+	DeleteBankCard usecases.DeleteBankCard
+	// CreateOTP - Сценарий использования для создания зашифрованного секрета одноразовых паролей
+	CreateOTP usecases.CreateOTP
+	// UpdateOTP - Сценарий использования для обновления существующего зашифрованного секрета одноразовых паролей
+	UpdateOTP usecases.UpdateOTP
+	// GetAllOTPs - Получение всех расшифрованных секретов одноразовых паролей
+	GetAllOTPs usecases.GetAllOTPs
+	// GetOTP - Получение расшифрованного секрета одноразовых паролей по идентификатору
+	GetOTP usecases.GetOTP
+	// DeleteOTP - Сценарий использования для удаления существующего секрета одноразовых паролей
+	DeleteOTP usecases.DeleteOTP
+	// CreateSSHKey - Сценарий использования для создания зашифрованного SSH ключа
+	CreateSSHKey usecases.CreateSSHKey
+	// UpdateSSHKey - Сценарий использования для обновления существующего зашифрованного SSH ключа
+	UpdateSSHKey usecases.UpdateSSHKey
+	// GetAllSSHKeys - Получение всех расшифрованных SSH ключей
+	GetAllSSHKeys usecases.GetAllSSHKeys
+	// GetSSHKey - Получение расшифрованного SSH ключа по идентификатору
+	GetSSHKey usecases.GetSSHKey
+	// DeleteSSHKey - Сценарий использования для удаления существующего SSH ключа
+	DeleteSSHKey usecases.DeleteSSHKey
+	// CreateItem - Сценарий использования для создания зашифрованных данных по шаблону
+	CreateItem usecases.CreateItem
+	// UpdateItem - Сценарий использования для обновления существующих зашифрованных данных по шаблону
+	UpdateItem usecases.UpdateItem
+	// GetAllItems - Получение всех расшифрованных данных по шаблону
+	GetAllItems usecases.GetAllItems
+	// GetItem - Получение расшифрованных данных по шаблону по идентификатору
+	GetItem usecases.GetItem
+	// DeleteItem - Сценарий использования для удаления существующих данных по шаблону
+	DeleteItem usecases.DeleteItem
+	// CreateFolder - Сценарий использования для создания папки с зашифрованным именем
+	CreateFolder usecases.CreateFolder
+	// UpdateFolder - Сценарий использования для переименования и перемещения существующей папки
+	UpdateFolder usecases.UpdateFolder
+	// GetAllFolders - Получение всех папок с расшифрованными именами
+	GetAllFolders usecases.GetAllFolders
+	// DeleteFolder - Сценарий использования для удаления существующей папки
+	DeleteFolder usecases.DeleteFolder
+	// CreateTag - Сценарий использования для создания метки с зашифрованным именем
+	CreateTag usecases.CreateTag
+	// UpdateTag - Сценарий использования для переименования существующей метки
+	UpdateTag usecases.UpdateTag
+	// GetAllTags - Получение всех меток с расшифрованными именами
+	GetAllTags usecases.GetAllTags
+	// DeleteTag - Сценарий использования для удаления существующей метки
+	DeleteTag usecases.DeleteTag
+	// CreateTemplate - Сценарий использования для создания зашифрованного пользовательского шаблона
+	CreateTemplate usecases.CreateTemplate
+	// GetAllTemplates - Получение всех расшифрованных пользовательских шаблонов
+	GetAllTemplates usecases.GetAllTemplates
+	// DeleteTemplate - Сценарий использования для удаления существующего пользовательского шаблона
+	DeleteTemplate usecases.DeleteTemplate
+	// SaveLabels - Сценарий использования для сохранения папки и меток данных
+	SaveLabels usecases.SaveLabels
+	// GetLabels - Получение папки и меток данных по идентификатору данных
+	GetLabels usecases.GetLabels
+	GetAll    usecases.GetAll
+	// GetChanges - Получение расшифрованных изменений данных пользователя после указанной ревизии
+	GetChanges usecases.GetChanges
 }
 
 // New - Фабрика приложения
@@ -48,110 +149,436 @@ func New(
 	joseService *jose.JOSEService,
 	crypto domain.CryptoServiceInterface,
 	userRepository domain.UserRepositoryInterface,
+	userKeyRepository domain.UserKeyRepositoryInterface,
+	refreshTokenRepository domain.RefreshTokenRepositoryInterface,
+	twoFactorRepository domain.TwoFactorRepositoryInterface,
 	textRepository domain.TextRepositoryInterface,
 	binaryRepository domain.BinaryRepositoryInterface,
+	binaryUploadRepository domain.BinaryUploadRepositoryInterface,
 	credentialsRepository domain.CredentialsRepositoryInterface,
 	bankCardRepository domain.BankCardRepositoryInterface,
+	otpRepository domain.OTPRepositoryInterface,
+	sshKeyRepository domain.SSHKeyRepositoryInterface,
+	itemRepository domain.ItemRepositoryInterface,
+	folderRepository domain.FolderRepositoryInterface,
+	tagRepository domain.TagRepositoryInterface,
+	templateRepository domain.TemplateRepositoryInterface,
+	labelRepository domain.LabelRepositoryInterface,
+	changesRepository domain.ChangesRepositoryInterface,
 ) *Application {
+	cryptoProvider := cryptoprovider.New(crypto, userRepository, userKeyRepository)
+
 	registration := usecases.Registration{
+		UserRepository:         userRepository,
+		RefreshTokenRepository: refreshTokenRepository,
+		Crypto:                 cryptoProvider,
+		JOSE:                   joseService,
+		Log:                    log,
+	}
+
+	login := usecases.Login{
+		UserRepository:         userRepository,
+		RefreshTokenRepository: refreshTokenRepository,
+		TwoFactorRepository:    twoFactorRepository,
+		JOSE:                   joseService,
+		Log:                    log,
+	}
+
+	twoFactorLogin := usecases.TwoFactorLogin{
+		TwoFactorRepository:    twoFactorRepository,
+		RefreshTokenRepository: refreshTokenRepository,
+		Crypto:                 cryptoProvider,
+		JOSE:                   joseService,
+		Log:                    log,
+	}
+	enrollTwoFactor := usecases.EnrollTwoFactor{
+		UserRepository:      userRepository,
+		TwoFactorRepository: twoFactorRepository,
+		Crypto:              cryptoProvider,
+		Log:                 log,
+	}
+	verifyTwoFactor := usecases.VerifyTwoFactor{
+		TwoFactorRepository: twoFactorRepository,
+		Crypto:              cryptoProvider,
+		JOSE:                joseService,
+		Log:                 log,
+	}
+	disableTwoFactor := usecases.DisableTwoFactor{
+		TwoFactorRepository: twoFactorRepository,
+		Crypto:              cryptoProvider,
+		JOSE:                joseService,
+		Log:                 log,
+	}
+
+	refresh := usecases.Refresh{
+		RefreshTokenRepository: refreshTokenRepository,
+		JOSE:                   joseService,
+		Log:                    log,
+	}
+
+	preLogin := usecases.PreLogin{
 		UserRepository: userRepository,
 		JOSE:           joseService,
 		Log:            log,
 	}
 
-	login := usecases.Login{
+	checkE2E := usecases.CheckE2E{
 		UserRepository: userRepository,
-		JOSE:           joseService,
 		Log:            log,
 	}
 
 	createText := usecases.CreateText{
 		TextRepository: textRepository,
-		Crypto:         crypto,
+		Crypto:         cryptoProvider,
 		Log:            log,
 	}
 	updateText := usecases.UpdateText{
 		TextRepository: textRepository,
-		Crypto:         crypto,
+		Crypto:         cryptoProvider,
 		Log:            log,
 	}
 	getAllTexts := usecases.GetAllTexts{
 		TextRepository: textRepository,
-		Crypto:         crypto,
+		Crypto:         cryptoProvider,
+		Log:            log,
+	}
+	getText := usecases.GetText{
+		TextRepository: textRepository,
+		Crypto:         cryptoProvider,
+		Log:            log,
+	}
+	deleteText := usecases.DeleteText{
+		TextRepository: textRepository,
 		Log:            log,
 	}
 
 	createBinary := usecases.CreateBinary{
 		BinaryRepository: binaryRepository,
-		Crypto:           crypto,
+		Crypto:           cryptoProvider,
 		Log:              log,
 	}
 	updateBinary := usecases.UpdateBinary{
 		BinaryRepository: binaryRepository,
-		Crypto:           crypto,
+		Crypto:           cryptoProvider,
 		Log:              log,
 	}
 	getAllBinaries := usecases.GetAllBinaries{
 		BinaryRepository: binaryRepository,
-		Crypto:           crypto,
+		Crypto:           cryptoProvider,
+		Log:              log,
+	}
+	getBinary := usecases.GetBinary{
+		BinaryRepository: binaryRepository,
+		Crypto:           cryptoProvider,
+		Log:              log,
+	}
+	deleteBinary := usecases.DeleteBinary{
+		BinaryRepository: binaryRepository,
 		Log:              log,
 	}
+	startBinaryUpload := usecases.StartBinaryUpload{
+		BinaryUploadRepository: binaryUploadRepository,
+		Log:                    log,
+	}
+	getBinaryUpload := usecases.GetBinaryUpload{
+		BinaryUploadRepository: binaryUploadRepository,
+		Log:                    log,
+	}
+	uploadBinaryChunk := usecases.UploadBinaryChunk{
+		BinaryUploadRepository: binaryUploadRepository,
+		Crypto:                 cryptoProvider,
+		Log:                    log,
+	}
+	commitBinaryUpload := usecases.CommitBinaryUpload{
+		BinaryRepository:       binaryRepository,
+		BinaryUploadRepository: binaryUploadRepository,
+		Crypto:                 cryptoProvider,
+		Log:                    log,
+	}
+	deleteExpiredUploads := usecases.DeleteExpiredUploads{
+		BinaryUploadRepository: binaryUploadRepository,
+		Log:                    log,
+	}
+	downloadBinary := usecases.DownloadBinary{
+		BinaryRepository:       binaryRepository,
+		BinaryUploadRepository: binaryUploadRepository,
+		Crypto:                 cryptoProvider,
+		Log:                    log,
+	}
 
 	createCredentials := usecases.CreateCredentials{
 		CredentialsRepository: credentialsRepository,
-		Crypto:                crypto,
+		Crypto:                cryptoProvider,
 		Log:                   log,
 	}
 	updateCredentials := usecases.UpdateCredentials{
 		CredentialsRepository: credentialsRepository,
-		Crypto:                crypto,
+		Crypto:                cryptoProvider,
 		Log:                   log,
 	}
 	getAllCredentials := usecases.GetAllCredentials{
 		CredentialsRepository: credentialsRepository,
-		Crypto:                crypto,
+		Crypto:                cryptoProvider,
+		Log:                   log,
+	}
+	getCredentials := usecases.GetCredentials{
+		CredentialsRepository: credentialsRepository,
+		Crypto:                cryptoProvider,
+		Log:                   log,
+	}
+	deleteCredentials := usecases.DeleteCredentials{
+		CredentialsRepository: credentialsRepository,
 		Log:                   log,
 	}
 
 	createBankCard := usecases.CreateBankCard{
 		BankCardRepository: bankCardRepository,
-		Crypto:             crypto,
+		Crypto:             cryptoProvider,
 		Log:                log,
 	}
 	updateBankCard := usecases.UpdateBankCard{
 		BankCardRepository: bankCardRepository,
-		Crypto:             crypto,
+		Crypto:             cryptoProvider,
 		Log:                log,
 	}
 	getAllBankCards := usecases.GetAllBankCards{
 		BankCardRepository: bankCardRepository,
-		Crypto:             crypto,
+		Crypto:             cryptoProvider,
+		Log:                log,
+	}
+	getBankCard := usecases.GetBankCard{
+		BankCardRepository: bankCardRepository,
+		Crypto:             cryptoProvider,
+		Log:                log,
+	}
+	deleteBankCard := usecases.DeleteBankCard{
+		BankCardRepository: bankCardRepository,
 		Log:                log,
 	}
 
+	createOTP := usecases.CreateOTP{
+		OTPRepository: otpRepository,
+		Crypto:        cryptoProvider,
+		Log:           log,
+	}
+	updateOTP := usecases.UpdateOTP{
+		OTPRepository: otpRepository,
+		Crypto:        cryptoProvider,
+		Log:           log,
+	}
+	getAllOTPs := usecases.GetAllOTPs{
+		OTPRepository: otpRepository,
+		Crypto:        cryptoProvider,
+		Log:           log,
+	}
+	getOTP := usecases.GetOTP{
+		OTPRepository: otpRepository,
+		Crypto:        cryptoProvider,
+		Log:           log,
+	}
+	deleteOTP := usecases.DeleteOTP{
+		OTPRepository: otpRepository,
+		Log:           log,
+	}
+
+	createSSHKey := usecases.CreateSSHKey{
+		SSHKeyRepository: sshKeyRepository,
+		Crypto:           cryptoProvider,
+		Log:              log,
+	}
+	updateSSHKey := usecases.UpdateSSHKey{
+		SSHKeyRepository: sshKeyRepository,
+		Crypto:           cryptoProvider,
+		Log:              log,
+	}
+	getAllSSHKeys := usecases.GetAllSSHKeys{
+		SSHKeyRepository: sshKeyRepository,
+		Crypto:           cryptoProvider,
+		Log:              log,
+	}
+	getSSHKey := usecases.GetSSHKey{
+		SSHKeyRepository: sshKeyRepository,
+		Crypto:           cryptoProvider,
+		Log:              log,
+	}
+	deleteSSHKey := usecases.DeleteSSHKey{
+		SSHKeyRepository: sshKeyRepository,
+		Log:              log,
+	}
+
+	createItem := usecases.CreateItem{
+		ItemRepository: itemRepository,
+		Crypto:         cryptoProvider,
+		Log:            log,
+	}
+	updateItem := usecases.UpdateItem{
+		ItemRepository: itemRepository,
+		Crypto:         cryptoProvider,
+		Log:            log,
+	}
+	getAllItems := usecases.GetAllItems{
+		ItemRepository: itemRepository,
+		Crypto:         cryptoProvider,
+		Log:            log,
+	}
+	getItem := usecases.GetItem{
+		ItemRepository: itemRepository,
+		Crypto:         cryptoProvider,
+		Log:            log,
+	}
+	deleteItem := usecases.DeleteItem{
+		ItemRepository: itemRepository,
+		Log:            log,
+	}
+
+	createFolder := usecases.CreateFolder{
+		FolderRepository: folderRepository,
+		Crypto:           cryptoProvider,
+		Log:              log,
+	}
+	updateFolder := usecases.UpdateFolder{
+		FolderRepository: folderRepository,
+		Crypto:           cryptoProvider,
+		Log:              log,
+	}
+	getAllFolders := usecases.GetAllFolders{
+		FolderRepository: folderRepository,
+		Crypto:           cryptoProvider,
+		Log:              log,
+	}
+	deleteFolder := usecases.DeleteFolder{
+		FolderRepository: folderRepository,
+		Log:              log,
+	}
+
+	createTag := usecases.CreateTag{
+		TagRepository: tagRepository,
+		Crypto:        cryptoProvider,
+		Log:           log,
+	}
+	updateTag := usecases.UpdateTag{
+		TagRepository: tagRepository,
+		Crypto:        cryptoProvider,
+		Log:           log,
+	}
+	getAllTags := usecases.GetAllTags{
+		TagRepository: tagRepository,
+		Crypto:        cryptoProvider,
+		Log:           log,
+	}
+	deleteTag := usecases.DeleteTag{
+		TagRepository: tagRepository,
+		Log:           log,
+	}
+
+	createTemplate := usecases.CreateTemplate{
+		TemplateRepository: templateRepository,
+		Crypto:             cryptoProvider,
+		Log:                log,
+	}
+	getAllTemplates := usecases.GetAllTemplates{
+		TemplateRepository: templateRepository,
+		Crypto:             cryptoProvider,
+		Log:                log,
+	}
+	deleteTemplate := usecases.DeleteTemplate{
+		TemplateRepository: templateRepository,
+		Log:                log,
+	}
+
+	saveLabels := usecases.SaveLabels{
+		LabelRepository:  labelRepository,
+		FolderRepository: folderRepository,
+		TagRepository:    tagRepository,
+		Log:              log,
+	}
+	getLabels := usecases.GetLabels{
+		LabelRepository: labelRepository,
+		Log:             log,
+	}
+
 	getAll := usecases.GetAll{
 		GetAllTexts:       getAllTexts,
 		GetAllBankCards:   getAllBankCards,
 		GetAllBinaries:    getAllBinaries,
 		GetAllCredentials: getAllCredentials,
+		GetAllOTPs:        getAllOTPs,
+		GetAllSSHKeys:     getAllSSHKeys,
+		GetAllItems:       getAllItems,
+		Log:               log,
+	}
+
+	getChanges := usecases.GetChanges{
+		ChangesRepository: changesRepository,
+		Crypto:            cryptoProvider,
 		Log:               log,
 	}
 
 	return &Application{
-		Registration:      registration,
-		Login:             login,
-		CreateText:        createText,
-		UpdateText:        updateText,
-		GetAllTexts:       getAllTexts,
-		CreateBinary:      createBinary,
-		UpdateBinary:      updateBinary,
-		GetAllBinaries:    getAllBinaries,
-		CreateCredentials: createCredentials,
-		UpdateCredentials: updateCredentials,
-		GetAllCredentials: getAllCredentials,
-		CreateBankCard:    createBankCard,
-		UpdateBankCard:    updateBankCard,
-		GetAllBankCards:   getAllBankCards,
-		GetAll:            getAll,
+		Registration:         registration,
+		Login:                login,
+		Refresh:              refresh,
+		TwoFactorLogin:       twoFactorLogin,
+		EnrollTwoFactor:      enrollTwoFactor,
+		VerifyTwoFactor:      verifyTwoFactor,
+		DisableTwoFactor:     disableTwoFactor,
+		PreLogin:             preLogin,
+		CheckE2E:             checkE2E,
+		CreateText:           createText,
+		UpdateText:           updateText,
+		GetAllTexts:          getAllTexts,
+		GetText:              getText,
+		DeleteText:           deleteText,
+		CreateBinary:         createBinary,
+		UpdateBinary:         updateBinary,
+		GetAllBinaries:       getAllBinaries,
+		GetBinary:            getBinary,
+		DeleteBinary:         deleteBinary,
+		StartBinaryUpload:    startBinaryUpload,
+		GetBinaryUpload:      getBinaryUpload,
+		UploadBinaryChunk:    uploadBinaryChunk,
+		CommitBinaryUpload:   commitBinaryUpload,
+		DeleteExpiredUploads: deleteExpiredUploads,
+		DownloadBinary:       downloadBinary,
+		CreateCredentials:    createCredentials,
+		UpdateCredentials:    updateCredentials,
+		GetAllCredentials:    getAllCredentials,
+		GetCredentials:       getCredentials,
+		DeleteCredentials:    deleteCredentials,
+		CreateBankCard:       createBankCard,
+		UpdateBankCard:       updateBankCard,
+		GetAllBankCards:      getAllBankCards,
+		GetBankCard:          getBankCard,
+		DeleteBankCard:       deleteBankCard,
+		CreateOTP:            createOTP,
+		UpdateOTP:            updateOTP,
+		GetAllOTPs:           getAllOTPs,
+		GetOTP:               getOTP,
+		DeleteOTP:            deleteOTP,
+		CreateSSHKey:         createSSHKey,
+		UpdateSSHKey:         updateSSHKey,
+		GetAllSSHKeys:        getAllSSHKeys,
+		GetSSHKey:            getSSHKey,
+		DeleteSSHKey:         deleteSSHKey,
+		CreateItem:           createItem,
+		UpdateItem:           updateItem,
+		GetAllItems:          getAllItems,
+		GetItem:              getItem,
+		DeleteItem:           deleteItem,
+		CreateFolder:         createFolder,
+		UpdateFolder:         updateFolder,
+		GetAllFolders:        getAllFolders,
+		DeleteFolder:         deleteFolder,
+		CreateTag:            createTag,
+		UpdateTag:            updateTag,
+		GetAllTags:           getAllTags,
+		DeleteTag:            deleteTag,
+		CreateTemplate:       createTemplate,
+		GetAllTemplates:      getAllTemplates,
+		DeleteTemplate:       deleteTemplate,
+		SaveLabels:           saveLabels,
+		GetLabels:            getLabels,
+		GetAll:               getAll,
+		GetChanges:           getChanges,
 	}
 }
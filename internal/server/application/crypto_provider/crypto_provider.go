@@ -0,0 +1,101 @@
+// Package cryptoprovider содержит имплементацию интерфейса CryptoProviderInterface
+package cryptoprovider
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// PlainCrypto - Сервис шифрования для пользователей со сквозным шифрованием.
+// Данные уже зашифрованы на клиенте, поэтому сохраняются и отдаются без изменений
+type PlainCrypto struct{}
+
+// Encrypt - Возвращает данные без изменений
+func (PlainCrypto) Encrypt(value []byte) ([]byte, error) {
+	return value, nil
+}
+
+// Decrypt - Возвращает данные без изменений
+func (PlainCrypto) Decrypt(value []byte) ([]byte, error) {
+	return value, nil
+}
+
+// CryptoProvider - Выбирает сервис шифрования в зависимости от режима шифрования пользователя.
+// Данные каждого пользователя шифруются собственным ключом (DEK), который хранится зашифрованным мастер-ключом (KEK)
+type CryptoProvider struct {
+	// Crypto - Сервис шифрования мастер-ключом сервера
+	Crypto domain.CryptoServiceInterface
+	// UserRepository - Интерфейс репозитория пользователя
+	UserRepository domain.UserRepositoryInterface
+	// UserKeyRepository - Интерфейс репозитория ключей шифрования данных пользователей
+	UserKeyRepository domain.UserKeyRepositoryInterface
+}
+
+// ForUser - Возвращает сервис шифрования данных пользователя.
+// Для пользователей со сквозным шифрованием сервер не имеет доступа к ключу и хранит данные как есть
+func (p CryptoProvider) ForUser(userID uuid.UUID) (domain.CryptoServiceInterface, error) {
+	user, err := p.UserRepository.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.E2E {
+		return PlainCrypto{}, nil
+	}
+
+	return p.ForServer(userID)
+}
+
+// ForServer - Возвращает сервис шифрования персональным ключом пользователя, в том числе пользователя
+// со сквозным шифрованием. Пользователям, зарегистрированным до появления персональных ключей, ключи выдаются
+// при запуске сервера, поэтому отсутствие ключа - ошибка
+func (p CryptoProvider) ForServer(userID uuid.UUID) (domain.CryptoServiceInterface, error) {
+	userKey, err := p.UserKeyRepository.Get(userID)
+	if errors.Is(err, domain.ErrEntityNotFound) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUserKeyNotFound, userID)
+	} else if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := p.Crypto.Decrypt(userKey.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.New(dataKey)
+}
+
+// NewKey - Генерирует новый ключ шифрования данных пользователя и шифрует его мастер-ключом
+func (p CryptoProvider) NewKey(userID uuid.UUID) (domain.UserKey, error) {
+	dataKey, err := crypto.GenerateKey()
+	if err != nil {
+		return domain.UserKey{}, err
+	}
+
+	wrappedKey, err := p.Crypto.Encrypt(dataKey)
+	if err != nil {
+		return domain.UserKey{}, err
+	}
+
+	return domain.UserKey{
+		UserID: userID,
+		Key:    wrappedKey,
+	}, nil
+}
+
+// New - Возвращает новый инстанс поставщика сервиса шифрования
+func New(
+	cryptoService domain.CryptoServiceInterface,
+	userRepository domain.UserRepositoryInterface,
+	userKeyRepository domain.UserKeyRepositoryInterface,
+) *CryptoProvider {
+	return &CryptoProvider{
+		Crypto:            cryptoService,
+		UserRepository:    userRepository,
+		UserKeyRepository: userKeyRepository,
+	}
+}
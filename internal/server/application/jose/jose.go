@@ -2,6 +2,12 @@
 package jose
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,13 +18,27 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const refreshTokenLength = 32
+
+const saltLength = 16
+
+// challengeExpiration - Время жизни токена частичной аутентификации, за это время нужно ввести код второго фактора
+const challengeExpiration = 5 * time.Minute
+
+// challengeClaim - Клейм токена частичной аутентификации, отличается от клейма UserID,
+// поэтому такой токен нельзя использовать для доступа к данным
+const challengeClaim = "ChallengeUserID"
+
 // JOSEService - JavaScript Object Signing and Encryption Service
 type JOSEService struct {
 	// TokenExp - Время жизни токена в секундах
 	TokenExp time.Duration
+	// RefreshTokenExp - Время жизни refresh token
+	RefreshTokenExp time.Duration
 	// JWKS - Ключ подписания токена
-	JWKs jwk.Key
-	log  *logrus.Logger
+	JWKs       jwk.Key
+	saltSecret []byte
+	log        *logrus.Logger
 }
 
 // IssueToken - Выпускает JWT для userID и подписывает его с помощью jwks
@@ -41,6 +61,23 @@ func (jose JOSEService) IssueToken(userID uuid.UUID) ([]byte, error) {
 	return signed, nil
 }
 
+// IssueRefreshToken - Выпускает непрозрачный refresh token из криптографически стойкой случайной последовательности
+func (jose JOSEService) IssueRefreshToken() (string, error) {
+	raw := make([]byte, refreshTokenLength)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// HashRefreshToken - Хэширует refresh token для хранения и поиска в базе данных
+func (jose JOSEService) HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
 // ParseUserID - Валидирует и парсит JWT, извлекает клейм UserID и возвращает в формате UUID
 func (jose JOSEService) ParseUserID(signed []byte) (uuid.UUID, error) {
 	var userID uuid.UUID
@@ -62,6 +99,56 @@ func (jose JOSEService) ParseUserID(signed []byte) (uuid.UUID, error) {
 	return userID, nil
 }
 
+// IssueChallenge - Выпускает токен частичной аутентификации с идентификатором challengeID
+// для пользователя, подтвердившего пароль
+func (jose JOSEService) IssueChallenge(userID, challengeID uuid.UUID) ([]byte, error) {
+	issuedAt := time.Now()
+	token, err := jwt.NewBuilder().
+		JwtID(challengeID.String()).
+		IssuedAt(issuedAt).
+		Expiration(issuedAt.Add(challengeExpiration)).
+		Claim(challengeClaim, userID.String()).
+		Build()
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return jwt.Sign(token, jwt.WithKey(jwa.HS256, jose.JWKs))
+}
+
+// ParseChallenge - Валидирует токен частичной аутентификации и возвращает идентификаторы пользователя и токена
+func (jose JOSEService) ParseChallenge(signed []byte) (uuid.UUID, uuid.UUID, error) {
+	token, err := jwt.Parse(
+		signed,
+		jwt.WithKey(jwa.HS256, jose.JWKs),
+		jwt.WithValidate(true),
+	)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	v, _ := token.Get(challengeClaim)
+	str, _ := v.(string)
+	userID, err := uuid.Parse(str)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	challengeID, err := uuid.Parse(token.JwtID())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return userID, challengeID, nil
+}
+
+// HashRecoveryCode - Хэширует код восстановления для хранения и поиска в базе данных,
+// регистр и разделители кода не учитываются
+func (jose JOSEService) HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
+
 // Hash - Хэширует пароль
 func (jose JOSEService) Hash(password string) string {
 	var passwordBytes = []byte(password)
@@ -77,6 +164,26 @@ func (jose JOSEService) VerifyPassword(hashedPassword, currPassword string) bool
 	return err == nil
 }
 
+// NewSalt - Возвращает случайную соль для вывода ключа хранилища на клиенте, соль сохраняется у пользователя
+func (jose JOSEService) NewSalt() ([]byte, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return salt, nil
+}
+
+// Salt - Возвращает подставную соль для логина, у которого нет сохраненной соли.
+// Соль детерминирована для логина и выводится из отдельного секрета, а не из ключа подписания токенов,
+// поэтому по ответу нельзя определить, существует ли пользователь
+func (jose JOSEService) Salt(login string) []byte {
+	mac := hmac.New(sha256.New, jose.saltSecret)
+	mac.Write([]byte("vault-salt:" + login))
+
+	return mac.Sum(nil)[:saltLength]
+}
+
 // GetCerts - Возвращает публичный ключ для верификации jwt на стороне клиента
 func (jose JOSEService) GetCerts() (jwk.Key, error) {
 	key, err := jose.JWKs.PublicKey()
@@ -90,7 +197,9 @@ func (jose JOSEService) GetCerts() (jwk.Key, error) {
 // New - Возвращает инстанс JOSEService, проверяет jwks на валидность
 func New(
 	rawJWK []byte,
+	saltSecret []byte,
 	jwtExpiration time.Duration,
+	refreshTokenExpiration time.Duration,
 	log *logrus.Logger,
 ) (*JOSEService, error) {
 	key, err := jwk.FromRaw(rawJWK)
@@ -98,9 +207,11 @@ func New(
 		return nil, err
 	}
 	joseService := JOSEService{
-		JWKs:     key,
-		TokenExp: jwtExpiration,
-		log:      log,
+		JWKs:            key,
+		saltSecret:      saltSecret,
+		TokenExp:        jwtExpiration,
+		RefreshTokenExp: refreshTokenExpiration,
+		log:             log,
 	}
 
 	return &joseService, nil
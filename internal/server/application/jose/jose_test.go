@@ -107,3 +107,69 @@ func TestJOSEInvalidUserID(t *testing.T) {
 	_, err = joseService.ParseUserID(signed)
 	require.Error(t, err)
 }
+
+func TestJOSERefreshToken(t *testing.T) {
+	joseService := JOSEService{
+		RefreshTokenExp: time.Hour,
+	}
+	first, err := joseService.IssueRefreshToken()
+	require.NoError(t, err)
+	second, err := joseService.IssueRefreshToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	assert.Equal(t, joseService.HashRefreshToken(first), joseService.HashRefreshToken(first))
+	assert.NotEqual(t, joseService.HashRefreshToken(first), joseService.HashRefreshToken(second))
+	assert.NotEqual(t, first, joseService.HashRefreshToken(first))
+}
+
+func TestJOSESalt(t *testing.T) {
+	joseService, err := New([]byte("My secret keys"), []byte("My salt secret"), time.Minute, time.Hour, nil)
+	require.NoError(t, err)
+	other, err := New([]byte("My secret keys"), []byte("Other salt secret"), time.Minute, time.Hour, nil)
+	require.NoError(t, err)
+
+	salt := joseService.Salt("login")
+	assert.Len(t, salt, saltLength)
+	assert.Equal(t, salt, joseService.Salt("login"))
+	assert.NotEqual(t, salt, joseService.Salt("other login"))
+	assert.NotEqual(t, salt, other.Salt("login"))
+
+	random, err := joseService.NewSalt()
+	require.NoError(t, err)
+	assert.Len(t, random, saltLength)
+	other2, err := joseService.NewSalt()
+	require.NoError(t, err)
+	assert.NotEqual(t, random, other2)
+}
+
+// Проверяем, что токен частичной аутентификации нельзя использовать как access token и наоборот
+func TestJOSEChallenge(t *testing.T) {
+	joseService, err := New([]byte("My secret keys"), []byte("My salt secret"), time.Minute, time.Hour, nil)
+	require.NoError(t, err)
+	userID := uuid.New()
+
+	challengeID := uuid.New()
+
+	challenge, err := joseService.IssueChallenge(userID, challengeID)
+	require.NoError(t, err)
+	parsed, parsedChallengeID, err := joseService.ParseChallenge(challenge)
+	require.NoError(t, err)
+	assert.Equal(t, userID, parsed)
+	assert.Equal(t, challengeID, parsedChallengeID)
+	_, err = joseService.ParseUserID(challenge)
+	require.Error(t, err)
+
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+	_, _, err = joseService.ParseChallenge(token)
+	require.Error(t, err)
+}
+
+func TestJOSEHashRecoveryCode(t *testing.T) {
+	joseService := JOSEService{}
+	hash := joseService.HashRecoveryCode("abcde-fghij")
+	assert.Equal(t, hash, joseService.HashRecoveryCode("ABCDE FGHIJ"))
+	assert.Equal(t, hash, joseService.HashRecoveryCode("abcdefghij"))
+	assert.NotEqual(t, hash, joseService.HashRecoveryCode("abcde-fghik"))
+}
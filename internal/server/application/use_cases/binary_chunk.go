@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+const (
+	// MaxChunkSize - Максимальный размер одной части бинарных данных в байтах
+	MaxChunkSize = 16 << 20
+	// chunkHeaderSize - Размер заголовка части: идентификатор данных и номер части
+	chunkHeaderSize = 16 + 8
+)
+
+// errChunkHeaderMismatch - Расшифрованная часть принадлежит другим данным или находится на другой позиции
+var errChunkHeaderMismatch = errors.New("chunk header mismatch")
+
+// chunkHeader - Возвращает заголовок части, привязывающий ее к данным и позиции
+func chunkHeader(binID uuid.UUID, index int64) []byte {
+	header := make([]byte, chunkHeaderSize)
+	copy(header, binID[:])
+	binary.BigEndian.PutUint64(header[len(binID):], uint64(index))
+
+	return header
+}
+
+// sealChunk - Шифрует часть вместе с заголовком, поэтому части нельзя переставить или подменить частями других данных
+func sealChunk(crypto domain.CryptoServiceInterface, binID uuid.UUID, index int64, content []byte) ([]byte, error) {
+	return crypto.Encrypt(append(chunkHeader(binID, index), content...))
+}
+
+// openChunk - Расшифровывает часть и проверяет ее заголовок
+func openChunk(crypto domain.CryptoServiceInterface, chunk *domain.BinaryChunk) ([]byte, error) {
+	plain, err := crypto.Decrypt(chunk.Content)
+	if err != nil {
+		return nil, err
+	}
+	if len(plain) < chunkHeaderSize || !bytes.Equal(plain[:chunkHeaderSize], chunkHeader(chunk.BinaryID, chunk.Index)) {
+		return nil, errChunkHeaderMismatch
+	}
+
+	return plain[chunkHeaderSize:], nil
+}
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// encryptBinaryMetadata - Шифрует метаданные и сохраняет их в бинарных данных
+func encryptBinaryMetadata(crypto domain.CryptoServiceInterface, bin *domain.Binary, metadata domain.BinaryMetadata) error {
+	fields := []struct {
+		dest  *[]byte
+		value string
+	}{
+		{&bin.Name, metadata.Name},
+		{&bin.MimeType, metadata.MimeType},
+		{&bin.SHA256, metadata.SHA256},
+		{&bin.Meta, metadata.Meta},
+	}
+	for _, field := range fields {
+		encrypted, err := crypto.Encrypt([]byte(field.value))
+		if err != nil {
+			return err
+		}
+		*field.dest = encrypted
+	}
+
+	return nil
+}
+
+// decryptOptional - Расшифровывает значение. Пустое значение остается пустым:
+// у данных, сохраненных до появления метаданных, метаданных нет, а списки возвращаются без содержимого
+func decryptOptional(crypto domain.CryptoServiceInterface, value []byte) ([]byte, error) {
+	if len(value) == 0 {
+		return value, nil
+	}
+
+	return crypto.Decrypt(value)
+}
+
+// decryptBinary - Расшифровывает содержимое, если оно было загружено, и метаданные бинарных данных
+func decryptBinary(crypto domain.CryptoServiceInterface, bin *domain.Binary) error {
+	for _, value := range []*[]byte{&bin.Content, &bin.Name, &bin.MimeType, &bin.SHA256, &bin.Meta} {
+		decrypted, err := decryptOptional(crypto, *value)
+		if err != nil {
+			return err
+		}
+		*value = decrypted
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// CheckE2E - Сценарий использования проверки режима сквозного шифрования пользователя
+type CheckE2E struct {
+	// UserRepository - Интерфейс репозитория пользователя
+	UserRepository domain.UserRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает истину, если данные пользователя шифруются на клиенте
+func (u CheckE2E) Do(userID uuid.UUID) (bool, error) {
+	user, err := u.UserRepository.GetByID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	return user.E2E, nil
+}
@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// CommitBinaryUpload - Сценарий использования для завершения загрузки бинарных данных частями
+type CommitBinaryUpload struct {
+	// BinaryRepository - Интерфейс репозитория для сохранения бинарных данных
+	BinaryRepository domain.BinaryRepositoryInterface
+	// BinaryUploadRepository - Интерфейс репозитория сессий загрузки
+	BinaryUploadRepository domain.BinaryUploadRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает идентификатор ресурса, он совпадает с идентификатором сессии.
+// Метаданные передаются при завершении, так как контрольная сумма известна клиенту только после отправки всех частей.
+// Если загружены не все части, возвращает ErrUploadIncomplete
+func (u CommitBinaryUpload) Do(userID, uploadID uuid.UUID, metadata domain.BinaryMetadata) (uuid.UUID, error) {
+	upload, err := u.BinaryUploadRepository.Get(userID, uploadID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	indexes, err := u.BinaryUploadRepository.GetChunkIndexes(userID, uploadID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	// Номера частей проверяются при загрузке и уникальны, поэтому достаточно сравнить количество
+	if int64(len(indexes)) != upload.ChunkCount() {
+		return uuid.Nil, domain.ErrUploadIncomplete
+	}
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	encryptedContent, err := crypto.Encrypt([]byte{})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	bin := domain.Binary{
+		ID:      upload.ID,
+		UserID:  userID,
+		Content: encryptedContent,
+		Size:    upload.Size,
+		Chunks:  upload.ChunkCount(),
+	}
+	if err = encryptBinaryMetadata(crypto, &bin, metadata); err != nil {
+		return uuid.Nil, err
+	}
+
+	return bin.ID, u.BinaryRepository.CommitUpload(bin)
+}
@@ -11,8 +11,8 @@ import (
 type CreateBankCard struct {
 	// BankCardRepository - Интерфейс репозитория для сохранения банковской карты
 	BankCardRepository domain.BankCardRepositoryInterface
-	// Crypto - Сервис для шифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
@@ -23,23 +23,27 @@ func (u *CreateBankCard) Do(
 	number, validThru, cvv, cardHolder, meta string,
 ) (uuid.UUID, error) {
 	cardID := uuid.New()
-	encryptedNumber, err := u.Crypto.Encrypt([]byte(number))
+	crypto, err := u.Crypto.ForUser(userID)
 	if err != nil {
 		return cardID, err
 	}
-	encryptedValidThru, err := u.Crypto.Encrypt([]byte(validThru))
+	encryptedNumber, err := crypto.Encrypt([]byte(number))
 	if err != nil {
 		return cardID, err
 	}
-	encryptedCVV, err := u.Crypto.Encrypt([]byte(cvv))
+	encryptedValidThru, err := crypto.Encrypt([]byte(validThru))
 	if err != nil {
 		return cardID, err
 	}
-	encryptedCardHolder, err := u.Crypto.Encrypt([]byte(cardHolder))
+	encryptedCVV, err := crypto.Encrypt([]byte(cvv))
 	if err != nil {
 		return cardID, err
 	}
-	encryptedMeta, err := u.Crypto.Encrypt([]byte(meta))
+	encryptedCardHolder, err := crypto.Encrypt([]byte(cardHolder))
+	if err != nil {
+		return cardID, err
+	}
+	encryptedMeta, err := crypto.Encrypt([]byte(meta))
 	if err != nil {
 		return cardID, err
 	}
@@ -11,25 +11,33 @@ import (
 type CreateBinary struct {
 	// BinaryRepository - Интерфейс репозитория для сохранения бинарных данных
 	BinaryRepository domain.BinaryRepositoryInterface
-	// Crypto - Сервис для шифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
 // Do - Вызов исполнения сценария использования, возвращает идентификатор ресурса
-func (u *CreateBinary) Do(userID uuid.UUID, content []byte) (uuid.UUID, error) {
+func (u *CreateBinary) Do(userID uuid.UUID, content []byte, metadata domain.BinaryMetadata) (uuid.UUID, error) {
 	binID := uuid.New()
-	encryptedContent, err := u.Crypto.Encrypt(content)
+	crypto, err := u.Crypto.ForUser(userID)
 	if err != nil {
 		return binID, err
 	}
-	text := domain.Binary{
+	encryptedContent, err := crypto.Encrypt(content)
+	if err != nil {
+		return binID, err
+	}
+	bin := domain.Binary{
 		ID:      binID,
 		UserID:  userID,
 		Content: encryptedContent,
+		Size:    int64(len(content)),
+	}
+	if err = encryptBinaryMetadata(crypto, &bin, metadata); err != nil {
+		return binID, err
 	}
-	err = u.BinaryRepository.Create(text)
+	err = u.BinaryRepository.Create(bin)
 
 	return binID, err
 }
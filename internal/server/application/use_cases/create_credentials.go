@@ -12,8 +12,8 @@ import (
 type CreateCredentials struct {
 	// CredentialsRepository - Интерфейс репозитория для сохранения логина и пароля
 	CredentialsRepository domain.CredentialsRepositoryInterface
-	// Crypto - Сервис для шифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
@@ -24,19 +24,23 @@ func (u *CreateCredentials) Do(
 	name, login, password, meta string,
 ) (uuid.UUID, error) {
 	credID := uuid.New()
-	encryptedName, err := u.Crypto.Encrypt([]byte(name))
+	crypto, err := u.Crypto.ForUser(userID)
 	if err != nil {
 		return credID, err
 	}
-	encryptedLogin, err := u.Crypto.Encrypt([]byte(login))
+	encryptedName, err := crypto.Encrypt([]byte(name))
 	if err != nil {
 		return credID, err
 	}
-	encryptedPassword, err := u.Crypto.Encrypt([]byte(password))
+	encryptedLogin, err := crypto.Encrypt([]byte(login))
 	if err != nil {
 		return credID, err
 	}
-	encryptedMeta, err := u.Crypto.Encrypt([]byte(meta))
+	encryptedPassword, err := crypto.Encrypt([]byte(password))
+	if err != nil {
+		return credID, err
+	}
+	encryptedMeta, err := crypto.Encrypt([]byte(meta))
 	if err != nil {
 		return credID, err
 	}
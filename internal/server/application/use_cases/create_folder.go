@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// CreateFolder - Сценарий использования для создания папки с зашифрованным именем
+type CreateFolder struct {
+	// FolderRepository - Интерфейс репозитория для сохранения папок
+	FolderRepository domain.FolderRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает идентификатор ресурса.
+// Нулевой parentID означает корень хранилища, иначе родительская папка должна существовать
+func (u *CreateFolder) Do(userID uuid.UUID, name string, parentID uuid.UUID) (uuid.UUID, error) {
+	folderID := uuid.New()
+	if parentID != uuid.Nil {
+		_, err := u.FolderRepository.Get(userID, parentID)
+		if err != nil {
+			return folderID, err
+		}
+	}
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return folderID, err
+	}
+	encrypted, err := encryptFields(crypto, name)
+	if err != nil {
+		return folderID, err
+	}
+	obj := domain.Folder{
+		ID:       folderID,
+		UserID:   userID,
+		ParentID: parentID,
+		Name:     encrypted[0],
+	}
+	err = u.FolderRepository.Create(&obj)
+
+	return folderID, err
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// CreateItem - Сценарий использования для создания зашифрованных данных по шаблону
+type CreateItem struct {
+	// ItemRepository - Интерфейс репозитория для сохранения данных по шаблону
+	ItemRepository domain.ItemRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает идентификатор ресурса.
+// Список полей сохраняется одним зашифрованным значением
+func (u *CreateItem) Do(
+	userID uuid.UUID,
+	name, template string,
+	fields []item.Field,
+	meta string,
+) (uuid.UUID, error) {
+	itemID := uuid.New()
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return itemID, err
+	}
+	fieldsJSON, err := json.Marshal(fields)
+	if err != nil {
+		return itemID, err
+	}
+	encrypted, err := encryptFields(crypto, name, template, string(fieldsJSON), meta)
+	if err != nil {
+		return itemID, err
+	}
+	obj := domain.Item{
+		ID:       itemID,
+		UserID:   userID,
+		Name:     encrypted[0],
+		Template: encrypted[1],
+		Fields:   encrypted[2],
+		Meta:     encrypted[3],
+	}
+	err = u.ItemRepository.Create(&obj)
+
+	return itemID, err
+}
@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// CreateOTP - Сценарий использования для создания зашифрованного секрета одноразовых паролей
+type CreateOTP struct {
+	// OTPRepository - Интерфейс репозитория для сохранения секретов одноразовых паролей
+	OTPRepository domain.OTPRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает идентификатор ресурса
+func (u *CreateOTP) Do(
+	userID uuid.UUID,
+	issuer, account, secret, algorithm, digits, period, meta string,
+) (uuid.UUID, error) {
+	otpID := uuid.New()
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return otpID, err
+	}
+	encrypted, err := encryptFields(crypto, issuer, account, secret, algorithm, digits, period, meta)
+	if err != nil {
+		return otpID, err
+	}
+	otp := domain.OTP{
+		ID:        otpID,
+		UserID:    userID,
+		Issuer:    encrypted[0],
+		Account:   encrypted[1],
+		Secret:    encrypted[2],
+		Algorithm: encrypted[3],
+		Digits:    encrypted[4],
+		Period:    encrypted[5],
+		Meta:      encrypted[6],
+	}
+	err = u.OTPRepository.Create(&otp)
+
+	return otpID, err
+}
+
+// encryptFields - Шифрует значения в порядке перечисления
+func encryptFields(crypto domain.CryptoServiceInterface, values ...string) ([][]byte, error) {
+	encrypted := make([][]byte, len(values))
+	for i, v := range values {
+		value, err := crypto.Encrypt([]byte(v))
+		if err != nil {
+			return nil, err
+		}
+		encrypted[i] = value
+	}
+
+	return encrypted, nil
+}
@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// CreateSSHKey - Сценарий использования для создания зашифрованного SSH ключа
+type CreateSSHKey struct {
+	// SSHKeyRepository - Интерфейс репозитория для сохранения SSH ключей
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает идентификатор ресурса
+func (u *CreateSSHKey) Do(
+	userID uuid.UUID,
+	privateKey, publicKey, comment, fingerprint, meta string,
+) (uuid.UUID, error) {
+	keyID := uuid.New()
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return keyID, err
+	}
+	encrypted, err := encryptFields(crypto, privateKey, publicKey, comment, fingerprint, meta)
+	if err != nil {
+		return keyID, err
+	}
+	key := domain.SSHKey{
+		ID:          keyID,
+		UserID:      userID,
+		PrivateKey:  encrypted[0],
+		PublicKey:   encrypted[1],
+		Comment:     encrypted[2],
+		Fingerprint: encrypted[3],
+		Meta:        encrypted[4],
+	}
+	err = u.SSHKeyRepository.Create(&key)
+
+	return keyID, err
+}
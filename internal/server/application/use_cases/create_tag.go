@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// CreateTag - Сценарий использования для создания метки с зашифрованным именем
+type CreateTag struct {
+	// TagRepository - Интерфейс репозитория для сохранения меток
+	TagRepository domain.TagRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает идентификатор ресурса
+func (u *CreateTag) Do(userID uuid.UUID, name string) (uuid.UUID, error) {
+	tagID := uuid.New()
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return tagID, err
+	}
+	encrypted, err := encryptFields(crypto, name)
+	if err != nil {
+		return tagID, err
+	}
+	obj := domain.Tag{
+		ID:     tagID,
+		UserID: userID,
+		Name:   encrypted[0],
+	}
+	err = u.TagRepository.Create(&obj)
+
+	return tagID, err
+}
@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// CreateTemplate - Сценарий использования для создания зашифрованного пользовательского шаблона
+type CreateTemplate struct {
+	// TemplateRepository - Интерфейс репозитория для сохранения шаблонов
+	TemplateRepository domain.TemplateRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает идентификатор ресурса.
+// Список описаний полей сохраняется одним зашифрованным значением
+func (u *CreateTemplate) Do(
+	userID uuid.UUID,
+	name, description string,
+	fields []item.FieldSpec,
+) (uuid.UUID, error) {
+	templateID := uuid.New()
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return templateID, err
+	}
+	fieldsJSON, err := json.Marshal(fields)
+	if err != nil {
+		return templateID, err
+	}
+	encrypted, err := encryptFields(crypto, name, description, string(fieldsJSON))
+	if err != nil {
+		return templateID, err
+	}
+	obj := domain.ItemTemplate{
+		ID:          templateID,
+		UserID:      userID,
+		Name:        encrypted[0],
+		Description: encrypted[1],
+		Fields:      encrypted[2],
+	}
+	err = u.TemplateRepository.Create(&obj)
+
+	return templateID, err
+}
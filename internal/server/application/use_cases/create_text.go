@@ -11,8 +11,8 @@ import (
 type CreateText struct {
 	// TextRepository - Интерфейс репозитория для сохранения текстовых данных
 	TextRepository domain.TextRepositoryInterface
-	// Crypto - Сервис для шифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
@@ -20,7 +20,11 @@ type CreateText struct {
 // Do - Вызов исполнения сценария использования, возвращает идентификатор ресурса
 func (u CreateText) Do(userID uuid.UUID, content string) (uuid.UUID, error) {
 	textID := uuid.New()
-	encryptedContent, err := u.Crypto.Encrypt([]byte(content))
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return textID, err
+	}
+	encryptedContent, err := crypto.Encrypt([]byte(content))
 	if err != nil {
 		return textID, err
 	}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DeleteBankCard - Сценарий использования для удаления существующей банковской карты
+type DeleteBankCard struct {
+	// BankCardRepository - Интерфейс репозитория для удаления банковской карты
+	BankCardRepository domain.BankCardRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования
+func (u DeleteBankCard) Do(userID, cardID uuid.UUID) error {
+	obj, err := u.BankCardRepository.Get(userID, cardID)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	return u.BankCardRepository.Delete(userID, cardID)
+}
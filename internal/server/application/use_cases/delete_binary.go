@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DeleteBinary - Сценарий использования для удаления существующих бинарных данных
+type DeleteBinary struct {
+	// BinaryRepository - Интерфейс репозитория для удаления бинарных данных
+	BinaryRepository domain.BinaryRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования
+func (u DeleteBinary) Do(userID, binID uuid.UUID) error {
+	obj, err := u.BinaryRepository.Get(userID, binID)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	return u.BinaryRepository.Delete(userID, binID)
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DeleteCredentials - Сценарий использования для удаления существующей пары логин и пароль
+type DeleteCredentials struct {
+	// CredentialsRepository - Интерфейс репозитория для удаления логина и пароля
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования
+func (u DeleteCredentials) Do(userID, credID uuid.UUID) error {
+	obj, err := u.CredentialsRepository.Get(userID, credID)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	return u.CredentialsRepository.Delete(userID, credID)
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DeleteExpiredUploads - Сценарий использования для удаления брошенных сессий загрузки бинарных данных частями
+type DeleteExpiredUploads struct {
+	// BinaryUploadRepository - Интерфейс репозитория сессий загрузки
+	BinaryUploadRepository domain.BinaryUploadRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, удаляет сессии, в которые дольше времени истечения
+// не загружались части, вместе с загруженными частями
+func (u DeleteExpiredUploads) Do() error {
+	count, err := u.BinaryUploadRepository.DeleteExpired()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		u.Log.Infof("deleted %d expired binary uploads", count)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DeleteFolder - Сценарий использования для удаления существующей папки
+type DeleteFolder struct {
+	// FolderRepository - Интерфейс репозитория для удаления папок
+	FolderRepository domain.FolderRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования,
+// вложенные папки и данные из удаленной папки переносятся в ее родительскую папку
+func (u DeleteFolder) Do(userID, folderID uuid.UUID) error {
+	_, err := u.FolderRepository.Get(userID, folderID)
+	if err != nil {
+		return err
+	}
+
+	return u.FolderRepository.Delete(userID, folderID)
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DeleteItem - Сценарий использования для удаления существующих данных по шаблону
+type DeleteItem struct {
+	// ItemRepository - Интерфейс репозитория для удаления данных по шаблону
+	ItemRepository domain.ItemRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования
+func (u DeleteItem) Do(userID, itemID uuid.UUID) error {
+	obj, err := u.ItemRepository.Get(userID, itemID)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	return u.ItemRepository.Delete(userID, itemID)
+}
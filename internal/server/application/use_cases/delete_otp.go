@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DeleteOTP - Сценарий использования для удаления существующего секрета одноразовых паролей
+type DeleteOTP struct {
+	// OTPRepository - Интерфейс репозитория для удаления секретов одноразовых паролей
+	OTPRepository domain.OTPRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования
+func (u DeleteOTP) Do(userID, otpID uuid.UUID) error {
+	obj, err := u.OTPRepository.Get(userID, otpID)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	return u.OTPRepository.Delete(userID, otpID)
+}
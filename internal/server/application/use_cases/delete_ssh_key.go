@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DeleteSSHKey - Сценарий использования для удаления существующего SSH ключа
+type DeleteSSHKey struct {
+	// SSHKeyRepository - Интерфейс репозитория для удаления SSH ключей
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования
+func (u DeleteSSHKey) Do(userID, keyID uuid.UUID) error {
+	obj, err := u.SSHKeyRepository.Get(userID, keyID)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	return u.SSHKeyRepository.Delete(userID, keyID)
+}
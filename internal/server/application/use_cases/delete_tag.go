@@ -0,0 +1,26 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DeleteTag - Сценарий использования для удаления существующей метки
+type DeleteTag struct {
+	// TagRepository - Интерфейс репозитория для удаления меток
+	TagRepository domain.TagRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, метка снимается со всех данных
+func (u DeleteTag) Do(userID, tagID uuid.UUID) error {
+	_, err := u.TagRepository.Get(userID, tagID)
+	if err != nil {
+		return err
+	}
+
+	return u.TagRepository.Delete(userID, tagID)
+}
@@ -0,0 +1,26 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DeleteTemplate - Сценарий использования для удаления существующего пользовательского шаблона
+type DeleteTemplate struct {
+	// TemplateRepository - Интерфейс репозитория для удаления шаблонов
+	TemplateRepository domain.TemplateRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, данные, созданные по шаблону, не изменяются
+func (u DeleteTemplate) Do(userID, templateID uuid.UUID) error {
+	_, err := u.TemplateRepository.Get(userID, templateID)
+	if err != nil {
+		return err
+	}
+
+	return u.TemplateRepository.Delete(userID, templateID)
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DeleteText - Сценарий использования для удаления существующих текстовых данных
+type DeleteText struct {
+	// TextRepository - Интерфейс репозитория для удаления текстовых данных
+	TextRepository domain.TextRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования
+func (u DeleteText) Do(userID, textID uuid.UUID) error {
+	obj, err := u.TextRepository.Get(userID, textID)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return domain.ErrEntityNotFound
+	}
+
+	return u.TextRepository.Delete(userID, textID)
+}
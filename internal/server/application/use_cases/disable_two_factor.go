@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/application/jose"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DisableTwoFactor - Сценарий использования отключения двухфакторной аутентификации
+type DisableTwoFactor struct {
+	// TwoFactorRepository - Интерфейс репозитория двухфакторной аутентификации
+	TwoFactorRepository domain.TwoFactorRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования персональным ключом пользователя
+	Crypto domain.CryptoProviderInterface
+	// JOSE - Сервис выдачи и верификации JWT
+	JOSE *jose.JOSEService
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, отключение подтверждается кодом TOTP или кодом восстановления
+func (u DisableTwoFactor) Do(userID uuid.UUID, code string) error {
+	twoFactor, err := u.TwoFactorRepository.Get(userID)
+	if err != nil {
+		return err
+	}
+	if !twoFactor.Enabled {
+		return domain.ErrEntityNotFound
+	}
+	userCrypto, err := u.Crypto.ForServer(userID)
+	if err != nil {
+		return err
+	}
+	err = checkSecondFactor(u.TwoFactorRepository, userCrypto, u.JOSE, twoFactor, code)
+	if err != nil {
+		return err
+	}
+
+	return u.TwoFactorRepository.Delete(userID)
+}
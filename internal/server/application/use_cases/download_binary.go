@@ -0,0 +1,65 @@
+package usecases
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// DownloadBinary - Сценарий использования для потоковой выгрузки расшифрованных бинарных данных
+type DownloadBinary struct {
+	// BinaryRepository - Интерфейс репозитория для получения бинарных данных
+	BinaryRepository domain.BinaryRepositoryInterface
+	// BinaryUploadRepository - Интерфейс репозитория частей бинарных данных
+	BinaryUploadRepository domain.BinaryUploadRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования. После проверки наличия данных вызывается start с их размером,
+// затем данные по одной части расшифровываются и записываются в возвращенный writer,
+// поэтому в памяти одновременно находится не больше одной части
+func (u DownloadBinary) Do(userID, binID uuid.UUID, start func(size int64) io.Writer) error {
+	bin, err := u.BinaryRepository.Get(userID, binID)
+	if err != nil {
+		return err
+	}
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return err
+	}
+	if bin.Chunks == 0 {
+		var content []byte
+		content, err = crypto.Decrypt(bin.Content)
+		if err != nil {
+			return err
+		}
+		_, err = start(int64(len(content))).Write(content)
+
+		return err
+	}
+
+	w := start(bin.Size)
+	for index := int64(0); index < bin.Chunks; index++ {
+		var chunk *domain.BinaryChunk
+		chunk, err = u.BinaryUploadRepository.GetChunk(userID, binID, index)
+		if err != nil {
+			return err
+		}
+		var content []byte
+		content, err = openChunk(crypto, chunk)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
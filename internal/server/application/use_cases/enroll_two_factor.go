@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/totp"
+)
+
+// EnrollTwoFactor - Сценарий использования подключения двухфакторной аутентификации.
+// Секрет сохраняется неподтвержденным, вход требует код только после подтверждения
+type EnrollTwoFactor struct {
+	// UserRepository - Интерфейс репозитория пользователя
+	UserRepository domain.UserRepositoryInterface
+	// TwoFactorRepository - Интерфейс репозитория двухфакторной аутентификации
+	TwoFactorRepository domain.TwoFactorRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования персональным ключом пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает секрет в base32 и otpauth URI
+func (u EnrollTwoFactor) Do(userID uuid.UUID) (string, string, error) {
+	user, err := u.UserRepository.GetByID(userID)
+	if err != nil {
+		return "", "", err
+	}
+	twoFactor, err := u.TwoFactorRepository.Get(userID)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return "", "", err
+	}
+	if twoFactor != nil && twoFactor.Enabled {
+		return "", "", domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+	userCrypto, err := u.Crypto.ForServer(userID)
+	if err != nil {
+		return "", "", err
+	}
+	encrypted, err := userCrypto.Encrypt([]byte(secret))
+	if err != nil {
+		return "", "", err
+	}
+	err = u.TwoFactorRepository.Save(domain.TwoFactor{
+		UserID: userID,
+		Secret: encrypted,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return secret, totp.URI(totpIssuer, user.Login, secret), nil
+}
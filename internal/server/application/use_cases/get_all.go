@@ -1,14 +1,15 @@
 package usecases
 
 import (
+	"slices"
+
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
-	"golang.org/x/sync/errgroup"
 
 	"github.com/Nickolasll/goph-keeper/internal/server/domain"
 )
 
-// GetAll - Сценарий использования для получения
+// GetAll - Сценарий использования для постраничного получения всех расшифрованных данных пользователя
 type GetAll struct {
 	// GetAllTexts - Сценарий использования для получения всех расшифрованных текстовых данных
 	GetAllTexts GetAllTexts
@@ -18,59 +19,91 @@ type GetAll struct {
 	GetAllBinaries GetAllBinaries
 	// GetAllCredentials - Сценарий использования для получения всех расшифрованных логинов и паролей
 	GetAllCredentials GetAllCredentials
+	// GetAllOTPs - Сценарий использования для получения всех расшифрованных секретов одноразовых паролей
+	GetAllOTPs GetAllOTPs
+	// GetAllSSHKeys - Сценарий использования для получения всех расшифрованных SSH ключей
+	GetAllSSHKeys GetAllSSHKeys
+	// GetAllItems - Сценарий использования для получения всех расшифрованных данных по шаблону
+	GetAllItems GetAllItems
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов исполнения сценария использования, возвращает все расшифрованные данные пользователя
-func (u *GetAll) Do(userID uuid.UUID) (
+// Do - Вызов исполнения сценария использования, возвращает не больше limit расшифрованных записей,
+// начиная с курсора after, и курсор следующей страницы, если она есть.
+// Виды данных перебираются по очереди в порядке domain.AllKinds, поэтому страница может содержать несколько видов
+func (u *GetAll) Do(userID uuid.UUID, limit int, after *domain.AllCursor) (
 	texts []domain.Text,
 	bankCards []*domain.BankCard,
 	binaries []domain.Binary,
 	credentials []*domain.Credentials,
+	otps []*domain.OTP,
+	sshKeys []*domain.SSHKey,
+	items []*domain.Item,
+	next *domain.AllCursor,
 	err error,
 ) {
-	g := errgroup.Group{}
-	g.Go(func() error {
-		res, err := u.GetAllTexts.Do(userID)
-		if err != nil {
-			return err
-		}
-		texts = res
+	texts = []domain.Text{}
+	bankCards = []*domain.BankCard{}
+	binaries = []domain.Binary{}
+	credentials = []*domain.Credentials{}
+	otps = []*domain.OTP{}
+	sshKeys = []*domain.SSHKey{}
+	items = []*domain.Item{}
 
-		return nil
-	})
-	g.Go(func() error {
-		res, err := u.GetAllBinaries.Do(userID)
-		if err != nil {
-			return err
+	start := 0
+	var cursor *domain.Cursor
+	if after != nil {
+		start = slices.Index(domain.AllKinds, after.Kind)
+		if start < 0 {
+			return texts, bankCards, binaries, credentials, otps, sshKeys, items, nil, domain.ErrInvalidCursor
 		}
-		binaries = res
+		cursor = after.After
+	}
 
-		return nil
-	})
-	g.Go(func() error {
-		res, err := u.GetAllCredentials.Do(userID)
-		if err != nil {
-			return err
+	remaining := limit
+	for i := start; i < len(domain.AllKinds); i++ {
+		kind := domain.AllKinds[i]
+		if remaining == 0 {
+			return texts, bankCards, binaries, credentials, otps, sshKeys, items, &domain.AllCursor{Kind: kind}, nil
 		}
-		credentials = res
 
-		return nil
-	})
-	g.Go(func() error {
-		res, err := u.GetAllBankCards.Do(userID)
+		page := domain.Page{Limit: remaining, After: cursor}
+		var count int
+		var kindNext *domain.Cursor
+		switch kind {
+		case domain.TextKind:
+			texts, kindNext, err = u.GetAllTexts.Do(userID, page)
+			count = len(texts)
+		case domain.BinaryKind:
+			binaries, kindNext, err = u.GetAllBinaries.Do(userID, page)
+			count = len(binaries)
+		case domain.CredentialsKind:
+			credentials, kindNext, err = u.GetAllCredentials.Do(userID, page)
+			count = len(credentials)
+		case domain.BankCardKind:
+			bankCards, kindNext, err = u.GetAllBankCards.Do(userID, page)
+			count = len(bankCards)
+		case domain.OTPKind:
+			otps, kindNext, err = u.GetAllOTPs.Do(userID, page)
+			count = len(otps)
+		case domain.SSHKeyKind:
+			sshKeys, kindNext, err = u.GetAllSSHKeys.Do(userID, page)
+			count = len(sshKeys)
+		case domain.ItemKind:
+			items, kindNext, err = u.GetAllItems.Do(userID, page)
+			count = len(items)
+		}
 		if err != nil {
-			return err
+			return []domain.Text{}, []*domain.BankCard{}, []domain.Binary{}, []*domain.Credentials{}, []*domain.OTP{}, []*domain.SSHKey{}, []*domain.Item{}, nil, err
+		}
+		if kindNext != nil {
+			return texts, bankCards, binaries, credentials, otps, sshKeys, items, &domain.AllCursor{Kind: kind, After: kindNext}, nil
 		}
-		bankCards = res
 
-		return nil
-	})
-	err = g.Wait()
-	if err != nil {
-		return []domain.Text{}, []*domain.BankCard{}, []domain.Binary{}, []*domain.Credentials{}, err
+		remaining -= count
+		cursor = nil
 	}
 
-	return texts, bankCards, binaries, credentials, nil
+	return texts, bankCards, binaries, credentials, otps, sshKeys, items, nil, nil
 }
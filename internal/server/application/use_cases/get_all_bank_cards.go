@@ -11,36 +11,51 @@ import (
 type GetAllBankCards struct {
 	// BankCardRepository - Интерфейс репозитория для получения банковских карт
 	BankCardRepository domain.BankCardRepositoryInterface
-	// Crypto - Сервис для дешифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов исполнения сценария использования, возвращает слайс расшифрованных логинов и паролей
-func (u GetAllBankCards) Do(userID uuid.UUID) ([]*domain.BankCard, error) {
-	cards, err := u.BankCardRepository.GetAll(userID)
+// Do - Вызов исполнения сценария использования, возвращает слайс расшифрованных банковских карт,
+// упорядоченных по времени создания, и курсор следующей страницы, если она есть
+func (u GetAllBankCards) Do(userID uuid.UUID, page domain.Page) ([]*domain.BankCard, *domain.Cursor, error) {
+	crypto, err := u.Crypto.ForUser(userID)
 	if err != nil {
-		return []*domain.BankCard{}, err
+		return []*domain.BankCard{}, nil, err
 	}
+	cards, next, err := u.BankCardRepository.GetAll(userID, page)
+	if err != nil {
+		return []*domain.BankCard{}, nil, err
+	}
+	cards, err = decryptBankCards(crypto, cards)
+	if err != nil {
+		return []*domain.BankCard{}, nil, err
+	}
+
+	return cards, next, nil
+}
+
+// decryptBankCards - Расшифровывает банковские карты, полученные из репозитория
+func decryptBankCards(crypto domain.CryptoServiceInterface, cards []*domain.BankCard) ([]*domain.BankCard, error) {
 	for i, v := range cards {
-		decryptedNumber, err := u.Crypto.Decrypt(v.Number)
+		decryptedNumber, err := crypto.Decrypt(v.Number)
 		if err != nil {
 			return []*domain.BankCard{}, err
 		}
-		decryptedValidThru, err := u.Crypto.Decrypt(v.ValidThru)
+		decryptedValidThru, err := crypto.Decrypt(v.ValidThru)
 		if err != nil {
 			return []*domain.BankCard{}, err
 		}
-		decryptedCVV, err := u.Crypto.Decrypt(v.CVV)
+		decryptedCVV, err := crypto.Decrypt(v.CVV)
 		if err != nil {
 			return []*domain.BankCard{}, err
 		}
-		decryptedCardHolder, err := u.Crypto.Decrypt(v.CardHolder)
+		decryptedCardHolder, err := crypto.Decrypt(v.CardHolder)
 		if err != nil {
 			return []*domain.BankCard{}, err
 		}
-		decryptedMeta, err := u.Crypto.Decrypt(v.Meta)
+		decryptedMeta, err := crypto.Decrypt(v.Meta)
 		if err != nil {
 			return []*domain.BankCard{}, err
 		}
@@ -7,29 +7,42 @@ import (
 	"github.com/Nickolasll/goph-keeper/internal/server/domain"
 )
 
-// GetAllBinaries - Сценарий использования для получения всех расшифрованных бинарных данных
+// GetAllBinaries - Сценарий использования для получения расшифрованных метаданных всех бинарных данных
 type GetAllBinaries struct {
 	// BinaryRepository - Интерфейс репозитория для получения бинарных данных
 	BinaryRepository domain.BinaryRepositoryInterface
-	// Crypto - Сервис для дешифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов исполнения сценария использования, возвращает слайс расшифрованных бинарных данных
-func (u GetAllBinaries) Do(userID uuid.UUID) ([]domain.Binary, error) {
-	bins, err := u.BinaryRepository.GetAll(userID)
+// Do - Вызов исполнения сценария использования, возвращает слайс бинарных данных с расшифрованными метаданными.
+// Возвращается страница списка, упорядоченного по времени создания, и курсор следующей страницы, если она есть.
+// Содержимое не загружается, его можно скачать отдельно
+func (u GetAllBinaries) Do(userID uuid.UUID, page domain.Page) ([]domain.Binary, *domain.Cursor, error) {
+	crypto, err := u.Crypto.ForUser(userID)
 	if err != nil {
-		return []domain.Binary{}, err
+		return []domain.Binary{}, nil, err
 	}
-	for i, v := range bins {
-		decryptedContent, err := u.Crypto.Decrypt(v.Content)
-		if err != nil {
+	bins, next, err := u.BinaryRepository.GetAll(userID, page)
+	if err != nil {
+		return []domain.Binary{}, nil, err
+	}
+	bins, err = decryptBinaries(crypto, bins)
+	if err != nil {
+		return []domain.Binary{}, nil, err
+	}
+
+	return bins, next, nil
+}
+
+// decryptBinaries - Расшифровывает бинарные данные, полученные из репозитория
+func decryptBinaries(crypto domain.CryptoServiceInterface, bins []domain.Binary) ([]domain.Binary, error) {
+	for i := range bins {
+		if err := decryptBinary(crypto, &bins[i]); err != nil {
 			return []domain.Binary{}, err
 		}
-		v.Content = decryptedContent
-		bins[i] = v
 	}
 
 	return bins, nil
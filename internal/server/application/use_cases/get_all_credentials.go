@@ -11,32 +11,47 @@ import (
 type GetAllCredentials struct {
 	// CredentialsRepository - Интерфейс репозитория для получения логинов и паролей
 	CredentialsRepository domain.CredentialsRepositoryInterface
-	// Crypto - Сервис для дешифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов исполнения сценария использования, возвращает слайс расшифрованных логинов и паролей
-func (u GetAllCredentials) Do(userID uuid.UUID) ([]*domain.Credentials, error) {
-	creds, err := u.CredentialsRepository.GetAll(userID)
+// Do - Вызов исполнения сценария использования, возвращает слайс расшифрованных логинов и паролей,
+// упорядоченных по времени создания, и курсор следующей страницы, если она есть
+func (u GetAllCredentials) Do(userID uuid.UUID, page domain.Page) ([]*domain.Credentials, *domain.Cursor, error) {
+	crypto, err := u.Crypto.ForUser(userID)
 	if err != nil {
-		return []*domain.Credentials{}, err
+		return []*domain.Credentials{}, nil, err
 	}
+	creds, next, err := u.CredentialsRepository.GetAll(userID, page)
+	if err != nil {
+		return []*domain.Credentials{}, nil, err
+	}
+	creds, err = decryptCredentials(crypto, creds)
+	if err != nil {
+		return []*domain.Credentials{}, nil, err
+	}
+
+	return creds, next, nil
+}
+
+// decryptCredentials - Расшифровывает логины и пароли, полученные из репозитория
+func decryptCredentials(crypto domain.CryptoServiceInterface, creds []*domain.Credentials) ([]*domain.Credentials, error) {
 	for i, v := range creds {
-		decryptedName, err := u.Crypto.Decrypt(v.Name)
+		decryptedName, err := crypto.Decrypt(v.Name)
 		if err != nil {
 			return []*domain.Credentials{}, err
 		}
-		decryptedLogin, err := u.Crypto.Decrypt(v.Login)
+		decryptedLogin, err := crypto.Decrypt(v.Login)
 		if err != nil {
 			return []*domain.Credentials{}, err
 		}
-		decryptedPassword, err := u.Crypto.Decrypt(v.Password)
+		decryptedPassword, err := crypto.Decrypt(v.Password)
 		if err != nil {
 			return []*domain.Credentials{}, err
 		}
-		decryptedMeta, err := u.Crypto.Decrypt(v.Meta)
+		decryptedMeta, err := crypto.Decrypt(v.Meta)
 		if err != nil {
 			return []*domain.Credentials{}, err
 		}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetAllFolders - Сценарий использования для получения всех папок с расшифрованными именами
+type GetAllFolders struct {
+	// FolderRepository - Интерфейс репозитория для получения папок
+	FolderRepository domain.FolderRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает слайс папок, упорядоченных по времени создания
+func (u GetAllFolders) Do(userID uuid.UUID) ([]*domain.Folder, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return []*domain.Folder{}, err
+	}
+	folders, err := u.FolderRepository.GetAll(userID)
+	if err != nil {
+		return []*domain.Folder{}, err
+	}
+
+	return decryptFolders(crypto, folders)
+}
+
+// decryptFolders - Расшифровывает имена папок, полученных из репозитория
+func decryptFolders(crypto domain.CryptoServiceInterface, folders []*domain.Folder) ([]*domain.Folder, error) {
+	for _, v := range folders {
+		decrypted, err := crypto.Decrypt(v.Name)
+		if err != nil {
+			return []*domain.Folder{}, err
+		}
+		v.Name = decrypted
+	}
+
+	return folders, nil
+}
@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetAllItems - Сценарий использования для получения всех расшифрованных данных по шаблону
+type GetAllItems struct {
+	// ItemRepository - Интерфейс репозитория для получения данных по шаблону
+	ItemRepository domain.ItemRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает слайс расшифрованных данных по шаблону,
+// упорядоченных по времени создания, и курсор следующей страницы, если она есть
+func (u GetAllItems) Do(userID uuid.UUID, page domain.Page) ([]*domain.Item, *domain.Cursor, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return []*domain.Item{}, nil, err
+	}
+	items, next, err := u.ItemRepository.GetAll(userID, page)
+	if err != nil {
+		return []*domain.Item{}, nil, err
+	}
+	items, err = decryptItems(crypto, items)
+	if err != nil {
+		return []*domain.Item{}, nil, err
+	}
+
+	return items, next, nil
+}
+
+// decryptItems - Расшифровывает данные по шаблону, полученные из репозитория
+func decryptItems(crypto domain.CryptoServiceInterface, items []*domain.Item) ([]*domain.Item, error) {
+	for i, v := range items {
+		fields := []*[]byte{&v.Name, &v.Template, &v.Fields, &v.Meta}
+		for _, field := range fields {
+			decrypted, err := crypto.Decrypt(*field)
+			if err != nil {
+				return []*domain.Item{}, err
+			}
+			*field = decrypted
+		}
+		items[i] = v
+	}
+
+	return items, nil
+}
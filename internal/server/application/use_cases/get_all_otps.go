@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetAllOTPs - Сценарий использования для получения всех расшифрованных секретов одноразовых паролей
+type GetAllOTPs struct {
+	// OTPRepository - Интерфейс репозитория для получения секретов одноразовых паролей
+	OTPRepository domain.OTPRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает слайс расшифрованных секретов одноразовых паролей,
+// упорядоченных по времени создания, и курсор следующей страницы, если она есть
+func (u GetAllOTPs) Do(userID uuid.UUID, page domain.Page) ([]*domain.OTP, *domain.Cursor, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return []*domain.OTP{}, nil, err
+	}
+	otps, next, err := u.OTPRepository.GetAll(userID, page)
+	if err != nil {
+		return []*domain.OTP{}, nil, err
+	}
+	otps, err = decryptOTPs(crypto, otps)
+	if err != nil {
+		return []*domain.OTP{}, nil, err
+	}
+
+	return otps, next, nil
+}
+
+// decryptOTPs - Расшифровывает секреты одноразовых паролей, полученные из репозитория
+func decryptOTPs(crypto domain.CryptoServiceInterface, otps []*domain.OTP) ([]*domain.OTP, error) {
+	for i, v := range otps {
+		fields := []*[]byte{&v.Issuer, &v.Account, &v.Secret, &v.Algorithm, &v.Digits, &v.Period, &v.Meta}
+		for _, field := range fields {
+			decrypted, err := crypto.Decrypt(*field)
+			if err != nil {
+				return []*domain.OTP{}, err
+			}
+			*field = decrypted
+		}
+		otps[i] = v
+	}
+
+	return otps, nil
+}
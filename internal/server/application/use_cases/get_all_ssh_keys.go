@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetAllSSHKeys - Сценарий использования для получения всех расшифрованных SSH ключей
+type GetAllSSHKeys struct {
+	// SSHKeyRepository - Интерфейс репозитория для получения SSH ключей
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает слайс расшифрованных SSH ключей,
+// упорядоченных по времени создания, и курсор следующей страницы, если она есть
+func (u GetAllSSHKeys) Do(userID uuid.UUID, page domain.Page) ([]*domain.SSHKey, *domain.Cursor, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return []*domain.SSHKey{}, nil, err
+	}
+	keys, next, err := u.SSHKeyRepository.GetAll(userID, page)
+	if err != nil {
+		return []*domain.SSHKey{}, nil, err
+	}
+	keys, err = decryptSSHKeys(crypto, keys)
+	if err != nil {
+		return []*domain.SSHKey{}, nil, err
+	}
+
+	return keys, next, nil
+}
+
+// decryptSSHKeys - Расшифровывает SSH ключи, полученные из репозитория
+func decryptSSHKeys(crypto domain.CryptoServiceInterface, keys []*domain.SSHKey) ([]*domain.SSHKey, error) {
+	for i, v := range keys {
+		fields := []*[]byte{&v.PrivateKey, &v.PublicKey, &v.Comment, &v.Fingerprint, &v.Meta}
+		for _, field := range fields {
+			decrypted, err := crypto.Decrypt(*field)
+			if err != nil {
+				return []*domain.SSHKey{}, err
+			}
+			*field = decrypted
+		}
+		keys[i] = v
+	}
+
+	return keys, nil
+}
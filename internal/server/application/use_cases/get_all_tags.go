@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetAllTags - Сценарий использования для получения всех меток с расшифрованными именами
+type GetAllTags struct {
+	// TagRepository - Интерфейс репозитория для получения меток
+	TagRepository domain.TagRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает слайс меток, упорядоченных по времени создания
+func (u GetAllTags) Do(userID uuid.UUID) ([]*domain.Tag, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return []*domain.Tag{}, err
+	}
+	tags, err := u.TagRepository.GetAll(userID)
+	if err != nil {
+		return []*domain.Tag{}, err
+	}
+
+	return decryptTags(crypto, tags)
+}
+
+// decryptTags - Расшифровывает имена меток, полученных из репозитория
+func decryptTags(crypto domain.CryptoServiceInterface, tags []*domain.Tag) ([]*domain.Tag, error) {
+	for _, v := range tags {
+		decrypted, err := crypto.Decrypt(v.Name)
+		if err != nil {
+			return []*domain.Tag{}, err
+		}
+		v.Name = decrypted
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetAllTemplates - Сценарий использования для получения всех расшифрованных пользовательских шаблонов
+type GetAllTemplates struct {
+	// TemplateRepository - Интерфейс репозитория для получения шаблонов
+	TemplateRepository domain.TemplateRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает слайс шаблонов, упорядоченных по времени создания
+func (u GetAllTemplates) Do(userID uuid.UUID) ([]*domain.ItemTemplate, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return []*domain.ItemTemplate{}, err
+	}
+	templates, err := u.TemplateRepository.GetAll(userID)
+	if err != nil {
+		return []*domain.ItemTemplate{}, err
+	}
+
+	return decryptTemplates(crypto, templates)
+}
+
+// decryptTemplates - Расшифровывает шаблоны, полученные из репозитория
+func decryptTemplates(
+	crypto domain.CryptoServiceInterface,
+	templates []*domain.ItemTemplate,
+) ([]*domain.ItemTemplate, error) {
+	for _, v := range templates {
+		for _, field := range []*[]byte{&v.Name, &v.Description, &v.Fields} {
+			decrypted, err := crypto.Decrypt(*field)
+			if err != nil {
+				return []*domain.ItemTemplate{}, err
+			}
+			*field = decrypted
+		}
+	}
+
+	return templates, nil
+}
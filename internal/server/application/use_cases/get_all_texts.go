@@ -11,20 +11,35 @@ import (
 type GetAllTexts struct {
 	// TextRepository - Интерфейс репозитория для получения текстовых данных
 	TextRepository domain.TextRepositoryInterface
-	// Crypto - Сервис для дешифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов исполнения сценария использования, возвращает слайс расшифрованных текстовых данных
-func (u GetAllTexts) Do(userID uuid.UUID) ([]domain.Text, error) {
-	texts, err := u.TextRepository.GetAll(userID)
+// Do - Вызов исполнения сценария использования, возвращает слайс расшифрованных текстовых данных,
+// упорядоченных по времени создания, и курсор следующей страницы, если она есть
+func (u GetAllTexts) Do(userID uuid.UUID, page domain.Page) ([]domain.Text, *domain.Cursor, error) {
+	crypto, err := u.Crypto.ForUser(userID)
 	if err != nil {
-		return []domain.Text{}, err
+		return []domain.Text{}, nil, err
 	}
+	texts, next, err := u.TextRepository.GetAll(userID, page)
+	if err != nil {
+		return []domain.Text{}, nil, err
+	}
+	texts, err = decryptTexts(crypto, texts)
+	if err != nil {
+		return []domain.Text{}, nil, err
+	}
+
+	return texts, next, nil
+}
+
+// decryptTexts - Расшифровывает текстовые данные, полученные из репозитория
+func decryptTexts(crypto domain.CryptoServiceInterface, texts []domain.Text) ([]domain.Text, error) {
 	for i, v := range texts {
-		decryptedContent, err := u.Crypto.Decrypt(v.Content)
+		decryptedContent, err := crypto.Decrypt(v.Content)
 		if err != nil {
 			return []domain.Text{}, err
 		}
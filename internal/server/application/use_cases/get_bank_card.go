@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetBankCard - Сценарий использования для получения расшифрованной банковской карты по идентификатору
+type GetBankCard struct {
+	// BankCardRepository - Интерфейс репозитория для получения банковских карт
+	BankCardRepository domain.BankCardRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает расшифрованную банковскую карту.
+// Если данных нет, возвращает ErrEntityNotFound
+func (u GetBankCard) Do(userID, cardID uuid.UUID) (*domain.BankCard, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	card, err := u.BankCardRepository.Get(userID, cardID)
+	if err != nil {
+		return nil, err
+	}
+	if card == nil {
+		return nil, domain.ErrEntityNotFound
+	}
+	_, err = decryptBankCards(crypto, []*domain.BankCard{card})
+	if err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
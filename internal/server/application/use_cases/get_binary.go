@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetBinary - Сценарий использования для получения расшифрованных метаданных бинарных данных по идентификатору
+type GetBinary struct {
+	// BinaryRepository - Интерфейс репозитория для получения бинарных данных
+	BinaryRepository domain.BinaryRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает расшифрованные метаданные без содержимого,
+// содержимое выгружается отдельно сценарием DownloadBinary. Если данных нет, возвращает ErrEntityNotFound
+func (u GetBinary) Do(userID, binID uuid.UUID) (*domain.Binary, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	bin, err := u.BinaryRepository.Get(userID, binID)
+	if err != nil {
+		return nil, err
+	}
+	if bin == nil {
+		return nil, domain.ErrEntityNotFound
+	}
+	bin.Content = nil
+	if err = decryptBinary(crypto, bin); err != nil {
+		return nil, err
+	}
+
+	return bin, nil
+}
@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetBinaryUpload - Сценарий использования для получения состояния загрузки бинарных данных частями
+type GetBinaryUpload struct {
+	// BinaryUploadRepository - Интерфейс репозитория сессий загрузки
+	BinaryUploadRepository domain.BinaryUploadRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает сессию загрузки и номера уже загруженных частей,
+// по ним клиент продолжает прерванную загрузку
+func (u GetBinaryUpload) Do(userID, uploadID uuid.UUID) (*domain.BinaryUpload, []int64, error) {
+	upload, err := u.BinaryUploadRepository.Get(userID, uploadID)
+	if err != nil {
+		return nil, nil, err
+	}
+	indexes, err := u.BinaryUploadRepository.GetChunkIndexes(userID, uploadID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return upload, indexes, nil
+}
@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetChanges - Сценарий использования для получения изменений данных пользователя после указанной ревизии
+type GetChanges struct {
+	// ChangesRepository - Интерфейс репозитория изменений данных пользователя
+	ChangesRepository domain.ChangesRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает не больше limit расшифрованных созданных или обновленных
+// данных и записей об удалении данных после ревизии since, а также ревизию, с которой нужно запрашивать следующие изменения
+func (u GetChanges) Do(userID uuid.UUID, since int64, limit int) (domain.Changes, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return domain.Changes{}, err
+	}
+	changes, err := u.ChangesRepository.GetSince(userID, since, limit)
+	if err != nil {
+		return domain.Changes{}, err
+	}
+	if changes.Texts, err = decryptTexts(crypto, changes.Texts); err != nil {
+		return domain.Changes{}, err
+	}
+	if changes.Binaries, err = decryptBinaries(crypto, changes.Binaries); err != nil {
+		return domain.Changes{}, err
+	}
+	if changes.Credentials, err = decryptCredentials(crypto, changes.Credentials); err != nil {
+		return domain.Changes{}, err
+	}
+	if changes.BankCards, err = decryptBankCards(crypto, changes.BankCards); err != nil {
+		return domain.Changes{}, err
+	}
+	if changes.OTPs, err = decryptOTPs(crypto, changes.OTPs); err != nil {
+		return domain.Changes{}, err
+	}
+	if changes.SSHKeys, err = decryptSSHKeys(crypto, changes.SSHKeys); err != nil {
+		return domain.Changes{}, err
+	}
+	if changes.Items, err = decryptItems(crypto, changes.Items); err != nil {
+		return domain.Changes{}, err
+	}
+	if changes.Folders, err = decryptFolders(crypto, changes.Folders); err != nil {
+		return domain.Changes{}, err
+	}
+	if changes.Tags, err = decryptTags(crypto, changes.Tags); err != nil {
+		return domain.Changes{}, err
+	}
+	if changes.Templates, err = decryptTemplates(crypto, changes.Templates); err != nil {
+		return domain.Changes{}, err
+	}
+
+	return changes, nil
+}
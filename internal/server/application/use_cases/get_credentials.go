@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetCredentials - Сценарий использования для получения расшифрованных логина и пароля по идентификатору
+type GetCredentials struct {
+	// CredentialsRepository - Интерфейс репозитория для получения логинов и паролей
+	CredentialsRepository domain.CredentialsRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает расшифрованные логин и пароль.
+// Если данных нет, возвращает ErrEntityNotFound
+func (u GetCredentials) Do(userID, credID uuid.UUID) (*domain.Credentials, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	cred, err := u.CredentialsRepository.Get(userID, credID)
+	if err != nil {
+		return nil, err
+	}
+	if cred == nil {
+		return nil, domain.ErrEntityNotFound
+	}
+	_, err = decryptCredentials(crypto, []*domain.Credentials{cred})
+	if err != nil {
+		return nil, err
+	}
+
+	return cred, nil
+}
@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetItem - Сценарий использования для получения расшифрованных данных по шаблону по идентификатору
+type GetItem struct {
+	// ItemRepository - Интерфейс репозитория для получения данных по шаблону
+	ItemRepository domain.ItemRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает расшифрованные данные по шаблону.
+// Если данных нет, возвращает ErrEntityNotFound
+func (u GetItem) Do(userID, itemID uuid.UUID) (*domain.Item, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := u.ItemRepository.Get(userID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, domain.ErrEntityNotFound
+	}
+	_, err = decryptItems(crypto, []*domain.Item{obj})
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,22 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetLabels - Сценарий использования для получения папки и меток данных
+type GetLabels struct {
+	// LabelRepository - Интерфейс репозитория для получения папок и меток данных
+	LabelRepository domain.LabelRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает ErrEntityNotFound,
+// если папка и метки данных не сохранялись
+func (u GetLabels) Do(userID, entityID uuid.UUID) (*domain.Labels, error) {
+	return u.LabelRepository.Get(userID, entityID)
+}
@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetOTP - Сценарий использования для получения расшифрованного секрета одноразовых паролей по идентификатору
+type GetOTP struct {
+	// OTPRepository - Интерфейс репозитория для получения секретов одноразовых паролей
+	OTPRepository domain.OTPRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает расшифрованный секрет одноразовых паролей.
+// Если данных нет, возвращает ErrEntityNotFound
+func (u GetOTP) Do(userID, otpID uuid.UUID) (*domain.OTP, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	otp, err := u.OTPRepository.Get(userID, otpID)
+	if err != nil {
+		return nil, err
+	}
+	if otp == nil {
+		return nil, domain.ErrEntityNotFound
+	}
+	_, err = decryptOTPs(crypto, []*domain.OTP{otp})
+	if err != nil {
+		return nil, err
+	}
+
+	return otp, nil
+}
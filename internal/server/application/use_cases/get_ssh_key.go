@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetSSHKey - Сценарий использования для получения расшифрованного SSH ключа по идентификатору
+type GetSSHKey struct {
+	// SSHKeyRepository - Интерфейс репозитория для получения SSH ключей
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает расшифрованный SSH ключ.
+// Если данных нет, возвращает ErrEntityNotFound
+func (u GetSSHKey) Do(userID, keyID uuid.UUID) (*domain.SSHKey, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	key, err := u.SSHKeyRepository.Get(userID, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, domain.ErrEntityNotFound
+	}
+	_, err = decryptSSHKeys(crypto, []*domain.SSHKey{key})
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
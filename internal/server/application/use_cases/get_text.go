@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// GetText - Сценарий использования для получения расшифрованных текстовых данных по идентификатору
+type GetText struct {
+	// TextRepository - Интерфейс репозитория для получения текстовых данных
+	TextRepository domain.TextRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает расшифрованные текстовые данные.
+// Если данных нет, возвращает ErrEntityNotFound
+func (u GetText) Do(userID, textID uuid.UUID) (*domain.Text, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	text, err := u.TextRepository.Get(userID, textID)
+	if err != nil {
+		return nil, err
+	}
+	if text == nil {
+		return nil, domain.ErrEntityNotFound
+	}
+	texts, err := decryptTexts(crypto, []domain.Text{*text})
+	if err != nil {
+		return nil, err
+	}
+
+	return &texts[0], nil
+}
@@ -3,6 +3,7 @@ package usecases
 import (
 	"errors"
 
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
 	"github.com/Nickolasll/goph-keeper/internal/server/application/jose"
@@ -13,31 +14,49 @@ import (
 type Login struct {
 	// UserRepository - Интерфейс репозитория пользователя
 	UserRepository domain.UserRepositoryInterface
+	// RefreshTokenRepository - Интерфейс репозитория refresh token
+	RefreshTokenRepository domain.RefreshTokenRepositoryInterface
+	// TwoFactorRepository - Интерфейс репозитория двухфакторной аутентификации
+	TwoFactorRepository domain.TwoFactorRepositoryInterface
 	// JOSE - Сервис выдачи и верификации JWT
 	JOSE *jose.JOSEService
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов исполнения сценария использования
-func (u Login) Do(login, password string) ([]byte, error) {
-	var token []byte
-
+// Do - Вызов исполнения сценария использования.
+// Если у пользователя включена двухфакторная аутентификация, возвращает TwoFactorRequiredError
+// с токеном частичной аутентификации вместо пары токенов
+func (u Login) Do(login, password string) ([]byte, string, error) {
 	user, err := u.UserRepository.GetByLogin(login)
 	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
-		return token, err
+		return nil, "", err
 	}
 	if user == nil {
-		return token, domain.ErrLoginOrPasswordIsInvalid
+		return nil, "", domain.ErrLoginOrPasswordIsInvalid
 	}
 
 	if !u.JOSE.VerifyPassword(user.Password, password) {
-		return token, domain.ErrLoginOrPasswordIsInvalid
+		return nil, "", domain.ErrLoginOrPasswordIsInvalid
+	}
+
+	twoFactor, err := u.TwoFactorRepository.Get(user.ID)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return nil, "", err
 	}
-	token, err = u.JOSE.IssueToken(user.ID)
-	if err != nil {
-		return token, err
+	if twoFactor != nil && twoFactor.Enabled {
+		challengeID := uuid.New()
+		if err = u.TwoFactorRepository.SaveChallenge(user.ID, challengeID); err != nil {
+			return nil, "", err
+		}
+		var challenge []byte
+		challenge, err = u.JOSE.IssueChallenge(user.ID, challengeID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return nil, "", &domain.TwoFactorRequiredError{Challenge: challenge}
 	}
 
-	return token, err
+	return issueTokenPair(u.JOSE, u.RefreshTokenRepository, user.ID, uuid.New())
 }
@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/application/jose"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// PreLogin - Сценарий использования получения параметров вывода ключа хранилища перед входом
+type PreLogin struct {
+	// UserRepository - Интерфейс репозитория пользователя
+	UserRepository domain.UserRepositoryInterface
+	// JOSE - Сервис выдачи и верификации JWT
+	JOSE *jose.JOSEService
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает признак сквозного шифрования и соль пользователя.
+// Для несуществующего логина возвращается детерминированная подставная соль, чтобы ответ не раскрывал наличие пользователя
+func (u PreLogin) Do(login string) (bool, []byte, error) {
+	user, err := u.UserRepository.GetByLogin(login)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return false, nil, err
+	}
+	if user == nil {
+		return false, u.JOSE.Salt(login), nil
+	}
+	if len(user.Salt) == 0 {
+		return user.E2E, u.JOSE.Salt(login), nil
+	}
+
+	return user.E2E, user.Salt, nil
+}
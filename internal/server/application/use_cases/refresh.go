@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/application/jose"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// Refresh - Сценарий использования выпуска новой пары токенов по refresh token с его ротацией
+type Refresh struct {
+	// RefreshTokenRepository - Интерфейс репозитория refresh token
+	RefreshTokenRepository domain.RefreshTokenRepositoryInterface
+	// JOSE - Сервис выдачи и верификации JWT
+	JOSE *jose.JOSEService
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования
+func (u Refresh) Do(refreshToken string) ([]byte, string, error) {
+	token, err := u.RefreshTokenRepository.GetByHash(u.JOSE.HashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return nil, "", domain.ErrInvalidRefreshToken
+		}
+
+		return nil, "", err
+	}
+
+	if token.Revoked {
+		// Повторное использование уже отозванного токена означает его компрометацию,
+		// поэтому отзываем все токены семейства
+		return nil, "", u.revokeFamily(token)
+	}
+	if time.Now().After(token.ExpiresAt) {
+		return nil, "", domain.ErrInvalidRefreshToken
+	}
+
+	err = u.RefreshTokenRepository.Revoke(token.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			// Токен был отозван конкурентным запросом между чтением и обновлением
+			return nil, "", u.revokeFamily(token)
+		}
+
+		return nil, "", err
+	}
+
+	return issueTokenPair(u.JOSE, u.RefreshTokenRepository, token.UserID, token.FamilyID)
+}
+
+func (u Refresh) revokeFamily(token *domain.RefreshToken) error {
+	u.Log.Warnf("refresh token reuse detected for user %s", token.UserID)
+	if err := u.RefreshTokenRepository.RevokeFamily(token.FamilyID); err != nil {
+		return err
+	}
+
+	return domain.ErrRefreshTokenReused
+}
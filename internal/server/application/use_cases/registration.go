@@ -14,39 +14,51 @@ import (
 type Registration struct {
 	// UserRepository - Интерфейс репозитория пользователя
 	UserRepository domain.UserRepositoryInterface
+	// RefreshTokenRepository - Интерфейс репозитория refresh token
+	RefreshTokenRepository domain.RefreshTokenRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// JOSE - Сервис выдачи и верификации JWT
 	JOSE *jose.JOSEService
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов исполнения сценария использования
-func (u Registration) Do(login, password string) ([]byte, error) {
-	var token []byte
-
+// Do - Вызов исполнения сценария использования.
+// Если e2e истинно, данные пользователя шифруются на клиенте и сервер хранит их как есть,
+// а salt - соль, из которой клиент вывел ключи, она возвращается клиенту при входе
+func (u Registration) Do(login, password string, e2e bool, salt []byte) ([]byte, string, error) {
 	user, err := u.UserRepository.GetByLogin(login)
 	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
-		return token, err
+		return nil, "", err
 	}
 	if user != nil {
-		return token, domain.ErrLoginAlreadyInUse
+		return nil, "", domain.ErrLoginAlreadyInUse
 	}
 
 	hashedPassword := u.JOSE.Hash(password)
+	if len(salt) == 0 {
+		salt, err = u.JOSE.NewSalt()
+		if err != nil {
+			return nil, "", err
+		}
+	}
 	newUser := domain.User{
 		ID:       uuid.New(),
 		Login:    login,
 		Password: hashedPassword,
+		E2E:      e2e,
+		Salt:     salt,
 	}
-	err = u.UserRepository.Create(newUser)
+	// Ключ выдается и пользователям со сквозным шифрованием: им шифруются секреты, которые сервер использует сам
+	userKey, err := u.Crypto.NewKey(newUser.ID)
 	if err != nil {
-		return token, err
+		return nil, "", err
 	}
-
-	token, err = u.JOSE.IssueToken(newUser.ID)
+	err = u.UserRepository.Create(newUser, &userKey)
 	if err != nil {
-		return token, err
+		return nil, "", err
 	}
 
-	return token, err
+	return issueTokenPair(u.JOSE, u.RefreshTokenRepository, newUser.ID, uuid.New())
 }
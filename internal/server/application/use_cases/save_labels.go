@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// SaveLabels - Сценарий использования для сохранения папки и меток данных
+type SaveLabels struct {
+	// LabelRepository - Интерфейс репозитория для сохранения папок и меток данных
+	LabelRepository domain.LabelRepositoryInterface
+	// FolderRepository - Интерфейс репозитория для проверки существования папки
+	FolderRepository domain.FolderRepositoryInterface
+	// TagRepository - Интерфейс репозитория для проверки существования меток
+	TagRepository domain.TagRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, заменяет папку и метки данных вида kind.
+// Нулевой folderID означает корень хранилища. Если данных, папки или одной из меток нет,
+// возвращает ErrEntityNotFound
+func (u SaveLabels) Do(userID, entityID uuid.UUID, kind string, folderID uuid.UUID, tagIDs []uuid.UUID) error {
+	if folderID != uuid.Nil {
+		_, err := u.FolderRepository.Get(userID, folderID)
+		if err != nil {
+			return err
+		}
+	}
+	for _, tagID := range tagIDs {
+		_, err := u.TagRepository.Get(userID, tagID)
+		if err != nil {
+			return err
+		}
+	}
+	labels := domain.Labels{
+		EntityID: entityID,
+		UserID:   userID,
+		Kind:     kind,
+		FolderID: folderID,
+		TagIDs:   tagIDs,
+	}
+
+	return u.LabelRepository.Save(&labels)
+}
@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// StartBinaryUpload - Сценарий использования для начала загрузки бинарных данных частями
+type StartBinaryUpload struct {
+	// BinaryUploadRepository - Интерфейс репозитория сессий загрузки
+	BinaryUploadRepository domain.BinaryUploadRepositoryInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает идентификатор сессии загрузки
+func (u StartBinaryUpload) Do(userID uuid.UUID, size int64, chunkSize int) (uuid.UUID, error) {
+	if size <= 0 || chunkSize <= 0 || chunkSize > MaxChunkSize {
+		return uuid.Nil, domain.ErrInvalidChunk
+	}
+	upload := domain.BinaryUpload{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Size:      size,
+		ChunkSize: chunkSize,
+	}
+
+	return upload.ID, u.BinaryUploadRepository.Create(upload)
+}
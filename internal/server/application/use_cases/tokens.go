@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/application/jose"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// issueTokenPair - Выпускает access token и refresh token в рамках семейства familyID
+func issueTokenPair(
+	joseService *jose.JOSEService,
+	repository domain.RefreshTokenRepositoryInterface,
+	userID uuid.UUID,
+	familyID uuid.UUID,
+) ([]byte, string, error) {
+	accessToken, err := joseService.IssueToken(userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	refreshToken, err := joseService.IssueRefreshToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = repository.Create(domain.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		FamilyID:  familyID,
+		Hash:      joseService.HashRefreshToken(refreshToken),
+		ExpiresAt: time.Now().Add(joseService.RefreshTokenExp),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
@@ -0,0 +1,110 @@
+package usecases
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/application/jose"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/totp"
+)
+
+const (
+	// totpIssuer - Издатель, отображаемый в приложении-аутентификаторе
+	totpIssuer = "GophKeeper"
+	// recoveryCodesCount - Количество кодов восстановления
+	recoveryCodesCount = 10
+	// recoveryCodeLength - Длина кода восстановления без разделителя
+	recoveryCodeLength = 10
+	// challengeAttempts - Количество попыток ввода кода по одному токену частичной аутентификации,
+	// после них нужно заново войти по паролю
+	challengeAttempts = 5
+)
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateRecoveryCodes - Генерирует одноразовые коды восстановления вида xxxxx-xxxxx
+func generateRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, recoveryCodesCount)
+	raw := make([]byte, recoveryCodeLength)
+	for i := 0; i < recoveryCodesCount; i++ {
+		if _, err := rand.Read(raw); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(recoveryEncoding.EncodeToString(raw))[:recoveryCodeLength]
+		codes = append(codes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+	}
+
+	return codes, nil
+}
+
+// isTOTPCode - Проверяет, похож ли код на код TOTP, иначе код считается кодом восстановления
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkTOTP - Проверяет код TOTP и запоминает его период, чтобы код нельзя было использовать повторно
+func checkTOTP(
+	repository domain.TwoFactorRepositoryInterface,
+	crypto domain.CryptoServiceInterface,
+	twoFactor *domain.TwoFactor,
+	code string,
+) (int64, error) {
+	secret, err := crypto.Decrypt(twoFactor.Secret)
+	if err != nil {
+		return 0, err
+	}
+	counter, err := totp.Validate(string(secret), code, time.Now())
+	if err != nil {
+		if errors.Is(err, totp.ErrInvalidCode) {
+			return 0, domain.ErrInvalidTwoFactorCode
+		}
+
+		return 0, err
+	}
+	err = repository.UseCounter(twoFactor.UserID, int64(counter))
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return 0, domain.ErrInvalidTwoFactorCode
+		}
+
+		return 0, err
+	}
+
+	return int64(counter), nil
+}
+
+// checkSecondFactor - Проверяет код TOTP или одноразовый код восстановления
+func checkSecondFactor(
+	repository domain.TwoFactorRepositoryInterface,
+	crypto domain.CryptoServiceInterface,
+	joseService *jose.JOSEService,
+	twoFactor *domain.TwoFactor,
+	code string,
+) error {
+	code = strings.TrimSpace(code)
+	if isTOTPCode(code) {
+		_, err := checkTOTP(repository, crypto, twoFactor, code)
+
+		return err
+	}
+
+	err := repository.UseRecoveryCode(twoFactor.UserID, joseService.HashRecoveryCode(code))
+	if errors.Is(err, domain.ErrEntityNotFound) {
+		return domain.ErrInvalidTwoFactorCode
+	}
+
+	return err
+}
@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/application/jose"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// TwoFactorLogin - Сценарий использования завершения входа кодом второго фактора
+type TwoFactorLogin struct {
+	// TwoFactorRepository - Интерфейс репозитория двухфакторной аутентификации
+	TwoFactorRepository domain.TwoFactorRepositoryInterface
+	// RefreshTokenRepository - Интерфейс репозитория refresh token
+	RefreshTokenRepository domain.RefreshTokenRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования персональным ключом пользователя
+	Crypto domain.CryptoProviderInterface
+	// JOSE - Сервис выдачи и верификации JWT
+	JOSE *jose.JOSEService
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования.
+// Обменивает токен частичной аутентификации и код TOTP или код восстановления на пару токенов.
+// По одному токену можно сделать challengeAttempts попыток, после этого и после успешного входа токен не действует
+func (u TwoFactorLogin) Do(challenge []byte, code string) ([]byte, string, error) {
+	userID, challengeID, err := u.JOSE.ParseChallenge(challenge)
+	if err != nil {
+		return nil, "", domain.ErrInvalidChallenge
+	}
+	twoFactor, err := u.TwoFactorRepository.Get(userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return nil, "", domain.ErrInvalidChallenge
+		}
+
+		return nil, "", err
+	}
+	if !twoFactor.Enabled {
+		return nil, "", domain.ErrInvalidChallenge
+	}
+	err = u.TwoFactorRepository.UseChallengeAttempt(userID, challengeID, challengeAttempts)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return nil, "", domain.ErrInvalidChallenge
+		}
+
+		return nil, "", err
+	}
+	userCrypto, err := u.Crypto.ForServer(userID)
+	if err != nil {
+		return nil, "", err
+	}
+	err = checkSecondFactor(u.TwoFactorRepository, userCrypto, u.JOSE, twoFactor, code)
+	if err != nil {
+		return nil, "", err
+	}
+	if err = u.TwoFactorRepository.CloseChallenge(userID, challengeID); err != nil {
+		return nil, "", err
+	}
+
+	return issueTokenPair(u.JOSE, u.RefreshTokenRepository, userID, uuid.New())
+}
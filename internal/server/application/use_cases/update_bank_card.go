@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
@@ -11,44 +13,54 @@ import (
 type UpdateBankCard struct {
 	// BankCardRepository - Интерфейс репозитория для сохранения банковской карты
 	BankCardRepository domain.BankCardRepositoryInterface
-	// Crypto - Сервис для шифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов исполнения сценария использования
+// Do - Вызов исполнения сценария использования, возвращает расшифрованные данные с новой версией.
+// Если version не равна 0 и не совпадает с текущей версией данных,
+// возвращает актуальную расшифрованную копию данных и ErrVersionConflict
 func (u UpdateBankCard) Do(
 	userID, id uuid.UUID,
 	number, validThru, cvv, cardHolder, meta string,
-) error {
+	version int64,
+) (*domain.BankCard, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
 	card, err := u.BankCardRepository.Get(userID, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if card == nil {
-		return domain.ErrEntityNotFound
+		return nil, domain.ErrEntityNotFound
+	}
+	if version != 0 && version != card.Version {
+		return u.conflict(userID, id)
 	}
 
-	encryptedNumber, err := u.Crypto.Encrypt([]byte(number))
+	encryptedNumber, err := crypto.Encrypt([]byte(number))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	encryptedValidThru, err := u.Crypto.Encrypt([]byte(validThru))
+	encryptedValidThru, err := crypto.Encrypt([]byte(validThru))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	encryptedCVV, err := u.Crypto.Encrypt([]byte(cvv))
+	encryptedCVV, err := crypto.Encrypt([]byte(cvv))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	encryptedCardHolder, err := u.Crypto.Encrypt([]byte(cardHolder))
+	encryptedCardHolder, err := crypto.Encrypt([]byte(cardHolder))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	encryptedMeta, err := u.Crypto.Encrypt([]byte(meta))
+	encryptedMeta, err := crypto.Encrypt([]byte(meta))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	card.Number = encryptedNumber
@@ -58,6 +70,37 @@ func (u UpdateBankCard) Do(
 	card.Meta = encryptedMeta
 
 	err = u.BankCardRepository.Update(card)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return u.conflict(userID, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	card.Number = []byte(number)
+	card.ValidThru = []byte(validThru)
+	card.CVV = []byte(cvv)
+	card.CardHolder = []byte(cardHolder)
+	card.Meta = []byte(meta)
+	card.Version++
+
+	return card, nil
+}
+
+// conflict - Возвращает актуальную расшифрованную копию данных вместе с ErrVersionConflict
+func (u UpdateBankCard) conflict(userID, id uuid.UUID) (*domain.BankCard, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	card, err := u.BankCardRepository.Get(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	_, err = decryptBankCards(crypto, []*domain.BankCard{card})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return card, domain.ErrVersionConflict
 }
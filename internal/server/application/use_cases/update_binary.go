@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
@@ -11,28 +13,78 @@ import (
 type UpdateBinary struct {
 	// BinaryRepository - Интерфейс репозитория для сохранения бинарных данных
 	BinaryRepository domain.BinaryRepositoryInterface
-	// Crypto - Сервис для шифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов исполнения сценария использования
-func (u UpdateBinary) Do(userID, id uuid.UUID, content []byte) error {
+// Do - Вызов исполнения сценария использования, возвращает расшифрованные данные с новой версией.
+// Если version не равна 0 и не совпадает с текущей версией данных,
+// возвращает актуальную расшифрованную копию данных и ErrVersionConflict
+func (u UpdateBinary) Do(
+	userID, id uuid.UUID,
+	content []byte,
+	metadata domain.BinaryMetadata,
+	version int64,
+) (*domain.Binary, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
 	bin, err := u.BinaryRepository.Get(userID, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if bin == nil {
-		return domain.ErrEntityNotFound
+		return nil, domain.ErrEntityNotFound
+	}
+	if version != 0 && version != bin.Version {
+		return u.conflict(userID, id)
 	}
 
-	encryptedContent, err := u.Crypto.Encrypt(content)
+	encryptedContent, err := crypto.Encrypt(content)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	bin.Content = encryptedContent
+	bin.Size = int64(len(content))
+	bin.Chunks = 0
+	if err = encryptBinaryMetadata(crypto, bin, metadata); err != nil {
+		return nil, err
+	}
 	err = u.BinaryRepository.Update(*bin)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return u.conflict(userID, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bin.Content = content
+	bin.Name = []byte(metadata.Name)
+	bin.MimeType = []byte(metadata.MimeType)
+	bin.SHA256 = []byte(metadata.SHA256)
+	bin.Meta = []byte(metadata.Meta)
+	bin.Version++
+
+	return bin, nil
+}
+
+// conflict - Возвращает актуальную расшифрованную копию данных вместе с ErrVersionConflict
+func (u UpdateBinary) conflict(userID, id uuid.UUID) (*domain.Binary, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	bin, err := u.BinaryRepository.Get(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	bins, err := decryptBinaries(crypto, []domain.Binary{*bin})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return &bins[0], domain.ErrVersionConflict
 }
@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
@@ -11,46 +13,86 @@ import (
 type UpdateCredentials struct {
 	// CredentialstRepository - Интерфейс репозитория для сохранения логина и пароля
 	CredentialsRepository domain.CredentialsRepositoryInterface
-	// Crypto - Сервис для шифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов исполнения сценария использования
+// Do - Вызов исполнения сценария использования, возвращает расшифрованные данные с новой версией.
+// Если version не равна 0 и не совпадает с текущей версией данных,
+// возвращает актуальную расшифрованную копию данных и ErrVersionConflict
 func (u UpdateCredentials) Do(
 	userID, id uuid.UUID,
 	name, login, password, meta string,
-) error {
+	version int64,
+) (*domain.Credentials, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
 	cred, err := u.CredentialsRepository.Get(userID, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if cred == nil {
-		return domain.ErrEntityNotFound
+		return nil, domain.ErrEntityNotFound
+	}
+	if version != 0 && version != cred.Version {
+		return u.conflict(userID, id)
 	}
 
-	encryptedName, err := u.Crypto.Encrypt([]byte(name))
+	encryptedName, err := crypto.Encrypt([]byte(name))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	encryptedLogin, err := u.Crypto.Encrypt([]byte(login))
+	encryptedLogin, err := crypto.Encrypt([]byte(login))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	encryptedPassword, err := u.Crypto.Encrypt([]byte(password))
+	encryptedPassword, err := crypto.Encrypt([]byte(password))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	encryptedMeta, err := u.Crypto.Encrypt([]byte(meta))
+	encryptedMeta, err := crypto.Encrypt([]byte(meta))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	cred.Name = encryptedName
 	cred.Login = encryptedLogin
 	cred.Password = encryptedPassword
 	cred.Meta = encryptedMeta
 	err = u.CredentialsRepository.Update(cred)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return u.conflict(userID, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cred.Name = []byte(name)
+	cred.Login = []byte(login)
+	cred.Password = []byte(password)
+	cred.Meta = []byte(meta)
+	cred.Version++
+
+	return cred, nil
+}
+
+// conflict - Возвращает актуальную расшифрованную копию данных вместе с ErrVersionConflict
+func (u UpdateCredentials) conflict(userID, id uuid.UUID) (*domain.Credentials, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	cred, err := u.CredentialsRepository.Get(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	_, err = decryptCredentials(crypto, []*domain.Credentials{cred})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return cred, domain.ErrVersionConflict
 }
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// UpdateFolder - Сценарий использования для переименования и перемещения существующей папки
+type UpdateFolder struct {
+	// FolderRepository - Интерфейс репозитория для сохранения папок
+	FolderRepository domain.FolderRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования.
+// Если папка перемещается в саму себя или в одну из вложенных папок, возвращает ErrFolderCycle
+func (u UpdateFolder) Do(userID, id uuid.UUID, name string, parentID uuid.UUID) error {
+	obj, err := u.FolderRepository.Get(userID, id)
+	if err != nil {
+		return err
+	}
+	// Поднимаемся от новой родительской папки к корню, перемещаемая папка не должна встретиться по пути
+	for ancestor := parentID; ancestor != uuid.Nil; {
+		if ancestor == id {
+			return domain.ErrFolderCycle
+		}
+		parent, err := u.FolderRepository.Get(userID, ancestor)
+		if err != nil {
+			return err
+		}
+		ancestor = parent.ParentID
+	}
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return err
+	}
+	encrypted, err := encryptFields(crypto, name)
+	if err != nil {
+		return err
+	}
+	obj.Name = encrypted[0]
+	obj.ParentID = parentID
+
+	return u.FolderRepository.Update(obj)
+}
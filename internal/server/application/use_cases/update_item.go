@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// UpdateItem - Сценарий использования для обновления существующих зашифрованных данных по шаблону
+type UpdateItem struct {
+	// ItemRepository - Интерфейс репозитория для сохранения данных по шаблону
+	ItemRepository domain.ItemRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает расшифрованные данные с новой версией.
+// Если version не равна 0 и не совпадает с текущей версией данных,
+// возвращает актуальную расшифрованную копию данных и ErrVersionConflict
+func (u UpdateItem) Do(
+	userID, id uuid.UUID,
+	name, template string,
+	fields []item.Field,
+	meta string,
+	version int64,
+) (*domain.Item, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := u.ItemRepository.Get(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, domain.ErrEntityNotFound
+	}
+	if version != 0 && version != obj.Version {
+		return u.conflict(userID, id)
+	}
+
+	fieldsJSON, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := encryptFields(crypto, name, template, string(fieldsJSON), meta)
+	if err != nil {
+		return nil, err
+	}
+	obj.Name = encrypted[0]
+	obj.Template = encrypted[1]
+	obj.Fields = encrypted[2]
+	obj.Meta = encrypted[3]
+	err = u.ItemRepository.Update(obj)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return u.conflict(userID, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	obj.Name = []byte(name)
+	obj.Template = []byte(template)
+	obj.Fields = fieldsJSON
+	obj.Meta = []byte(meta)
+	obj.Version++
+
+	return obj, nil
+}
+
+// conflict - Возвращает актуальную расшифрованную копию данных вместе с ErrVersionConflict
+func (u UpdateItem) conflict(userID, id uuid.UUID) (*domain.Item, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := u.ItemRepository.Get(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	_, err = decryptItems(crypto, []*domain.Item{obj})
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, domain.ErrVersionConflict
+}
@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// UpdateOTP - Сценарий использования для обновления существующего зашифрованного секрета одноразовых паролей
+type UpdateOTP struct {
+	// OTPRepository - Интерфейс репозитория для сохранения секретов одноразовых паролей
+	OTPRepository domain.OTPRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает расшифрованные данные с новой версией.
+// Если version не равна 0 и не совпадает с текущей версией данных,
+// возвращает актуальную расшифрованную копию данных и ErrVersionConflict
+func (u UpdateOTP) Do(
+	userID, id uuid.UUID,
+	issuer, account, secret, algorithm, digits, period, meta string,
+	version int64,
+) (*domain.OTP, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	otp, err := u.OTPRepository.Get(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if otp == nil {
+		return nil, domain.ErrEntityNotFound
+	}
+	if version != 0 && version != otp.Version {
+		return u.conflict(userID, id)
+	}
+
+	encrypted, err := encryptFields(crypto, issuer, account, secret, algorithm, digits, period, meta)
+	if err != nil {
+		return nil, err
+	}
+	otp.Issuer = encrypted[0]
+	otp.Account = encrypted[1]
+	otp.Secret = encrypted[2]
+	otp.Algorithm = encrypted[3]
+	otp.Digits = encrypted[4]
+	otp.Period = encrypted[5]
+	otp.Meta = encrypted[6]
+	err = u.OTPRepository.Update(otp)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return u.conflict(userID, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	otp.Issuer = []byte(issuer)
+	otp.Account = []byte(account)
+	otp.Secret = []byte(secret)
+	otp.Algorithm = []byte(algorithm)
+	otp.Digits = []byte(digits)
+	otp.Period = []byte(period)
+	otp.Meta = []byte(meta)
+	otp.Version++
+
+	return otp, nil
+}
+
+// conflict - Возвращает актуальную расшифрованную копию данных вместе с ErrVersionConflict
+func (u UpdateOTP) conflict(userID, id uuid.UUID) (*domain.OTP, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	otp, err := u.OTPRepository.Get(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	_, err = decryptOTPs(crypto, []*domain.OTP{otp})
+	if err != nil {
+		return nil, err
+	}
+
+	return otp, domain.ErrVersionConflict
+}
@@ -0,0 +1,88 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// UpdateSSHKey - Сценарий использования для обновления существующего зашифрованного SSH ключа
+type UpdateSSHKey struct {
+	// SSHKeyRepository - Интерфейс репозитория для сохранения SSH ключей
+	SSHKeyRepository domain.SSHKeyRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования, возвращает расшифрованные данные с новой версией.
+// Если version не равна 0 и не совпадает с текущей версией данных,
+// возвращает актуальную расшифрованную копию данных и ErrVersionConflict
+func (u UpdateSSHKey) Do(
+	userID, id uuid.UUID,
+	privateKey, publicKey, comment, fingerprint, meta string,
+	version int64,
+) (*domain.SSHKey, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	key, err := u.SSHKeyRepository.Get(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, domain.ErrEntityNotFound
+	}
+	if version != 0 && version != key.Version {
+		return u.conflict(userID, id)
+	}
+
+	encrypted, err := encryptFields(crypto, privateKey, publicKey, comment, fingerprint, meta)
+	if err != nil {
+		return nil, err
+	}
+	key.PrivateKey = encrypted[0]
+	key.PublicKey = encrypted[1]
+	key.Comment = encrypted[2]
+	key.Fingerprint = encrypted[3]
+	key.Meta = encrypted[4]
+	err = u.SSHKeyRepository.Update(key)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return u.conflict(userID, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key.PrivateKey = []byte(privateKey)
+	key.PublicKey = []byte(publicKey)
+	key.Comment = []byte(comment)
+	key.Fingerprint = []byte(fingerprint)
+	key.Meta = []byte(meta)
+	key.Version++
+
+	return key, nil
+}
+
+// conflict - Возвращает актуальную расшифрованную копию данных вместе с ErrVersionConflict
+func (u UpdateSSHKey) conflict(userID, id uuid.UUID) (*domain.SSHKey, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	key, err := u.SSHKeyRepository.Get(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	_, err = decryptSSHKeys(crypto, []*domain.SSHKey{key})
+	if err != nil {
+		return nil, err
+	}
+
+	return key, domain.ErrVersionConflict
+}
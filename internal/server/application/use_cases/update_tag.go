@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// UpdateTag - Сценарий использования для переименования существующей метки
+type UpdateTag struct {
+	// TagRepository - Интерфейс репозитория для сохранения меток
+	TagRepository domain.TagRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования
+func (u UpdateTag) Do(userID, id uuid.UUID, name string) error {
+	obj, err := u.TagRepository.Get(userID, id)
+	if err != nil {
+		return err
+	}
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return err
+	}
+	encrypted, err := encryptFields(crypto, name)
+	if err != nil {
+		return err
+	}
+	obj.Name = encrypted[0]
+
+	return u.TagRepository.Update(obj)
+}
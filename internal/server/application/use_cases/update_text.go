@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
@@ -11,28 +13,64 @@ import (
 type UpdateText struct {
 	// TextRepository - Интерфейс репозитория для сохранения текстовых данных
 	TextRepository domain.TextRepositoryInterface
-	// Crypto - Сервис для шифрования данных
-	Crypto domain.CryptoServiceInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
 	// Log - логгер
 	Log *logrus.Logger
 }
 
-// Do - Вызов исполнения сценария использования
-func (u UpdateText) Do(userID, id uuid.UUID, content string) error {
+// Do - Вызов исполнения сценария использования, возвращает расшифрованные данные с новой версией.
+// Если version не равна 0 и не совпадает с текущей версией данных,
+// возвращает актуальную расшифрованную копию данных и ErrVersionConflict
+func (u UpdateText) Do(userID, id uuid.UUID, content string, version int64) (*domain.Text, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
 	text, err := u.TextRepository.Get(userID, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if text == nil {
-		return domain.ErrEntityNotFound
+		return nil, domain.ErrEntityNotFound
+	}
+	if version != 0 && version != text.Version {
+		return u.conflict(userID, id)
 	}
 
-	encryptedContent, err := u.Crypto.Encrypt([]byte(content))
+	encryptedContent, err := crypto.Encrypt([]byte(content))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	text.Content = encryptedContent
 	err = u.TextRepository.Update(*text)
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return u.conflict(userID, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	text.Content = []byte(content)
+	text.Version++
+
+	return text, nil
+}
+
+// conflict - Возвращает актуальную расшифрованную копию данных вместе с ErrVersionConflict
+func (u UpdateText) conflict(userID, id uuid.UUID) (*domain.Text, error) {
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	text, err := u.TextRepository.Get(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	texts, err := decryptTexts(crypto, []domain.Text{*text})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return &texts[0], domain.ErrVersionConflict
 }
@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// UploadBinaryChunk - Сценарий использования для загрузки одной части бинарных данных
+type UploadBinaryChunk struct {
+	// BinaryUploadRepository - Интерфейс репозитория сессий загрузки
+	BinaryUploadRepository domain.BinaryUploadRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования данных пользователя
+	Crypto domain.CryptoProviderInterface
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования. Часть шифруется и сохраняется,
+// повторная загрузка части с тем же номером перезаписывает ее.
+// Если номер или размер части не соответствуют сессии загрузки, возвращает ErrInvalidChunk
+func (u UploadBinaryChunk) Do(userID, uploadID uuid.UUID, index int64, content []byte) error {
+	upload, err := u.BinaryUploadRepository.Get(userID, uploadID)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= upload.ChunkCount() || int64(len(content)) != upload.ChunkLength(index) {
+		return domain.ErrInvalidChunk
+	}
+	crypto, err := u.Crypto.ForUser(userID)
+	if err != nil {
+		return err
+	}
+	encrypted, err := sealChunk(crypto, uploadID, index, content)
+	if err != nil {
+		return err
+	}
+	chunk := domain.BinaryChunk{
+		BinaryID: uploadID,
+		UserID:   userID,
+		Index:    index,
+		Content:  encrypted,
+	}
+
+	return u.BinaryUploadRepository.SaveChunk(chunk)
+}
@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/application/jose"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// VerifyTwoFactor - Сценарий использования подтверждения подключения двухфакторной аутентификации
+type VerifyTwoFactor struct {
+	// TwoFactorRepository - Интерфейс репозитория двухфакторной аутентификации
+	TwoFactorRepository domain.TwoFactorRepositoryInterface
+	// Crypto - Поставщик сервиса шифрования персональным ключом пользователя
+	Crypto domain.CryptoProviderInterface
+	// JOSE - Сервис выдачи и верификации JWT
+	JOSE *jose.JOSEService
+	// Log - логгер
+	Log *logrus.Logger
+}
+
+// Do - Вызов исполнения сценария использования.
+// Проверяет код из приложения-аутентификатора, включает двухфакторную аутентификацию
+// и возвращает коды восстановления, на сервере хранятся только их хэши
+func (u VerifyTwoFactor) Do(userID uuid.UUID, code string) ([]string, error) {
+	twoFactor, err := u.TwoFactorRepository.Get(userID)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor.Enabled {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+	userCrypto, err := u.Crypto.ForServer(userID)
+	if err != nil {
+		return nil, err
+	}
+	counter, err := checkTOTP(u.TwoFactorRepository, userCrypto, twoFactor, code)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, 0, len(codes))
+	for _, recoveryCode := range codes {
+		hashes = append(hashes, u.JOSE.HashRecoveryCode(recoveryCode))
+	}
+	err = u.TwoFactorRepository.SaveRecoveryCodes(userID, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor.Enabled = true
+	twoFactor.LastCounter = counter
+	err = u.TwoFactorRepository.Save(*twoFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "github.com/google/uuid"
+
 // CryptoServiceInterface - Интерфейс сервиса шифрования
 type CryptoServiceInterface interface {
 	// Encrypt - Зашифровывает данные
@@ -7,3 +9,15 @@ type CryptoServiceInterface interface {
 	// Decrypt - Расщифровывает данные
 	Decrypt(value []byte) ([]byte, error)
 }
+
+// CryptoProviderInterface - Интерфейс поставщика сервиса шифрования данных пользователя
+type CryptoProviderInterface interface {
+	// ForUser - Возвращает сервис шифрования данных пользователя
+	ForUser(userID uuid.UUID) (CryptoServiceInterface, error)
+	// ForServer - Возвращает сервис шифрования персональным ключом пользователя независимо от режима
+	// сквозного шифрования, для секретов, которые сервер использует сам, например секрета второго фактора
+	ForServer(userID uuid.UUID) (CryptoServiceInterface, error)
+	// NewKey - Генерирует новый ключ шифрования данных пользователя, зашифрованный мастер-ключом.
+	// Ключ сохраняется вместе с пользователем
+	NewKey(userID uuid.UUID) (UserKey, error)
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -12,8 +14,77 @@ type User struct {
 	Login string
 	// Password - Хэш пароля пользователя
 	Password string
+	// E2E - Признак сквозного шифрования, данные шифруются на клиенте и хранятся на сервере как есть
+	E2E bool
+	// Salt - Соль для вывода ключа хранилища из мастер-пароля на клиенте
+	Salt []byte
 }
 
+// RefreshToken - Сущность refresh token, используется для выпуска новой пары токенов без ввода пароля
+type RefreshToken struct {
+	// ID - Уникальный идентификатор refresh token
+	ID uuid.UUID
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// FamilyID - Идентификатор семейства токенов, выпущенных друг за другом при ротации
+	FamilyID uuid.UUID
+	// Hash - Хэш значения токена, само значение на сервере не хранится
+	Hash string
+	// ExpiresAt - Время истечения срока действия токена
+	ExpiresAt time.Time
+	// Revoked - Признак отозванного токена
+	Revoked bool
+}
+
+// UserKey - Ключ шифрования данных пользователя (DEK), хранится зашифрованным мастер-ключом сервера (KEK)
+type UserKey struct {
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// Key - Зашифрованный мастер-ключом ключ шифрования данных
+	Key []byte
+}
+
+// TwoFactor - Настройки двухфакторной аутентификации пользователя по TOTP (RFC 6238)
+type TwoFactor struct {
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// Secret - Секрет TOTP, зашифрованный мастер-ключом сервера
+	Secret []byte
+	// Enabled - Признак подтвержденного подключения, до подтверждения вход выполняется только по паролю
+	Enabled bool
+	// LastCounter - Номер периода последнего принятого кода, защищает от повторного использования кода
+	LastCounter int64
+}
+
+// InitialVersion - Версия только что созданных данных
+const InitialVersion int64 = 1
+
+const (
+	// TextKind - Тип данных "Произвольный текст"
+	TextKind = "text"
+	// BinaryKind - Тип данных "Произвольные бинарные данные"
+	BinaryKind = "binary"
+	// CredentialsKind - Тип данных "Логин и пароль"
+	CredentialsKind = "credentials"
+	// BankCardKind - Тип данных "Банковская карта"
+	BankCardKind = "bank_card"
+	// OTPKind - Тип данных "Секрет одноразовых паролей TOTP"
+	OTPKind = "otp"
+	// SSHKeyKind - Тип данных "SSH ключ"
+	SSHKeyKind = "ssh_key"
+	// ItemKind - Тип данных "Произвольные данные по шаблону"
+	ItemKind = "item"
+	// FolderKind - Папка, в которую помещаются данные
+	FolderKind = "folder"
+	// TagKind - Метка, которой отмечаются данные
+	TagKind = "tag"
+	// TemplateKind - Пользовательский шаблон данных
+	TemplateKind = "template"
+)
+
+// AllKinds - Порядок видов данных при постраничном получении всех данных пользователя
+var AllKinds = []string{TextKind, BinaryKind, CredentialsKind, BankCardKind, OTPKind, SSHKeyKind, ItemKind}
+
 // Text - Сущность типа хранимой информации "Произвольный текст"
 type Text struct {
 	// ID - Уникальный идентификатор "Текстовых данных"
@@ -22,6 +93,14 @@ type Text struct {
 	UserID uuid.UUID
 	// Content - Зашифрованный текст
 	Content []byte
+	// Version - Версия данных, увеличивается при каждом обновлении
+	Version int64
+	// Revision - Ревизия последнего изменения, монотонно возрастает
+	Revision int64
+	// UpdatedAt - Время последнего изменения
+	UpdatedAt time.Time
+	// CreatedAt - Время создания, задает порядок данных в списках
+	CreatedAt time.Time
 }
 
 // Binary - Сущность типа хранимой информации "Произвольные бинарные данные"
@@ -30,7 +109,78 @@ type Binary struct {
 	ID uuid.UUID
 	// UserID - Ссылка на пользователя
 	UserID uuid.UUID
-	// Content - Зашифрованные бинарные данные
+	// Content - Зашифрованные бинарные данные, для данных, загруженных частями, пустые
+	Content []byte
+	// Name - Зашифрованное имя исходного файла
+	Name []byte
+	// MimeType - Зашифрованный MIME тип содержимого
+	MimeType []byte
+	// SHA256 - Зашифрованная контрольная сумма SHA-256 содержимого в шестнадцатеричном виде
+	SHA256 []byte
+	// Meta - Зашифрованные произвольные текстовые метаданные
+	Meta []byte
+	// Size - Размер данных в байтах
+	Size int64
+	// Chunks - Количество частей, 0 означает, что данные хранятся целиком в Content
+	Chunks int64
+	// Version - Версия данных, увеличивается при каждом обновлении
+	Version int64
+	// Revision - Ревизия последнего изменения, монотонно возрастает
+	Revision int64
+	// UpdatedAt - Время последнего изменения
+	UpdatedAt time.Time
+	// CreatedAt - Время создания, задает порядок данных в списках
+	CreatedAt time.Time
+}
+
+// BinaryMetadata - Расшифрованные метаданные бинарных данных
+type BinaryMetadata struct {
+	// Name - Имя исходного файла
+	Name string
+	// MimeType - MIME тип содержимого
+	MimeType string
+	// SHA256 - Контрольная сумма SHA-256 содержимого в шестнадцатеричном виде
+	SHA256 string
+	// Meta - Произвольные текстовые метаданные
+	Meta string
+}
+
+// BinaryUpload - Сессия загрузки бинарных данных частями.
+// Идентификатор сессии становится идентификатором бинарных данных после завершения загрузки
+type BinaryUpload struct {
+	// ID - Уникальный идентификатор сессии загрузки
+	ID uuid.UUID
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// Size - Полный размер загружаемых данных в байтах
+	Size int64
+	// ChunkSize - Размер одной части в байтах, последняя часть может быть меньше
+	ChunkSize int
+}
+
+// ChunkCount - Возвращает количество частей загрузки
+func (u BinaryUpload) ChunkCount() int64 {
+	return (u.Size + int64(u.ChunkSize) - 1) / int64(u.ChunkSize)
+}
+
+// ChunkLength - Возвращает ожидаемый размер части с номером index
+func (u BinaryUpload) ChunkLength(index int64) int64 {
+	if index == u.ChunkCount()-1 {
+		return u.Size - index*int64(u.ChunkSize)
+	}
+
+	return int64(u.ChunkSize)
+}
+
+// BinaryChunk - Зашифрованная часть бинарных данных
+type BinaryChunk struct {
+	// BinaryID - Ссылка на бинарные данные или сессию загрузки
+	BinaryID uuid.UUID
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// Index - Номер части, начиная с 0
+	Index int64
+	// Content - Зашифрованное содержимое части
 	Content []byte
 }
 
@@ -48,6 +198,14 @@ type Credentials struct {
 	Password []byte
 	// Meta - Зашифрованные произвольные текстовые метаданные
 	Meta []byte
+	// Version - Версия данных, увеличивается при каждом обновлении
+	Version int64
+	// Revision - Ревизия последнего изменения, монотонно возрастает
+	Revision int64
+	// UpdatedAt - Время последнего изменения
+	UpdatedAt time.Time
+	// CreatedAt - Время создания, задает порядок данных в списках
+	CreatedAt time.Time
 }
 
 // BankCard - Сущность типа хранимой информации "Банковкая карта"
@@ -66,4 +224,242 @@ type BankCard struct {
 	CardHolder []byte
 	// Meta - Зашифрованные произвольные текстовые метаданные
 	Meta []byte
+	// Version - Версия данных, увеличивается при каждом обновлении
+	Version int64
+	// Revision - Ревизия последнего изменения, монотонно возрастает
+	Revision int64
+	// UpdatedAt - Время последнего изменения
+	UpdatedAt time.Time
+	// CreatedAt - Время создания, задает порядок данных в списках
+	CreatedAt time.Time
+}
+
+// OTP - Сущность типа хранимой информации "Секрет одноразовых паролей TOTP" приложения-аутентификатора.
+// Количество цифр и период хранятся строками, чтобы клиенты со сквозным шифрованием могли их зашифровать
+type OTP struct {
+	// ID - Уникальный идентификатор секрета
+	ID uuid.UUID
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// Issuer - Зашифрованное наименование сервиса
+	Issuer []byte
+	// Account - Зашифрованная учетная запись в сервисе
+	Account []byte
+	// Secret - Зашифрованный секрет в кодировке base32
+	Secret []byte
+	// Algorithm - Зашифрованный алгоритм HMAC
+	Algorithm []byte
+	// Digits - Зашифрованное количество цифр в коде
+	Digits []byte
+	// Period - Зашифрованный период действия кода в секундах
+	Period []byte
+	// Meta - Зашифрованные произвольные текстовые метаданные
+	Meta []byte
+	// Version - Версия данных, увеличивается при каждом обновлении
+	Version int64
+	// Revision - Ревизия последнего изменения, монотонно возрастает
+	Revision int64
+	// UpdatedAt - Время последнего изменения
+	UpdatedAt time.Time
+	// CreatedAt - Время создания, задает порядок данных в списках
+	CreatedAt time.Time
+}
+
+// SSHKey - Сущность типа хранимой информации "SSH ключ"
+type SSHKey struct {
+	// ID - Уникальный идентификатор ключа
+	ID uuid.UUID
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// PrivateKey - Зашифрованный закрытый ключ в формате PEM, может быть защищен парольной фразой
+	PrivateKey []byte
+	// PublicKey - Зашифрованный открытый ключ в формате authorized_keys
+	PublicKey []byte
+	// Comment - Зашифрованный комментарий ключа
+	Comment []byte
+	// Fingerprint - Зашифрованный отпечаток открытого ключа SHA256
+	Fingerprint []byte
+	// Meta - Зашифрованные произвольные текстовые метаданные
+	Meta []byte
+	// Version - Версия данных, увеличивается при каждом обновлении
+	Version int64
+	// Revision - Ревизия последнего изменения, монотонно возрастает
+	Revision int64
+	// UpdatedAt - Время последнего изменения
+	UpdatedAt time.Time
+	// CreatedAt - Время создания, задает порядок данных в списках
+	CreatedAt time.Time
+}
+
+// Item - Сущность типа хранимой информации "Произвольные данные по шаблону"
+type Item struct {
+	// ID - Уникальный идентификатор данных
+	ID uuid.UUID
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// Name - Зашифрованное наименование данных
+	Name []byte
+	// Template - Зашифрованное имя шаблона, по которому созданы данные
+	Template []byte
+	// Fields - Зашифрованный JSON список полей с именем, типом и значением
+	Fields []byte
+	// Meta - Зашифрованные произвольные текстовые метаданные
+	Meta []byte
+	// Version - Версия данных, увеличивается при каждом обновлении
+	Version int64
+	// Revision - Ревизия последнего изменения, монотонно возрастает
+	Revision int64
+	// UpdatedAt - Время последнего изменения
+	UpdatedAt time.Time
+	// CreatedAt - Время создания, задает порядок данных в списках
+	CreatedAt time.Time
+}
+
+// Folder - Сущность папки, папки образуют дерево, в каждую папку можно поместить данные любого вида
+type Folder struct {
+	// ID - Уникальный идентификатор папки
+	ID uuid.UUID
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// ParentID - Идентификатор родительской папки, uuid.Nil для папок в корне
+	ParentID uuid.UUID
+	// Name - Зашифрованное имя папки
+	Name []byte
+	// Revision - Ревизия последнего изменения, монотонно возрастает
+	Revision int64
+	// UpdatedAt - Время последнего изменения
+	UpdatedAt time.Time
+}
+
+// Tag - Сущность метки, данные любого вида можно отметить несколькими метками
+type Tag struct {
+	// ID - Уникальный идентификатор метки
+	ID uuid.UUID
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// Name - Зашифрованное имя метки
+	Name []byte
+	// Revision - Ревизия последнего изменения, монотонно возрастает
+	Revision int64
+	// UpdatedAt - Время последнего изменения
+	UpdatedAt time.Time
+}
+
+// ItemTemplate - Сущность пользовательского шаблона, по которому создаются данные с произвольными полями
+type ItemTemplate struct {
+	// ID - Уникальный идентификатор шаблона
+	ID uuid.UUID
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// Name - Зашифрованное имя шаблона
+	Name []byte
+	// Description - Зашифрованное описание шаблона
+	Description []byte
+	// Fields - Зашифрованный JSON список описаний полей с именем, типом и признаком обязательности
+	Fields []byte
+	// Revision - Ревизия последнего изменения, монотонно возрастает
+	Revision int64
+	// UpdatedAt - Время последнего изменения
+	UpdatedAt time.Time
+}
+
+// Labels - Папка и метки данных любого вида
+type Labels struct {
+	// EntityID - Идентификатор данных
+	EntityID uuid.UUID
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// Kind - Вид данных
+	Kind string
+	// FolderID - Идентификатор папки, uuid.Nil если данные лежат в корне
+	FolderID uuid.UUID
+	// TagIDs - Идентификаторы меток
+	TagIDs []uuid.UUID
+	// Revision - Ревизия последнего изменения, монотонно возрастает
+	Revision int64
+	// UpdatedAt - Время последнего изменения
+	UpdatedAt time.Time
+}
+
+// Tombstone - Сущность записи об удалении данных, используется для инкрементальной синхронизации
+type Tombstone struct {
+	// ID - Идентификатор удаленных данных
+	ID uuid.UUID
+	// UserID - Ссылка на пользователя
+	UserID uuid.UUID
+	// Kind - Тип удаленных данных
+	Kind string
+	// Revision - Ревизия удаления
+	Revision int64
+	// DeletedAt - Время удаления
+	DeletedAt time.Time
+}
+
+// Changes - Изменения данных пользователя после указанной ревизии
+type Changes struct {
+	// Revision - Максимальная ревизия среди изменений
+	Revision int64
+	// More - Признак того, что после Revision есть еще изменения
+	More bool
+	// Texts - Созданные или обновленные расшифрованные текстовые данные
+	Texts []Text
+	// Binaries - Созданные или обновленные расшифрованные бинарные данные
+	Binaries []Binary
+	// Credentials - Созданные или обновленные расшифрованные логины и пароли
+	Credentials []*Credentials
+	// BankCards - Созданные или обновленные расшифрованные банковские карты
+	BankCards []*BankCard
+	// OTPs - Созданные или обновленные расшифрованные секреты одноразовых паролей
+	OTPs []*OTP
+	// SSHKeys - Созданные или обновленные расшифрованные SSH ключи
+	SSHKeys []*SSHKey
+	// Items - Созданные или обновленные расшифрованные данные по шаблону
+	Items []*Item
+	// Folders - Созданные или обновленные папки с расшифрованными именами
+	Folders []*Folder
+	// Tags - Созданные или обновленные метки с расшифрованными именами
+	Tags []*Tag
+	// Templates - Созданные пользовательские шаблоны с расшифрованными именами, описаниями и полями
+	Templates []*ItemTemplate
+	// Labels - Измененные папки и метки данных
+	Labels []Labels
+	// Tombstones - Записи об удалении данных
+	Tombstones []Tombstone
+}
+
+// Cursor - Позиция в списке данных, упорядоченном по времени создания и идентификатору
+type Cursor struct {
+	// CreatedAt - Время создания последней записи предыдущей страницы
+	CreatedAt time.Time
+	// ID - Идентификатор последней записи предыдущей страницы
+	ID uuid.UUID
+}
+
+// AllCursor - Позиция в списке всех данных пользователя: виды данных перебираются в порядке AllKinds,
+// внутри вида данные упорядочены по времени создания
+type AllCursor struct {
+	// Kind - Вид данных, с которого начинается страница
+	Kind string
+	// After - Курсор внутри вида данных, nil если страница начинается с начала вида
+	After *Cursor
+}
+
+// Page - Параметры постраничного получения списка данных
+type Page struct {
+	// Limit - Максимальное количество записей на странице
+	Limit int
+	// After - Курсор, после которого начинается страница, nil для первой страницы
+	After *Cursor
+}
+
+// Paginate - Обрезает выборку до размера страницы и возвращает курсор следующей страницы.
+// Репозиторий выбирает Limit+1 запись: если лишняя запись есть, значит существует следующая страница
+func Paginate[T any](items []T, limit int, cursor func(T) Cursor) ([]T, *Cursor) {
+	if len(items) <= limit {
+		return items, nil
+	}
+	items = items[:limit]
+	next := cursor(items[limit-1])
+
+	return items, &next
 }
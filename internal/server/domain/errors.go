@@ -5,3 +5,24 @@ import "errors"
 var ErrLoginAlreadyInUse = errors.New("login already in use")
 var ErrLoginOrPasswordIsInvalid = errors.New("login or password is invalid")
 var ErrEntityNotFound = errors.New("entity not found")
+var ErrInvalidRefreshToken = errors.New("refresh token is invalid or expired")
+var ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+var ErrVersionConflict = errors.New("version conflict")
+var ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication already enabled")
+var ErrInvalidTwoFactorCode = errors.New("two-factor code is invalid")
+var ErrInvalidChallenge = errors.New("two-factor challenge is invalid or expired")
+var ErrInvalidChunk = errors.New("chunk index or size is invalid")
+var ErrUploadIncomplete = errors.New("upload is incomplete")
+var ErrInvalidCursor = errors.New("pagination cursor is invalid")
+var ErrUserKeyNotFound = errors.New("user data key not found")
+var ErrFolderCycle = errors.New("folder can not be moved into itself or its subfolder")
+
+// TwoFactorRequiredError - Пароль верный, но для входа требуется код второго фактора
+type TwoFactorRequiredError struct {
+	// Challenge - Токен частичной аутентификации, обменивается на пару токенов вместе с кодом
+	Challenge []byte
+}
+
+func (e *TwoFactorRequiredError) Error() string {
+	return "two-factor code required"
+}
@@ -6,10 +6,58 @@ import (
 
 // UserRepositoryInterface - Интерфейс репозитория пользователя
 type UserRepositoryInterface interface {
-	// Create - Сохраняет нового пользователя
-	Create(user User) error
+	// Create - Сохраняет нового пользователя вместе с ключом шифрования его данных в одной транзакции.
+	// Для пользователей со сквозным шифрованием ключ не передается
+	Create(user User, key *UserKey) error
 	// GetByLogin - Возвращает пользователя по логину, если он существует
 	GetByLogin(login string) (*User, error)
+	// GetByID - Возвращает пользователя по идентификатору, если он существует
+	GetByID(userID uuid.UUID) (*User, error)
+}
+
+// UserKeyRepositoryInterface - Интерфейс репозитория ключей шифрования данных пользователей
+type UserKeyRepositoryInterface interface {
+	// Create - Сохраняет новый ключ шифрования данных пользователя
+	Create(key UserKey) error
+	// Get - Возвращает ключ шифрования данных пользователя, если он существует
+	Get(userID uuid.UUID) (*UserKey, error)
+}
+
+// TwoFactorRepositoryInterface - Интерфейс репозитория двухфакторной аутентификации
+type TwoFactorRepositoryInterface interface {
+	// Save - Создает или перезаписывает настройки двухфакторной аутентификации пользователя
+	Save(twoFactor TwoFactor) error
+	// Get - Возвращает настройки двухфакторной аутентификации пользователя, если они существуют
+	Get(userID uuid.UUID) (*TwoFactor, error)
+	// Delete - Удаляет настройки двухфакторной аутентификации и коды восстановления пользователя
+	Delete(userID uuid.UUID) error
+	// UseCounter - Запоминает номер периода принятого кода.
+	// Если код этого или более позднего периода уже принимался, возвращает ErrEntityNotFound
+	UseCounter(userID uuid.UUID, counter int64) error
+	// SaveRecoveryCodes - Заменяет коды восстановления пользователя, сохраняются только хэши кодов
+	SaveRecoveryCodes(userID uuid.UUID, hashes []string) error
+	// UseRecoveryCode - Помечает код восстановления использованным,
+	// если неиспользованного кода с таким хэшем нет, возвращает ErrEntityNotFound
+	UseRecoveryCode(userID uuid.UUID, hash string) error
+	// SaveChallenge - Запоминает выданный токен частичной аутентификации, предыдущий токен перестает действовать
+	SaveChallenge(userID, challengeID uuid.UUID) error
+	// UseChallengeAttempt - Засчитывает попытку ввода кода по токену частичной аутентификации.
+	// Если токен не последний выданный, закрыт или по нему исчерпаны limit попыток, возвращает ErrEntityNotFound
+	UseChallengeAttempt(userID, challengeID uuid.UUID, limit int) error
+	// CloseChallenge - Закрывает токен частичной аутентификации после успешного входа
+	CloseChallenge(userID, challengeID uuid.UUID) error
+}
+
+// RefreshTokenRepositoryInterface - Интерфейс репозитория refresh token
+type RefreshTokenRepositoryInterface interface {
+	// Create - Сохраняет новый refresh token
+	Create(token RefreshToken) error
+	// GetByHash - Возвращает refresh token по хэшу его значения, если он существует
+	GetByHash(hash string) (*RefreshToken, error)
+	// Revoke - Отзывает активный refresh token, возвращает ErrEntityNotFound, если активного токена нет
+	Revoke(tokenID uuid.UUID) error
+	// RevokeFamily - Отзывает все refresh token семейства
+	RevokeFamily(familyID uuid.UUID) error
 }
 
 // TextRepositoryInterface - Интерфейс репозитория для произвольных текстовых данных
@@ -17,23 +65,50 @@ type TextRepositoryInterface interface {
 	// Create - Сохраняет новые текстовые данные
 	Create(text Text) error
 	// Update - Сохраняет существующие текстовые данные
+	// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
 	Update(text Text) error
 	// Get - Возвращает текстовые данные по идентификатору пользователя и данных, если они существуют
 	Get(userID uuid.UUID, textID uuid.UUID) (*Text, error)
-	// GetAll - Возвращает список текстовых данных, принадлежащих пользователю
-	GetAll(userID uuid.UUID) ([]Text, error)
+	// GetAll - Возвращает страницу списка текстовых данных пользователя, упорядоченного по времени создания,
+	// и курсор следующей страницы, если она есть
+	GetAll(userID uuid.UUID, page Page) ([]Text, *Cursor, error)
+	// Delete - Удаляет текстовые данные по идентификатору пользователя и данных
+	Delete(userID uuid.UUID, textID uuid.UUID) error
 }
 
 // BinaryRepositoryInterface - Интерфейс репозитория для произвольных бинарных данных
 type BinaryRepositoryInterface interface {
 	// Create - Сохраняет новые бинарные данные
 	Create(bin Binary) error
+	// CommitUpload - Сохраняет бинарные данные, загруженные частями, и удаляет сессию их загрузки в одной транзакции.
+	// Если сессия не существует, возвращает ErrEntityNotFound
+	CommitUpload(bin Binary) error
 	// Update - Сохраняет существующие бинарные данные
+	// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
 	Update(bin Binary) error
 	// Get - Возвращает бинарные данные по идентификатору пользователя и данных, если они существуют
 	Get(userID uuid.UUID, binID uuid.UUID) (*Binary, error)
-	// GetAll - Возвращает список бинарных данных, принадлежащих пользователю
-	GetAll(userID uuid.UUID) ([]Binary, error)
+	// GetAll - Возвращает страницу списка метаданных бинарных данных пользователя без содержимого,
+	// упорядоченного по времени создания, и курсор следующей страницы, если она есть
+	GetAll(userID uuid.UUID, page Page) ([]Binary, *Cursor, error)
+	// Delete - Удаляет бинарные данные по идентификатору пользователя и данных
+	Delete(userID uuid.UUID, binID uuid.UUID) error
+}
+
+// BinaryUploadRepositoryInterface - Интерфейс репозитория сессий загрузки бинарных данных частями
+type BinaryUploadRepositoryInterface interface {
+	// Create - Сохраняет новую сессию загрузки
+	Create(upload BinaryUpload) error
+	// Get - Возвращает сессию загрузки по идентификатору пользователя и сессии, если она существует и не истекла
+	Get(userID uuid.UUID, uploadID uuid.UUID) (*BinaryUpload, error)
+	// DeleteExpired - Удаляет истекшие сессии загрузки вместе с их частями, возвращает количество удаленных сессий
+	DeleteExpired() (int64, error)
+	// SaveChunk - Создает или перезаписывает часть бинарных данных и продлевает сессию загрузки
+	SaveChunk(chunk BinaryChunk) error
+	// GetChunkIndexes - Возвращает номера сохраненных частей бинарных данных в порядке возрастания
+	GetChunkIndexes(userID uuid.UUID, binID uuid.UUID) ([]int64, error)
+	// GetChunk - Возвращает часть бинарных данных по номеру, если она существует
+	GetChunk(userID uuid.UUID, binID uuid.UUID, index int64) (*BinaryChunk, error)
 }
 
 // CredentialsRepositoryInterface - Интерфейс репозитория для логинов и паролей
@@ -41,11 +116,15 @@ type CredentialsRepositoryInterface interface {
 	// Create - Сохраняет новую пару логина и пароля
 	Create(cred *Credentials) error
 	// Update - Сохраняет существующую пару логина и пароля
+	// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
 	Update(cred *Credentials) error
 	// Get - Возвращает пару логин и пароль по идентификатору пользователя и данных, если они существуют
 	Get(userID uuid.UUID, credID uuid.UUID) (*Credentials, error)
-	// GetAll - Возвращает список логинов и паролей, принадлежащих пользователю
-	GetAll(userID uuid.UUID) ([]*Credentials, error)
+	// GetAll - Возвращает страницу списка логинов и паролей пользователя, упорядоченного по времени создания,
+	// и курсор следующей страницы, если она есть
+	GetAll(userID uuid.UUID, page Page) ([]*Credentials, *Cursor, error)
+	// Delete - Удаляет пару логин и пароль по идентификатору пользователя и данных
+	Delete(userID uuid.UUID, credID uuid.UUID) error
 }
 
 // BankCardRepositoryInterface - Интерфейс репозитория для банковских карт
@@ -53,9 +132,119 @@ type BankCardRepositoryInterface interface {
 	// Create - Сохраняет новую банковскую карту
 	Create(card *BankCard) error
 	// Update - Сохраняет существующую банковскую карту
+	// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
 	Update(card *BankCard) error
 	// Get - Возвращает банковскую карту по идентификатору пользователя и данных, если они существуют
 	Get(userID uuid.UUID, cardID uuid.UUID) (*BankCard, error)
-	// GetAll - Возвращает список банковских карт, принадлежащих пользователю
-	GetAll(userID uuid.UUID) ([]*BankCard, error)
+	// GetAll - Возвращает страницу списка банковских карт пользователя, упорядоченного по времени создания,
+	// и курсор следующей страницы, если она есть
+	GetAll(userID uuid.UUID, page Page) ([]*BankCard, *Cursor, error)
+	// Delete - Удаляет банковскую карту по идентификатору пользователя и данных
+	Delete(userID uuid.UUID, cardID uuid.UUID) error
+}
+
+// OTPRepositoryInterface - Интерфейс репозитория для секретов одноразовых паролей
+type OTPRepositoryInterface interface {
+	// Create - Сохраняет новый секрет одноразовых паролей
+	Create(otp *OTP) error
+	// Update - Сохраняет существующий секрет одноразовых паролей
+	// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
+	Update(otp *OTP) error
+	// Get - Возвращает секрет одноразовых паролей по идентификатору пользователя и данных, если он существует
+	Get(userID uuid.UUID, otpID uuid.UUID) (*OTP, error)
+	// GetAll - Возвращает страницу списка секретов одноразовых паролей пользователя, упорядоченного
+	// по времени создания, и курсор следующей страницы, если она есть
+	GetAll(userID uuid.UUID, page Page) ([]*OTP, *Cursor, error)
+	// Delete - Удаляет секрет одноразовых паролей по идентификатору пользователя и данных
+	Delete(userID uuid.UUID, otpID uuid.UUID) error
+}
+
+// SSHKeyRepositoryInterface - Интерфейс репозитория для SSH ключей
+type SSHKeyRepositoryInterface interface {
+	// Create - Сохраняет новый SSH ключ
+	Create(key *SSHKey) error
+	// Update - Сохраняет существующий SSH ключ
+	// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
+	Update(key *SSHKey) error
+	// Get - Возвращает SSH ключ по идентификатору пользователя и данных, если он существует
+	Get(userID uuid.UUID, keyID uuid.UUID) (*SSHKey, error)
+	// GetAll - Возвращает страницу списка SSH ключей пользователя, упорядоченного
+	// по времени создания, и курсор следующей страницы, если она есть
+	GetAll(userID uuid.UUID, page Page) ([]*SSHKey, *Cursor, error)
+	// Delete - Удаляет SSH ключ по идентификатору пользователя и данных
+	Delete(userID uuid.UUID, keyID uuid.UUID) error
+}
+
+// ItemRepositoryInterface - Интерфейс репозитория для данных по шаблону
+type ItemRepositoryInterface interface {
+	// Create - Сохраняет новые данные по шаблону
+	Create(item *Item) error
+	// Update - Сохраняет существующие данные по шаблону
+	// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
+	Update(item *Item) error
+	// Get - Возвращает данные по шаблону по идентификатору пользователя и данных, если они существуют
+	Get(userID uuid.UUID, itemID uuid.UUID) (*Item, error)
+	// GetAll - Возвращает страницу списка данных по шаблону пользователя, упорядоченного
+	// по времени создания, и курсор следующей страницы, если она есть
+	GetAll(userID uuid.UUID, page Page) ([]*Item, *Cursor, error)
+	// Delete - Удаляет данные по шаблону по идентификатору пользователя и данных
+	Delete(userID uuid.UUID, itemID uuid.UUID) error
+}
+
+// FolderRepositoryInterface - Интерфейс репозитория для папок
+type FolderRepositoryInterface interface {
+	// Create - Сохраняет новую папку
+	Create(folder *Folder) error
+	// Update - Сохраняет новое имя и родительскую папку существующей папки
+	Update(folder *Folder) error
+	// Get - Возвращает папку по идентификатору пользователя и папки, если она существует
+	Get(userID uuid.UUID, folderID uuid.UUID) (*Folder, error)
+	// GetAll - Возвращает все папки пользователя
+	GetAll(userID uuid.UUID) ([]*Folder, error)
+	// Delete - Удаляет папку, вложенные папки и данные из нее переносятся в родительскую папку
+	Delete(userID uuid.UUID, folderID uuid.UUID) error
+}
+
+// TagRepositoryInterface - Интерфейс репозитория для меток
+type TagRepositoryInterface interface {
+	// Create - Сохраняет новую метку
+	Create(tag *Tag) error
+	// Update - Сохраняет новое имя существующей метки
+	Update(tag *Tag) error
+	// Get - Возвращает метку по идентификатору пользователя и метки, если она существует
+	Get(userID uuid.UUID, tagID uuid.UUID) (*Tag, error)
+	// GetAll - Возвращает все метки пользователя
+	GetAll(userID uuid.UUID) ([]*Tag, error)
+	// Delete - Удаляет метку и снимает ее со всех данных
+	Delete(userID uuid.UUID, tagID uuid.UUID) error
+}
+
+// TemplateRepositoryInterface - Интерфейс репозитория для пользовательских шаблонов
+type TemplateRepositoryInterface interface {
+	// Create - Сохраняет новый шаблон
+	Create(tmpl *ItemTemplate) error
+	// Get - Возвращает шаблон по идентификатору пользователя и шаблона, если он существует
+	Get(userID uuid.UUID, templateID uuid.UUID) (*ItemTemplate, error)
+	// GetAll - Возвращает все шаблоны пользователя
+	GetAll(userID uuid.UUID) ([]*ItemTemplate, error)
+	// Delete - Удаляет шаблон, данные, созданные по нему, не изменяются
+	Delete(userID uuid.UUID, templateID uuid.UUID) error
+}
+
+// LabelRepositoryInterface - Интерфейс репозитория для папок и меток данных
+type LabelRepositoryInterface interface {
+	// Save - Сохраняет папку и метки данных, заменяя предыдущие.
+	// Если у пользователя нет данных вида Kind с идентификатором EntityID, возвращает ErrEntityNotFound
+	Save(labels *Labels) error
+	// Get - Возвращает папку и метки данных, если они были сохранены
+	Get(userID uuid.UUID, entityID uuid.UUID) (*Labels, error)
+}
+
+// ChangesRepositoryInterface - Интерфейс репозитория изменений данных пользователя для инкрементальной синхронизации
+type ChangesRepositoryInterface interface {
+	// GetSince - Возвращает не больше limit зашифрованных данных пользователя, измененных после ревизии since,
+	// и записей об удалении данных с наименьшими ревизиями. Все изменения читаются из одного снимка хранилища,
+	// в который попадают все ревизии не больше наибольшей ревизии в нем, поэтому ревизию результата
+	// можно использовать как since следующего запроса
+	GetSince(userID uuid.UUID, since int64, limit int) (Changes, error)
 }
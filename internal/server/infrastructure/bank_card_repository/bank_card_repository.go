@@ -65,6 +65,7 @@ func (r BankCardRepository) Create(card *domain.BankCard) error {
 }
 
 // Update - Обновляет существующую банковскую карту
+// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
 func (r BankCardRepository) Update(card *domain.BankCard) error {
 	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
 	defer cancel()
@@ -76,9 +77,13 @@ func (r BankCardRepository) Update(card *domain.BankCard) error {
 			, cvv = @cvv
 			, card_holder = @card_holder
 			, meta = @meta
+			, version = bank_card_data.version + 1
+			, revision = nextval('revision_seq')
+			, updated_at = now()
 		WHERE
 			bank_card_data.id = @id
 		    AND bank_card_data.user_id = @userID
+		    AND bank_card_data.version = @version
 		;`
 
 	args := pgx.NamedArgs{
@@ -89,10 +94,17 @@ func (r BankCardRepository) Update(card *domain.BankCard) error {
 		"cvv":         card.CVV,
 		"card_holder": card.CardHolder,
 		"meta":        card.Meta,
+		"version":     card.Version,
+	}
+	tag, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrVersionConflict
 	}
-	_, err := r.DBPool.Exec(ctx, sql, args)
 
-	return err
+	return nil
 }
 
 // Get - Возвращает банковскую карту по идентификатору пользователя и карты, если они существуют
@@ -110,6 +122,9 @@ func (r BankCardRepository) Get(userID, cardID uuid.UUID) (*domain.BankCard, err
 			, bank_card_data.cvv
 			, bank_card_data.card_holder
 			, bank_card_data.meta
+			, bank_card_data.version
+			, bank_card_data.revision
+			, bank_card_data.updated_at
 		FROM
 			bank_card_data
 		WHERE
@@ -130,6 +145,9 @@ func (r BankCardRepository) Get(userID, cardID uuid.UUID) (*domain.BankCard, err
 			&card.CVV,
 			&card.CardHolder,
 			&card.Meta,
+			&card.Version,
+			&card.Revision,
+			&card.UpdatedAt,
 		)
 
 	if err != nil {
@@ -143,11 +161,58 @@ func (r BankCardRepository) Get(userID, cardID uuid.UUID) (*domain.BankCard, err
 	return &card, err
 }
 
-// GetAll - Возвращает список банковских карт, принадлежащих пользователю
-func (r BankCardRepository) GetAll(userID uuid.UUID) ([]*domain.BankCard, error) {
-	result := []*domain.BankCard{}
-	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
-	defer cancel()
+// GetAll - Возвращает страницу списка банковских карт пользователя, упорядоченного по времени создания,
+// и курсор следующей страницы, если она есть
+func (r BankCardRepository) GetAll(userID uuid.UUID, page domain.Page) ([]*domain.BankCard, *domain.Cursor, error) {
+	sql := `
+		SELECT
+			bank_card_data.id
+			, bank_card_data.user_id
+			, bank_card_data.number
+			, bank_card_data.valid_thru
+			, bank_card_data.cvv
+			, bank_card_data.card_holder
+			, bank_card_data.meta
+			, bank_card_data.version
+			, bank_card_data.revision
+			, bank_card_data.updated_at
+			, bank_card_data.created_at
+		FROM
+			bank_card_data
+		WHERE
+			bank_card_data.user_id = @userID
+			AND (@first::boolean OR (bank_card_data.created_at, bank_card_data.id) > (@createdAt::timestamptz, @id::uuid))
+		ORDER BY
+			bank_card_data.created_at
+			, bank_card_data.id
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID":    userID,
+		"first":     page.After == nil,
+		"createdAt": time.Time{},
+		"id":        uuid.Nil,
+		"limit":     page.Limit + 1,
+	}
+	if page.After != nil {
+		args["createdAt"] = page.After.CreatedAt
+		args["id"] = page.After.ID
+	}
+
+	result, err := r.query(sql, args)
+	if err != nil {
+		return result, nil, err
+	}
+	result, next := domain.Paginate(result, page.Limit, func(v *domain.BankCard) domain.Cursor {
+		return domain.Cursor{CreatedAt: v.CreatedAt, ID: v.ID}
+	})
+
+	return result, next, nil
+}
+
+// GetSince - Возвращает банковские карты пользователя, измененные после ревизии since, в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r BankCardRepository) GetSince(ctx context.Context, tx pgx.Tx, userID uuid.UUID, since int64, limit int) ([]*domain.BankCard, error) {
 	sql := `
 		SELECT
 			bank_card_data.id
@@ -157,19 +222,50 @@ func (r BankCardRepository) GetAll(userID uuid.UUID) ([]*domain.BankCard, error)
 			, bank_card_data.cvv
 			, bank_card_data.card_holder
 			, bank_card_data.meta
+			, bank_card_data.version
+			, bank_card_data.revision
+			, bank_card_data.updated_at
+			, bank_card_data.created_at
 		FROM
 			bank_card_data
 		WHERE
 			bank_card_data.user_id = @userID
+			AND bank_card_data.revision > @since
+		ORDER BY
+			bank_card_data.revision
+		LIMIT @limit
 		;`
 	args := pgx.NamedArgs{
 		"userID": userID,
+		"since":  since,
+		"limit":  limit,
+	}
+
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.BankCard{}, err
 	}
 
+	return scan(rows)
+}
+
+// query - Выполняет запрос списка и возвращает выбранные строки
+func (r BankCardRepository) query(sql string, args pgx.NamedArgs) ([]*domain.BankCard, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
 	rows, err := r.DBPool.Query(ctx, sql, args)
 	if err != nil {
-		return result, err
+		return []*domain.BankCard{}, err
 	}
+
+	return scan(rows)
+}
+
+// scan - Читает и закрывает строки результата запроса
+func scan(rows pgx.Rows) ([]*domain.BankCard, error) {
+	result := []*domain.BankCard{}
+	var err error
 	defer rows.Close()
 	for rows.Next() {
 		var card domain.BankCard
@@ -181,6 +277,10 @@ func (r BankCardRepository) GetAll(userID uuid.UUID) ([]*domain.BankCard, error)
 			&card.CVV,
 			&card.CardHolder,
 			&card.Meta,
+			&card.Version,
+			&card.Revision,
+			&card.UpdatedAt,
+			&card.CreatedAt,
 		)
 		if err == nil {
 			result = append(result, &card)
@@ -193,6 +293,39 @@ func (r BankCardRepository) GetAll(userID uuid.UUID) ([]*domain.BankCard, error)
 	return result, err
 }
 
+// Delete - Удаляет банковскую карту по идентификатору пользователя и данных
+func (r BankCardRepository) Delete(userID, cardID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	// Вместе с удалением сохраняем запись об удалении для инкрементальной синхронизации клиентов,
+	// папка и метки данных удаляются в том же запросе, клиенты удаляют их локально по записи об удалении
+	sql := `
+		WITH deleted AS (
+			DELETE FROM bank_card_data
+			WHERE
+				bank_card_data.id = @cardID
+			    AND bank_card_data.user_id = @userID
+			RETURNING bank_card_data.id, bank_card_data.user_id
+		), deleted_labels AS (
+			DELETE FROM labels
+			USING deleted
+			WHERE
+				labels.entity_id = deleted.id
+				AND labels.user_id = deleted.user_id
+		)
+		INSERT INTO tombstones (id, user_id, kind)
+		SELECT deleted.id, deleted.user_id, @kind FROM deleted
+		;`
+	args := pgx.NamedArgs{
+		"cardID": cardID,
+		"userID": userID,
+		"kind":   domain.BankCardKind,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
 // New - Возвращает новый инстанс репозитория
 func New(
 	dbPool *pgxpool.Pool,
@@ -15,6 +15,14 @@ import (
 	"github.com/Nickolasll/goph-keeper/internal/server/domain"
 )
 
+// deleteChunksSQL - Удаляет части бинарных данных
+const deleteChunksSQL = `
+	DELETE FROM binary_chunks
+	WHERE
+		binary_chunks.binary_id = @id
+		AND binary_chunks.user_id = @userID
+	;`
+
 // BinaryRepository - Имплементация репозитория для произвольных бинарных данных
 type BinaryRepository struct {
 	// DBPool - Интерфейс пула соединений pgxpool
@@ -24,35 +32,86 @@ type BinaryRepository struct {
 	log     *logrus.Logger
 }
 
+// insertSQL - Сохраняет новые бинарные данные
+const insertSQL = `
+	INSERT INTO binary_data
+	(
+		id
+		, user_id
+		, content
+		, name
+		, mime_type
+		, sha256
+		, meta
+		, size
+		, chunks
+	)
+	VALUES
+	(
+		@id
+		, @userID
+		, @content
+		, @name
+		, @mimeType
+		, @sha256
+		, @meta
+		, @size
+		, @chunks
+	)
+	;`
+
+func insertArgs(bin domain.Binary) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"id":       bin.ID,
+		"userID":   bin.UserID,
+		"content":  bin.Content,
+		"name":     bin.Name,
+		"mimeType": bin.MimeType,
+		"sha256":   bin.SHA256,
+		"meta":     bin.Meta,
+		"size":     bin.Size,
+		"chunks":   bin.Chunks,
+	}
+}
+
 // Create - Сохраняет новые бинарные данных
 func (r BinaryRepository) Create(bin domain.Binary) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	_, err := r.DBPool.Exec(ctx, insertSQL, insertArgs(bin))
+
+	return err
+}
+
+// CommitUpload - Сохраняет бинарные данные, загруженные частями, и удаляет сессию их загрузки в одной транзакции.
+// Идентификатор данных совпадает с идентификатором сессии. Если сессия не существует, возвращает ErrEntityNotFound
+func (r BinaryRepository) CommitUpload(bin domain.Binary) error {
 	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
 	defer cancel()
 	sql := `
-		INSERT INTO binary_data
-		(
-			id
-			, user_id
-			, content
-		)
-		VALUES
-		(
-			@id
-			, @userID
-			, @content
-		)
+		DELETE FROM binary_uploads
+		WHERE
+			binary_uploads.id = @id
+			AND binary_uploads.user_id = @userID
 		;`
-	args := pgx.NamedArgs{
-		"id":      bin.ID,
-		"userID":  bin.UserID,
-		"content": bin.Content,
-	}
-	_, err := r.DBPool.Exec(ctx, sql, args)
+	args := insertArgs(bin)
 
-	return err
+	return pgx.BeginFunc(ctx, r.DBPool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, sql, args)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return domain.ErrEntityNotFound
+		}
+		_, err = tx.Exec(ctx, insertSQL, args)
+
+		return err
+	})
 }
 
-// Update - Обновляет существующие бинарные данные
+// Update - Обновляет существующие бинарные данные, части ранее загруженных данных удаляются
+// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
 func (r BinaryRepository) Update(bin domain.Binary) error {
 	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
 	defer cancel()
@@ -60,19 +119,46 @@ func (r BinaryRepository) Update(bin domain.Binary) error {
 		UPDATE binary_data
 		SET 
 			content = @content
+			, name = @name
+			, mime_type = @mimeType
+			, sha256 = @sha256
+			, meta = @meta
+			, size = @size
+			, chunks = @chunks
+			, version = binary_data.version + 1
+			, revision = nextval('revision_seq')
+			, updated_at = now()
 		WHERE
 		    binary_data.id = @id
 		    AND binary_data.user_id = @userID
+		    AND binary_data.version = @version
 		;`
 
 	args := pgx.NamedArgs{
-		"id":      bin.ID,
-		"userID":  bin.UserID,
-		"content": bin.Content,
+		"id":       bin.ID,
+		"userID":   bin.UserID,
+		"content":  bin.Content,
+		"name":     bin.Name,
+		"mimeType": bin.MimeType,
+		"sha256":   bin.SHA256,
+		"meta":     bin.Meta,
+		"size":     bin.Size,
+		"chunks":   bin.Chunks,
+		"version":  bin.Version,
 	}
-	_, err := r.DBPool.Exec(ctx, sql, args)
 
-	return err
+	return pgx.BeginFunc(ctx, r.DBPool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, sql, args)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return domain.ErrVersionConflict
+		}
+		_, err = tx.Exec(ctx, deleteChunksSQL, args)
+
+		return err
+	})
 }
 
 // Get - Возвращает бинарные данные по идентификатору пользователя и данных, если они существуют
@@ -86,6 +172,15 @@ func (r BinaryRepository) Get(userID, binID uuid.UUID) (*domain.Binary, error) {
 		    binary_data.id
 			, binary_data.user_id
 			, binary_data.content
+			, binary_data.name
+			, binary_data.mime_type
+			, binary_data.sha256
+			, binary_data.meta
+			, binary_data.size
+			, binary_data.chunks
+			, binary_data.version
+			, binary_data.revision
+			, binary_data.updated_at
 		FROM
 		    binary_data
 		WHERE
@@ -96,7 +191,12 @@ func (r BinaryRepository) Get(userID, binID uuid.UUID) (*domain.Binary, error) {
 		"binID":  binID,
 		"userID": userID,
 	}
-	err := r.DBPool.QueryRow(ctx, sql, args).Scan(&bin.ID, &bin.UserID, &bin.Content)
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(
+			&bin.ID, &bin.UserID, &bin.Content, &bin.Name, &bin.MimeType, &bin.SHA256, &bin.Meta,
+			&bin.Size, &bin.Chunks, &bin.Version, &bin.Revision, &bin.UpdatedAt,
+		)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -109,33 +209,131 @@ func (r BinaryRepository) Get(userID, binID uuid.UUID) (*domain.Binary, error) {
 	return &bin, err
 }
 
-// GetAll - Возвращает список бинарных данных, принадлежащих пользователю
-func (r BinaryRepository) GetAll(userID uuid.UUID) ([]domain.Binary, error) {
-	result := []domain.Binary{}
-	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
-	defer cancel()
+// GetAll - Возвращает страницу списка метаданных бинарных данных без содержимого пользователя, упорядоченного по времени создания,
+// и курсор следующей страницы, если она есть
+func (r BinaryRepository) GetAll(userID uuid.UUID, page domain.Page) ([]domain.Binary, *domain.Cursor, error) {
 	sql := `
 		SELECT
-		    binary_data.id
+			binary_data.id
 			, binary_data.user_id
-			, binary_data.content
+			, binary_data.name
+			, binary_data.mime_type
+			, binary_data.sha256
+			, binary_data.meta
+			, binary_data.size
+			, binary_data.chunks
+			, binary_data.version
+			, binary_data.revision
+			, binary_data.updated_at
+			, binary_data.created_at
 		FROM
-		    binary_data
+			binary_data
 		WHERE
-		    binary_data.user_id = @userID
+			binary_data.user_id = @userID
+			AND (@first::boolean OR (binary_data.created_at, binary_data.id) > (@createdAt::timestamptz, @id::uuid))
+		ORDER BY
+			binary_data.created_at
+			, binary_data.id
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID":    userID,
+		"first":     page.After == nil,
+		"createdAt": time.Time{},
+		"id":        uuid.Nil,
+		"limit":     page.Limit + 1,
+	}
+	if page.After != nil {
+		args["createdAt"] = page.After.CreatedAt
+		args["id"] = page.After.ID
+	}
+
+	result, err := r.query(sql, args)
+	if err != nil {
+		return result, nil, err
+	}
+	result, next := domain.Paginate(result, page.Limit, func(v domain.Binary) domain.Cursor {
+		return domain.Cursor{CreatedAt: v.CreatedAt, ID: v.ID}
+	})
+
+	return result, next, nil
+}
+
+// GetSince - Возвращает метаданные бинарных данных пользователя без содержимого,
+// измененных после ревизии since, в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r BinaryRepository) GetSince(ctx context.Context, tx pgx.Tx, userID uuid.UUID, since int64, limit int) ([]domain.Binary, error) {
+	sql := `
+		SELECT
+			binary_data.id
+			, binary_data.user_id
+			, binary_data.name
+			, binary_data.mime_type
+			, binary_data.sha256
+			, binary_data.meta
+			, binary_data.size
+			, binary_data.chunks
+			, binary_data.version
+			, binary_data.revision
+			, binary_data.updated_at
+			, binary_data.created_at
+		FROM
+			binary_data
+		WHERE
+			binary_data.user_id = @userID
+			AND binary_data.revision > @since
+		ORDER BY
+			binary_data.revision
+		LIMIT @limit
 		;`
 	args := pgx.NamedArgs{
 		"userID": userID,
+		"since":  since,
+		"limit":  limit,
 	}
 
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return []domain.Binary{}, err
+	}
+
+	return scan(rows)
+}
+
+// query - Выполняет запрос списка и возвращает выбранные строки
+func (r BinaryRepository) query(sql string, args pgx.NamedArgs) ([]domain.Binary, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
 	rows, err := r.DBPool.Query(ctx, sql, args)
 	if err != nil {
-		return result, err
+		return []domain.Binary{}, err
 	}
+
+	return scan(rows)
+}
+
+// scan - Читает и закрывает строки результата запроса
+func scan(rows pgx.Rows) ([]domain.Binary, error) {
+	result := []domain.Binary{}
+	var err error
 	defer rows.Close()
 	for rows.Next() {
 		var bin domain.Binary
-		err = rows.Scan(&bin.ID, &bin.UserID, &bin.Content)
+		err = rows.Scan(
+			&bin.ID,
+			&bin.UserID,
+			&bin.Name,
+			&bin.MimeType,
+			&bin.SHA256,
+			&bin.Meta,
+			&bin.Size,
+			&bin.Chunks,
+			&bin.Version,
+			&bin.Revision,
+			&bin.UpdatedAt,
+			&bin.CreatedAt,
+		)
 		if err == nil {
 			result = append(result, bin)
 		}
@@ -147,6 +345,46 @@ func (r BinaryRepository) GetAll(userID uuid.UUID) ([]domain.Binary, error) {
 	return result, err
 }
 
+// Delete - Удаляет бинарные данные вместе с частями по идентификатору пользователя и данных
+func (r BinaryRepository) Delete(userID, binID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	// Вместе с удалением сохраняем запись об удалении для инкрементальной синхронизации клиентов,
+	// папка и метки данных удаляются в том же запросе, клиенты удаляют их локально по записи об удалении
+	sql := `
+		WITH deleted AS (
+			DELETE FROM binary_data
+			WHERE
+				binary_data.id = @id
+			    AND binary_data.user_id = @userID
+			RETURNING binary_data.id, binary_data.user_id
+		), deleted_labels AS (
+			DELETE FROM labels
+			USING deleted
+			WHERE
+				labels.entity_id = deleted.id
+				AND labels.user_id = deleted.user_id
+		)
+		INSERT INTO tombstones (id, user_id, kind)
+		SELECT deleted.id, deleted.user_id, @kind FROM deleted
+		;`
+	args := pgx.NamedArgs{
+		"id":     binID,
+		"userID": userID,
+		"kind":   domain.BinaryKind,
+	}
+
+	return pgx.BeginFunc(ctx, r.DBPool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, sql, args)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, deleteChunksSQL, args)
+
+		return err
+	})
+}
+
 // New - Возвращает новый инстанс репозитория
 func New(
 	dbPool *pgxpool.Pool,
@@ -0,0 +1,269 @@
+// Package binaryuploadrepository содержит имлементацию интерфейса репозитория BinaryUploadRepositoryInterface
+package binaryuploadrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// BinaryUploadRepository - Имплементация репозитория сессий загрузки бинарных данных частями
+type BinaryUploadRepository struct {
+	// DBPool - Пул соединений pgx
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	// Expiration - Время, после которого сессия без загрузки новых частей считается брошенной
+	Expiration time.Duration
+	log        *logrus.Logger
+}
+
+// expiredBefore - Возвращает момент, раньше которого последняя активность сессии означает ее истечение
+func (r BinaryUploadRepository) expiredBefore() time.Time {
+	return time.Now().Add(-r.Expiration)
+}
+
+// Create - Сохраняет новую сессию загрузки
+func (r BinaryUploadRepository) Create(upload domain.BinaryUpload) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO binary_uploads
+		(
+			id
+			, user_id
+			, size
+			, chunk_size
+		)
+		VALUES
+		(
+			@id
+			, @userID
+			, @size
+			, @chunkSize
+		)
+		;`
+	args := pgx.NamedArgs{
+		"id":        upload.ID,
+		"userID":    upload.UserID,
+		"size":      upload.Size,
+		"chunkSize": upload.ChunkSize,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// Get - Возвращает сессию загрузки по идентификатору пользователя и сессии, если она существует и не истекла
+func (r BinaryUploadRepository) Get(userID, uploadID uuid.UUID) (*domain.BinaryUpload, error) {
+	var upload domain.BinaryUpload
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			binary_uploads.id
+			, binary_uploads.user_id
+			, binary_uploads.size
+			, binary_uploads.chunk_size
+		FROM
+			binary_uploads
+		WHERE
+			binary_uploads.id = @uploadID
+			AND binary_uploads.user_id = @userID
+			AND binary_uploads.updated_at >= @expiredBefore
+		;`
+	args := pgx.NamedArgs{
+		"uploadID":      uploadID,
+		"userID":        userID,
+		"expiredBefore": r.expiredBefore(),
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(&upload.ID, &upload.UserID, &upload.Size, &upload.ChunkSize)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &upload, nil
+}
+
+// DeleteExpired - Удаляет истекшие сессии загрузки вместе с их частями, возвращает количество удаленных сессий.
+// Части завершенной загрузки принадлежат бинарным данным и не удаляются, так как ее сессия удаляется при завершении
+func (r BinaryUploadRepository) DeleteExpired() (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		WITH expired AS (
+			DELETE FROM binary_uploads
+			WHERE
+				binary_uploads.updated_at < @expiredBefore
+			RETURNING binary_uploads.id
+		), deleted AS (
+			DELETE FROM binary_chunks
+			WHERE
+				binary_chunks.binary_id IN (SELECT expired.id FROM expired)
+		)
+		SELECT count(*) FROM expired
+		;`
+	args := pgx.NamedArgs{
+		"expiredBefore": r.expiredBefore(),
+	}
+	var count int64
+	err := r.DBPool.QueryRow(ctx, sql, args).Scan(&count)
+
+	return count, err
+}
+
+// SaveChunk - Создает или перезаписывает часть бинарных данных и продлевает сессию загрузки.
+// Если сессия уже удалена как истекшая, часть не сохраняется и возвращается ErrEntityNotFound
+func (r BinaryUploadRepository) SaveChunk(chunk domain.BinaryChunk) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO binary_chunks
+		(
+			id
+			, binary_id
+			, user_id
+			, idx
+			, content
+		)
+		VALUES
+		(
+			@id
+			, @binID
+			, @userID
+			, @index
+			, @content
+		)
+		ON CONFLICT (binary_id, idx) DO UPDATE SET
+			content = EXCLUDED.content
+		;`
+	args := pgx.NamedArgs{
+		"id":      uuid.New(),
+		"binID":   chunk.BinaryID,
+		"userID":  chunk.UserID,
+		"index":   chunk.Index,
+		"content": chunk.Content,
+	}
+	touchSQL := `
+		UPDATE binary_uploads
+		SET
+			updated_at = now()
+		WHERE
+			binary_uploads.id = @binID
+			AND binary_uploads.user_id = @userID
+		;`
+
+	return pgx.BeginFunc(ctx, r.DBPool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, touchSQL, args)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return domain.ErrEntityNotFound
+		}
+		_, err = tx.Exec(ctx, sql, args)
+
+		return err
+	})
+}
+
+// GetChunkIndexes - Возвращает номера сохраненных частей бинарных данных в порядке возрастания
+func (r BinaryUploadRepository) GetChunkIndexes(userID, binID uuid.UUID) ([]int64, error) {
+	result := []int64{}
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			binary_chunks.idx
+		FROM
+			binary_chunks
+		WHERE
+			binary_chunks.binary_id = @binID
+			AND binary_chunks.user_id = @userID
+		ORDER BY
+			binary_chunks.idx
+		;`
+	args := pgx.NamedArgs{
+		"binID":  binID,
+		"userID": userID,
+	}
+	rows, err := r.DBPool.Query(ctx, sql, args)
+	if err != nil {
+		return result, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var index int64
+		if err = rows.Scan(&index); err != nil {
+			return result, err
+		}
+		result = append(result, index)
+	}
+
+	return result, rows.Err()
+}
+
+// GetChunk - Возвращает часть бинарных данных по номеру, если она существует
+func (r BinaryUploadRepository) GetChunk(userID, binID uuid.UUID, index int64) (*domain.BinaryChunk, error) {
+	var chunk domain.BinaryChunk
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			binary_chunks.binary_id
+			, binary_chunks.user_id
+			, binary_chunks.idx
+			, binary_chunks.content
+		FROM
+			binary_chunks
+		WHERE
+			binary_chunks.binary_id = @binID
+			AND binary_chunks.user_id = @userID
+			AND binary_chunks.idx = @index
+		;`
+	args := pgx.NamedArgs{
+		"binID":  binID,
+		"userID": userID,
+		"index":  index,
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(&chunk.BinaryID, &chunk.UserID, &chunk.Index, &chunk.Content)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &chunk, nil
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	expiration time.Duration,
+	log *logrus.Logger,
+) *BinaryUploadRepository {
+	return &BinaryUploadRepository{
+		DBPool:     dbPool,
+		Timeout:    timeout,
+		Expiration: expiration,
+		log:        log,
+	}
+}
@@ -0,0 +1,261 @@
+// Package changesrepository содержит имлементацию интерфейса репозитория ChangesRepositoryInterface
+package changesrepository
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	bcardrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/bank_card_repository"
+	binrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/binary_repository"
+	crederepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/credentials_repository"
+	folderrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/folder_repository"
+	itemrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/item_repository"
+	labelrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/label_repository"
+	otprepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/otp_repository"
+	sshrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/ssh_key_repository"
+	tagrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/tag_repository"
+	tmplrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/template_repository"
+	txtrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/text_repository"
+	tmbrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/tombstone_repository"
+)
+
+// Ревизии выдаются триггером next_revision под монопольной блокировкой пользователя до конца транзакции,
+// чтение изменений открывает снимок под разделяемой блокировкой с тем же ключом
+const (
+	lockSQL   = `SELECT pg_advisory_lock_shared(hashtextextended('revision:' || @userID::text, 0));`
+	unlockSQL = `SELECT pg_advisory_unlock_shared(hashtextextended('revision:' || @userID::text, 0));`
+)
+
+// ChangesRepository - Имплементация репозитория изменений данных пользователя
+type ChangesRepository struct {
+	// DBPool - Пул соединений pgx
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout     time.Duration
+	log         *logrus.Logger
+	texts       *txtrepo.TextRepository
+	binaries    *binrepo.BinaryRepository
+	credentials *crederepo.CredentialsRepository
+	bankCards   *bcardrepo.BankCardRepository
+	otps        *otprepo.OTPRepository
+	sshKeys     *sshrepo.SSHKeyRepository
+	items       *itemrepo.ItemRepository
+	folders     *folderrepo.FolderRepository
+	tags        *tagrepo.TagRepository
+	templates   *tmplrepo.TemplateRepository
+	labels      *labelrepo.LabelRepository
+	tombstones  *tmbrepo.TombstoneRepository
+}
+
+// GetSince - Возвращает не больше limit зашифрованных данных пользователя, измененных после ревизии since,
+// и записей об удалении данных с наименьшими ревизиями. Все изменения читаются в одной транзакции REPEATABLE READ.
+// Снимок транзакции открывается, когда у пользователя нет незафиксированных транзакций с выданной ревизией,
+// поэтому в него попадают все ревизии не больше наибольшей ревизии в нем, а транзакции, начатые после,
+// получат большие ревизии
+func (r ChangesRepository) GetSince(userID uuid.UUID, since int64, limit int) (domain.Changes, error) {
+	changes := domain.Changes{}
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	conn, err := r.DBPool.Acquire(ctx)
+	if err != nil {
+		return changes, err
+	}
+	defer conn.Release()
+
+	args := pgx.NamedArgs{"userID": userID}
+	if _, err = conn.Exec(ctx, lockSQL, args); err != nil {
+		return changes, err
+	}
+	locked := true
+	defer func() {
+		if !locked {
+			return
+		}
+		unlockCtx, unlockCancel := context.WithTimeout(context.Background(), r.Timeout)
+		defer unlockCancel()
+		if _, err := conn.Exec(unlockCtx, unlockSQL, args); err != nil {
+			r.log.Error(err)
+		}
+	}()
+
+	opts := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+	err = pgx.BeginTxFunc(ctx, conn, opts, func(tx pgx.Tx) error {
+		// Первый запрос транзакции открывает снимок, после этого блокировка больше не нужна
+		if _, err := tx.Exec(ctx, unlockSQL, args); err != nil {
+			return err
+		}
+		locked = false
+
+		// Каждый вид данных читается с запасом в одну запись: если всего записей больше limit,
+		// то после наибольшей ревизии страницы есть еще изменения
+		return r.read(ctx, tx, userID, since, limit+1, &changes)
+	})
+	if err != nil {
+		return domain.Changes{}, err
+	}
+
+	revisions := revisions(&changes)
+	slices.Sort(revisions)
+	changes.Revision = since
+	if len(revisions) > limit {
+		changes.Revision = revisions[limit-1]
+		changes.More = true
+		truncate(&changes, changes.Revision)
+	} else if len(revisions) > 0 {
+		changes.Revision = revisions[len(revisions)-1]
+	}
+
+	return changes, nil
+}
+
+// read - Читает изменения всех видов данных в транзакции tx
+func (r ChangesRepository) read(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID uuid.UUID,
+	since int64,
+	limit int,
+	changes *domain.Changes,
+) error {
+	var err error
+	if changes.Texts, err = r.texts.GetSince(ctx, tx, userID, since, limit); err != nil {
+		return err
+	}
+	if changes.Binaries, err = r.binaries.GetSince(ctx, tx, userID, since, limit); err != nil {
+		return err
+	}
+	if changes.Credentials, err = r.credentials.GetSince(ctx, tx, userID, since, limit); err != nil {
+		return err
+	}
+	if changes.BankCards, err = r.bankCards.GetSince(ctx, tx, userID, since, limit); err != nil {
+		return err
+	}
+	if changes.OTPs, err = r.otps.GetSince(ctx, tx, userID, since, limit); err != nil {
+		return err
+	}
+	if changes.SSHKeys, err = r.sshKeys.GetSince(ctx, tx, userID, since, limit); err != nil {
+		return err
+	}
+	if changes.Items, err = r.items.GetSince(ctx, tx, userID, since, limit); err != nil {
+		return err
+	}
+	if changes.Folders, err = r.folders.GetSince(ctx, tx, userID, since, limit); err != nil {
+		return err
+	}
+	if changes.Tags, err = r.tags.GetSince(ctx, tx, userID, since, limit); err != nil {
+		return err
+	}
+	if changes.Templates, err = r.templates.GetSince(ctx, tx, userID, since, limit); err != nil {
+		return err
+	}
+	if changes.Labels, err = r.labels.GetSince(ctx, tx, userID, since, limit); err != nil {
+		return err
+	}
+	changes.Tombstones, err = r.tombstones.GetSince(ctx, tx, userID, since, limit)
+
+	return err
+}
+
+// revisions - Возвращает ревизии всех изменений
+func revisions(changes *domain.Changes) []int64 {
+	result := []int64{}
+	for _, v := range changes.Texts {
+		result = append(result, v.Revision)
+	}
+	for _, v := range changes.Binaries {
+		result = append(result, v.Revision)
+	}
+	for _, v := range changes.Credentials {
+		result = append(result, v.Revision)
+	}
+	for _, v := range changes.BankCards {
+		result = append(result, v.Revision)
+	}
+	for _, v := range changes.OTPs {
+		result = append(result, v.Revision)
+	}
+	for _, v := range changes.SSHKeys {
+		result = append(result, v.Revision)
+	}
+	for _, v := range changes.Items {
+		result = append(result, v.Revision)
+	}
+	for _, v := range changes.Folders {
+		result = append(result, v.Revision)
+	}
+	for _, v := range changes.Tags {
+		result = append(result, v.Revision)
+	}
+	for _, v := range changes.Templates {
+		result = append(result, v.Revision)
+	}
+	for _, v := range changes.Labels {
+		result = append(result, v.Revision)
+	}
+	for _, v := range changes.Tombstones {
+		result = append(result, v.Revision)
+	}
+
+	return result
+}
+
+// truncate - Оставляет изменения с ревизией не больше cutoff
+func truncate(changes *domain.Changes, cutoff int64) {
+	changes.Texts = slices.DeleteFunc(changes.Texts, func(v domain.Text) bool { return v.Revision > cutoff })
+	changes.Binaries = slices.DeleteFunc(changes.Binaries, func(v domain.Binary) bool { return v.Revision > cutoff })
+	changes.Credentials = slices.DeleteFunc(changes.Credentials, func(v *domain.Credentials) bool { return v.Revision > cutoff })
+	changes.BankCards = slices.DeleteFunc(changes.BankCards, func(v *domain.BankCard) bool { return v.Revision > cutoff })
+	changes.OTPs = slices.DeleteFunc(changes.OTPs, func(v *domain.OTP) bool { return v.Revision > cutoff })
+	changes.SSHKeys = slices.DeleteFunc(changes.SSHKeys, func(v *domain.SSHKey) bool { return v.Revision > cutoff })
+	changes.Items = slices.DeleteFunc(changes.Items, func(v *domain.Item) bool { return v.Revision > cutoff })
+	changes.Folders = slices.DeleteFunc(changes.Folders, func(v *domain.Folder) bool { return v.Revision > cutoff })
+	changes.Tags = slices.DeleteFunc(changes.Tags, func(v *domain.Tag) bool { return v.Revision > cutoff })
+	changes.Templates = slices.DeleteFunc(changes.Templates, func(v *domain.ItemTemplate) bool { return v.Revision > cutoff })
+	changes.Labels = slices.DeleteFunc(changes.Labels, func(v domain.Labels) bool { return v.Revision > cutoff })
+	changes.Tombstones = slices.DeleteFunc(changes.Tombstones, func(v domain.Tombstone) bool { return v.Revision > cutoff })
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+	texts *txtrepo.TextRepository,
+	binaries *binrepo.BinaryRepository,
+	credentials *crederepo.CredentialsRepository,
+	bankCards *bcardrepo.BankCardRepository,
+	otps *otprepo.OTPRepository,
+	sshKeys *sshrepo.SSHKeyRepository,
+	items *itemrepo.ItemRepository,
+	folders *folderrepo.FolderRepository,
+	tags *tagrepo.TagRepository,
+	templates *tmplrepo.TemplateRepository,
+	labels *labelrepo.LabelRepository,
+	tombstones *tmbrepo.TombstoneRepository,
+) *ChangesRepository {
+	return &ChangesRepository{
+		DBPool:      dbPool,
+		Timeout:     timeout,
+		log:         log,
+		texts:       texts,
+		binaries:    binaries,
+		credentials: credentials,
+		bankCards:   bankCards,
+		otps:        otps,
+		sshKeys:     sshKeys,
+		items:       items,
+		folders:     folders,
+		tags:        tags,
+		templates:   templates,
+		labels:      labels,
+		tombstones:  tombstones,
+	}
+}
@@ -62,6 +62,7 @@ func (r CredentialsRepository) Create(cred *domain.Credentials) error {
 }
 
 // Update - Обновляет существующую пару логин и пароль
+// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
 func (r CredentialsRepository) Update(cred *domain.Credentials) error {
 	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
 	defer cancel()
@@ -72,9 +73,13 @@ func (r CredentialsRepository) Update(cred *domain.Credentials) error {
 			, login = @login
 			, password = @password
 			, meta = @meta
+			, version = credentials_data.version + 1
+			, revision = nextval('revision_seq')
+			, updated_at = now()
 		WHERE
 			credentials_data.id = @id
 		    AND credentials_data.user_id = @userID
+		    AND credentials_data.version = @version
 		;`
 
 	args := pgx.NamedArgs{
@@ -84,10 +89,17 @@ func (r CredentialsRepository) Update(cred *domain.Credentials) error {
 		"login":    cred.Login,
 		"password": cred.Password,
 		"meta":     cred.Meta,
+		"version":  cred.Version,
+	}
+	tag, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrVersionConflict
 	}
-	_, err := r.DBPool.Exec(ctx, sql, args)
 
-	return err
+	return nil
 }
 
 // Get - Возвращает пару логин и пароль по идентификатору пользователя и данных, если они существуют
@@ -104,6 +116,9 @@ func (r CredentialsRepository) Get(userID, credID uuid.UUID) (*domain.Credential
 			, credentials_data.login
 			, credentials_data.password
 			, credentials_data.meta
+			, credentials_data.version
+			, credentials_data.revision
+			, credentials_data.updated_at
 		FROM
 			credentials_data
 		WHERE
@@ -123,6 +138,9 @@ func (r CredentialsRepository) Get(userID, credID uuid.UUID) (*domain.Credential
 			&cred.Login,
 			&cred.Password,
 			&cred.Meta,
+			&cred.Version,
+			&cred.Revision,
+			&cred.UpdatedAt,
 		)
 
 	if err != nil {
@@ -136,11 +154,9 @@ func (r CredentialsRepository) Get(userID, credID uuid.UUID) (*domain.Credential
 	return &cred, err
 }
 
-// GetAll - Возвращает список пар логин и пароль, принадлежащих пользователю
-func (r CredentialsRepository) GetAll(userID uuid.UUID) ([]*domain.Credentials, error) {
-	result := []*domain.Credentials{}
-	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
-	defer cancel()
+// GetAll - Возвращает страницу списка пар логин и пароль пользователя, упорядоченного по времени создания,
+// и курсор следующей страницы, если она есть
+func (r CredentialsRepository) GetAll(userID uuid.UUID, page domain.Page) ([]*domain.Credentials, *domain.Cursor, error) {
 	sql := `
 		SELECT
 			credentials_data.id
@@ -149,19 +165,104 @@ func (r CredentialsRepository) GetAll(userID uuid.UUID) ([]*domain.Credentials,
 			, credentials_data.login
 			, credentials_data.password
 			, credentials_data.meta
+			, credentials_data.version
+			, credentials_data.revision
+			, credentials_data.updated_at
+			, credentials_data.created_at
 		FROM
 			credentials_data
 		WHERE
 			credentials_data.user_id = @userID
+			AND (@first::boolean OR (credentials_data.created_at, credentials_data.id) > (@createdAt::timestamptz, @id::uuid))
+		ORDER BY
+			credentials_data.created_at
+			, credentials_data.id
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID":    userID,
+		"first":     page.After == nil,
+		"createdAt": time.Time{},
+		"id":        uuid.Nil,
+		"limit":     page.Limit + 1,
+	}
+	if page.After != nil {
+		args["createdAt"] = page.After.CreatedAt
+		args["id"] = page.After.ID
+	}
+
+	result, err := r.query(sql, args)
+	if err != nil {
+		return result, nil, err
+	}
+	result, next := domain.Paginate(result, page.Limit, func(v *domain.Credentials) domain.Cursor {
+		return domain.Cursor{CreatedAt: v.CreatedAt, ID: v.ID}
+	})
+
+	return result, next, nil
+}
+
+// GetSince - Возвращает логины и пароли пользователя, измененные после ревизии since, в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r CredentialsRepository) GetSince(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID uuid.UUID,
+	since int64,
+	limit int,
+) ([]*domain.Credentials, error) {
+	sql := `
+		SELECT
+			credentials_data.id
+			, credentials_data.user_id
+			, credentials_data.name
+			, credentials_data.login
+			, credentials_data.password
+			, credentials_data.meta
+			, credentials_data.version
+			, credentials_data.revision
+			, credentials_data.updated_at
+			, credentials_data.created_at
+		FROM
+			credentials_data
+		WHERE
+			credentials_data.user_id = @userID
+			AND credentials_data.revision > @since
+		ORDER BY
+			credentials_data.revision
+		LIMIT @limit
 		;`
 	args := pgx.NamedArgs{
 		"userID": userID,
+		"since":  since,
+		"limit":  limit,
+	}
+
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.Credentials{}, err
 	}
 
+	return scan(rows)
+}
+
+// query - Выполняет запрос списка и возвращает выбранные строки
+func (r CredentialsRepository) query(sql string, args pgx.NamedArgs) ([]*domain.Credentials, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
 	rows, err := r.DBPool.Query(ctx, sql, args)
 	if err != nil {
-		return result, err
+		return []*domain.Credentials{}, err
 	}
+
+	return scan(rows)
+}
+
+// scan - Читает и закрывает строки результата запроса
+func scan(rows pgx.Rows) ([]*domain.Credentials, error) {
+	result := []*domain.Credentials{}
+	var err error
 	defer rows.Close()
 	for rows.Next() {
 		var cred domain.Credentials
@@ -172,6 +273,10 @@ func (r CredentialsRepository) GetAll(userID uuid.UUID) ([]*domain.Credentials,
 			&cred.Login,
 			&cred.Password,
 			&cred.Meta,
+			&cred.Version,
+			&cred.Revision,
+			&cred.UpdatedAt,
+			&cred.CreatedAt,
 		)
 		if err == nil {
 			result = append(result, &cred)
@@ -184,6 +289,39 @@ func (r CredentialsRepository) GetAll(userID uuid.UUID) ([]*domain.Credentials,
 	return result, err
 }
 
+// Delete - Удаляет пару логин и пароль по идентификатору пользователя и данных
+func (r CredentialsRepository) Delete(userID, credID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	// Вместе с удалением сохраняем запись об удалении для инкрементальной синхронизации клиентов,
+	// папка и метки данных удаляются в том же запросе, клиенты удаляют их локально по записи об удалении
+	sql := `
+		WITH deleted AS (
+			DELETE FROM credentials_data
+			WHERE
+				credentials_data.id = @credID
+			    AND credentials_data.user_id = @userID
+			RETURNING credentials_data.id, credentials_data.user_id
+		), deleted_labels AS (
+			DELETE FROM labels
+			USING deleted
+			WHERE
+				labels.entity_id = deleted.id
+				AND labels.user_id = deleted.user_id
+		)
+		INSERT INTO tombstones (id, user_id, kind)
+		SELECT deleted.id, deleted.user_id, @kind FROM deleted
+		;`
+	args := pgx.NamedArgs{
+		"credID": credID,
+		"userID": userID,
+		"kind":   domain.CredentialsKind,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
 // New - Возвращает новый инстанс репозитория
 func New(
 	dbPool *pgxpool.Pool,
@@ -0,0 +1,296 @@
+// Package folderrepository содержит имлементацию интерфейса репозитория FolderRepositoryInterface
+package folderrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// FolderRepository - Имплементация репозитория для папок
+type FolderRepository struct {
+	// DBPool - Интерфейс пула соединений pgxpool
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+// Create - Сохраняет новую папку
+func (r FolderRepository) Create(folder *domain.Folder) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO folders
+		(
+			id
+			, user_id
+			, parent_id
+			, name
+		)
+		VALUES
+		(
+			@id
+			, @userID
+			, @parentID
+			, @name
+		)
+		;`
+	args := pgx.NamedArgs{
+		"id":       folder.ID,
+		"userID":   folder.UserID,
+		"parentID": folder.ParentID,
+		"name":     folder.Name,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// Update - Сохраняет новое имя и родительскую папку существующей папки
+func (r FolderRepository) Update(folder *domain.Folder) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE folders
+		SET
+			parent_id = @parentID
+			, name = @name
+			, revision = nextval('revision_seq')
+			, updated_at = now()
+		WHERE
+			folders.id = @id
+		    AND folders.user_id = @userID
+		;`
+	args := pgx.NamedArgs{
+		"id":       folder.ID,
+		"userID":   folder.UserID,
+		"parentID": folder.ParentID,
+		"name":     folder.Name,
+	}
+	tag, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// Get - Возвращает папку по идентификатору пользователя и папки, если она существует
+func (r FolderRepository) Get(userID, folderID uuid.UUID) (*domain.Folder, error) {
+	var folder domain.Folder
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			folders.id
+			, folders.user_id
+			, folders.parent_id
+			, folders.name
+			, folders.revision
+			, folders.updated_at
+		FROM
+			folders
+		WHERE
+			folders.id = @folderID
+		    AND folders.user_id = @userID
+		;`
+	args := pgx.NamedArgs{
+		"folderID": folderID,
+		"userID":   userID,
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(
+			&folder.ID,
+			&folder.UserID,
+			&folder.ParentID,
+			&folder.Name,
+			&folder.Revision,
+			&folder.UpdatedAt,
+		)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &folder, err
+}
+
+// GetAll - Возвращает все папки пользователя
+func (r FolderRepository) GetAll(userID uuid.UUID) ([]*domain.Folder, error) {
+	sql := `
+		SELECT
+			folders.id
+			, folders.user_id
+			, folders.parent_id
+			, folders.name
+			, folders.revision
+			, folders.updated_at
+		FROM
+			folders
+		WHERE
+			folders.user_id = @userID
+		ORDER BY
+			folders.created_at
+			, folders.id
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+	}
+
+	return r.query(sql, args)
+}
+
+// GetSince - Возвращает папки пользователя, измененные после ревизии since, в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r FolderRepository) GetSince(ctx context.Context, tx pgx.Tx, userID uuid.UUID, since int64, limit int) ([]*domain.Folder, error) {
+	sql := `
+		SELECT
+			folders.id
+			, folders.user_id
+			, folders.parent_id
+			, folders.name
+			, folders.revision
+			, folders.updated_at
+		FROM
+			folders
+		WHERE
+			folders.user_id = @userID
+			AND folders.revision > @since
+		ORDER BY
+			folders.revision
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+		"since":  since,
+		"limit":  limit,
+	}
+
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.Folder{}, err
+	}
+
+	return scan(rows)
+}
+
+// query - Выполняет запрос списка и возвращает выбранные строки
+func (r FolderRepository) query(sql string, args pgx.NamedArgs) ([]*domain.Folder, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	rows, err := r.DBPool.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.Folder{}, err
+	}
+
+	return scan(rows)
+}
+
+// scan - Читает и закрывает строки результата запроса
+func scan(rows pgx.Rows) ([]*domain.Folder, error) {
+	result := []*domain.Folder{}
+	var err error
+	defer rows.Close()
+	for rows.Next() {
+		var folder domain.Folder
+		err = rows.Scan(
+			&folder.ID,
+			&folder.UserID,
+			&folder.ParentID,
+			&folder.Name,
+			&folder.Revision,
+			&folder.UpdatedAt,
+		)
+		if err == nil {
+			result = append(result, &folder)
+		}
+	}
+	if rows.Err() != nil {
+		return result, err
+	}
+
+	return result, err
+}
+
+// Delete - Удаляет папку, вложенные папки и данные из нее переносятся в родительскую папку
+func (r FolderRepository) Delete(userID, folderID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	// Перенесенные папки и данные получают новую ревизию, чтобы клиенты получили их вместе с удалением
+	sql := `
+		WITH target AS (
+			SELECT folders.id, folders.user_id, folders.parent_id
+			FROM folders
+			WHERE
+				folders.id = @folderID
+			    AND folders.user_id = @userID
+		), children AS (
+			UPDATE folders
+			SET
+				parent_id = target.parent_id
+				, revision = nextval('revision_seq')
+				, updated_at = now()
+			FROM target
+			WHERE
+				folders.parent_id = target.id
+			    AND folders.user_id = target.user_id
+		), contents AS (
+			UPDATE labels
+			SET
+				folder_id = target.parent_id
+				, revision = nextval('revision_seq')
+				, updated_at = now()
+			FROM target
+			WHERE
+				labels.folder_id = target.id
+			    AND labels.user_id = target.user_id
+		), deleted AS (
+			DELETE FROM folders
+			USING target
+			WHERE folders.id = target.id
+			RETURNING folders.id, folders.user_id
+		)
+		INSERT INTO tombstones (id, user_id, kind)
+		SELECT deleted.id, deleted.user_id, @kind FROM deleted
+		;`
+	args := pgx.NamedArgs{
+		"folderID": folderID,
+		"userID":   userID,
+		"kind":     domain.FolderKind,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *FolderRepository {
+	return &FolderRepository{
+		DBPool:  dbPool,
+		Timeout: timeout,
+		log:     log,
+	}
+}
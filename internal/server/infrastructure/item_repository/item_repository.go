@@ -0,0 +1,331 @@
+// Package itemrepository содержит имлементацию интерфейса репозитория ItemRepositoryInterface
+package itemrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// ItemRepository - Имплементация репозитория для данных по шаблону
+type ItemRepository struct {
+	// DBPool - Интерфейс пула соединений pgxpool
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+// Create - Сохраняет новые данные по шаблону
+func (r ItemRepository) Create(item *domain.Item) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO item_data
+		(
+			id
+			, user_id
+			, name
+			, template
+			, fields
+			, meta
+		)
+		VALUES
+		(
+			@id
+			, @userID
+			, @name
+			, @template
+			, @fields
+			, @meta
+		)
+		;`
+	args := pgx.NamedArgs{
+		"id":       item.ID,
+		"userID":   item.UserID,
+		"name":     item.Name,
+		"template": item.Template,
+		"fields":   item.Fields,
+		"meta":     item.Meta,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// Update - Обновляет существующие данные по шаблону
+// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
+func (r ItemRepository) Update(item *domain.Item) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE item_data
+		SET 
+			name = @name
+			, template = @template
+			, fields = @fields
+			, meta = @meta
+			, version = item_data.version + 1
+			, revision = nextval('revision_seq')
+			, updated_at = now()
+		WHERE
+			item_data.id = @id
+		    AND item_data.user_id = @userID
+		    AND item_data.version = @version
+		;`
+
+	args := pgx.NamedArgs{
+		"id":       item.ID,
+		"userID":   item.UserID,
+		"name":     item.Name,
+		"template": item.Template,
+		"fields":   item.Fields,
+		"meta":     item.Meta,
+		"version":  item.Version,
+	}
+	tag, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrVersionConflict
+	}
+
+	return nil
+}
+
+// Get - Возвращает данные по шаблону по идентификатору пользователя и данных, если они существуют
+func (r ItemRepository) Get(userID, itemID uuid.UUID) (*domain.Item, error) {
+	var item domain.Item
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			item_data.id
+			, item_data.user_id
+			, item_data.name
+			, item_data.template
+			, item_data.fields
+			, item_data.meta
+			, item_data.version
+			, item_data.revision
+			, item_data.updated_at
+		FROM
+			item_data
+		WHERE
+			item_data.id = @itemID
+		    AND item_data.user_id = @userID
+		;`
+	args := pgx.NamedArgs{
+		"itemID": itemID,
+		"userID": userID,
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(
+			&item.ID,
+			&item.UserID,
+			&item.Name,
+			&item.Template,
+			&item.Fields,
+			&item.Meta,
+			&item.Version,
+			&item.Revision,
+			&item.UpdatedAt,
+		)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &item, err
+}
+
+// GetAll - Возвращает страницу списка данных по шаблону пользователя, упорядоченного
+// по времени создания, и курсор следующей страницы, если она есть
+func (r ItemRepository) GetAll(userID uuid.UUID, page domain.Page) ([]*domain.Item, *domain.Cursor, error) {
+	sql := `
+		SELECT
+			item_data.id
+			, item_data.user_id
+			, item_data.name
+			, item_data.template
+			, item_data.fields
+			, item_data.meta
+			, item_data.version
+			, item_data.revision
+			, item_data.updated_at
+			, item_data.created_at
+		FROM
+			item_data
+		WHERE
+			item_data.user_id = @userID
+			AND (@first::boolean OR (item_data.created_at, item_data.id) > (@createdAt::timestamptz, @id::uuid))
+		ORDER BY
+			item_data.created_at
+			, item_data.id
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID":    userID,
+		"first":     page.After == nil,
+		"createdAt": time.Time{},
+		"id":        uuid.Nil,
+		"limit":     page.Limit + 1,
+	}
+	if page.After != nil {
+		args["createdAt"] = page.After.CreatedAt
+		args["id"] = page.After.ID
+	}
+
+	result, err := r.query(sql, args)
+	if err != nil {
+		return result, nil, err
+	}
+	result, next := domain.Paginate(result, page.Limit, func(v *domain.Item) domain.Cursor {
+		return domain.Cursor{CreatedAt: v.CreatedAt, ID: v.ID}
+	})
+
+	return result, next, nil
+}
+
+// GetSince - Возвращает данные по шаблону пользователя, измененные после ревизии since,
+// в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r ItemRepository) GetSince(ctx context.Context, tx pgx.Tx, userID uuid.UUID, since int64, limit int) ([]*domain.Item, error) {
+	sql := `
+		SELECT
+			item_data.id
+			, item_data.user_id
+			, item_data.name
+			, item_data.template
+			, item_data.fields
+			, item_data.meta
+			, item_data.version
+			, item_data.revision
+			, item_data.updated_at
+			, item_data.created_at
+		FROM
+			item_data
+		WHERE
+			item_data.user_id = @userID
+			AND item_data.revision > @since
+		ORDER BY
+			item_data.revision
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+		"since":  since,
+		"limit":  limit,
+	}
+
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.Item{}, err
+	}
+
+	return scan(rows)
+}
+
+// query - Выполняет запрос списка и возвращает выбранные строки
+func (r ItemRepository) query(sql string, args pgx.NamedArgs) ([]*domain.Item, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	rows, err := r.DBPool.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.Item{}, err
+	}
+
+	return scan(rows)
+}
+
+// scan - Читает и закрывает строки результата запроса
+func scan(rows pgx.Rows) ([]*domain.Item, error) {
+	result := []*domain.Item{}
+	var err error
+	defer rows.Close()
+	for rows.Next() {
+		var item domain.Item
+		err = rows.Scan(
+			&item.ID,
+			&item.UserID,
+			&item.Name,
+			&item.Template,
+			&item.Fields,
+			&item.Meta,
+			&item.Version,
+			&item.Revision,
+			&item.UpdatedAt,
+			&item.CreatedAt,
+		)
+		if err == nil {
+			result = append(result, &item)
+		}
+	}
+	if rows.Err() != nil {
+		return result, err
+	}
+
+	return result, err
+}
+
+// Delete - Удаляет данные по шаблону по идентификатору пользователя и данных
+func (r ItemRepository) Delete(userID, itemID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	// Вместе с удалением сохраняем запись об удалении для инкрементальной синхронизации клиентов,
+	// папка и метки данных удаляются в том же запросе, клиенты удаляют их локально по записи об удалении
+	sql := `
+		WITH deleted AS (
+			DELETE FROM item_data
+			WHERE
+				item_data.id = @itemID
+			    AND item_data.user_id = @userID
+			RETURNING item_data.id, item_data.user_id
+		), deleted_labels AS (
+			DELETE FROM labels
+			USING deleted
+			WHERE
+				labels.entity_id = deleted.id
+				AND labels.user_id = deleted.user_id
+		)
+		INSERT INTO tombstones (id, user_id, kind)
+		SELECT deleted.id, deleted.user_id, @kind FROM deleted
+		;`
+	args := pgx.NamedArgs{
+		"itemID": itemID,
+		"userID": userID,
+		"kind":   domain.ItemKind,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *ItemRepository {
+	return &ItemRepository{
+		DBPool:  dbPool,
+		Timeout: timeout,
+		log:     log,
+	}
+}
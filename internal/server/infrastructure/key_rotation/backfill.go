@@ -0,0 +1,348 @@
+package keyrotation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
+)
+
+// userWithoutKey - Пользователь, у которого нет персонального ключа
+type userWithoutKey struct {
+	ID  uuid.UUID
+	E2E bool
+}
+
+// usersWithoutKey - Возвращает пачку пользователей, у которых нет персонального ключа
+func (r Rotator) usersWithoutKey() ([]userWithoutKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			users.id
+			, users.e2e
+		FROM
+			users
+		WHERE
+			NOT EXISTS (
+				SELECT 1 FROM user_keys WHERE user_keys.user_id = users.id
+			)
+		ORDER BY users.id
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"limit": r.BatchSize,
+	}
+	rows, err := r.DBPool.Query(ctx, sql, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[userWithoutKey])
+}
+
+// selectUserBatch - Возвращает пачку записей пользователя в порядке первичного ключа и блокирует их до конца транзакции
+func (r Rotator) selectUserBatch(ctx context.Context, tx pgx.Tx, t table, userID, after uuid.UUID) ([]row, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.Timeout)
+	defer cancel()
+	sql := fmt.Sprintf(`
+		SELECT %[2]s, %[3]s
+		FROM %[1]s
+		WHERE
+			user_id = @userID
+			AND %[2]s > @after
+		ORDER BY %[2]s
+		LIMIT @limit
+		FOR UPDATE
+		;`, t.name, t.key, strings.Join(t.columns, ", "))
+	args := pgx.NamedArgs{
+		"userID": userID,
+		"after":  after,
+		"limit":  r.BatchSize,
+	}
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []row{}
+	for rows.Next() {
+		item := row{values: make([][]byte, len(t.columns))}
+		dest := []any{&item.id}
+		for i := range item.values {
+			dest = append(dest, &item.values[i])
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+
+	return result, rows.Err()
+}
+
+// backfillTable - Перешифровывает записи пользователя из мастер-ключа персональным ключом.
+// Пустые значения не зашифрованы и сохраняются как есть
+func (r Rotator) backfillTable(
+	ctx context.Context,
+	tx pgx.Tx,
+	t table,
+	userID uuid.UUID,
+	userCrypto *crypto.CryptoService,
+) (int, error) {
+	set := make([]string, 0, len(t.columns))
+	for i, column := range t.columns {
+		set = append(set, fmt.Sprintf("%s = $%d", column, i+2))
+	}
+	sql := fmt.Sprintf(`UPDATE %s SET %s WHERE %s = $1;`, t.name, strings.Join(set, ", "), t.key)
+
+	processed := 0
+	after := uuid.Nil
+	for {
+		rows, err := r.selectUserBatch(ctx, tx, t, userID, after)
+		if err != nil {
+			return processed, err
+		}
+		if len(rows) == 0 {
+			return processed, nil
+		}
+		for _, item := range rows {
+			args := []any{item.id}
+			for _, value := range item.values {
+				if len(value) == 0 {
+					args = append(args, value)
+
+					continue
+				}
+				decrypted, err := r.Crypto.Decrypt(value)
+				if err != nil {
+					return processed, fmt.Errorf("%s %s: %w", t.name, item.id, err)
+				}
+				encrypted, err := userCrypto.Encrypt(decrypted)
+				if err != nil {
+					return processed, err
+				}
+				args = append(args, encrypted)
+			}
+			if err = r.exec(ctx, tx, sql, args...); err != nil {
+				return processed, err
+			}
+		}
+		processed += len(rows)
+		after = rows[len(rows)-1].id
+	}
+}
+
+func (r Rotator) exec(ctx context.Context, tx pgx.Tx, sql string, args ...any) error {
+	ctx, cancel := context.WithTimeout(ctx, r.Timeout)
+	defer cancel()
+	_, err := tx.Exec(ctx, sql, args...)
+
+	return err
+}
+
+// backfillUser - Выдает пользователю персональный ключ и перешифровывает им данные пользователя в одной транзакции.
+// У пользователя со сквозным шифрованием перешифровываются только секреты, которые сервер использует сам
+func (r Rotator) backfillUser(userID uuid.UUID, e2e bool) error {
+	dataKey, err := crypto.GenerateKey()
+	if err != nil {
+		return err
+	}
+	userCrypto, err := crypto.New(dataKey)
+	if err != nil {
+		return err
+	}
+	wrappedKey, err := r.Crypto.Encrypt(dataKey)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	return pgx.BeginFunc(ctx, r.DBPool, func(tx pgx.Tx) error {
+		for _, t := range tables {
+			if !t.legacy || (e2e && !t.server) {
+				continue
+			}
+			count, err := r.backfillTable(ctx, tx, t, userID, userCrypto)
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				r.log.Infof("user %s: %s: re-encrypted %d", userID, t.name, count)
+			}
+		}
+		sql := `
+			INSERT INTO user_keys
+			(
+				user_id
+				, key
+			)
+			VALUES
+			(
+				@userID
+				, @key
+			)
+			;`
+		args := pgx.NamedArgs{
+			"userID": userID,
+			"key":    wrappedKey,
+		}
+
+		return r.exec(ctx, tx, sql, args)
+	})
+}
+
+// Backfill - Выдает персональные ключи пользователям, зарегистрированным до их появления,
+// и перешифровывает их данные из мастер-ключа персональным ключом, затем перешифровывает персональными ключами
+// секреты, которые сервер использует сам и раньше шифровал мастер-ключом.
+// Каждый пользователь обрабатывается в отдельной транзакции, поэтому прерванное заполнение можно запустить повторно
+func (r Rotator) Backfill() error {
+	total := 0
+	for {
+		users, err := r.usersWithoutKey()
+		if err != nil {
+			return err
+		}
+		if len(users) == 0 {
+			break
+		}
+		for _, user := range users {
+			if err = r.backfillUser(user.ID, user.E2E); err != nil {
+				return fmt.Errorf("user %s: %w", user.ID, err)
+			}
+		}
+		total += len(users)
+		r.log.Infof("user keys: issued %d", total)
+	}
+	r.log.Infof("user keys: done, issued %d", total)
+
+	for _, t := range tables {
+		if !t.server {
+			continue
+		}
+		if err := r.backfillSecrets(t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// selectSecretsBatch - Возвращает пачку записей пользователей, у которых есть персональный ключ,
+// и зашифрованные мастер-ключом персональные ключи по идентификатору записи
+func (r Rotator) selectSecretsBatch(t table, after uuid.UUID) ([]row, map[uuid.UUID][]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	columns := make([]string, 0, len(t.columns))
+	for _, column := range t.columns {
+		columns = append(columns, t.name+"."+column)
+	}
+	sql := fmt.Sprintf(`
+		SELECT %[1]s.%[2]s, %[3]s, user_keys.key
+		FROM %[1]s
+		JOIN user_keys ON user_keys.user_id = %[1]s.user_id
+		WHERE
+			%[1]s.%[2]s > @after
+		ORDER BY %[1]s.%[2]s
+		LIMIT @limit
+		;`, t.name, t.key, strings.Join(columns, ", "))
+	args := pgx.NamedArgs{
+		"after": after,
+		"limit": r.BatchSize,
+	}
+	rows, err := r.DBPool.Query(ctx, sql, args)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	result := []row{}
+	keys := map[uuid.UUID][]byte{}
+	for rows.Next() {
+		var key []byte
+		item := row{values: make([][]byte, len(t.columns))}
+		dest := []any{&item.id}
+		for i := range item.values {
+			dest = append(dest, &item.values[i])
+		}
+		if err = rows.Scan(append(dest, &key)...); err != nil {
+			return nil, nil, err
+		}
+		result = append(result, item)
+		keys[item.id] = key
+	}
+
+	return result, keys, rows.Err()
+}
+
+// reencryptSecret - Перешифровывает значения записи из мастер-ключа персональным ключом userKey,
+// возвращает false, если значения уже зашифрованы персональным ключом и не расшифровываются мастер-ключом
+func (r Rotator) reencryptSecret(item row, userKey []byte) ([][]byte, bool, error) {
+	dataKey, err := r.Crypto.Decrypt(userKey)
+	if err != nil {
+		return nil, false, err
+	}
+	userCrypto, err := crypto.New(dataKey)
+	if err != nil {
+		return nil, false, err
+	}
+
+	values := make([][]byte, len(item.values))
+	changed := false
+	for i, value := range item.values {
+		if len(value) == 0 {
+			values[i] = value
+
+			continue
+		}
+		decrypted, err := r.Crypto.Decrypt(value)
+		if errors.Is(err, crypto.ErrUnknownKey) {
+			values[i] = value
+
+			continue
+		} else if err != nil {
+			return nil, false, err
+		}
+		values[i], err = userCrypto.Encrypt(decrypted)
+		if err != nil {
+			return nil, false, err
+		}
+		changed = true
+	}
+
+	return values, changed, nil
+}
+
+// backfillSecrets - Перешифровывает персональными ключами секреты таблицы, которые сервер раньше шифровал
+// мастер-ключом, у пользователей, которым персональный ключ уже выдан
+func (r Rotator) backfillSecrets(t table) error {
+	rotated := 0
+	after := uuid.Nil
+	for {
+		rows, keys, err := r.selectSecretsBatch(t, after)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		count, err := r.updateBatch(t, rows, func(item row) ([][]byte, bool, error) {
+			return r.reencryptSecret(item, keys[item.id])
+		})
+		if err != nil {
+			return err
+		}
+		rotated += count
+		after = rows[len(rows)-1].id
+	}
+	r.log.Infof("%s: done, re-encrypted with user keys %d", t.name, rotated)
+
+	return nil
+}
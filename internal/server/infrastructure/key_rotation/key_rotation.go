@@ -0,0 +1,253 @@
+// Package keyrotation содержит перешифрование данных сервера при ротации мастер-ключа
+package keyrotation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
+)
+
+// table - Таблица с данными, зашифрованными мастер-ключом
+type table struct {
+	// name - Название таблицы
+	name string
+	// key - Первичный ключ таблицы
+	key string
+	// columns - Зашифрованные колонки
+	columns []string
+	// legacy - Данные пользователя: мастер-ключом они зашифрованы только у пользователей, зарегистрированных
+	// до появления персональных ключей, и перешифровываются персональным ключом при выдаче ключа
+	legacy bool
+	// server - Секреты, которые сервер использует сам: они шифруются персональным ключом и у пользователей
+	// со сквозным шифрованием, а данные других таблиц таких пользователей зашифрованы на клиенте
+	server bool
+}
+
+var tables = []table{
+	{name: "user_keys", key: "user_id", columns: []string{"key"}},
+	{name: "two_factor", key: "user_id", columns: []string{"secret"}, legacy: true, server: true},
+	{name: "text_data", key: "id", columns: []string{"content"}, legacy: true},
+	{name: "binary_data", key: "id", columns: []string{"content", "name", "mime_type", "sha256", "meta"}, legacy: true},
+	{name: "binary_chunks", key: "id", columns: []string{"content"}, legacy: true},
+	{name: "credentials_data", key: "id", columns: []string{"name", "login", "password", "meta"}, legacy: true},
+	{name: "bank_card_data", key: "id", columns: []string{"number", "valid_thru", "cvv", "card_holder", "meta"}, legacy: true},
+	{name: "otp_data", key: "id", columns: []string{"issuer", "account", "secret", "algorithm", "digits", "period", "meta"}, legacy: true},
+	{name: "ssh_key_data", key: "id", columns: []string{"private_key", "public_key", "comment", "fingerprint", "meta"}, legacy: true},
+	{name: "item_data", key: "id", columns: []string{"name", "template", "fields", "meta"}, legacy: true},
+	{name: "folders", key: "id", columns: []string{"name"}, legacy: true},
+	{name: "tags", key: "id", columns: []string{"name"}, legacy: true},
+	{name: "item_templates", key: "id", columns: []string{"name", "description", "fields"}, legacy: true},
+}
+
+// row - Запись таблицы с зашифрованными значениями
+type row struct {
+	id     uuid.UUID
+	values [][]byte
+}
+
+// Rotator - Перешифровывает данные, зашифрованные мастер-ключом, текущим ключом набора.
+// Записи обрабатываются пачками в порядке первичного ключа, уже перешифрованные записи пропускаются,
+// поэтому прерванную ротацию можно запустить повторно
+type Rotator struct {
+	// DBPool - Пул соединений pgx
+	DBPool *pgxpool.Pool
+	// Crypto - Набор ключей, текущий ключ используется для шифрования
+	Crypto *crypto.KeyRing
+	// BatchSize - Количество записей, обрабатываемых в одной транзакции
+	BatchSize int
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+func (r Rotator) count(t table) (int, error) {
+	var total int
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := fmt.Sprintf(`SELECT count(*) FROM %s;`, t.name)
+	err := r.DBPool.QueryRow(ctx, sql).Scan(&total)
+
+	return total, err
+}
+
+func (r Rotator) selectBatch(t table, after uuid.UUID) ([]row, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := fmt.Sprintf(`
+		SELECT %[2]s, %[3]s
+		FROM %[1]s
+		WHERE
+			%[2]s > @after
+		ORDER BY %[2]s
+		LIMIT @limit
+		;`, t.name, t.key, strings.Join(t.columns, ", "))
+	args := pgx.NamedArgs{
+		"after": after,
+		"limit": r.BatchSize,
+	}
+	rows, err := r.DBPool.Query(ctx, sql, args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []row{}
+	for rows.Next() {
+		item := row{values: make([][]byte, len(t.columns))}
+		dest := []any{&item.id}
+		for i := range item.values {
+			dest = append(dest, &item.values[i])
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+
+	return result, rows.Err()
+}
+
+// reencrypt - Перешифровывает значения записи текущим ключом, возвращает false, если запись уже перешифрована.
+// Пустые значения не зашифрованы, например метаданные бинарных данных, сохраненных до их появления
+func (r Rotator) reencrypt(item row) ([][]byte, bool, error) {
+	values := make([][]byte, len(item.values))
+	changed := false
+	for i, value := range item.values {
+		if len(value) == 0 || r.Crypto.IsCurrent(value) {
+			values[i] = value
+
+			continue
+		}
+		decrypted, err := r.Crypto.Decrypt(value)
+		if err != nil {
+			return nil, false, err
+		}
+		values[i], err = r.Crypto.Encrypt(decrypted)
+		if err != nil {
+			return nil, false, err
+		}
+		changed = true
+	}
+
+	return values, changed, nil
+}
+
+// updateBatch - Сохраняет значения пачки записей, перешифрованные функцией reencrypt, в одной транзакции.
+// Запись обновляется, только если ее значения не изменились с момента чтения,
+// иначе ее уже перезаписал сервер, который шифрует данные текущим ключом
+func (r Rotator) updateBatch(t table, rows []row, reencrypt func(row) ([][]byte, bool, error)) (int, error) {
+	set := make([]string, 0, len(t.columns))
+	where := make([]string, 0, len(t.columns))
+	for i, column := range t.columns {
+		set = append(set, fmt.Sprintf("%s = $%d", column, i+2))
+		where = append(where, fmt.Sprintf("%s = $%d", column, len(t.columns)+i+2))
+	}
+	sql := fmt.Sprintf(`UPDATE %s SET %s WHERE %s = $1 AND %s;`,
+		t.name, strings.Join(set, ", "), t.key, strings.Join(where, " AND "))
+
+	rotated := 0
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	err := pgx.BeginFunc(ctx, r.DBPool, func(tx pgx.Tx) error {
+		for _, item := range rows {
+			values, changed, err := reencrypt(item)
+			if err != nil {
+				return fmt.Errorf("%s %s: %w", t.name, item.id, err)
+			}
+			if !changed {
+				continue
+			}
+			args := []any{item.id}
+			for _, value := range values {
+				args = append(args, value)
+			}
+			for _, value := range item.values {
+				args = append(args, value)
+			}
+			tag, err := tx.Exec(ctx, sql, args...)
+			if err != nil {
+				return err
+			}
+			rotated += int(tag.RowsAffected())
+		}
+
+		return nil
+	})
+
+	return rotated, err
+}
+
+func (r Rotator) rotateTable(t table) error {
+	total, err := r.count(t)
+	if err != nil {
+		return err
+	}
+
+	processed, rotated, count := 0, 0, 0
+	after := uuid.Nil
+	for {
+		var rows []row
+		rows, err = r.selectBatch(t, after)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		count, err = r.updateBatch(t, rows, r.reencrypt)
+		if err != nil {
+			return err
+		}
+		processed += len(rows)
+		rotated += count
+		after = rows[len(rows)-1].id
+		r.log.Infof("%s: processed %d/%d, re-encrypted %d", t.name, processed, total, rotated)
+	}
+	r.log.Infof("%s: done, re-encrypted %d", t.name, rotated)
+
+	return nil
+}
+
+// Run - Выдает недостающие персональные ключи и перешифровывает все данные, зашифрованные мастер-ключом
+func (r Rotator) Run() error {
+	if err := r.Backfill(); err != nil {
+		return err
+	}
+
+	r.log.Infof("rotating master key, current key id %s", r.Crypto.CurrentKeyID())
+	for _, t := range tables {
+		if t.legacy {
+			continue
+		}
+		if err := r.rotateTable(t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// New - Возвращает новый инстанс ротации ключа
+func New(
+	dbPool *pgxpool.Pool,
+	keyRing *crypto.KeyRing,
+	batchSize int,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *Rotator {
+	return &Rotator{
+		DBPool:    dbPool,
+		Crypto:    keyRing,
+		BatchSize: batchSize,
+		Timeout:   timeout,
+		log:       log,
+	}
+}
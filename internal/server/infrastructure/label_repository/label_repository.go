@@ -0,0 +1,227 @@
+// Package labelrepository содержит имлементацию интерфейса репозитория LabelRepositoryInterface
+package labelrepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// entityTables - Таблицы данных по видам, по ним проверяется существование данных
+var entityTables = map[string]string{
+	domain.TextKind:        "text_data",
+	domain.BinaryKind:      "binary_data",
+	domain.CredentialsKind: "credentials_data",
+	domain.BankCardKind:    "bank_card_data",
+	domain.OTPKind:         "otp_data",
+	domain.SSHKeyKind:      "ssh_key_data",
+	domain.ItemKind:        "item_data",
+}
+
+// LabelRepository - Имплементация репозитория для папок и меток данных
+type LabelRepository struct {
+	// DBPool - Интерфейс пула соединений pgxpool
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+// Save - Сохраняет папку и метки данных, заменяя предыдущие.
+// Если у пользователя нет данных вида Kind с идентификатором EntityID, возвращает ErrEntityNotFound
+func (r LabelRepository) Save(labels *domain.Labels) error {
+	table, ok := entityTables[labels.Kind]
+	if !ok {
+		return domain.ErrEntityNotFound
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	// Имя таблицы берется только из entityTables
+	sql := fmt.Sprintf(`
+		INSERT INTO labels
+		(
+			entity_id
+			, user_id
+			, kind
+			, folder_id
+		)
+		SELECT
+			@entityID
+			, @userID
+			, @kind
+			, @folderID
+		WHERE EXISTS (
+			SELECT 1 FROM %[1]s WHERE %[1]s.id = @entityID AND %[1]s.user_id = @userID
+		)
+		ON CONFLICT (entity_id) DO UPDATE
+		SET
+			folder_id = EXCLUDED.folder_id
+			, revision = nextval('revision_seq')
+			, updated_at = now()
+		WHERE
+			labels.user_id = EXCLUDED.user_id
+		;`, table)
+	args := pgx.NamedArgs{
+		"entityID": labels.EntityID,
+		"userID":   labels.UserID,
+		"kind":     labels.Kind,
+		"folderID": labels.FolderID,
+		"tagIDs":   labels.TagIDs,
+	}
+
+	return pgx.BeginFunc(ctx, r.DBPool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, sql, args)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return domain.ErrEntityNotFound
+		}
+		_, err = tx.Exec(ctx, `DELETE FROM label_tags WHERE label_tags.entity_id = @entityID;`, args)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO label_tags (entity_id, tag_id)
+			SELECT DISTINCT @entityID::uuid, tag_id FROM unnest(@tagIDs::uuid[]) AS tag_id
+			;`, args)
+
+		return err
+	})
+}
+
+// Get - Возвращает папку и метки данных, если они были сохранены
+func (r LabelRepository) Get(userID, entityID uuid.UUID) (*domain.Labels, error) {
+	sql := `
+		SELECT
+			labels.entity_id
+			, labels.user_id
+			, labels.kind
+			, labels.folder_id
+			, array_remove(array_agg(label_tags.tag_id ORDER BY label_tags.tag_id), NULL)
+			, labels.revision
+			, labels.updated_at
+		FROM
+			labels
+			LEFT JOIN label_tags ON label_tags.entity_id = labels.entity_id
+		WHERE
+			labels.entity_id = @entityID
+		    AND labels.user_id = @userID
+		GROUP BY
+			labels.entity_id
+		;`
+	args := pgx.NamedArgs{
+		"entityID": entityID,
+		"userID":   userID,
+	}
+	result, err := r.query(sql, args)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, domain.ErrEntityNotFound
+	}
+
+	return &result[0], nil
+}
+
+// GetSince - Возвращает папки и метки данных пользователя, измененные после ревизии since,
+// в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r LabelRepository) GetSince(ctx context.Context, tx pgx.Tx, userID uuid.UUID, since int64, limit int) ([]domain.Labels, error) {
+	sql := `
+		SELECT
+			labels.entity_id
+			, labels.user_id
+			, labels.kind
+			, labels.folder_id
+			, array_remove(array_agg(label_tags.tag_id ORDER BY label_tags.tag_id), NULL)
+			, labels.revision
+			, labels.updated_at
+		FROM
+			labels
+			LEFT JOIN label_tags ON label_tags.entity_id = labels.entity_id
+		WHERE
+			labels.user_id = @userID
+			AND labels.revision > @since
+		GROUP BY
+			labels.entity_id
+		ORDER BY
+			labels.revision
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+		"since":  since,
+		"limit":  limit,
+	}
+
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return []domain.Labels{}, err
+	}
+
+	return scan(rows)
+}
+
+// query - Выполняет запрос списка и возвращает выбранные строки
+func (r LabelRepository) query(sql string, args pgx.NamedArgs) ([]domain.Labels, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	rows, err := r.DBPool.Query(ctx, sql, args)
+	if err != nil {
+		return []domain.Labels{}, err
+	}
+
+	return scan(rows)
+}
+
+// scan - Читает и закрывает строки результата запроса
+func scan(rows pgx.Rows) ([]domain.Labels, error) {
+	result := []domain.Labels{}
+	var err error
+	defer rows.Close()
+	for rows.Next() {
+		var labels domain.Labels
+		err = rows.Scan(
+			&labels.EntityID,
+			&labels.UserID,
+			&labels.Kind,
+			&labels.FolderID,
+			&labels.TagIDs,
+			&labels.Revision,
+			&labels.UpdatedAt,
+		)
+		if err == nil {
+			result = append(result, labels)
+		}
+	}
+	if rows.Err() != nil {
+		return result, err
+	}
+
+	return result, err
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *LabelRepository {
+	return &LabelRepository{
+		DBPool:  dbPool,
+		Timeout: timeout,
+		log:     log,
+	}
+}
@@ -0,0 +1,361 @@
+// Package otprepository содержит имлементацию интерфейса репозитория OTPRepositoryInterface
+package otprepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// OTPRepository - Имплементация репозитория для секретов одноразовых паролей
+type OTPRepository struct {
+	// DBPool - Интерфейс пула соединений pgxpool
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+// Create - Сохраняет новый секрет одноразовых паролей
+func (r OTPRepository) Create(otp *domain.OTP) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO otp_data
+		(
+			id
+			, user_id
+			, issuer
+			, account
+			, secret
+			, algorithm
+			, digits
+			, period
+			, meta
+		)
+		VALUES
+		(
+			@id
+			, @userID
+			, @issuer
+			, @account
+			, @secret
+			, @algorithm
+			, @digits
+			, @period
+			, @meta
+		)
+		;`
+	args := pgx.NamedArgs{
+		"id":        otp.ID,
+		"userID":    otp.UserID,
+		"issuer":    otp.Issuer,
+		"account":   otp.Account,
+		"secret":    otp.Secret,
+		"algorithm": otp.Algorithm,
+		"digits":    otp.Digits,
+		"period":    otp.Period,
+		"meta":      otp.Meta,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// Update - Обновляет существующий секрет одноразовых паролей
+// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
+func (r OTPRepository) Update(otp *domain.OTP) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE otp_data
+		SET 
+			issuer = @issuer
+			, account = @account
+			, secret = @secret
+			, algorithm = @algorithm
+			, digits = @digits
+			, period = @period
+			, meta = @meta
+			, version = otp_data.version + 1
+			, revision = nextval('revision_seq')
+			, updated_at = now()
+		WHERE
+			otp_data.id = @id
+		    AND otp_data.user_id = @userID
+		    AND otp_data.version = @version
+		;`
+
+	args := pgx.NamedArgs{
+		"id":        otp.ID,
+		"userID":    otp.UserID,
+		"issuer":    otp.Issuer,
+		"account":   otp.Account,
+		"secret":    otp.Secret,
+		"algorithm": otp.Algorithm,
+		"digits":    otp.Digits,
+		"period":    otp.Period,
+		"meta":      otp.Meta,
+		"version":   otp.Version,
+	}
+	tag, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrVersionConflict
+	}
+
+	return nil
+}
+
+// Get - Возвращает секрет одноразовых паролей по идентификатору пользователя и данных, если он существует
+func (r OTPRepository) Get(userID, otpID uuid.UUID) (*domain.OTP, error) {
+	var otp domain.OTP
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			otp_data.id
+			, otp_data.user_id
+			, otp_data.issuer
+			, otp_data.account
+			, otp_data.secret
+			, otp_data.algorithm
+			, otp_data.digits
+			, otp_data.period
+			, otp_data.meta
+			, otp_data.version
+			, otp_data.revision
+			, otp_data.updated_at
+		FROM
+			otp_data
+		WHERE
+			otp_data.id = @otpID
+		    AND otp_data.user_id = @userID
+		;`
+	args := pgx.NamedArgs{
+		"otpID":  otpID,
+		"userID": userID,
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(
+			&otp.ID,
+			&otp.UserID,
+			&otp.Issuer,
+			&otp.Account,
+			&otp.Secret,
+			&otp.Algorithm,
+			&otp.Digits,
+			&otp.Period,
+			&otp.Meta,
+			&otp.Version,
+			&otp.Revision,
+			&otp.UpdatedAt,
+		)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &otp, err
+}
+
+// GetAll - Возвращает страницу списка секретов одноразовых паролей пользователя, упорядоченного
+// по времени создания, и курсор следующей страницы, если она есть
+func (r OTPRepository) GetAll(userID uuid.UUID, page domain.Page) ([]*domain.OTP, *domain.Cursor, error) {
+	sql := `
+		SELECT
+			otp_data.id
+			, otp_data.user_id
+			, otp_data.issuer
+			, otp_data.account
+			, otp_data.secret
+			, otp_data.algorithm
+			, otp_data.digits
+			, otp_data.period
+			, otp_data.meta
+			, otp_data.version
+			, otp_data.revision
+			, otp_data.updated_at
+			, otp_data.created_at
+		FROM
+			otp_data
+		WHERE
+			otp_data.user_id = @userID
+			AND (@first::boolean OR (otp_data.created_at, otp_data.id) > (@createdAt::timestamptz, @id::uuid))
+		ORDER BY
+			otp_data.created_at
+			, otp_data.id
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID":    userID,
+		"first":     page.After == nil,
+		"createdAt": time.Time{},
+		"id":        uuid.Nil,
+		"limit":     page.Limit + 1,
+	}
+	if page.After != nil {
+		args["createdAt"] = page.After.CreatedAt
+		args["id"] = page.After.ID
+	}
+
+	result, err := r.query(sql, args)
+	if err != nil {
+		return result, nil, err
+	}
+	result, next := domain.Paginate(result, page.Limit, func(v *domain.OTP) domain.Cursor {
+		return domain.Cursor{CreatedAt: v.CreatedAt, ID: v.ID}
+	})
+
+	return result, next, nil
+}
+
+// GetSince - Возвращает секреты одноразовых паролей пользователя, измененные после ревизии since,
+// в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r OTPRepository) GetSince(ctx context.Context, tx pgx.Tx, userID uuid.UUID, since int64, limit int) ([]*domain.OTP, error) {
+	sql := `
+		SELECT
+			otp_data.id
+			, otp_data.user_id
+			, otp_data.issuer
+			, otp_data.account
+			, otp_data.secret
+			, otp_data.algorithm
+			, otp_data.digits
+			, otp_data.period
+			, otp_data.meta
+			, otp_data.version
+			, otp_data.revision
+			, otp_data.updated_at
+			, otp_data.created_at
+		FROM
+			otp_data
+		WHERE
+			otp_data.user_id = @userID
+			AND otp_data.revision > @since
+		ORDER BY
+			otp_data.revision
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+		"since":  since,
+		"limit":  limit,
+	}
+
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.OTP{}, err
+	}
+
+	return scan(rows)
+}
+
+// query - Выполняет запрос списка и возвращает выбранные строки
+func (r OTPRepository) query(sql string, args pgx.NamedArgs) ([]*domain.OTP, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	rows, err := r.DBPool.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.OTP{}, err
+	}
+
+	return scan(rows)
+}
+
+// scan - Читает и закрывает строки результата запроса
+func scan(rows pgx.Rows) ([]*domain.OTP, error) {
+	result := []*domain.OTP{}
+	var err error
+	defer rows.Close()
+	for rows.Next() {
+		var otp domain.OTP
+		err = rows.Scan(
+			&otp.ID,
+			&otp.UserID,
+			&otp.Issuer,
+			&otp.Account,
+			&otp.Secret,
+			&otp.Algorithm,
+			&otp.Digits,
+			&otp.Period,
+			&otp.Meta,
+			&otp.Version,
+			&otp.Revision,
+			&otp.UpdatedAt,
+			&otp.CreatedAt,
+		)
+		if err == nil {
+			result = append(result, &otp)
+		}
+	}
+	if rows.Err() != nil {
+		return result, err
+	}
+
+	return result, err
+}
+
+// Delete - Удаляет секрет одноразовых паролей по идентификатору пользователя и данных
+func (r OTPRepository) Delete(userID, otpID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	// Вместе с удалением сохраняем запись об удалении для инкрементальной синхронизации клиентов,
+	// папка и метки данных удаляются в том же запросе, клиенты удаляют их локально по записи об удалении
+	sql := `
+		WITH deleted AS (
+			DELETE FROM otp_data
+			WHERE
+				otp_data.id = @otpID
+			    AND otp_data.user_id = @userID
+			RETURNING otp_data.id, otp_data.user_id
+		), deleted_labels AS (
+			DELETE FROM labels
+			USING deleted
+			WHERE
+				labels.entity_id = deleted.id
+				AND labels.user_id = deleted.user_id
+		)
+		INSERT INTO tombstones (id, user_id, kind)
+		SELECT deleted.id, deleted.user_id, @kind FROM deleted
+		;`
+	args := pgx.NamedArgs{
+		"otpID":  otpID,
+		"userID": userID,
+		"kind":   domain.OTPKind,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *OTPRepository {
+	return &OTPRepository{
+		DBPool:  dbPool,
+		Timeout: timeout,
+		log:     log,
+	}
+}
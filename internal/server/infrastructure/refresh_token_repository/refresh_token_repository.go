@@ -0,0 +1,152 @@
+// Package refreshtokenrepository содержит имлементацию интерфейса репозитория RefreshTokenRepositoryInterface
+package refreshtokenrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// RefreshTokenRepository - Имлементация репозитория refresh token
+type RefreshTokenRepository struct {
+	// DBPool - Пул соединений pgx
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+// Create - Сохраняет новый refresh token
+func (r RefreshTokenRepository) Create(token domain.RefreshToken) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO refresh_tokens
+		(
+			id
+			, user_id
+			, family_id
+			, token_hash
+			, expires_at
+			, revoked
+		)
+		VALUES 
+		(
+			@id
+			, @userID
+			, @familyID
+			, @tokenHash
+			, @expiresAt
+			, @revoked
+		)
+		;`
+	args := pgx.NamedArgs{
+		"id":        token.ID,
+		"userID":    token.UserID,
+		"familyID":  token.FamilyID,
+		"tokenHash": token.Hash,
+		"expiresAt": token.ExpiresAt,
+		"revoked":   token.Revoked,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// GetByHash - Возвращает refresh token по хэшу его значения, если он существует
+func (r RefreshTokenRepository) GetByHash(hash string) (*domain.RefreshToken, error) {
+	var token domain.RefreshToken
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			refresh_tokens.id
+			, refresh_tokens.user_id
+			, refresh_tokens.family_id
+			, refresh_tokens.token_hash
+			, refresh_tokens.expires_at
+			, refresh_tokens.revoked
+		FROM
+			refresh_tokens
+		WHERE
+			refresh_tokens.token_hash = @tokenHash
+		;`
+	args := pgx.NamedArgs{
+		"tokenHash": hash,
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(&token.ID, &token.UserID, &token.FamilyID, &token.Hash, &token.ExpiresAt, &token.Revoked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Revoke - Отзывает активный refresh token, возвращает ErrEntityNotFound, если активного токена нет
+func (r RefreshTokenRepository) Revoke(tokenID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE refresh_tokens
+		SET revoked = true
+		WHERE
+			refresh_tokens.id = @tokenID
+			AND refresh_tokens.revoked = false
+		;`
+	args := pgx.NamedArgs{
+		"tokenID": tokenID,
+	}
+	tag, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// RevokeFamily - Отзывает все refresh token семейства
+func (r RefreshTokenRepository) RevokeFamily(familyID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE refresh_tokens
+		SET revoked = true
+		WHERE
+			refresh_tokens.family_id = @familyID
+		;`
+	args := pgx.NamedArgs{
+		"familyID": familyID,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		DBPool:  dbPool,
+		Timeout: timeout,
+		log:     log,
+	}
+}
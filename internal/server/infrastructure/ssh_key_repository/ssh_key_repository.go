@@ -0,0 +1,341 @@
+// Package sshkeyrepository содержит имлементацию интерфейса репозитория SSHKeyRepositoryInterface
+package sshkeyrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// SSHKeyRepository - Имплементация репозитория для SSH ключей
+type SSHKeyRepository struct {
+	// DBPool - Интерфейс пула соединений pgxpool
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+// Create - Сохраняет новый SSH ключ
+func (r SSHKeyRepository) Create(key *domain.SSHKey) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO ssh_key_data
+		(
+			id
+			, user_id
+			, private_key
+			, public_key
+			, comment
+			, fingerprint
+			, meta
+		)
+		VALUES
+		(
+			@id
+			, @userID
+			, @privateKey
+			, @publicKey
+			, @comment
+			, @fingerprint
+			, @meta
+		)
+		;`
+	args := pgx.NamedArgs{
+		"id":          key.ID,
+		"userID":      key.UserID,
+		"privateKey":  key.PrivateKey,
+		"publicKey":   key.PublicKey,
+		"comment":     key.Comment,
+		"fingerprint": key.Fingerprint,
+		"meta":        key.Meta,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// Update - Обновляет существующий SSH ключ
+// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
+func (r SSHKeyRepository) Update(key *domain.SSHKey) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE ssh_key_data
+		SET 
+			private_key = @privateKey
+			, public_key = @publicKey
+			, comment = @comment
+			, fingerprint = @fingerprint
+			, meta = @meta
+			, version = ssh_key_data.version + 1
+			, revision = nextval('revision_seq')
+			, updated_at = now()
+		WHERE
+			ssh_key_data.id = @id
+		    AND ssh_key_data.user_id = @userID
+		    AND ssh_key_data.version = @version
+		;`
+
+	args := pgx.NamedArgs{
+		"id":          key.ID,
+		"userID":      key.UserID,
+		"privateKey":  key.PrivateKey,
+		"publicKey":   key.PublicKey,
+		"comment":     key.Comment,
+		"fingerprint": key.Fingerprint,
+		"meta":        key.Meta,
+		"version":     key.Version,
+	}
+	tag, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrVersionConflict
+	}
+
+	return nil
+}
+
+// Get - Возвращает SSH ключ по идентификатору пользователя и данных, если он существует
+func (r SSHKeyRepository) Get(userID, keyID uuid.UUID) (*domain.SSHKey, error) {
+	var key domain.SSHKey
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			ssh_key_data.id
+			, ssh_key_data.user_id
+			, ssh_key_data.private_key
+			, ssh_key_data.public_key
+			, ssh_key_data.comment
+			, ssh_key_data.fingerprint
+			, ssh_key_data.meta
+			, ssh_key_data.version
+			, ssh_key_data.revision
+			, ssh_key_data.updated_at
+		FROM
+			ssh_key_data
+		WHERE
+			ssh_key_data.id = @keyID
+		    AND ssh_key_data.user_id = @userID
+		;`
+	args := pgx.NamedArgs{
+		"keyID":  keyID,
+		"userID": userID,
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(
+			&key.ID,
+			&key.UserID,
+			&key.PrivateKey,
+			&key.PublicKey,
+			&key.Comment,
+			&key.Fingerprint,
+			&key.Meta,
+			&key.Version,
+			&key.Revision,
+			&key.UpdatedAt,
+		)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &key, err
+}
+
+// GetAll - Возвращает страницу списка SSH ключей пользователя, упорядоченного
+// по времени создания, и курсор следующей страницы, если она есть
+func (r SSHKeyRepository) GetAll(userID uuid.UUID, page domain.Page) ([]*domain.SSHKey, *domain.Cursor, error) {
+	sql := `
+		SELECT
+			ssh_key_data.id
+			, ssh_key_data.user_id
+			, ssh_key_data.private_key
+			, ssh_key_data.public_key
+			, ssh_key_data.comment
+			, ssh_key_data.fingerprint
+			, ssh_key_data.meta
+			, ssh_key_data.version
+			, ssh_key_data.revision
+			, ssh_key_data.updated_at
+			, ssh_key_data.created_at
+		FROM
+			ssh_key_data
+		WHERE
+			ssh_key_data.user_id = @userID
+			AND (@first::boolean OR (ssh_key_data.created_at, ssh_key_data.id) > (@createdAt::timestamptz, @id::uuid))
+		ORDER BY
+			ssh_key_data.created_at
+			, ssh_key_data.id
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID":    userID,
+		"first":     page.After == nil,
+		"createdAt": time.Time{},
+		"id":        uuid.Nil,
+		"limit":     page.Limit + 1,
+	}
+	if page.After != nil {
+		args["createdAt"] = page.After.CreatedAt
+		args["id"] = page.After.ID
+	}
+
+	result, err := r.query(sql, args)
+	if err != nil {
+		return result, nil, err
+	}
+	result, next := domain.Paginate(result, page.Limit, func(v *domain.SSHKey) domain.Cursor {
+		return domain.Cursor{CreatedAt: v.CreatedAt, ID: v.ID}
+	})
+
+	return result, next, nil
+}
+
+// GetSince - Возвращает SSH ключи пользователя, измененные после ревизии since,
+// в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r SSHKeyRepository) GetSince(ctx context.Context, tx pgx.Tx, userID uuid.UUID, since int64, limit int) ([]*domain.SSHKey, error) {
+	sql := `
+		SELECT
+			ssh_key_data.id
+			, ssh_key_data.user_id
+			, ssh_key_data.private_key
+			, ssh_key_data.public_key
+			, ssh_key_data.comment
+			, ssh_key_data.fingerprint
+			, ssh_key_data.meta
+			, ssh_key_data.version
+			, ssh_key_data.revision
+			, ssh_key_data.updated_at
+			, ssh_key_data.created_at
+		FROM
+			ssh_key_data
+		WHERE
+			ssh_key_data.user_id = @userID
+			AND ssh_key_data.revision > @since
+		ORDER BY
+			ssh_key_data.revision
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+		"since":  since,
+		"limit":  limit,
+	}
+
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.SSHKey{}, err
+	}
+
+	return scan(rows)
+}
+
+// query - Выполняет запрос списка и возвращает выбранные строки
+func (r SSHKeyRepository) query(sql string, args pgx.NamedArgs) ([]*domain.SSHKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	rows, err := r.DBPool.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.SSHKey{}, err
+	}
+
+	return scan(rows)
+}
+
+// scan - Читает и закрывает строки результата запроса
+func scan(rows pgx.Rows) ([]*domain.SSHKey, error) {
+	result := []*domain.SSHKey{}
+	var err error
+	defer rows.Close()
+	for rows.Next() {
+		var key domain.SSHKey
+		err = rows.Scan(
+			&key.ID,
+			&key.UserID,
+			&key.PrivateKey,
+			&key.PublicKey,
+			&key.Comment,
+			&key.Fingerprint,
+			&key.Meta,
+			&key.Version,
+			&key.Revision,
+			&key.UpdatedAt,
+			&key.CreatedAt,
+		)
+		if err == nil {
+			result = append(result, &key)
+		}
+	}
+	if rows.Err() != nil {
+		return result, err
+	}
+
+	return result, err
+}
+
+// Delete - Удаляет SSH ключ по идентификатору пользователя и данных
+func (r SSHKeyRepository) Delete(userID, keyID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	// Вместе с удалением сохраняем запись об удалении для инкрементальной синхронизации клиентов,
+	// папка и метки данных удаляются в том же запросе, клиенты удаляют их локально по записи об удалении
+	sql := `
+		WITH deleted AS (
+			DELETE FROM ssh_key_data
+			WHERE
+				ssh_key_data.id = @keyID
+			    AND ssh_key_data.user_id = @userID
+			RETURNING ssh_key_data.id, ssh_key_data.user_id
+		), deleted_labels AS (
+			DELETE FROM labels
+			USING deleted
+			WHERE
+				labels.entity_id = deleted.id
+				AND labels.user_id = deleted.user_id
+		)
+		INSERT INTO tombstones (id, user_id, kind)
+		SELECT deleted.id, deleted.user_id, @kind FROM deleted
+		;`
+	args := pgx.NamedArgs{
+		"keyID":  keyID,
+		"userID": userID,
+		"kind":   domain.SSHKeyKind,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *SSHKeyRepository {
+	return &SSHKeyRepository{
+		DBPool:  dbPool,
+		Timeout: timeout,
+		log:     log,
+	}
+}
@@ -0,0 +1,272 @@
+// Package tagrepository содержит имлементацию интерфейса репозитория TagRepositoryInterface
+package tagrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// TagRepository - Имплементация репозитория для меток
+type TagRepository struct {
+	// DBPool - Интерфейс пула соединений pgxpool
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+// Create - Сохраняет новую метку
+func (r TagRepository) Create(tag *domain.Tag) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO tags
+		(
+			id
+			, user_id
+			, name
+		)
+		VALUES
+		(
+			@id
+			, @userID
+			, @name
+		)
+		;`
+	args := pgx.NamedArgs{
+		"id":     tag.ID,
+		"userID": tag.UserID,
+		"name":   tag.Name,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// Update - Сохраняет новое имя существующей метки
+func (r TagRepository) Update(tag *domain.Tag) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE tags
+		SET
+			name = @name
+			, revision = nextval('revision_seq')
+			, updated_at = now()
+		WHERE
+			tags.id = @id
+		    AND tags.user_id = @userID
+		;`
+	args := pgx.NamedArgs{
+		"id":     tag.ID,
+		"userID": tag.UserID,
+		"name":   tag.Name,
+	}
+	result, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// Get - Возвращает метку по идентификатору пользователя и метки, если она существует
+func (r TagRepository) Get(userID, tagID uuid.UUID) (*domain.Tag, error) {
+	var tag domain.Tag
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			tags.id
+			, tags.user_id
+			, tags.name
+			, tags.revision
+			, tags.updated_at
+		FROM
+			tags
+		WHERE
+			tags.id = @tagID
+		    AND tags.user_id = @userID
+		;`
+	args := pgx.NamedArgs{
+		"tagID":  tagID,
+		"userID": userID,
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(
+			&tag.ID,
+			&tag.UserID,
+			&tag.Name,
+			&tag.Revision,
+			&tag.UpdatedAt,
+		)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &tag, err
+}
+
+// GetAll - Возвращает все метки пользователя
+func (r TagRepository) GetAll(userID uuid.UUID) ([]*domain.Tag, error) {
+	sql := `
+		SELECT
+			tags.id
+			, tags.user_id
+			, tags.name
+			, tags.revision
+			, tags.updated_at
+		FROM
+			tags
+		WHERE
+			tags.user_id = @userID
+		ORDER BY
+			tags.created_at
+			, tags.id
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+	}
+
+	return r.query(sql, args)
+}
+
+// GetSince - Возвращает метки пользователя, измененные после ревизии since, в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r TagRepository) GetSince(ctx context.Context, tx pgx.Tx, userID uuid.UUID, since int64, limit int) ([]*domain.Tag, error) {
+	sql := `
+		SELECT
+			tags.id
+			, tags.user_id
+			, tags.name
+			, tags.revision
+			, tags.updated_at
+		FROM
+			tags
+		WHERE
+			tags.user_id = @userID
+			AND tags.revision > @since
+		ORDER BY
+			tags.revision
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+		"since":  since,
+		"limit":  limit,
+	}
+
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.Tag{}, err
+	}
+
+	return scan(rows)
+}
+
+// query - Выполняет запрос списка и возвращает выбранные строки
+func (r TagRepository) query(sql string, args pgx.NamedArgs) ([]*domain.Tag, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	rows, err := r.DBPool.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.Tag{}, err
+	}
+
+	return scan(rows)
+}
+
+// scan - Читает и закрывает строки результата запроса
+func scan(rows pgx.Rows) ([]*domain.Tag, error) {
+	result := []*domain.Tag{}
+	var err error
+	defer rows.Close()
+	for rows.Next() {
+		var tag domain.Tag
+		err = rows.Scan(
+			&tag.ID,
+			&tag.UserID,
+			&tag.Name,
+			&tag.Revision,
+			&tag.UpdatedAt,
+		)
+		if err == nil {
+			result = append(result, &tag)
+		}
+	}
+	if rows.Err() != nil {
+		return result, err
+	}
+
+	return result, err
+}
+
+// Delete - Удаляет метку и снимает ее со всех данных
+func (r TagRepository) Delete(userID, tagID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	// Данные, с которых снята метка, получают новую ревизию, чтобы клиенты получили их вместе с удалением,
+	// сами связи с меткой удаляются каскадно
+	sql := `
+		WITH deleted AS (
+			DELETE FROM tags
+			WHERE
+				tags.id = @tagID
+			    AND tags.user_id = @userID
+			RETURNING tags.id, tags.user_id
+		), untagged AS (
+			UPDATE labels
+			SET
+				revision = nextval('revision_seq')
+				, updated_at = now()
+			FROM label_tags, deleted
+			WHERE
+				label_tags.tag_id = deleted.id
+			    AND labels.entity_id = label_tags.entity_id
+			    AND labels.user_id = deleted.user_id
+		)
+		INSERT INTO tombstones (id, user_id, kind)
+		SELECT deleted.id, deleted.user_id, @kind FROM deleted
+		;`
+	args := pgx.NamedArgs{
+		"tagID":  tagID,
+		"userID": userID,
+		"kind":   domain.TagKind,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *TagRepository {
+	return &TagRepository{
+		DBPool:  dbPool,
+		Timeout: timeout,
+		log:     log,
+	}
+}
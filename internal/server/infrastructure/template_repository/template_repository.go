@@ -0,0 +1,252 @@
+// Package templaterepository содержит имлементацию интерфейса репозитория TemplateRepositoryInterface
+package templaterepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// TemplateRepository - Имплементация репозитория для пользовательских шаблонов
+type TemplateRepository struct {
+	// DBPool - Интерфейс пула соединений pgxpool
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+// Create - Сохраняет новый шаблон
+func (r TemplateRepository) Create(tmpl *domain.ItemTemplate) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO item_templates
+		(
+			id
+			, user_id
+			, name
+			, description
+			, fields
+		)
+		VALUES
+		(
+			@id
+			, @userID
+			, @name
+			, @description
+			, @fields
+		)
+		;`
+	args := pgx.NamedArgs{
+		"id":          tmpl.ID,
+		"userID":      tmpl.UserID,
+		"name":        tmpl.Name,
+		"description": tmpl.Description,
+		"fields":      tmpl.Fields,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// Get - Возвращает шаблон по идентификатору пользователя и шаблона, если он существует
+func (r TemplateRepository) Get(userID, templateID uuid.UUID) (*domain.ItemTemplate, error) {
+	var tmpl domain.ItemTemplate
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			item_templates.id
+			, item_templates.user_id
+			, item_templates.name
+			, item_templates.description
+			, item_templates.fields
+			, item_templates.revision
+			, item_templates.updated_at
+		FROM
+			item_templates
+		WHERE
+			item_templates.id = @templateID
+		    AND item_templates.user_id = @userID
+		;`
+	args := pgx.NamedArgs{
+		"templateID": templateID,
+		"userID":     userID,
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(
+			&tmpl.ID,
+			&tmpl.UserID,
+			&tmpl.Name,
+			&tmpl.Description,
+			&tmpl.Fields,
+			&tmpl.Revision,
+			&tmpl.UpdatedAt,
+		)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &tmpl, err
+}
+
+// GetAll - Возвращает все шаблоны пользователя
+func (r TemplateRepository) GetAll(userID uuid.UUID) ([]*domain.ItemTemplate, error) {
+	sql := `
+		SELECT
+			item_templates.id
+			, item_templates.user_id
+			, item_templates.name
+			, item_templates.description
+			, item_templates.fields
+			, item_templates.revision
+			, item_templates.updated_at
+		FROM
+			item_templates
+		WHERE
+			item_templates.user_id = @userID
+		ORDER BY
+			item_templates.created_at
+			, item_templates.id
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+	}
+
+	return r.query(sql, args)
+}
+
+// GetSince - Возвращает шаблоны пользователя, измененные после ревизии since, в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r TemplateRepository) GetSince(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID uuid.UUID,
+	since int64,
+	limit int,
+) ([]*domain.ItemTemplate, error) {
+	sql := `
+		SELECT
+			item_templates.id
+			, item_templates.user_id
+			, item_templates.name
+			, item_templates.description
+			, item_templates.fields
+			, item_templates.revision
+			, item_templates.updated_at
+		FROM
+			item_templates
+		WHERE
+			item_templates.user_id = @userID
+			AND item_templates.revision > @since
+		ORDER BY
+			item_templates.revision
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+		"since":  since,
+		"limit":  limit,
+	}
+
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.ItemTemplate{}, err
+	}
+
+	return scan(rows)
+}
+
+// query - Выполняет запрос списка и возвращает выбранные строки
+func (r TemplateRepository) query(sql string, args pgx.NamedArgs) ([]*domain.ItemTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	rows, err := r.DBPool.Query(ctx, sql, args)
+	if err != nil {
+		return []*domain.ItemTemplate{}, err
+	}
+
+	return scan(rows)
+}
+
+// scan - Читает и закрывает строки результата запроса
+func scan(rows pgx.Rows) ([]*domain.ItemTemplate, error) {
+	result := []*domain.ItemTemplate{}
+	var err error
+	defer rows.Close()
+	for rows.Next() {
+		var tmpl domain.ItemTemplate
+		err = rows.Scan(
+			&tmpl.ID,
+			&tmpl.UserID,
+			&tmpl.Name,
+			&tmpl.Description,
+			&tmpl.Fields,
+			&tmpl.Revision,
+			&tmpl.UpdatedAt,
+		)
+		if err == nil {
+			result = append(result, &tmpl)
+		}
+	}
+	if rows.Err() != nil {
+		return result, err
+	}
+
+	return result, err
+}
+
+// Delete - Удаляет шаблон и сохраняет запись об удалении
+func (r TemplateRepository) Delete(userID, templateID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		WITH deleted AS (
+			DELETE FROM item_templates
+			WHERE
+				item_templates.id = @templateID
+			    AND item_templates.user_id = @userID
+			RETURNING item_templates.id, item_templates.user_id
+		)
+		INSERT INTO tombstones (id, user_id, kind)
+		SELECT deleted.id, deleted.user_id, @kind FROM deleted
+		;`
+	args := pgx.NamedArgs{
+		"templateID": templateID,
+		"userID":     userID,
+		"kind":       domain.TemplateKind,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *TemplateRepository {
+	return &TemplateRepository{
+		DBPool:  dbPool,
+		Timeout: timeout,
+		log:     log,
+	}
+}
@@ -53,6 +53,7 @@ func (r TextRepository) Create(text domain.Text) error {
 }
 
 // Update - Обновляет текстовые данные
+// Если версия данных в хранилище не совпадает с версией обновляемых данных, возвращает ErrVersionConflict
 func (r TextRepository) Update(text domain.Text) error {
 	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
 	defer cancel()
@@ -60,19 +61,30 @@ func (r TextRepository) Update(text domain.Text) error {
 		UPDATE text_data
 		SET 
 			content = @content
+			, version = text_data.version + 1
+			, revision = nextval('revision_seq')
+			, updated_at = now()
 		WHERE
 		    text_data.id = @id
 		    AND text_data.user_id = @userID
+		    AND text_data.version = @version
 		;`
 
 	args := pgx.NamedArgs{
 		"id":      text.ID,
 		"userID":  text.UserID,
 		"content": text.Content,
+		"version": text.Version,
+	}
+	tag, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrVersionConflict
 	}
-	_, err := r.DBPool.Exec(ctx, sql, args)
 
-	return err
+	return nil
 }
 
 // Get - Возвращает текстовые данные по идентификатору пользователя и данных, если они существуют
@@ -86,6 +98,9 @@ func (r TextRepository) Get(userID, textID uuid.UUID) (*domain.Text, error) {
 		    text_data.id
 			, text_data.user_id
 			, text_data.content
+			, text_data.version
+			, text_data.revision
+			, text_data.updated_at
 		FROM
 		    text_data
 		WHERE
@@ -98,7 +113,7 @@ func (r TextRepository) Get(userID, textID uuid.UUID) (*domain.Text, error) {
 	}
 	err := r.DBPool.
 		QueryRow(ctx, sql, args).
-		Scan(&text.ID, &text.UserID, &text.Content)
+		Scan(&text.ID, &text.UserID, &text.Content, &text.Version, &text.Revision, &text.UpdatedAt)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -111,33 +126,115 @@ func (r TextRepository) Get(userID, textID uuid.UUID) (*domain.Text, error) {
 	return &text, err
 }
 
-// GetAll - Возвращает список текстовых данных, принадлежащих пользователю
-func (r TextRepository) GetAll(userID uuid.UUID) ([]domain.Text, error) {
-	result := []domain.Text{}
-	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
-	defer cancel()
+// GetAll - Возвращает страницу списка текстовых данных пользователя, упорядоченного по времени создания,
+// и курсор следующей страницы, если она есть
+func (r TextRepository) GetAll(userID uuid.UUID, page domain.Page) ([]domain.Text, *domain.Cursor, error) {
 	sql := `
 		SELECT
-		    text_data.id
+			text_data.id
 			, text_data.user_id
 			, text_data.content
+			, text_data.version
+			, text_data.revision
+			, text_data.updated_at
+			, text_data.created_at
 		FROM
-		    text_data
+			text_data
 		WHERE
-		    text_data.user_id = @userID
+			text_data.user_id = @userID
+			AND (@first::boolean OR (text_data.created_at, text_data.id) > (@createdAt::timestamptz, @id::uuid))
+		ORDER BY
+			text_data.created_at
+			, text_data.id
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID":    userID,
+		"first":     page.After == nil,
+		"createdAt": time.Time{},
+		"id":        uuid.Nil,
+		"limit":     page.Limit + 1,
+	}
+	if page.After != nil {
+		args["createdAt"] = page.After.CreatedAt
+		args["id"] = page.After.ID
+	}
+
+	result, err := r.query(sql, args)
+	if err != nil {
+		return result, nil, err
+	}
+	result, next := domain.Paginate(result, page.Limit, func(v domain.Text) domain.Cursor {
+		return domain.Cursor{CreatedAt: v.CreatedAt, ID: v.ID}
+	})
+
+	return result, next, nil
+}
+
+// GetSince - Возвращает текстовые данные пользователя, измененные после ревизии since, в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r TextRepository) GetSince(ctx context.Context, tx pgx.Tx, userID uuid.UUID, since int64, limit int) ([]domain.Text, error) {
+	sql := `
+		SELECT
+			text_data.id
+			, text_data.user_id
+			, text_data.content
+			, text_data.version
+			, text_data.revision
+			, text_data.updated_at
+			, text_data.created_at
+		FROM
+			text_data
+		WHERE
+			text_data.user_id = @userID
+			AND text_data.revision > @since
+		ORDER BY
+			text_data.revision
+		LIMIT @limit
 		;`
 	args := pgx.NamedArgs{
 		"userID": userID,
+		"since":  since,
+		"limit":  limit,
+	}
+
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return []domain.Text{}, err
 	}
 
+	return scan(rows)
+}
+
+// query - Выполняет запрос списка и возвращает выбранные строки
+func (r TextRepository) query(sql string, args pgx.NamedArgs) ([]domain.Text, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
 	rows, err := r.DBPool.Query(ctx, sql, args)
 	if err != nil {
-		return result, err
+		return []domain.Text{}, err
 	}
+
+	return scan(rows)
+}
+
+// scan - Читает и закрывает строки результата запроса
+func scan(rows pgx.Rows) ([]domain.Text, error) {
+	result := []domain.Text{}
+	var err error
 	defer rows.Close()
 	for rows.Next() {
 		var text domain.Text
-		err = rows.Scan(&text.ID, &text.UserID, &text.Content)
+		err = rows.Scan(
+			&text.ID,
+			&text.UserID,
+			&text.Content,
+			&text.Version,
+			&text.Revision,
+			&text.UpdatedAt,
+			&text.CreatedAt,
+		)
 		if err == nil {
 			result = append(result, text)
 		}
@@ -149,6 +246,39 @@ func (r TextRepository) GetAll(userID uuid.UUID) ([]domain.Text, error) {
 	return result, err
 }
 
+// Delete - Удаляет текстовые данные по идентификатору пользователя и данных
+func (r TextRepository) Delete(userID, textID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	// Вместе с удалением сохраняем запись об удалении для инкрементальной синхронизации клиентов,
+	// папка и метки данных удаляются в том же запросе, клиенты удаляют их локально по записи об удалении
+	sql := `
+		WITH deleted AS (
+			DELETE FROM text_data
+			WHERE
+				text_data.id = @textID
+			    AND text_data.user_id = @userID
+			RETURNING text_data.id, text_data.user_id
+		), deleted_labels AS (
+			DELETE FROM labels
+			USING deleted
+			WHERE
+				labels.entity_id = deleted.id
+				AND labels.user_id = deleted.user_id
+		)
+		INSERT INTO tombstones (id, user_id, kind)
+		SELECT deleted.id, deleted.user_id, @kind FROM deleted
+		;`
+	args := pgx.NamedArgs{
+		"textID": textID,
+		"userID": userID,
+		"kind":   domain.TextKind,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
 // New - Возвращает новый инстанс репозитория
 func New(
 	dbPool *pgxpool.Pool,
@@ -0,0 +1,93 @@
+// Package tombstonerepository содержит репозиторий записей об удалении данных для инкрементальной синхронизации
+package tombstonerepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// TombstoneRepository - Имлементация репозитория записей об удалении данных
+type TombstoneRepository struct {
+	// DBPool - Пул соединений pgx
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+// GetSince - Возвращает записи об удалении данных пользователя после ревизии since в порядке возрастания ревизии.
+// Возвращает не больше limit записей, запрос выполняется в транзакции tx
+func (r TombstoneRepository) GetSince(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID uuid.UUID,
+	since int64,
+	limit int,
+) ([]domain.Tombstone, error) {
+	result := []domain.Tombstone{}
+	sql := `
+		SELECT
+			tombstones.id
+			, tombstones.user_id
+			, tombstones.kind
+			, tombstones.revision
+			, tombstones.deleted_at
+		FROM
+			tombstones
+		WHERE
+			tombstones.user_id = @userID
+			AND tombstones.revision > @since
+		ORDER BY
+			tombstones.revision
+		LIMIT @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+		"since":  since,
+		"limit":  limit,
+	}
+
+	rows, err := tx.Query(ctx, sql, args)
+	if err != nil {
+		return result, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tombstone domain.Tombstone
+		err = rows.Scan(
+			&tombstone.ID,
+			&tombstone.UserID,
+			&tombstone.Kind,
+			&tombstone.Revision,
+			&tombstone.DeletedAt,
+		)
+		if err == nil {
+			result = append(result, tombstone)
+		}
+	}
+	if rows.Err() != nil {
+		return result, err
+	}
+
+	return result, err
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *TombstoneRepository {
+	return &TombstoneRepository{
+		DBPool:  dbPool,
+		Timeout: timeout,
+		log:     log,
+	}
+}
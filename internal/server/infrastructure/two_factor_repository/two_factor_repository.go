@@ -0,0 +1,293 @@
+// Package twofactorrepository содержит имлементацию интерфейса репозитория TwoFactorRepositoryInterface
+package twofactorrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// TwoFactorRepository - Имлементация репозитория двухфакторной аутентификации
+type TwoFactorRepository struct {
+	// DBPool - Пул соединений pgx
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+// Save - Создает или перезаписывает настройки двухфакторной аутентификации пользователя
+func (r TwoFactorRepository) Save(twoFactor domain.TwoFactor) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO two_factor
+		(
+			user_id
+			, secret
+			, enabled
+			, last_counter
+		)
+		VALUES
+		(
+			@userID
+			, @secret
+			, @enabled
+			, @lastCounter
+		)
+		ON CONFLICT (user_id) DO UPDATE SET
+			secret = EXCLUDED.secret
+			, enabled = EXCLUDED.enabled
+			, last_counter = EXCLUDED.last_counter
+		;`
+	args := pgx.NamedArgs{
+		"userID":      twoFactor.UserID,
+		"secret":      twoFactor.Secret,
+		"enabled":     twoFactor.Enabled,
+		"lastCounter": twoFactor.LastCounter,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// Get - Возвращает настройки двухфакторной аутентификации пользователя, если они существуют
+func (r TwoFactorRepository) Get(userID uuid.UUID) (*domain.TwoFactor, error) {
+	var twoFactor domain.TwoFactor
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			two_factor.user_id
+			, two_factor.secret
+			, two_factor.enabled
+			, two_factor.last_counter
+		FROM
+			two_factor
+		WHERE
+			two_factor.user_id = @userID
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(&twoFactor.UserID, &twoFactor.Secret, &twoFactor.Enabled, &twoFactor.LastCounter)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &twoFactor, nil
+}
+
+// Delete - Удаляет настройки двухфакторной аутентификации и коды восстановления пользователя
+func (r TwoFactorRepository) Delete(userID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	args := pgx.NamedArgs{
+		"userID": userID,
+	}
+
+	return pgx.BeginFunc(ctx, r.DBPool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `DELETE FROM recovery_codes WHERE recovery_codes.user_id = @userID;`, args)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `DELETE FROM two_factor WHERE two_factor.user_id = @userID;`, args)
+
+		return err
+	})
+}
+
+// UseCounter - Запоминает номер периода принятого кода.
+// Если код этого или более позднего периода уже принимался, возвращает ErrEntityNotFound
+func (r TwoFactorRepository) UseCounter(userID uuid.UUID, counter int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE two_factor
+		SET last_counter = @counter
+		WHERE
+			two_factor.user_id = @userID
+			AND two_factor.last_counter < @counter
+		;`
+	args := pgx.NamedArgs{
+		"userID":  userID,
+		"counter": counter,
+	}
+	tag, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// SaveRecoveryCodes - Заменяет коды восстановления пользователя, сохраняются только хэши кодов
+func (r TwoFactorRepository) SaveRecoveryCodes(userID uuid.UUID, hashes []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO recovery_codes
+		(
+			id
+			, user_id
+			, code_hash
+		)
+		VALUES
+		(
+			@id
+			, @userID
+			, @codeHash
+		)
+		;`
+
+	return pgx.BeginFunc(ctx, r.DBPool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(
+			ctx,
+			`DELETE FROM recovery_codes WHERE recovery_codes.user_id = @userID;`,
+			pgx.NamedArgs{"userID": userID},
+		)
+		if err != nil {
+			return err
+		}
+		for _, hash := range hashes {
+			args := pgx.NamedArgs{
+				"id":       uuid.New(),
+				"userID":   userID,
+				"codeHash": hash,
+			}
+			if _, err = tx.Exec(ctx, sql, args); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// UseRecoveryCode - Помечает код восстановления использованным,
+// если неиспользованного кода с таким хэшем нет, возвращает ErrEntityNotFound
+func (r TwoFactorRepository) UseRecoveryCode(userID uuid.UUID, hash string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE recovery_codes
+		SET used_at = now()
+		WHERE
+			recovery_codes.user_id = @userID
+			AND recovery_codes.code_hash = @codeHash
+			AND recovery_codes.used_at IS NULL
+		;`
+	args := pgx.NamedArgs{
+		"userID":   userID,
+		"codeHash": hash,
+	}
+	tag, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// SaveChallenge - Запоминает выданный токен частичной аутентификации, предыдущий токен перестает действовать
+func (r TwoFactorRepository) SaveChallenge(userID, challengeID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE two_factor
+		SET
+			challenge_id = @challengeID
+			, attempts = 0
+		WHERE
+			two_factor.user_id = @userID
+		;`
+	args := pgx.NamedArgs{
+		"userID":      userID,
+		"challengeID": challengeID,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// UseChallengeAttempt - Засчитывает попытку ввода кода по токену частичной аутентификации.
+// Попытка засчитывается до проверки кода, поэтому параллельные запросы не превысят limit.
+// Если токен не последний выданный, закрыт или по нему исчерпаны limit попыток, возвращает ErrEntityNotFound
+func (r TwoFactorRepository) UseChallengeAttempt(userID, challengeID uuid.UUID, limit int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE two_factor
+		SET attempts = attempts + 1
+		WHERE
+			two_factor.user_id = @userID
+			AND two_factor.challenge_id = @challengeID
+			AND two_factor.attempts < @limit
+		;`
+	args := pgx.NamedArgs{
+		"userID":      userID,
+		"challengeID": challengeID,
+		"limit":       limit,
+	}
+	tag, err := r.DBPool.Exec(ctx, sql, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// CloseChallenge - Закрывает токен частичной аутентификации после успешного входа
+func (r TwoFactorRepository) CloseChallenge(userID, challengeID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		UPDATE two_factor
+		SET challenge_id = NULL
+		WHERE
+			two_factor.user_id = @userID
+			AND two_factor.challenge_id = @challengeID
+		;`
+	args := pgx.NamedArgs{
+		"userID":      userID,
+		"challengeID": challengeID,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *TwoFactorRepository {
+	return &TwoFactorRepository{
+		DBPool:  dbPool,
+		Timeout: timeout,
+		log:     log,
+	}
+}
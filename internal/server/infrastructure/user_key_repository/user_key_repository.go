@@ -0,0 +1,93 @@
+// Package userkeyrepository содержит имлементацию интерфейса репозитория UserKeyRepositoryInterface
+package userkeyrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+// UserKeyRepository - Имлементация репозитория ключей шифрования данных пользователей
+type UserKeyRepository struct {
+	// DBPool - Пул соединений pgx
+	DBPool *pgxpool.Pool
+	// Timeout - Таймаут операции
+	Timeout time.Duration
+	log     *logrus.Logger
+}
+
+// Create - Сохраняет новый ключ шифрования данных пользователя
+func (r UserKeyRepository) Create(key domain.UserKey) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		INSERT INTO user_keys
+		(
+			user_id
+			, key
+		)
+		VALUES 
+		(
+			@userID
+			, @key
+		)
+		;`
+	args := pgx.NamedArgs{
+		"userID": key.UserID,
+		"key":    key.Key,
+	}
+	_, err := r.DBPool.Exec(ctx, sql, args)
+
+	return err
+}
+
+// Get - Возвращает ключ шифрования данных пользователя, если он существует
+func (r UserKeyRepository) Get(userID uuid.UUID) (*domain.UserKey, error) {
+	var key domain.UserKey
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			user_keys.user_id
+			, user_keys.key
+		FROM
+			user_keys
+		WHERE
+			user_keys.user_id = @userID
+		;`
+	args := pgx.NamedArgs{
+		"userID": userID,
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(&key.UserID, &key.Key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// New - Возвращает новый инстанс репозитория
+func New(
+	dbPool *pgxpool.Pool,
+	timeout time.Duration,
+	log *logrus.Logger,
+) *UserKeyRepository {
+	return &UserKeyRepository{
+		DBPool:  dbPool,
+		Timeout: timeout,
+		log:     log,
+	}
+}
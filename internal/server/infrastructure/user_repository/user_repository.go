@@ -6,6 +6,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
@@ -22,8 +23,9 @@ type UserRepository struct {
 	log     *logrus.Logger
 }
 
-// Create - Сохраняет нового пользователя
-func (r UserRepository) Create(user domain.User) error {
+// Create - Сохраняет нового пользователя вместе с ключом шифрования его данных в одной транзакции,
+// поэтому пользователь без ключа не появится, а повторная регистрация после ошибки возможна
+func (r UserRepository) Create(user domain.User, key *domain.UserKey) error {
 	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
 	defer cancel()
 	sql := `
@@ -32,22 +34,53 @@ func (r UserRepository) Create(user domain.User) error {
 			id
 			, login
 			, password
+			, e2e
+			, salt
 		)
 		VALUES 
 		(
 			@id
 			, @login
 			, @password
+			, @e2e
+			, @salt
 		)
 		;`
 	args := pgx.NamedArgs{
 		"id":       user.ID,
 		"login":    user.Login,
 		"password": user.Password,
+		"e2e":      user.E2E,
+		"salt":     user.Salt,
 	}
-	_, err := r.DBPool.Exec(ctx, sql, args)
 
-	return err
+	return pgx.BeginFunc(ctx, r.DBPool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, sql, args); err != nil {
+			return err
+		}
+		if key == nil {
+			return nil
+		}
+		sql := `
+			INSERT INTO user_keys
+			(
+				user_id
+				, key
+			)
+			VALUES
+			(
+				@userID
+				, @key
+			)
+			;`
+		args := pgx.NamedArgs{
+			"userID": key.UserID,
+			"key":    key.Key,
+		}
+		_, err := tx.Exec(ctx, sql, args)
+
+		return err
+	})
 }
 
 // GetByLogin - Возвращает пользователя по логину, если он существует
@@ -60,6 +93,8 @@ func (r UserRepository) GetByLogin(login string) (*domain.User, error) {
 			users.id
 			, users.login
 			, users.password
+			, users.e2e
+			, users.salt
 		FROM
 			users
 		WHERE
@@ -70,7 +105,41 @@ func (r UserRepository) GetByLogin(login string) (*domain.User, error) {
 	}
 	err := r.DBPool.
 		QueryRow(ctx, sql, args).
-		Scan(&user.ID, &user.Login, &user.Password)
+		Scan(&user.ID, &user.Login, &user.Password, &user.E2E, &user.Salt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrEntityNotFound
+		}
+
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// GetByID - Возвращает пользователя по идентификатору, если он существует
+func (r UserRepository) GetByID(userID uuid.UUID) (*domain.User, error) {
+	var user domain.User
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	sql := `
+		SELECT
+			users.id
+			, users.login
+			, users.password
+			, users.e2e
+			, users.salt
+		FROM
+			users
+		WHERE
+			users.id = @id
+		;`
+	args := pgx.NamedArgs{
+		"id": userID,
+	}
+	err := r.DBPool.
+		QueryRow(ctx, sql, args).
+		Scan(&user.ID, &user.Login, &user.Password, &user.E2E, &user.Salt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrEntityNotFound
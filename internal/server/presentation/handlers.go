@@ -1,12 +1,17 @@
 package presentation
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
+	"strconv"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
+	usecases "github.com/Nickolasll/goph-keeper/internal/server/application/use_cases"
 	"github.com/Nickolasll/goph-keeper/internal/server/domain"
 )
 
@@ -14,11 +19,12 @@ import (
 // @ID auth-register
 // @Tags Auth
 // @Accept json
-// @Param payload body registrationPayload true "Логин и Пароль"
+// @Param payload body registrationPayload true "Логин, Пароль и признак сквозного шифрования"
 // @Success 200
 // @Failure 400 "Некорректный формат данных"
 // @Failure 409 "Логин уже занят"
 // @Header 200 {string} Authorization eyJhbGciOiJI...qIScZUU8P0Zhck "JWT"
+// @Header 200 {string} X-Refresh-Token 3q2-7wAAAAAAAAAA...xXv4 "Refresh token"
 // @Router /auth/register [post]
 func registrationHandler(w http.ResponseWriter, r *http.Request) { //nolint: dupl
 	var payload registrationPayload
@@ -36,7 +42,7 @@ func registrationHandler(w http.ResponseWriter, r *http.Request) { //nolint: dup
 
 		return
 	}
-	token, err := app.Registration.Do(payload.Login, payload.Password)
+	token, refreshToken, err := app.Registration.Do(payload.Login, payload.Password, payload.E2E, payload.Salt)
 	if err != nil {
 		if errors.Is(err, domain.ErrLoginAlreadyInUse) {
 			w.WriteHeader(http.StatusConflict)
@@ -49,6 +55,7 @@ func registrationHandler(w http.ResponseWriter, r *http.Request) { //nolint: dup
 	}
 
 	w.Header().Set("Authorization", string(token))
+	w.Header().Set(refreshTokenHeader, refreshToken)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -58,9 +65,12 @@ func registrationHandler(w http.ResponseWriter, r *http.Request) { //nolint: dup
 // @Accept json
 // @Param payload body registrationPayload true "Логин и Пароль"
 // @Success 200
+// @Success 202 "Требуется код второго фактора"
 // @Failure 400 "Некорректный формат данных"
 // @Failure 401 "Неправильный логин или пароль"
 // @Header 200 {string} Authorization eyJhbGciOiJI...qIScZUU8P0Zhck "JWT"
+// @Header 200 {string} X-Refresh-Token 3q2-7wAAAAAAAAAA...xXv4 "Refresh token"
+// @Header 202 {string} X-Two-Factor-Challenge eyJhbGciOiJI...qIScZUU8P0Zhck "Токен частичной аутентификации"
 // @Router /auth/login [post]
 func loginHandler(w http.ResponseWriter, r *http.Request) { //nolint: dupl
 	var payload registrationPayload
@@ -78,9 +88,13 @@ func loginHandler(w http.ResponseWriter, r *http.Request) { //nolint: dupl
 
 		return
 	}
-	token, err := app.Login.Do(payload.Login, payload.Password)
+	token, refreshToken, err := app.Login.Do(payload.Login, payload.Password)
 	if err != nil {
-		if errors.Is(err, domain.ErrLoginOrPasswordIsInvalid) {
+		var twoFactorRequired *domain.TwoFactorRequiredError
+		if errors.As(err, &twoFactorRequired) {
+			w.Header().Set(challengeHeader, string(twoFactorRequired.Challenge))
+			w.WriteHeader(http.StatusAccepted)
+		} else if errors.Is(err, domain.ErrLoginOrPasswordIsInvalid) {
 			w.WriteHeader(http.StatusUnauthorized)
 		} else {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -91,71 +105,85 @@ func loginHandler(w http.ResponseWriter, r *http.Request) { //nolint: dupl
 	}
 
 	w.Header().Set("Authorization", string(token))
+	w.Header().Set(refreshTokenHeader, refreshToken)
 	w.WriteHeader(http.StatusOK)
 }
 
-// @Summary Создать и зашифровать текстовые данные
-// @ID text-create
-// @Tags Text
-// @Accept plain
-// @Param data body string true "Текст для сохранения"
-// @Success 201
+// @Summary Выпуск новой пары токенов по refresh token
+// @ID auth-refresh
+// @Tags Auth
+// @Accept json
+// @Param payload body refreshPayload true "Refresh token"
+// @Success 200
 // @Failure 400 "Некорректный формат данных"
-// @Failure 401 "Нет токена авторизации или токен невалиден"
-// @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
-// @Router /text/create [post]
-// @Security ApiKeyAuth
-func createTextHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
-	body, err := parseBody(textType, r)
-	if err != nil || len(body) == 0 {
+// @Failure 401 "Refresh token недействителен, истек или был использован повторно"
+// @Header 200 {string} Authorization eyJhbGciOiJI...qIScZUU8P0Zhck "JWT"
+// @Header 200 {string} X-Refresh-Token 3q2-7wAAAAAAAAAA...xXv4 "Refresh token"
+// @Router /auth/refresh [post]
+func refreshHandler(w http.ResponseWriter, r *http.Request) {
+	var payload refreshPayload
+	body, err := parseBody(jsonType, r)
+	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
 
 		return
 	}
-	textID, err := app.CreateText.Do(userID, string(body))
+	payload, err = payload.Load(body)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
+		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
 
 		return
 	}
-	w.Header().Add("Location", textID.String())
-	w.WriteHeader(http.StatusCreated)
+	token, refreshToken, err := app.Refresh.Do(payload.RefreshToken)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRefreshToken) || errors.Is(err, domain.ErrRefreshTokenReused) {
+			w.WriteHeader(http.StatusUnauthorized)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+
+	w.Header().Set("Authorization", string(token))
+	w.Header().Set(refreshTokenHeader, refreshToken)
+	w.WriteHeader(http.StatusOK)
 }
 
-// @Summary Обновить и зашифровать существующие текстовые данные
-// @ID text-update
-// @Tags Text
-// @Accept plain
-// @Param text_id path string true "Text ID"
-// @Param data body string true "Текст для сохранения"
+// @Summary Завершение входа кодом второго фактора
+// @ID auth-2fa-login
+// @Tags Auth
+// @Accept json
+// @Param payload body twoFactorLoginPayload true "Токен частичной аутентификации и код TOTP или код восстановления"
 // @Success 200
-// @Failure 400 "Некорректный формат данных или идентификатора"
-// @Failure 401 "Нет токена авторизации или токен невалиден"
-// @Failure 404 "Не найдено"
-// @Router /text/{text_id} [post]
-// @Security ApiKeyAuth
-func updateTextHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
-	id, err := getRouteID(r, "textID")
+// @Failure 400 "Некорректный формат данных"
+// @Failure 401 "Неправильный код, токен частичной аутентификации истек или по нему исчерпаны попытки"
+// @Header 200 {string} Authorization eyJhbGciOiJI...qIScZUU8P0Zhck "JWT"
+// @Header 200 {string} X-Refresh-Token 3q2-7wAAAAAAAAAA...xXv4 "Refresh token"
+// @Router /auth/2fa/login [post]
+func twoFactorLoginHandler(w http.ResponseWriter, r *http.Request) {
+	var payload twoFactorLoginPayload
+	body, err := parseBody(jsonType, r)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
 
 		return
 	}
-
-	body, err := parseBody(textType, r)
-	if err != nil || len(body) == 0 {
+	payload, err = payload.Load(body)
+	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
 
 		return
 	}
-	err = app.UpdateText.Do(userID, id, string(body))
+	token, refreshToken, err := app.TwoFactorLogin.Do([]byte(payload.Challenge), payload.Code)
 	if err != nil {
-		if errors.Is(err, domain.ErrEntityNotFound) {
-			w.WriteHeader(http.StatusNotFound)
+		if errors.Is(err, domain.ErrInvalidChallenge) || errors.Is(err, domain.ErrInvalidTwoFactorCode) {
+			w.WriteHeader(http.StatusUnauthorized)
 		} else {
 			w.WriteHeader(http.StatusInternalServerError)
 			log.Error(err)
@@ -163,22 +191,33 @@ func updateTextHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID)
 
 		return
 	}
+
+	w.Header().Set("Authorization", string(token))
+	w.Header().Set(refreshTokenHeader, refreshToken)
 	w.WriteHeader(http.StatusOK)
 }
 
-// @Summary Получить все расшифрованные текстовые данные
-// @ID text-all
-// @Tags Text
-// @Success 200 {object} GetAllTextsResponse
+// @Summary Подключение двухфакторной аутентификации
+// @Description Возвращает секрет TOTP и otpauth URI для QR-кода, вход требует код только после подтверждения
+// @ID auth-2fa-enroll
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} TwoFactorEnrollResponse
 // @Failure 401 "Нет токена авторизации или токен невалиден"
-// @Router /text/all [get]
+// @Failure 409 "Двухфакторная аутентификация уже включена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Router /auth/2fa/enroll [post]
 // @Security ApiKeyAuth
-func getAllTextsHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID) {
-	textsResponse := []textResponse{}
-	w.Header().Set(contentTypeHeader, jsonType)
-	texts, err := app.GetAllTexts.Do(userID)
+func enrollTwoFactorHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID) {
+	secret, uri, err := app.EnrollTwoFactor.Do(userID)
 	if err != nil {
+		if errors.Is(err, domain.ErrTwoFactorAlreadyEnabled) {
+			w.WriteHeader(http.StatusConflict)
+
+			return
+		}
 		log.Error(err)
+		w.Header().Set(contentTypeHeader, jsonType)
 		err = responseError(w, err.Error())
 		if err != nil {
 			log.Error(err)
@@ -187,144 +226,258 @@ func getAllTextsHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID
 		return
 	}
 
-	for _, v := range texts {
-		respItem := textResponse{
-			ID:      v.ID.String(),
-			Content: string(v.Content),
+	w.Header().Set(contentTypeHeader, jsonType)
+	response := TwoFactorEnrollResponse{
+		Status: true,
+	}
+	response.Data.Secret = secret
+	response.Data.URI = uri
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// @Summary Подтверждение подключения двухфакторной аутентификации
+// @Description Проверяет код из приложения-аутентификатора и возвращает одноразовые коды восстановления
+// @ID auth-2fa-verify
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param payload body twoFactorCodePayload true "Код TOTP"
+// @Success 200 {object} TwoFactorVerifyResponse
+// @Failure 400 "Некорректный формат данных"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 403 "Неправильный код"
+// @Failure 404 "Подключение не начато"
+// @Failure 409 "Двухфакторная аутентификация уже включена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Router /auth/2fa/verify [post]
+// @Security ApiKeyAuth
+func verifyTwoFactorHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload twoFactorCodePayload
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	codes, err := app.VerifyTwoFactor.Do(userID, payload.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidTwoFactorCode):
+			w.WriteHeader(http.StatusForbidden)
+		case errors.Is(err, domain.ErrEntityNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errors.Is(err, domain.ErrTwoFactorAlreadyEnabled):
+			w.WriteHeader(http.StatusConflict)
+		default:
+			log.Error(err)
+			w.Header().Set(contentTypeHeader, jsonType)
+			err = responseError(w, err.Error())
+			if err != nil {
+				log.Error(err)
+			}
 		}
-		textsResponse = append(textsResponse, respItem)
+
+		return
 	}
 
-	response := GetAllTextsResponse{
+	w.Header().Set(contentTypeHeader, jsonType)
+	response := TwoFactorVerifyResponse{
 		Status: true,
 	}
-	response.Data.Texts = textsResponse
-
+	response.Data.RecoveryCodes = codes
 	err = makeResponse(w, http.StatusOK, response)
 	if err != nil {
 		log.Error(err)
-		err = responseError(w, err.Error())
+	}
+}
 
-		if err != nil {
+// @Summary Отключение двухфакторной аутентификации
+// @ID auth-2fa-disable
+// @Tags Auth
+// @Accept json
+// @Param payload body twoFactorCodePayload true "Код TOTP или код восстановления"
+// @Success 200
+// @Failure 400 "Некорректный формат данных"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 403 "Неправильный код"
+// @Failure 404 "Двухфакторная аутентификация не включена"
+// @Router /auth/2fa/disable [post]
+// @Security ApiKeyAuth
+func disableTwoFactorHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload twoFactorCodePayload
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.DisableTwoFactor.Do(userID, payload.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidTwoFactorCode):
+			w.WriteHeader(http.StatusForbidden)
+		case errors.Is(err, domain.ErrEntityNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
 			log.Error(err)
 		}
 
 		return
 	}
+
+	w.WriteHeader(http.StatusOK)
 }
 
-// @Summary Получение публичного ключа для валидации JWT на клиенте
-// @ID auth-certs
+// @Summary Параметры вывода ключа хранилища из мастер-пароля
+// @ID auth-prelogin
 // @Tags Auth
-// @Success 200 {object} object{kty=string,alg=string,x=string,y=string,use=string,kid=string}
-// @Router /auth/certs [get]
-func getCertsHandler(w http.ResponseWriter, _ *http.Request) {
-	certs, err := joseService.GetCerts()
+// @Accept json
+// @Produce json
+// @Param payload body preLoginPayload true "Логин"
+// @Success 200 {object} PreLoginResponse
+// @Failure 400 "Некорректный формат данных"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Router /auth/prelogin [post]
+func preLoginHandler(w http.ResponseWriter, r *http.Request) {
+	var payload preLoginPayload
+	body, err := parseBody(jsonType, r)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
+		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
 
 		return
 	}
-	resp, err := json.Marshal(certs)
+	payload, err = payload.Load(body)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
+		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
 
 		return
 	}
-	w.Header().Add("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, err = w.Write(resp)
+	w.Header().Set(contentTypeHeader, jsonType)
+	e2e, salt, err := app.PreLogin.Do(payload.Login)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
 		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
 	}
-}
 
-// @Summary Запрос состояния сервиса
-// @Tags Status
-// @ID health
-// @Success 200
-// @Failure 500
-// @Router /health [get]
-func getHealthHandler(w http.ResponseWriter, _ *http.Request) {
-	w.WriteHeader(http.StatusOK)
+	response := PreLoginResponse{
+		Status: true,
+	}
+	response.Data.E2E = e2e
+	response.Data.Salt = base64.StdEncoding.EncodeToString(salt)
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+	}
 }
 
-// @Summary Создать и зашифровать бинарные данные
-// @ID binary-create
-// @Tags Binary
-// @Accept mpfd
-// @Param data body []byte true "Содержимое файла"
+// @Summary Создать и зашифровать текстовые данные
+// @ID text-create
+// @Tags Text
+// @Accept plain
+// @Param data body string true "Текст для сохранения"
 // @Success 201
 // @Failure 400 "Некорректный формат данных"
 // @Failure 401 "Нет токена авторизации или токен невалиден"
 // @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
-// @Router /binary/create [post]
+// @Header 201 {string} ETag "Версия ресурса"
+// @Router /text/create [post]
 // @Security ApiKeyAuth
-func createBinaryHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
-	body, err := parseBody(binaryType, r)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		log.Error(err)
-
-		return
-	}
-	if len(body) == 0 {
+func createTextHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	body, err := parseBody(textType, r)
+	if err != nil || len(body) == 0 {
 		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
 
 		return
 	}
-	binID, err := app.CreateBinary.Do(userID, body)
+	textID, err := app.CreateText.Do(userID, string(body))
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		log.Error(err)
 
 		return
 	}
-	w.Header().Add("Location", binID.String())
+	w.Header().Add("Location", textID.String())
+	setETag(w, domain.InitialVersion)
 	w.WriteHeader(http.StatusCreated)
 }
 
-// @Summary Обновить и зашифровать существующие бинарные данные
-// @ID binary-update
-// @Tags Binary
-// @Accept mpfd
-// @Param binary_id path string true "Binary ID"
-// @Param data body []byte true "Содержимое файла"
+// @Summary Обновить и зашифровать существующие текстовые данные
+// @ID text-update
+// @Tags Text
+// @Accept plain
+// @Param text_id path string true "Text ID"
+// @Param data body string true "Текст для сохранения"
+// @Param If-Match header string false "Ожидаемая версия ресурса, при несовпадении вернется 409"
 // @Success 200
+// @Header 200 {string} ETag "Новая версия ресурса"
 // @Failure 400 "Некорректный формат данных или идентификатора"
 // @Failure 401 "Нет токена авторизации или токен невалиден"
 // @Failure 404 "Не найдено"
-// @Router /binary/{binary_id} [post]
+// @Failure 409 {object} UpdateTextConflictResponse "Версия ресурса изменилась, в ответе актуальная копия"
+// @Router /text/{text_id} [post]
 // @Security ApiKeyAuth
-func updateBinaryHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
-	id, err := getRouteID(r, "binaryID")
+func updateTextHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "textID")
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
 
 		return
 	}
-	body, err := parseBody(binaryType, r)
-	if err != nil {
+
+	body, err := parseBody(textType, r)
+	if err != nil || len(body) == 0 {
 		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
 
 		return
 	}
-	if len(body) == 0 {
+	version, err := getIfMatchVersion(r)
+	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
 
 		return
 	}
-	err = app.UpdateBinary.Do(userID, id, body)
+	text, err := app.UpdateText.Do(userID, id, string(body), version)
 	if err != nil {
 		if errors.Is(err, domain.ErrEntityNotFound) {
 			w.WriteHeader(http.StatusNotFound)
+		} else if errors.Is(err, domain.ErrVersionConflict) {
+			response := UpdateTextConflictResponse{
+				Status:  false,
+				Message: err.Error(),
+				Data:    newTextResponse(*text),
+			}
+			responseConflict(w, text.Version, response)
 		} else {
 			w.WriteHeader(http.StatusInternalServerError)
 			log.Error(err)
@@ -332,20 +485,30 @@ func updateBinaryHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUI
 
 		return
 	}
+	setETag(w, text.Version)
 	w.WriteHeader(http.StatusOK)
 }
 
-// @Summary Получить все расшифрованные бинарные данные
-// @ID binary-all
-// @Tags Binary
-// @Success 200 {object} GetAllBinariesResponse
+// @Summary Получить все расшифрованные текстовые данные
+// @ID text-all
+// @Tags Text
+// @Param limit query int false "Размер страницы, по умолчанию 100, не больше 1000"
+// @Param cursor query string false "Курсор следующей страницы из предыдущего ответа"
+// @Success 200 {object} GetAllTextsResponse
+// @Failure 400 "Невалидные параметры страницы"
 // @Failure 401 "Нет токена авторизации или токен невалиден"
-// @Router /binary/all [get]
+// @Router /text/all [get]
 // @Security ApiKeyAuth
-func getAllBinariesHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID) {
-	binariesResponse := []binaryResponse{}
+func getAllTextsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	page, err := getPageQuery(r, domain.TextKind)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	textsResponse := []textResponse{}
 	w.Header().Set(contentTypeHeader, jsonType)
-	binaries, err := app.GetAllBinaries.Do(userID)
+	texts, next, err := app.GetAllTexts.Do(userID, page)
 	if err != nil {
 		log.Error(err)
 		err = responseError(w, err.Error())
@@ -356,18 +519,1886 @@ func getAllBinariesHandler(w http.ResponseWriter, _ *http.Request, userID uuid.U
 		return
 	}
 
-	for _, v := range binaries {
-		respItem := binaryResponse{
-			ID:      v.ID.String(),
-			Content: v.Content,
+	for _, v := range texts {
+		respItem := newTextResponse(v)
+		textsResponse = append(textsResponse, respItem)
+	}
+
+	response := GetAllTextsResponse{
+		Status: true,
+	}
+	response.Data.Texts = textsResponse
+
+	response.Data.NextCursor = encodeCursor(domain.TextKind, next)
+
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+}
+
+// @Summary Получить расшифрованные текстовые данные по идентификатору
+// @ID text-get
+// @Tags Text
+// @Param text_id path string true "Text ID"
+// @Success 200 {object} GetTextResponse
+// @Header 200 {string} ETag "Текущая версия ресурса"
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /text/{text_id} [get]
+// @Security ApiKeyAuth
+func getTextHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "textID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Set(contentTypeHeader, jsonType)
+	text, err := app.GetText.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	response := GetTextResponse{
+		Status: true,
+		Data:   newTextResponse(*text),
+	}
+	setETag(w, text.Version)
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// @Summary Удалить существующие текстовые данные
+// @ID text-delete
+// @Tags Text
+// @Param text_id path string true "Text ID"
+// @Success 200
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /text/{text_id} [delete]
+// @Security ApiKeyAuth
+func deleteTextHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "textID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.DeleteText.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Получение публичного ключа для валидации JWT на клиенте
+// @ID auth-certs
+// @Tags Auth
+// @Success 200 {object} object{kty=string,alg=string,x=string,y=string,use=string,kid=string}
+// @Router /auth/certs [get]
+func getCertsHandler(w http.ResponseWriter, _ *http.Request) {
+	certs, err := joseService.GetCerts()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	resp, err := json.Marshal(certs)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+	}
+}
+
+// @Summary Запрос состояния сервиса
+// @Tags Status
+// @ID health
+// @Success 200
+// @Failure 500
+// @Router /health [get]
+func getHealthHandler(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Создать и зашифровать бинарные данные
+// @ID binary-create
+// @Tags Binary
+// @Accept mpfd
+// @Param data body []byte true "Содержимое файла"
+// @Param X-Binary-Metadata header string false "Метаданные в формате JSON (name, mime_type, sha256, meta), закодированные в base64"
+// @Success 201
+// @Failure 400 "Некорректный формат данных"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
+// @Header 201 {string} ETag "Версия ресурса"
+// @Router /binary/create [post]
+// @Security ApiKeyAuth
+func createBinaryHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	body, err := parseBody(binaryType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	if len(body) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	metadata, err := getBinaryMetadata(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	binID, err := app.CreateBinary.Do(userID, body, metadata)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Add("Location", binID.String())
+	setETag(w, domain.InitialVersion)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Summary Обновить и зашифровать существующие бинарные данные
+// @ID binary-update
+// @Tags Binary
+// @Accept mpfd
+// @Param binary_id path string true "Binary ID"
+// @Param data body []byte true "Содержимое файла"
+// @Param X-Binary-Metadata header string false "Метаданные в формате JSON (name, mime_type, sha256, meta), закодированные в base64"
+// @Param If-Match header string false "Ожидаемая версия ресурса, при несовпадении вернется 409"
+// @Success 200
+// @Header 200 {string} ETag "Новая версия ресурса"
+// @Failure 400 "Некорректный формат данных или идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Failure 409 {object} UpdateBinaryConflictResponse "Версия ресурса изменилась, в ответе актуальная копия"
+// @Router /binary/{binary_id} [post]
+// @Security ApiKeyAuth
+func updateBinaryHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "binaryID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	body, err := parseBody(binaryType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	if len(body) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	version, err := getIfMatchVersion(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	metadata, err := getBinaryMetadata(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	bin, err := app.UpdateBinary.Do(userID, id, body, metadata, version)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if errors.Is(err, domain.ErrVersionConflict) {
+			response := UpdateBinaryConflictResponse{
+				Status:  false,
+				Message: err.Error(),
+				Data:    newBinaryResponse(*bin),
+			}
+			responseConflict(w, bin.Version, response)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	setETag(w, bin.Version)
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Получить расшифрованные метаданные всех бинарных данных
+// @Description Содержимое не возвращается, его можно скачать отдельным запросом
+// @ID binary-all
+// @Tags Binary
+// @Param limit query int false "Размер страницы, по умолчанию 100, не больше 1000"
+// @Param cursor query string false "Курсор следующей страницы из предыдущего ответа"
+// @Success 200 {object} GetAllBinariesResponse
+// @Failure 400 "Невалидные параметры страницы"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Router /binary/all [get]
+// @Security ApiKeyAuth
+func getAllBinariesHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	page, err := getPageQuery(r, domain.BinaryKind)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	binariesResponse := []binaryResponse{}
+	w.Header().Set(contentTypeHeader, jsonType)
+	binaries, next, err := app.GetAllBinaries.Do(userID, page)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	for _, v := range binaries {
+		respItem := newBinaryResponse(v)
+		binariesResponse = append(binariesResponse, respItem)
+	}
+
+	response := GetAllBinariesResponse{
+		Status: true,
+	}
+	response.Data.Binaries = binariesResponse
+
+	response.Data.NextCursor = encodeCursor(domain.BinaryKind, next)
+
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+}
+
+// @Summary Получить расшифрованные метаданные бинарных данных по идентификатору
+// @Description Содержимое не возвращается, его можно скачать запросом /binary/{binary_id}/content
+// @ID binary-get
+// @Tags Binary
+// @Param binary_id path string true "Binary ID"
+// @Success 200 {object} GetBinaryResponse
+// @Header 200 {string} ETag "Текущая версия ресурса"
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /binary/{binary_id} [get]
+// @Security ApiKeyAuth
+func getBinaryHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "binaryID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Set(contentTypeHeader, jsonType)
+	bin, err := app.GetBinary.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	response := GetBinaryResponse{
+		Status: true,
+		Data:   newBinaryResponse(*bin),
+	}
+	setETag(w, bin.Version)
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// @Summary Удалить существующие бинарные данные
+// @ID binary-delete
+// @Tags Binary
+// @Param binary_id path string true "Binary ID"
+// @Success 200
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /binary/{binary_id} [delete]
+// @Security ApiKeyAuth
+func deleteBinaryHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "binaryID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.DeleteBinary.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Начать загрузку бинарных данных частями
+// @Description Части загружаются в любом порядке, прерванную загрузку можно продолжить, запросив номера уже полученных частей
+// @ID binary-upload-start
+// @Tags Binary
+// @Accept json
+// @Param payload body binaryUploadPayload true "Полный размер данных и размер части в байтах, не больше 16 МиБ"
+// @Success 201
+// @Failure 400 "Некорректный формат данных или размер части"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID сессии загрузки"
+// @Router /binary/upload [post]
+// @Security ApiKeyAuth
+func startBinaryUploadHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload binaryUploadPayload
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	uploadID, err := app.StartBinaryUpload.Do(userID, payload.Size, payload.ChunkSize)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidChunk) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.Header().Add("Location", uploadID.String())
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Summary Получить состояние загрузки бинарных данных частями
+// @ID binary-upload-get
+// @Tags Binary
+// @Param upload_id path string true "Upload ID"
+// @Success 200 {object} BinaryUploadResponse
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка"
+// @Router /binary/upload/{upload_id} [get]
+// @Security ApiKeyAuth
+func getBinaryUploadHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "uploadID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	upload, received, err := app.GetBinaryUpload.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			log.Error(err)
+			w.Header().Set(contentTypeHeader, jsonType)
+			if err = responseError(w, err.Error()); err != nil {
+				log.Error(err)
+			}
+		}
+
+		return
+	}
+	response := BinaryUploadResponse{
+		Status: true,
+	}
+	response.Data.Size = upload.Size
+	response.Data.ChunkSize = upload.ChunkSize
+	response.Data.Received = received
+	w.Header().Set(contentTypeHeader, jsonType)
+	if err = makeResponse(w, http.StatusOK, response); err != nil {
+		log.Error(err)
+	}
+}
+
+// @Summary Загрузить одну часть бинарных данных
+// @Description Размер части должен совпадать с размером из сессии, последняя часть содержит остаток.
+// @Description Повторная загрузка части с тем же номером перезаписывает ее
+// @ID binary-upload-chunk
+// @Tags Binary
+// @Accept octet-stream
+// @Param upload_id path string true "Upload ID"
+// @Param index path int true "Номер части, начиная с 0"
+// @Param data body []byte true "Содержимое части"
+// @Success 204
+// @Failure 400 "Некорректный формат данных, номер или размер части"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /binary/upload/{upload_id}/{index} [put]
+// @Security ApiKeyAuth
+func uploadBinaryChunkHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "uploadID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	index, err := strconv.ParseInt(chi.URLParam(r, "index"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, usecases.MaxChunkSize)
+	body, err := parseBody(octetStreamType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.UploadBinaryChunk.Do(userID, id, index, body)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidChunk):
+			w.WriteHeader(http.StatusBadRequest)
+		case errors.Is(err, domain.ErrEntityNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary Завершить загрузку бинарных данных частями
+// @ID binary-upload-commit
+// @Tags Binary
+// @Param upload_id path string true "Upload ID"
+// @Param X-Binary-Metadata header string false "Метаданные в формате JSON (name, mime_type, sha256, meta), закодированные в base64"
+// @Success 201
+// @Failure 400 "Некорректный формат идентификатора, метаданных или загружены не все части"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
+// @Header 201 {string} ETag "Версия ресурса"
+// @Router /binary/upload/{upload_id}/commit [post]
+// @Security ApiKeyAuth
+func commitBinaryUploadHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "uploadID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	metadata, err := getBinaryMetadata(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	binID, err := app.CommitBinaryUpload.Do(userID, id, metadata)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUploadIncomplete):
+			w.WriteHeader(http.StatusBadRequest)
+		case errors.Is(err, domain.ErrEntityNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.Header().Add("Location", binID.String())
+	setETag(w, domain.InitialVersion)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Summary Скачать расшифрованное содержимое бинарных данных
+// @Description Данные расшифровываются и отправляются по частям, не загружаясь в память целиком
+// @ID binary-content
+// @Tags Binary
+// @Produce octet-stream
+// @Param binary_id path string true "Binary ID"
+// @Success 200 {file} file "Содержимое бинарных данных"
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /binary/{binary_id}/content [get]
+// @Security ApiKeyAuth
+func downloadBinaryHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "binaryID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	started := false
+	err = app.DownloadBinary.Do(userID, id, func(size int64) io.Writer {
+		started = true
+		w.Header().Set(contentTypeHeader, octetStreamType)
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		w.WriteHeader(http.StatusOK)
+
+		return w
+	})
+	if err == nil {
+		return
+	}
+	log.Error(err)
+	// Статус уже отправлен, клиент обнаружит обрыв по несовпадению длины
+	if started {
+		return
+	}
+	if errors.Is(err, domain.ErrEntityNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+	} else {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// @Summary Создать и зашифровать логин и пароль
+// @ID credentials-create
+// @Tags Credentials
+// @Accept json
+// @Param data body credentialsPayload true "Наименование, логин и пароль"
+// @Success 201
+// @Failure 400 "Некорректный формат данных"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
+// @Header 201 {string} ETag "Версия ресурса"
+// @Router /credentials/create [post]
+// @Security ApiKeyAuth
+func createCredentialsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload credentialsPayload
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	credID, err := app.CreateCredentials.Do(
+		userID,
+		payload.Name,
+		payload.Login,
+		payload.Password,
+		payload.Meta,
+	)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Add("Location", credID.String())
+	setETag(w, domain.InitialVersion)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Summary Обновить и зашифровать существующий логин и пароль
+// @ID credentials-update
+// @Tags Credentials
+// @Accept json
+// @Param credentials_id path string true "Credentials ID"
+// @Param data body credentialsPayload true "Наименование, логин и пароль"
+// @Param If-Match header string false "Ожидаемая версия ресурса, при несовпадении вернется 409"
+// @Success 200
+// @Header 200 {string} ETag "Новая версия ресурса"
+// @Failure 400 "Некорректный формат данных или идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Failure 409 {object} UpdateCredentialsConflictResponse "Версия ресурса изменилась, в ответе актуальная копия"
+// @Router /credentials/{credentials_id} [post]
+// @Security ApiKeyAuth
+func updateCredentialsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload credentialsPayload
+	id, err := getRouteID(r, "credID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	version, err := getIfMatchVersion(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	cred, err := app.UpdateCredentials.Do(
+		userID,
+		id,
+		payload.Name,
+		payload.Login,
+		payload.Password,
+		payload.Meta,
+		version,
+	)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if errors.Is(err, domain.ErrVersionConflict) {
+			response := UpdateCredentialsConflictResponse{
+				Status:  false,
+				Message: err.Error(),
+				Data:    newCredentialsResponse(cred),
+			}
+			responseConflict(w, cred.Version, response)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	setETag(w, cred.Version)
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Получить все расшифрованные логины и пароли
+// @ID credentials-all
+// @Tags Credentials
+// @Param limit query int false "Размер страницы, по умолчанию 100, не больше 1000"
+// @Param cursor query string false "Курсор следующей страницы из предыдущего ответа"
+// @Success 200 {object} GetAllCredentialsResponse
+// @Failure 400 "Невалидные параметры страницы"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Router /credentials/all [get]
+// @Security ApiKeyAuth
+func getAllCredentialsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	page, err := getPageQuery(r, domain.CredentialsKind)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	credResponse := []credentialsResponse{}
+	w.Header().Set(contentTypeHeader, jsonType)
+	credentials, next, err := app.GetAllCredentials.Do(userID, page)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	for _, v := range credentials {
+		respItem := newCredentialsResponse(v)
+		credResponse = append(credResponse, respItem)
+	}
+
+	response := GetAllCredentialsResponse{
+		Status: true,
+	}
+	response.Data.Credentials = credResponse
+
+	response.Data.NextCursor = encodeCursor(domain.CredentialsKind, next)
+
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+}
+
+// @Summary Получить расшифрованные логин и пароль по идентификатору
+// @ID credentials-get
+// @Tags Credentials
+// @Param credentials_id path string true "Credentials ID"
+// @Success 200 {object} GetCredentialsResponse
+// @Header 200 {string} ETag "Текущая версия ресурса"
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /credentials/{credentials_id} [get]
+// @Security ApiKeyAuth
+func getCredentialsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "credID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Set(contentTypeHeader, jsonType)
+	cred, err := app.GetCredentials.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	response := GetCredentialsResponse{
+		Status: true,
+		Data:   newCredentialsResponse(cred),
+	}
+	setETag(w, cred.Version)
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// @Summary Удалить существующий логин и пароль
+// @ID credentials-delete
+// @Tags Credentials
+// @Param credentials_id path string true "Credentials ID"
+// @Success 200
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /credentials/{credentials_id} [delete]
+// @Security ApiKeyAuth
+func deleteCredentialsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "credID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.DeleteCredentials.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Создать и зашифровать банковскую карту
+// @ID bank-card-create
+// @Tags BankCard
+// @Accept json
+// @Param data body bankCardPayload true "Номер, срок действия, cvv код, ФИО держателя карты"
+// @Success 201
+// @Failure 400 "Некорректный формат данных"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
+// @Header 201 {string} ETag "Версия ресурса"
+// @Router /bank_card/create [post]
+// @Security ApiKeyAuth
+func createBankCardHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload bankCardPayload
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	e2e, err := app.CheckE2E.Do(userID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body, e2e)
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	cardID, err := app.CreateBankCard.Do(
+		userID,
+		payload.Number,
+		payload.ValidThru,
+		payload.CVV,
+		payload.CardHolder,
+		payload.Meta,
+	)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Add("Location", cardID.String())
+	setETag(w, domain.InitialVersion)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Summary Обновить и зашифровать существующую банковскую карту
+// @ID bank-card-update
+// @Tags BankCard
+// @Accept json
+// @Param bank_card_id path string true "Bank Card ID"
+// @Param data body bankCardPayload true "Номер, срок действия, cvv код, ФИО держателя карты"
+// @Param If-Match header string false "Ожидаемая версия ресурса, при несовпадении вернется 409"
+// @Success 200
+// @Header 200 {string} ETag "Новая версия ресурса"
+// @Failure 400 "Некорректный формат данных или идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Failure 409 {object} UpdateBankCardConflictResponse "Версия ресурса изменилась, в ответе актуальная копия"
+// @Router /bank_card/{bank_card_id} [post]
+// @Security ApiKeyAuth
+func updateBankCardHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload bankCardPayload
+	id, err := getRouteID(r, "cardID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	e2e, err := app.CheckE2E.Do(userID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body, e2e)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	version, err := getIfMatchVersion(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	card, err := app.UpdateBankCard.Do(
+		userID,
+		id,
+		payload.Number,
+		payload.ValidThru,
+		payload.CVV,
+		payload.CardHolder,
+		payload.Meta,
+		version,
+	)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if errors.Is(err, domain.ErrVersionConflict) {
+			response := UpdateBankCardConflictResponse{
+				Status:  false,
+				Message: err.Error(),
+				Data:    newBankCardResponse(card),
+			}
+			responseConflict(w, card.Version, response)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	setETag(w, card.Version)
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Получить все расшифрованные банковские карты
+// @ID bank-card-all
+// @Tags BankCard
+// @Param limit query int false "Размер страницы, по умолчанию 100, не больше 1000"
+// @Param cursor query string false "Курсор следующей страницы из предыдущего ответа"
+// @Success 200 {object} GetAllBankCardsResponse
+// @Failure 400 "Невалидные параметры страницы"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Router /bank_card/all [get]
+// @Security ApiKeyAuth
+func getAllBankCardsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	page, err := getPageQuery(r, domain.BankCardKind)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	bankCardsResponse := []bankCardResponse{}
+	w.Header().Set(contentTypeHeader, jsonType)
+	bankCards, next, err := app.GetAllBankCards.Do(userID, page)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	for _, v := range bankCards {
+		respItem := newBankCardResponse(v)
+		bankCardsResponse = append(bankCardsResponse, respItem)
+	}
+
+	response := GetAllBankCardsResponse{
+		Status: true,
+	}
+	response.Data.BankCards = bankCardsResponse
+
+	response.Data.NextCursor = encodeCursor(domain.BankCardKind, next)
+
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+}
+
+// @Summary Получить расшифрованную банковскую карту по идентификатору
+// @ID bank-card-get
+// @Tags BankCard
+// @Param bank_card_id path string true "Bank Card ID"
+// @Success 200 {object} GetBankCardResponse
+// @Header 200 {string} ETag "Текущая версия ресурса"
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /bank_card/{bank_card_id} [get]
+// @Security ApiKeyAuth
+func getBankCardHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "cardID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Set(contentTypeHeader, jsonType)
+	card, err := app.GetBankCard.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	response := GetBankCardResponse{
+		Status: true,
+		Data:   newBankCardResponse(card),
+	}
+	setETag(w, card.Version)
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// @Summary Удалить существующую банковскую карту
+// @ID bank-card-delete
+// @Tags BankCard
+// @Param bank_card_id path string true "Bank Card ID"
+// @Success 200
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /bank_card/{bank_card_id} [delete]
+// @Security ApiKeyAuth
+func deleteBankCardHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "cardID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.DeleteBankCard.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Создать и зашифровать секрет одноразовых паролей
+// @ID otp-create
+// @Tags OTP
+// @Accept json
+// @Param data body otpPayload true "Сервис, учетная запись, секрет base32, алгоритм, количество цифр и период в секундах"
+// @Success 201
+// @Failure 400 "Некорректный формат данных"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
+// @Header 201 {string} ETag "Версия ресурса"
+// @Router /otp/create [post]
+// @Security ApiKeyAuth
+func createOTPHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload otpPayload
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	e2e, err := app.CheckE2E.Do(userID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body, e2e)
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	otpID, err := app.CreateOTP.Do(
+		userID,
+		payload.Issuer,
+		payload.Account,
+		payload.Secret,
+		payload.Algorithm,
+		payload.Digits,
+		payload.Period,
+		payload.Meta,
+	)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Add("Location", otpID.String())
+	setETag(w, domain.InitialVersion)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Summary Обновить и зашифровать существующий секрет одноразовых паролей
+// @ID otp-update
+// @Tags OTP
+// @Accept json
+// @Param otp_id path string true "OTP ID"
+// @Param data body otpPayload true "Сервис, учетная запись, секрет base32, алгоритм, количество цифр и период в секундах"
+// @Param If-Match header string false "Ожидаемая версия ресурса, при несовпадении вернется 409"
+// @Success 200
+// @Header 200 {string} ETag "Новая версия ресурса"
+// @Failure 400 "Некорректный формат данных или идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Failure 409 {object} UpdateOTPConflictResponse "Версия ресурса изменилась, в ответе актуальная копия"
+// @Router /otp/{otp_id} [post]
+// @Security ApiKeyAuth
+func updateOTPHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload otpPayload
+	id, err := getRouteID(r, "otpID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	e2e, err := app.CheckE2E.Do(userID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body, e2e)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	version, err := getIfMatchVersion(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	otp, err := app.UpdateOTP.Do(
+		userID,
+		id,
+		payload.Issuer,
+		payload.Account,
+		payload.Secret,
+		payload.Algorithm,
+		payload.Digits,
+		payload.Period,
+		payload.Meta,
+		version,
+	)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if errors.Is(err, domain.ErrVersionConflict) {
+			response := UpdateOTPConflictResponse{
+				Status:  false,
+				Message: err.Error(),
+				Data:    newOTPResponse(otp),
+			}
+			responseConflict(w, otp.Version, response)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	setETag(w, otp.Version)
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Получить все расшифрованные секреты одноразовых паролей
+// @ID otp-all
+// @Tags OTP
+// @Param limit query int false "Размер страницы, по умолчанию 100, не больше 1000"
+// @Param cursor query string false "Курсор следующей страницы из предыдущего ответа"
+// @Success 200 {object} GetAllOTPsResponse
+// @Failure 400 "Невалидные параметры страницы"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Router /otp/all [get]
+// @Security ApiKeyAuth
+func getAllOTPsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	page, err := getPageQuery(r, domain.OTPKind)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	otpsResponse := []otpResponse{}
+	w.Header().Set(contentTypeHeader, jsonType)
+	otps, next, err := app.GetAllOTPs.Do(userID, page)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	for _, v := range otps {
+		respItem := newOTPResponse(v)
+		otpsResponse = append(otpsResponse, respItem)
+	}
+
+	response := GetAllOTPsResponse{
+		Status: true,
+	}
+	response.Data.OTPs = otpsResponse
+
+	response.Data.NextCursor = encodeCursor(domain.OTPKind, next)
+
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+}
+
+// @Summary Получить расшифрованный секрет одноразовых паролей по идентификатору
+// @ID otp-get
+// @Tags OTP
+// @Param otp_id path string true "OTP ID"
+// @Success 200 {object} GetOTPResponse
+// @Header 200 {string} ETag "Текущая версия ресурса"
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /otp/{otp_id} [get]
+// @Security ApiKeyAuth
+func getOTPHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "otpID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Set(contentTypeHeader, jsonType)
+	otp, err := app.GetOTP.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	response := GetOTPResponse{
+		Status: true,
+		Data:   newOTPResponse(otp),
+	}
+	setETag(w, otp.Version)
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// @Summary Удалить существующий секрет одноразовых паролей
+// @ID otp-delete
+// @Tags OTP
+// @Param otp_id path string true "OTP ID"
+// @Success 200
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /otp/{otp_id} [delete]
+// @Security ApiKeyAuth
+func deleteOTPHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "otpID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.DeleteOTP.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Создать и зашифровать SSH ключ
+// @ID ssh-key-create
+// @Tags SSHKey
+// @Accept json
+// @Param data body sshKeyPayload true "Закрытый ключ PEM, открытый ключ, комментарий и отпечаток"
+// @Success 201
+// @Failure 400 "Некорректный формат данных"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
+// @Header 201 {string} ETag "Версия ресурса"
+// @Router /ssh_key/create [post]
+// @Security ApiKeyAuth
+func createSSHKeyHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload sshKeyPayload
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	e2e, err := app.CheckE2E.Do(userID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body, e2e)
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	keyID, err := app.CreateSSHKey.Do(
+		userID,
+		payload.PrivateKey,
+		payload.PublicKey,
+		payload.Comment,
+		payload.Fingerprint,
+		payload.Meta,
+	)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Add("Location", keyID.String())
+	setETag(w, domain.InitialVersion)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Summary Обновить и зашифровать существующий SSH ключ
+// @ID ssh-key-update
+// @Tags SSHKey
+// @Accept json
+// @Param ssh_key_id path string true "SSH key ID"
+// @Param data body sshKeyPayload true "Закрытый ключ PEM, открытый ключ, комментарий и отпечаток"
+// @Param If-Match header string false "Ожидаемая версия ресурса, при несовпадении вернется 409"
+// @Success 200
+// @Header 200 {string} ETag "Новая версия ресурса"
+// @Failure 400 "Некорректный формат данных или идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Failure 409 {object} UpdateSSHKeyConflictResponse "Версия ресурса изменилась, в ответе актуальная копия"
+// @Router /ssh_key/{ssh_key_id} [post]
+// @Security ApiKeyAuth
+func updateSSHKeyHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload sshKeyPayload
+	id, err := getRouteID(r, "sshKeyID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	e2e, err := app.CheckE2E.Do(userID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body, e2e)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	version, err := getIfMatchVersion(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	key, err := app.UpdateSSHKey.Do(
+		userID,
+		id,
+		payload.PrivateKey,
+		payload.PublicKey,
+		payload.Comment,
+		payload.Fingerprint,
+		payload.Meta,
+		version,
+	)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if errors.Is(err, domain.ErrVersionConflict) {
+			response := UpdateSSHKeyConflictResponse{
+				Status:  false,
+				Message: err.Error(),
+				Data:    newSSHKeyResponse(key),
+			}
+			responseConflict(w, key.Version, response)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	setETag(w, key.Version)
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Получить все расшифрованные SSH ключи
+// @ID ssh-key-all
+// @Tags SSHKey
+// @Param limit query int false "Размер страницы, по умолчанию 100, не больше 1000"
+// @Param cursor query string false "Курсор следующей страницы из предыдущего ответа"
+// @Success 200 {object} GetAllSSHKeysResponse
+// @Failure 400 "Невалидные параметры страницы"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Router /ssh_key/all [get]
+// @Security ApiKeyAuth
+func getAllSSHKeysHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	page, err := getPageQuery(r, domain.SSHKeyKind)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	sshKeysResponse := []sshKeyResponse{}
+	w.Header().Set(contentTypeHeader, jsonType)
+	keys, next, err := app.GetAllSSHKeys.Do(userID, page)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	for _, v := range keys {
+		respItem := newSSHKeyResponse(v)
+		sshKeysResponse = append(sshKeysResponse, respItem)
+	}
+
+	response := GetAllSSHKeysResponse{
+		Status: true,
+	}
+	response.Data.SSHKeys = sshKeysResponse
+
+	response.Data.NextCursor = encodeCursor(domain.SSHKeyKind, next)
+
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+}
+
+// @Summary Получить расшифрованный SSH ключ по идентификатору
+// @ID ssh-key-get
+// @Tags SSHKey
+// @Param ssh_key_id path string true "SSH key ID"
+// @Success 200 {object} GetSSHKeyResponse
+// @Header 200 {string} ETag "Текущая версия ресурса"
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /ssh_key/{ssh_key_id} [get]
+// @Security ApiKeyAuth
+func getSSHKeyHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "sshKeyID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Set(contentTypeHeader, jsonType)
+	key, err := app.GetSSHKey.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	response := GetSSHKeyResponse{
+		Status: true,
+		Data:   newSSHKeyResponse(key),
+	}
+	setETag(w, key.Version)
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// @Summary Удалить существующий SSH ключ
+// @ID ssh-key-delete
+// @Tags SSHKey
+// @Param ssh_key_id path string true "SSH key ID"
+// @Success 200
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /ssh_key/{ssh_key_id} [delete]
+// @Security ApiKeyAuth
+func deleteSSHKeyHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "sshKeyID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.DeleteSSHKey.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Создать и зашифровать данные по шаблону
+// @ID item-create
+// @Tags Item
+// @Accept json
+// @Param data body itemPayload true "Название, шаблон, поля с типами и значениями"
+// @Success 201
+// @Failure 400 "Некорректный формат данных"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
+// @Header 201 {string} ETag "Версия ресурса"
+// @Router /item/create [post]
+// @Security ApiKeyAuth
+func createItemHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload itemPayload
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	e2e, err := app.CheckE2E.Do(userID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body, e2e)
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	itemID, err := app.CreateItem.Do(
+		userID,
+		payload.Name,
+		payload.Template,
+		payload.Fields,
+		payload.Meta,
+	)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Add("Location", itemID.String())
+	setETag(w, domain.InitialVersion)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Summary Обновить и зашифровать существующие данные по шаблону
+// @ID item-update
+// @Tags Item
+// @Accept json
+// @Param item_id path string true "Item ID"
+// @Param data body itemPayload true "Название, шаблон, поля с типами и значениями"
+// @Param If-Match header string false "Ожидаемая версия ресурса, при несовпадении вернется 409"
+// @Success 200
+// @Header 200 {string} ETag "Новая версия ресурса"
+// @Failure 400 "Некорректный формат данных или идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Failure 409 {object} UpdateItemConflictResponse "Версия ресурса изменилась, в ответе актуальная копия"
+// @Router /item/{item_id} [post]
+// @Security ApiKeyAuth
+func updateItemHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload itemPayload
+	id, err := getRouteID(r, "itemID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	e2e, err := app.CheckE2E.Do(userID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body, e2e)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	version, err := getIfMatchVersion(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	obj, err := app.UpdateItem.Do(
+		userID,
+		id,
+		payload.Name,
+		payload.Template,
+		payload.Fields,
+		payload.Meta,
+		version,
+	)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if errors.Is(err, domain.ErrVersionConflict) {
+			response := UpdateItemConflictResponse{
+				Status:  false,
+				Message: err.Error(),
+				Data:    newItemResponse(obj),
+			}
+			responseConflict(w, obj.Version, response)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
 		}
-		binariesResponse = append(binariesResponse, respItem)
+
+		return
 	}
+	setETag(w, obj.Version)
+	w.WriteHeader(http.StatusOK)
+}
 
-	response := GetAllBinariesResponse{
+// @Summary Получить все расшифрованные данные по шаблону
+// @ID item-all
+// @Tags Item
+// @Param limit query int false "Размер страницы, по умолчанию 100, не больше 1000"
+// @Param cursor query string false "Курсор следующей страницы из предыдущего ответа"
+// @Success 200 {object} GetAllItemsResponse
+// @Failure 400 "Невалидные параметры страницы"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Router /item/all [get]
+// @Security ApiKeyAuth
+func getAllItemsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	page, err := getPageQuery(r, domain.ItemKind)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	itemsResponse := []itemResponse{}
+	w.Header().Set(contentTypeHeader, jsonType)
+	items, next, err := app.GetAllItems.Do(userID, page)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	for _, v := range items {
+		respItem := newItemResponse(v)
+		itemsResponse = append(itemsResponse, respItem)
+	}
+
+	response := GetAllItemsResponse{
 		Status: true,
 	}
-	response.Data.Binaries = binariesResponse
+	response.Data.Items = itemsResponse
+
+	response.Data.NextCursor = encodeCursor(domain.ItemKind, next)
 
 	err = makeResponse(w, http.StatusOK, response)
 	if err != nil {
@@ -382,19 +2413,269 @@ func getAllBinariesHandler(w http.ResponseWriter, _ *http.Request, userID uuid.U
 	}
 }
 
-// @Summary Создать и зашифровать логин и пароль
-// @ID credentials-create
-// @Tags Credentials
+// @Summary Получить расшифрованные данные по шаблону по идентификатору
+// @ID item-get
+// @Tags Item
+// @Param item_id path string true "Item ID"
+// @Success 200 {object} GetItemResponse
+// @Header 200 {string} ETag "Текущая версия ресурса"
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /item/{item_id} [get]
+// @Security ApiKeyAuth
+func getItemHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "itemID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	w.Header().Set(contentTypeHeader, jsonType)
+	obj, err := app.GetItem.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	response := GetItemResponse{
+		Status: true,
+		Data:   newItemResponse(obj),
+	}
+	setETag(w, obj.Version)
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// @Summary Удалить существующие данные по шаблону
+// @ID item-delete
+// @Tags Item
+// @Param item_id path string true "Item ID"
+// @Success 200
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /item/{item_id} [delete]
+// @Security ApiKeyAuth
+func deleteItemHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "itemID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.DeleteItem.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Создать папку с зашифрованным именем
+// @ID folder-create
+// @Tags Folder
 // @Accept json
-// @Param data body credentialsPayload true "Наименование, логин и пароль"
+// @Param data body folderPayload true "Имя и идентификатор родительской папки, нулевой UUID означает корень"
 // @Success 201
 // @Failure 400 "Некорректный формат данных"
 // @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Родительская папка не найдена"
 // @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
-// @Router /credentials/create [post]
+// @Router /folder/create [post]
 // @Security ApiKeyAuth
-func createCredentialsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
-	var payload credentialsPayload
+func createFolderHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload folderPayload
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	folderID, err := app.CreateFolder.Do(userID, payload.Name, payload.ParentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.Header().Add("Location", folderID.String())
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Summary Переименовать или переместить существующую папку
+// @ID folder-update
+// @Tags Folder
+// @Accept json
+// @Param folder_id path string true "Folder ID"
+// @Param data body folderPayload true "Имя и идентификатор родительской папки, нулевой UUID означает корень"
+// @Success 200
+// @Failure 400 "Некорректный формат данных или папка перемещается в саму себя"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /folder/{folder_id} [post]
+// @Security ApiKeyAuth
+func updateFolderHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload folderPayload
+	id, err := getRouteID(r, "folderID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	body, err := parseBody(jsonType, r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.UpdateFolder.Do(userID, id, payload.Name, payload.ParentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if errors.Is(err, domain.ErrFolderCycle) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Получить все папки с расшифрованными именами
+// @ID folder-all
+// @Tags Folder
+// @Success 200 {object} GetAllFoldersResponse
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Router /folder/all [get]
+// @Security ApiKeyAuth
+func getAllFoldersHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID) {
+	foldersResponse := []folderResponse{}
+	w.Header().Set(contentTypeHeader, jsonType)
+	folders, err := app.GetAllFolders.Do(userID)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	for _, v := range folders {
+		respItem := newFolderResponse(v)
+		foldersResponse = append(foldersResponse, respItem)
+	}
+
+	response := GetAllFoldersResponse{
+		Status: true,
+	}
+	response.Data.Folders = foldersResponse
+
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+}
+
+// @Summary Удалить существующую папку
+// @Description Вложенные папки и данные из удаленной папки переносятся в ее родительскую папку
+// @ID folder-delete
+// @Tags Folder
+// @Param folder_id path string true "Folder ID"
+// @Success 200
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /folder/{folder_id} [delete]
+// @Security ApiKeyAuth
+func deleteFolderHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "folderID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.DeleteFolder.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Создать метку с зашифрованным именем
+// @ID tag-create
+// @Tags Tag
+// @Accept json
+// @Param data body tagPayload true "Имя метки"
+// @Success 201
+// @Failure 400 "Некорректный формат данных"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
+// @Router /tag/create [post]
+// @Security ApiKeyAuth
+func createTagHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload tagPayload
 	body, err := parseBody(jsonType, r)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -409,38 +2690,32 @@ func createCredentialsHandler(w http.ResponseWriter, r *http.Request, userID uui
 
 		return
 	}
-	credID, err := app.CreateCredentials.Do(
-		userID,
-		payload.Name,
-		payload.Login,
-		payload.Password,
-		payload.Meta,
-	)
+	tagID, err := app.CreateTag.Do(userID, payload.Name)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		log.Error(err)
 
 		return
 	}
-	w.Header().Add("Location", credID.String())
+	w.Header().Add("Location", tagID.String())
 	w.WriteHeader(http.StatusCreated)
 }
 
-// @Summary Обновить и зашифровать существующий логин и пароль
-// @ID credentials-update
-// @Tags Credentials
+// @Summary Переименовать существующую метку
+// @ID tag-update
+// @Tags Tag
 // @Accept json
-// @Param credentials_id path string true "Credentials ID"
-// @Param data body credentialsPayload true "Наименование, логин и пароль"
+// @Param tag_id path string true "Tag ID"
+// @Param data body tagPayload true "Имя метки"
 // @Success 200
 // @Failure 400 "Некорректный формат данных или идентификатора"
 // @Failure 401 "Нет токена авторизации или токен невалиден"
 // @Failure 404 "Не найдено"
-// @Router /credentials/{credentials_id} [post]
+// @Router /tag/{tag_id} [post]
 // @Security ApiKeyAuth
-func updateCredentialsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
-	var payload credentialsPayload
-	id, err := getRouteID(r, "credID")
+func updateTagHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload tagPayload
+	id, err := getRouteID(r, "tagID")
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
@@ -461,14 +2736,7 @@ func updateCredentialsHandler(w http.ResponseWriter, r *http.Request, userID uui
 
 		return
 	}
-	err = app.UpdateCredentials.Do(
-		userID,
-		id,
-		payload.Name,
-		payload.Login,
-		payload.Password,
-		payload.Meta,
-	)
+	err = app.UpdateTag.Do(userID, id, payload.Name)
 	if err != nil {
 		if errors.Is(err, domain.ErrEntityNotFound) {
 			w.WriteHeader(http.StatusNotFound)
@@ -482,17 +2750,17 @@ func updateCredentialsHandler(w http.ResponseWriter, r *http.Request, userID uui
 	w.WriteHeader(http.StatusOK)
 }
 
-// @Summary Получить все расшифрованные логины и пароли
-// @ID credentials-all
-// @Tags Credentials
-// @Success 200 {object} GetAllCredentialsResponse
+// @Summary Получить все метки с расшифрованными именами
+// @ID tag-all
+// @Tags Tag
+// @Success 200 {object} GetAllTagsResponse
 // @Failure 401 "Нет токена авторизации или токен невалиден"
-// @Router /credentials/all [get]
+// @Router /tag/all [get]
 // @Security ApiKeyAuth
-func getAllCredentialsHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID) {
-	credResponse := []credentialsResponse{}
+func getAllTagsHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID) {
+	tagsResponse := []tagResponse{}
 	w.Header().Set(contentTypeHeader, jsonType)
-	credentials, err := app.GetAllCredentials.Do(userID)
+	tags, err := app.GetAllTags.Do(userID)
 	if err != nil {
 		log.Error(err)
 		err = responseError(w, err.Error())
@@ -503,21 +2771,15 @@ func getAllCredentialsHandler(w http.ResponseWriter, _ *http.Request, userID uui
 		return
 	}
 
-	for _, v := range credentials {
-		respItem := credentialsResponse{
-			ID:       v.ID.String(),
-			Name:     string(v.Name),
-			Login:    string(v.Login),
-			Password: string(v.Password),
-			Meta:     string(v.Meta),
-		}
-		credResponse = append(credResponse, respItem)
+	for _, v := range tags {
+		respItem := newTagResponse(v)
+		tagsResponse = append(tagsResponse, respItem)
 	}
 
-	response := GetAllCredentialsResponse{
+	response := GetAllTagsResponse{
 		Status: true,
 	}
-	response.Data.Credentials = credResponse
+	response.Data.Tags = tagsResponse
 
 	err = makeResponse(w, http.StatusOK, response)
 	if err != nil {
@@ -532,19 +2794,53 @@ func getAllCredentialsHandler(w http.ResponseWriter, _ *http.Request, userID uui
 	}
 }
 
-// @Summary Создать и зашифровать банковскую карту
-// @ID bank-card-create
-// @Tags BankCard
+// @Summary Удалить существующую метку
+// @Description Метка снимается со всех данных
+// @ID tag-delete
+// @Tags Tag
+// @Param tag_id path string true "Tag ID"
+// @Success 200
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /tag/{tag_id} [delete]
+// @Security ApiKeyAuth
+func deleteTagHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "tagID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.DeleteTag.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Создать зашифрованный пользовательский шаблон
+// @Description Если у пользователя включено сквозное шифрование, проверяется только наличие имени
+// @ID template-create
+// @Tags Template
 // @Accept json
-// @Param data body bankCardPayload true "Номер, срок действия, cvv код, ФИО держателя карты"
+// @Param data body templatePayload true "Имя, описание и поля шаблона"
 // @Success 201
 // @Failure 400 "Некорректный формат данных"
 // @Failure 401 "Нет токена авторизации или токен невалиден"
 // @Header 201 {string} Location 020cb30c-c495-4a18-ac09-fd68c6f7c941 "UUID ресурса"
-// @Router /bank_card/create [post]
+// @Router /template/create [post]
 // @Security ApiKeyAuth
-func createBankCardHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
-	var payload bankCardPayload
+func createTemplateHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload templatePayload
 	body, err := parseBody(jsonType, r)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -552,46 +2848,124 @@ func createBankCardHandler(w http.ResponseWriter, r *http.Request, userID uuid.U
 
 		return
 	}
-	payload, err = payload.Load(body)
+	e2e, err := app.CheckE2E.Do(userID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		log.Error(err)
+
+		return
+	}
+	payload, err = payload.Load(body, e2e)
 	if err != nil {
 		log.Error(err)
 		w.WriteHeader(http.StatusBadRequest)
 
 		return
 	}
-	cardID, err := app.CreateBankCard.Do(
-		userID,
-		payload.Number,
-		payload.ValidThru,
-		payload.CVV,
-		payload.CardHolder,
-		payload.Meta,
-	)
+	templateID, err := app.CreateTemplate.Do(userID, payload.Name, payload.Description, payload.Fields)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		log.Error(err)
 
 		return
 	}
-	w.Header().Add("Location", cardID.String())
+	w.Header().Add("Location", templateID.String())
 	w.WriteHeader(http.StatusCreated)
 }
 
-// @Summary Обновить и зашифровать существующую банковскую карту
-// @ID bank-card-update
-// @Tags BankCard
+// @Summary Получить все расшифрованные пользовательские шаблоны
+// @ID template-all
+// @Tags Template
+// @Success 200 {object} GetAllTemplatesResponse
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Router /template/all [get]
+// @Security ApiKeyAuth
+func getAllTemplatesHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID) {
+	templatesResponse := []templateResponse{}
+	w.Header().Set(contentTypeHeader, jsonType)
+	templates, err := app.GetAllTemplates.Do(userID)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	for _, v := range templates {
+		respItem := newTemplateResponse(v)
+		templatesResponse = append(templatesResponse, respItem)
+	}
+
+	response := GetAllTemplatesResponse{
+		Status: true,
+	}
+	response.Data.Templates = templatesResponse
+
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+}
+
+// @Summary Удалить существующий пользовательский шаблон
+// @Description Данные, созданные по шаблону, не изменяются
+// @ID template-delete
+// @Tags Template
+// @Param template_id path string true "Template ID"
+// @Success 200
+// @Failure 400 "Некорректный формат идентификатора"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Failure 404 "Не найдено"
+// @Router /template/{template_id} [delete]
+// @Security ApiKeyAuth
+func deleteTemplateHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "templateID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
+	err = app.DeleteTemplate.Do(userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			log.Error(err)
+		}
+
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// @Summary Назначить папку и метки данным
+// @Description Папка и метки заменяются целиком, нулевой UUID папки означает корень
+// @ID labels-save
+// @Tags Labels
 // @Accept json
-// @Param bank_card_id path string true "Bank Card ID"
-// @Param data body bankCardPayload true "Номер, срок действия, cvv код, ФИО держателя карты"
+// @Param entity_id path string true "Entity ID"
+// @Param data body labelsPayload true "Вид данных, идентификаторы папки и меток"
 // @Success 200
 // @Failure 400 "Некорректный формат данных или идентификатора"
 // @Failure 401 "Нет токена авторизации или токен невалиден"
-// @Failure 404 "Не найдено"
-// @Router /bank_card/{bank_card_id} [post]
+// @Failure 404 "Данные, папка или метка не найдены"
+// @Router /labels/{entity_id} [post]
 // @Security ApiKeyAuth
-func updateBankCardHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
-	var payload bankCardPayload
-	id, err := getRouteID(r, "cardID")
+func saveLabelsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	var payload labelsPayload
+	id, err := getRouteID(r, "entityID")
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		log.Error(err)
@@ -612,15 +2986,7 @@ func updateBankCardHandler(w http.ResponseWriter, r *http.Request, userID uuid.U
 
 		return
 	}
-	err = app.UpdateBankCard.Do(
-		userID,
-		id,
-		payload.Number,
-		payload.ValidThru,
-		payload.CVV,
-		payload.CardHolder,
-		payload.Meta,
-	)
+	err = app.SaveLabels.Do(userID, id, payload.Kind, payload.FolderID, payload.TagIDs)
 	if err != nil {
 		if errors.Is(err, domain.ErrEntityNotFound) {
 			w.WriteHeader(http.StatusNotFound)
@@ -634,18 +3000,32 @@ func updateBankCardHandler(w http.ResponseWriter, r *http.Request, userID uuid.U
 	w.WriteHeader(http.StatusOK)
 }
 
-// @Summary Получить все расшифрованные банковские карты
-// @ID bank-card-all
-// @Tags BankCard
-// @Success 200 {object} GetAllBankCardsResponse
+// @Summary Получить папку и метки данных
+// @ID labels-get
+// @Tags Labels
+// @Param entity_id path string true "Entity ID"
+// @Success 200 {object} GetLabelsResponse
+// @Failure 400 "Некорректный формат идентификатора"
 // @Failure 401 "Нет токена авторизации или токен невалиден"
-// @Router /bank_card/all [get]
+// @Failure 404 "Папка и метки данным не назначались"
+// @Router /labels/{entity_id} [get]
 // @Security ApiKeyAuth
-func getAllBankCardsHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID) {
-	bankCardsResponse := []bankCardResponse{}
+func getLabelsHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	id, err := getRouteID(r, "entityID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		log.Error(err)
+
+		return
+	}
 	w.Header().Set(contentTypeHeader, jsonType)
-	bankCards, err := app.GetAllBankCards.Do(userID)
+	labels, err := app.GetLabels.Do(userID, id)
 	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
 		log.Error(err)
 		err = responseError(w, err.Error())
 		if err != nil {
@@ -655,50 +3035,49 @@ func getAllBankCardsHandler(w http.ResponseWriter, _ *http.Request, userID uuid.
 		return
 	}
 
-	for _, v := range bankCards {
-		respItem := bankCardResponse{
-			ID:         v.ID.String(),
-			Number:     string(v.Number),
-			ValidThru:  string(v.ValidThru),
-			CVV:        string(v.CVV),
-			CardHolder: string(v.CardHolder),
-			Meta:       string(v.Meta),
-		}
-		bankCardsResponse = append(bankCardsResponse, respItem)
-	}
-
-	response := GetAllBankCardsResponse{
+	response := GetLabelsResponse{
 		Status: true,
+		Data:   newLabelsResponse(*labels),
 	}
-	response.Data.BankCards = bankCardsResponse
-
 	err = makeResponse(w, http.StatusOK, response)
 	if err != nil {
 		log.Error(err)
-		err = responseError(w, err.Error())
-
-		if err != nil {
-			log.Error(err)
-		}
-
-		return
 	}
 }
 
 // @Summary Получить все расшифрованные данные пользователя
+// @Description Бинарные данные возвращаются без содержимого, только с метаданными
 // @ID all
 // @Tags All
+// @Param limit query int false "Размер страницы, по умолчанию 100, не больше 1000"
+// @Param cursor query string false "Курсор следующей страницы из предыдущего ответа"
 // @Success 200 {object} GetAllResponse
+// @Failure 400 "Невалидные параметры страницы"
 // @Failure 401 "Нет токена авторизации или токен невалиден"
 // @Router /all [get]
 // @Security ApiKeyAuth
-func getAllHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID) {
+func getAllHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	limit, err := getLimitQuery(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	cursor, err := getCursorQuery(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
 	credResponse := []credentialsResponse{}
 	bankCardsResponse := []bankCardResponse{}
 	textsResponse := []textResponse{}
 	binariesResponse := []binaryResponse{}
+	otpsResponse := []otpResponse{}
+	sshKeysResponse := []sshKeyResponse{}
+	itemsResponse := []itemResponse{}
 	w.Header().Set(contentTypeHeader, jsonType)
-	texts, bankCards, binaries, credentials, err := app.GetAll.Do(userID)
+	texts, bankCards, binaries, credentials, otps, sshKeys, items, next, err := app.GetAll.Do(userID, limit, cursor)
 	if err != nil {
 		log.Error(err)
 		err = responseError(w, err.Error())
@@ -710,40 +3089,33 @@ func getAllHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID) {
 	}
 
 	for _, v := range bankCards {
-		respItem := bankCardResponse{
-			ID:         v.ID.String(),
-			Number:     string(v.Number),
-			ValidThru:  string(v.ValidThru),
-			CVV:        string(v.CVV),
-			CardHolder: string(v.CardHolder),
-			Meta:       string(v.Meta),
-		}
+		respItem := newBankCardResponse(v)
 		bankCardsResponse = append(bankCardsResponse, respItem)
 	}
 	for _, v := range credentials {
-		respItem := credentialsResponse{
-			ID:       v.ID.String(),
-			Name:     string(v.Name),
-			Login:    string(v.Login),
-			Password: string(v.Password),
-			Meta:     string(v.Meta),
-		}
+		respItem := newCredentialsResponse(v)
 		credResponse = append(credResponse, respItem)
 	}
 	for _, v := range texts {
-		respItem := textResponse{
-			ID:      v.ID.String(),
-			Content: string(v.Content),
-		}
+		respItem := newTextResponse(v)
 		textsResponse = append(textsResponse, respItem)
 	}
 	for _, v := range binaries {
-		respItem := binaryResponse{
-			ID:      v.ID.String(),
-			Content: v.Content,
-		}
+		respItem := newBinaryResponse(v)
 		binariesResponse = append(binariesResponse, respItem)
 	}
+	for _, v := range otps {
+		respItem := newOTPResponse(v)
+		otpsResponse = append(otpsResponse, respItem)
+	}
+	for _, v := range sshKeys {
+		respItem := newSSHKeyResponse(v)
+		sshKeysResponse = append(sshKeysResponse, respItem)
+	}
+	for _, v := range items {
+		respItem := newItemResponse(v)
+		itemsResponse = append(itemsResponse, respItem)
+	}
 
 	response := GetAllResponse{
 		Status: true,
@@ -752,6 +3124,133 @@ func getAllHandler(w http.ResponseWriter, _ *http.Request, userID uuid.UUID) {
 	response.Data.Credentials = credResponse
 	response.Data.Texts = textsResponse
 	response.Data.Binaries = binariesResponse
+	response.Data.OTPs = otpsResponse
+	response.Data.SSHKeys = sshKeysResponse
+	response.Data.Items = itemsResponse
+
+	response.Data.NextCursor = encodeAllCursor(next)
+
+	err = makeResponse(w, http.StatusOK, response)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+}
+
+// @Summary Получить расшифрованные изменения данных пользователя после указанной ревизии
+// @Description Возвращает созданные или обновленные данные и идентификаторы удаленных данных,
+// @Description а также ревизию, которую нужно передать в следующем запросе.
+// @Description Возвращается не больше limit изменений с наименьшими ревизиями, признак more означает,
+// @Description что после возвращенной ревизии есть еще изменения.
+// @Description Бинарные данные возвращаются без содержимого, только с метаданными
+// @ID changes
+// @Tags All
+// @Param since query int false "Ревизия, после которой нужно вернуть изменения, по умолчанию 0"
+// @Param limit query int false "Размер страницы, по умолчанию 100, не больше 1000"
+// @Success 200 {object} GetChangesResponse
+// @Failure 400 "Невалидная ревизия или размер страницы"
+// @Failure 401 "Нет токена авторизации или токен невалиден"
+// @Router /changes [get]
+// @Security ApiKeyAuth
+func getChangesHandler(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	since, err := getSinceQuery(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	limit, err := getLimitQuery(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	w.Header().Set(contentTypeHeader, jsonType)
+	changes, err := app.GetChanges.Do(userID, since, limit)
+	if err != nil {
+		log.Error(err)
+		err = responseError(w, err.Error())
+		if err != nil {
+			log.Error(err)
+		}
+
+		return
+	}
+
+	response := GetChangesResponse{
+		Status: true,
+	}
+	response.Data.Revision = changes.Revision
+	response.Data.More = changes.More
+	response.Data.Texts = []textResponse{}
+	response.Data.Binaries = []binaryResponse{}
+	response.Data.Credentials = []credentialsResponse{}
+	response.Data.BankCards = []bankCardResponse{}
+	response.Data.OTPs = []otpResponse{}
+	response.Data.SSHKeys = []sshKeyResponse{}
+	response.Data.Items = []itemResponse{}
+	response.Data.Folders = []folderResponse{}
+	response.Data.Tags = []tagResponse{}
+	response.Data.Templates = []templateResponse{}
+	response.Data.Labels = []labelsResponse{}
+	response.Data.Deleted = []deletedResponse{}
+	for _, v := range changes.Texts {
+		respItem := newTextResponse(v)
+		response.Data.Texts = append(response.Data.Texts, respItem)
+	}
+	for _, v := range changes.Binaries {
+		respItem := newBinaryResponse(v)
+		response.Data.Binaries = append(response.Data.Binaries, respItem)
+	}
+	for _, v := range changes.Credentials {
+		respItem := newCredentialsResponse(v)
+		response.Data.Credentials = append(response.Data.Credentials, respItem)
+	}
+	for _, v := range changes.BankCards {
+		respItem := newBankCardResponse(v)
+		response.Data.BankCards = append(response.Data.BankCards, respItem)
+	}
+	for _, v := range changes.OTPs {
+		respItem := newOTPResponse(v)
+		response.Data.OTPs = append(response.Data.OTPs, respItem)
+	}
+	for _, v := range changes.SSHKeys {
+		respItem := newSSHKeyResponse(v)
+		response.Data.SSHKeys = append(response.Data.SSHKeys, respItem)
+	}
+	for _, v := range changes.Items {
+		respItem := newItemResponse(v)
+		response.Data.Items = append(response.Data.Items, respItem)
+	}
+	for _, v := range changes.Folders {
+		respItem := newFolderResponse(v)
+		response.Data.Folders = append(response.Data.Folders, respItem)
+	}
+	for _, v := range changes.Tags {
+		respItem := newTagResponse(v)
+		response.Data.Tags = append(response.Data.Tags, respItem)
+	}
+	for _, v := range changes.Templates {
+		respItem := newTemplateResponse(v)
+		response.Data.Templates = append(response.Data.Templates, respItem)
+	}
+	for _, v := range changes.Labels {
+		respItem := newLabelsResponse(v)
+		response.Data.Labels = append(response.Data.Labels, respItem)
+	}
+	for _, v := range changes.Tombstones {
+		respItem := deletedResponse{
+			ID:   v.ID.String(),
+			Kind: v.Kind,
+		}
+		response.Data.Deleted = append(response.Data.Deleted, respItem)
+	}
 
 	err = makeResponse(w, http.StatusOK, response)
 	if err != nil {
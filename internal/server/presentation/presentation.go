@@ -40,25 +40,79 @@ func New(
 
 	router.Post("/api/v1/auth/register", registrationHandler)
 	router.Post("/api/v1/auth/login", loginHandler)
+	router.Post("/api/v1/auth/refresh", refreshHandler)
+	router.Post("/api/v1/auth/2fa/login", twoFactorLoginHandler)
+	router.Post("/api/v1/auth/2fa/enroll", auth(enrollTwoFactorHandler))
+	router.Post("/api/v1/auth/2fa/verify", auth(verifyTwoFactorHandler))
+	router.Post("/api/v1/auth/2fa/disable", auth(disableTwoFactorHandler))
+	router.Post("/api/v1/auth/prelogin", preLoginHandler)
 	router.Get("/api/v1/auth/certs", getCertsHandler)
 
 	router.Post("/api/v1/text/create", auth(createTextHandler))
 	router.Post("/api/v1/text/{textID}", auth(updateTextHandler))
+	router.Delete("/api/v1/text/{textID}", auth(deleteTextHandler))
 	router.Get("/api/v1/text/all", auth(getAllTextsHandler))
+	router.Get("/api/v1/text/{textID}", auth(getTextHandler))
 
 	router.Post("/api/v1/binary/create", auth(createBinaryHandler))
 	router.Post("/api/v1/binary/{binaryID}", auth(updateBinaryHandler))
+	router.Delete("/api/v1/binary/{binaryID}", auth(deleteBinaryHandler))
 	router.Get("/api/v1/binary/all", auth(getAllBinariesHandler))
+	router.Get("/api/v1/binary/{binaryID}", auth(getBinaryHandler))
+	router.Get("/api/v1/binary/{binaryID}/content", auth(downloadBinaryHandler))
+	router.Post("/api/v1/binary/upload", auth(startBinaryUploadHandler))
+	router.Get("/api/v1/binary/upload/{uploadID}", auth(getBinaryUploadHandler))
+	router.Put("/api/v1/binary/upload/{uploadID}/{index}", auth(uploadBinaryChunkHandler))
+	router.Post("/api/v1/binary/upload/{uploadID}/commit", auth(commitBinaryUploadHandler))
 
 	router.Post("/api/v1/credentials/create", auth(createCredentialsHandler))
 	router.Post("/api/v1/credentials/{credID}", auth(updateCredentialsHandler))
+	router.Delete("/api/v1/credentials/{credID}", auth(deleteCredentialsHandler))
 	router.Get("/api/v1/credentials/all", auth(getAllCredentialsHandler))
+	router.Get("/api/v1/credentials/{credID}", auth(getCredentialsHandler))
 
 	router.Post("/api/v1/bank_card/create", auth(createBankCardHandler))
 	router.Post("/api/v1/bank_card/{cardID}", auth(updateBankCardHandler))
+	router.Delete("/api/v1/bank_card/{cardID}", auth(deleteBankCardHandler))
 	router.Get("/api/v1/bank_card/all", auth(getAllBankCardsHandler))
+	router.Get("/api/v1/bank_card/{cardID}", auth(getBankCardHandler))
+
+	router.Post("/api/v1/otp/create", auth(createOTPHandler))
+	router.Post("/api/v1/otp/{otpID}", auth(updateOTPHandler))
+	router.Delete("/api/v1/otp/{otpID}", auth(deleteOTPHandler))
+	router.Get("/api/v1/otp/all", auth(getAllOTPsHandler))
+	router.Get("/api/v1/otp/{otpID}", auth(getOTPHandler))
+
+	router.Post("/api/v1/ssh_key/create", auth(createSSHKeyHandler))
+	router.Post("/api/v1/ssh_key/{sshKeyID}", auth(updateSSHKeyHandler))
+	router.Delete("/api/v1/ssh_key/{sshKeyID}", auth(deleteSSHKeyHandler))
+	router.Get("/api/v1/ssh_key/all", auth(getAllSSHKeysHandler))
+	router.Get("/api/v1/ssh_key/{sshKeyID}", auth(getSSHKeyHandler))
+
+	router.Post("/api/v1/item/create", auth(createItemHandler))
+	router.Post("/api/v1/item/{itemID}", auth(updateItemHandler))
+	router.Delete("/api/v1/item/{itemID}", auth(deleteItemHandler))
+	router.Get("/api/v1/item/all", auth(getAllItemsHandler))
+	router.Get("/api/v1/item/{itemID}", auth(getItemHandler))
+
+	router.Post("/api/v1/folder/create", auth(createFolderHandler))
+	router.Post("/api/v1/folder/{folderID}", auth(updateFolderHandler))
+	router.Delete("/api/v1/folder/{folderID}", auth(deleteFolderHandler))
+	router.Get("/api/v1/folder/all", auth(getAllFoldersHandler))
+
+	router.Post("/api/v1/tag/create", auth(createTagHandler))
+	router.Post("/api/v1/tag/{tagID}", auth(updateTagHandler))
+	router.Delete("/api/v1/tag/{tagID}", auth(deleteTagHandler))
+	router.Get("/api/v1/tag/all", auth(getAllTagsHandler))
+	router.Post("/api/v1/template/create", auth(createTemplateHandler))
+	router.Delete("/api/v1/template/{templateID}", auth(deleteTemplateHandler))
+	router.Get("/api/v1/template/all", auth(getAllTemplatesHandler))
+
+	router.Post("/api/v1/labels/{entityID}", auth(saveLabelsHandler))
+	router.Get("/api/v1/labels/{entityID}", auth(getLabelsHandler))
 
 	router.Get("/api/v1/all", auth(getAllHandler))
+	router.Get("/api/v1/changes", auth(getChangesHandler))
 
 	return router
 }
@@ -3,8 +3,16 @@ package presentation
 import (
 	"encoding/json"
 	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/totp"
 )
 
 var validCardNumber, validThru, validCVV, validCardHolder *regexp.Regexp
@@ -12,6 +20,8 @@ var validCardNumber, validThru, validCVV, validCardHolder *regexp.Regexp
 type registrationPayload struct {
 	Login    string `json:"login" validate:"required"`
 	Password string `json:"password" validate:"required"`
+	E2E      bool   `json:"e2e"`
+	Salt     []byte `json:"salt" validate:"required_if=E2E true,omitempty,len=16"`
 }
 
 func (registrationPayload) Load(data []byte) (registrationPayload, error) {
@@ -25,6 +35,118 @@ func (registrationPayload) Load(data []byte) (registrationPayload, error) {
 	return payload, err
 }
 
+type preLoginPayload struct {
+	Login string `json:"login" validate:"required"`
+}
+
+func (preLoginPayload) Load(data []byte) (preLoginPayload, error) {
+	var payload preLoginPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	err = validate.Struct(payload)
+
+	return payload, err
+}
+
+type refreshPayload struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+func (refreshPayload) Load(data []byte) (refreshPayload, error) {
+	var payload refreshPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	err = validate.Struct(payload)
+
+	return payload, err
+}
+
+type twoFactorLoginPayload struct {
+	Challenge string `json:"challenge" validate:"required"`
+	Code      string `json:"code" validate:"required"`
+}
+
+func (twoFactorLoginPayload) Load(data []byte) (twoFactorLoginPayload, error) {
+	var payload twoFactorLoginPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	err = validate.Struct(payload)
+
+	return payload, err
+}
+
+type twoFactorCodePayload struct {
+	Code string `json:"code" validate:"required"`
+}
+
+func (twoFactorCodePayload) Load(data []byte) (twoFactorCodePayload, error) {
+	var payload twoFactorCodePayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	err = validate.Struct(payload)
+
+	return payload, err
+}
+
+type binaryUploadPayload struct {
+	Size      int64 `json:"size" validate:"required,gt=0"`
+	ChunkSize int   `json:"chunk_size" validate:"required,gt=0"`
+}
+
+func (binaryUploadPayload) Load(data []byte) (binaryUploadPayload, error) {
+	var payload binaryUploadPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	err = validate.Struct(payload)
+
+	return payload, err
+}
+
+type binaryMetadataPayload struct {
+	Name     string `json:"name" validate:"max=1024"`
+	MimeType string `json:"mime_type" validate:"max=1024"`
+	SHA256   string `json:"sha256" validate:"max=1024"`
+	Meta     string `json:"meta"`
+}
+
+func (binaryMetadataPayload) Load(data []byte) (binaryMetadataPayload, error) {
+	var payload binaryMetadataPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	err = validate.Struct(payload)
+
+	return payload, err
+}
+
+type cursorPayload struct {
+	Kind      string    `json:"kind" validate:"oneof=text binary credentials bank_card otp ssh_key item"`
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
+func (cursorPayload) Load(data []byte) (cursorPayload, error) {
+	var payload cursorPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	err = validate.Struct(payload)
+
+	return payload, err
+}
+
 type credentialsPayload struct {
 	Name     string `json:"name" validate:"required,min=1"`
 	Login    string `json:"login" validate:"required,min=1"`
@@ -51,12 +173,189 @@ type bankCardPayload struct {
 	Meta       string `json:"meta"`
 }
 
-func (bankCardPayload) Load(data []byte) (bankCardPayload, error) {
+// Load - Если данные зашифрованы на клиенте (e2e), проверяется только наличие обязательных полей
+func (bankCardPayload) Load(data []byte, e2e bool) (bankCardPayload, error) {
 	var payload bankCardPayload
 	err := json.Unmarshal(data, &payload)
 	if err != nil {
 		return payload, err
 	}
+	if e2e {
+		for _, v := range []string{payload.Number, payload.ValidThru, payload.CVV} {
+			if err = validate.Var(v, "required"); err != nil {
+				return payload, err
+			}
+		}
+
+		return payload, nil
+	}
+	err = validate.Struct(payload)
+
+	return payload, err
+}
+
+type otpPayload struct {
+	Issuer    string `json:"issuer"`
+	Account   string `json:"account"`
+	Secret    string `json:"secret" validate:"required"`
+	Algorithm string `json:"algorithm" validate:"required"`
+	Digits    string `json:"digits" validate:"required,number"`
+	Period    string `json:"period" validate:"required,number"`
+	Meta      string `json:"meta"`
+}
+
+// Load - Количество цифр и период передаются строками, чтобы их можно было зашифровать на клиенте.
+// Если данные зашифрованы на клиенте (e2e), проверяется только наличие обязательных полей,
+// иначе проверяется, что по параметрам можно вычислить код
+func (otpPayload) Load(data []byte, e2e bool) (otpPayload, error) {
+	var payload otpPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	if e2e {
+		for _, v := range []string{payload.Secret, payload.Algorithm, payload.Digits, payload.Period} {
+			if err = validate.Var(v, "required"); err != nil {
+				return payload, err
+			}
+		}
+
+		return payload, nil
+	}
+	if err = validate.Struct(payload); err != nil {
+		return payload, err
+	}
+	key := totp.NewKey(payload.Issuer, payload.Account, payload.Secret)
+	key.Algorithm = payload.Algorithm
+	if key.Digits, err = strconv.Atoi(payload.Digits); err != nil {
+		return payload, err
+	}
+	period, err := strconv.Atoi(payload.Period)
+	if err != nil {
+		return payload, err
+	}
+	key.Period = time.Duration(period) * time.Second
+
+	return payload, key.Validate()
+}
+
+type sshKeyPayload struct {
+	PrivateKey  string `json:"private_key" validate:"required"`
+	PublicKey   string `json:"public_key" validate:"required"`
+	Comment     string `json:"comment"`
+	Fingerprint string `json:"fingerprint" validate:"required"`
+	Meta        string `json:"meta"`
+}
+
+// Load - Если данные зашифрованы на клиенте (e2e), проверяется только наличие обязательных полей,
+// иначе проверяется, что открытый ключ разбирается и отпечаток ему соответствует.
+// Закрытый ключ может быть защищен парольной фразой, поэтому сервер его не разбирает
+func (sshKeyPayload) Load(data []byte, e2e bool) (sshKeyPayload, error) {
+	var payload sshKeyPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	if err = validate.Struct(payload); err != nil || e2e {
+		return payload, err
+	}
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(payload.PublicKey))
+	if err != nil {
+		return payload, err
+	}
+	if ssh.FingerprintSHA256(publicKey) != payload.Fingerprint {
+		return payload, errFingerprintMismatch
+	}
+
+	return payload, nil
+}
+
+type itemPayload struct {
+	Name     string       `json:"name" validate:"required"`
+	Template string       `json:"template"`
+	Fields   []item.Field `json:"fields"`
+	Meta     string       `json:"meta"`
+}
+
+// Load - Если данные зашифрованы на клиенте (e2e), проверяется только наличие обязательных полей,
+// иначе проверяется, что у полей уникальные имена, известные типы и значения им соответствуют
+func (itemPayload) Load(data []byte, e2e bool) (itemPayload, error) {
+	var payload itemPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	if err = validate.Struct(payload); err != nil || e2e {
+		return payload, err
+	}
+
+	return payload, item.Validate(payload.Fields)
+}
+
+type folderPayload struct {
+	Name     string    `json:"name" validate:"required"`
+	ParentID uuid.UUID `json:"parent_id"`
+}
+
+func (folderPayload) Load(data []byte) (folderPayload, error) {
+	var payload folderPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	err = validate.Struct(payload)
+
+	return payload, err
+}
+
+type tagPayload struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (tagPayload) Load(data []byte) (tagPayload, error) {
+	var payload tagPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	err = validate.Struct(payload)
+
+	return payload, err
+}
+
+type templatePayload struct {
+	Name        string           `json:"name" validate:"required"`
+	Description string           `json:"description"`
+	Fields      []item.FieldSpec `json:"fields"`
+}
+
+// Load - Если шаблон зашифрован на клиенте (e2e), проверяется только наличие имени,
+// иначе проверяется, что у полей уникальные имена и известные типы
+func (templatePayload) Load(data []byte, e2e bool) (templatePayload, error) {
+	var payload templatePayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
+	if err = validate.Struct(payload); err != nil || e2e {
+		return payload, err
+	}
+
+	return payload, item.ValidateSpecs(payload.Fields)
+}
+
+type labelsPayload struct {
+	Kind     string      `json:"kind" validate:"oneof=text binary credentials bank_card otp ssh_key item"`
+	FolderID uuid.UUID   `json:"folder_id"`
+	TagIDs   []uuid.UUID `json:"tag_ids"`
+}
+
+func (labelsPayload) Load(data []byte) (labelsPayload, error) {
+	var payload labelsPayload
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return payload, err
+	}
 	err = validate.Struct(payload)
 
 	return payload, err
@@ -81,26 +380,70 @@ func validateCardHolder(fl validator.FieldLevel) bool {
 type textResponse struct {
 	ID      string `json:"id"`
 	Content string `json:"content"`
+	Version int64  `json:"version"`
+}
+
+func newTextResponse(text domain.Text) textResponse {
+	return textResponse{
+		ID:      text.ID.String(),
+		Content: string(text.Content),
+		Version: text.Version,
+	}
 }
 
 type GetAllTextsResponse struct {
 	Status  bool   `json:"status"`
 	Message string `json:"message"`
 	Data    struct {
-		Texts []textResponse `json:"texts"`
+		Texts      []textResponse `json:"texts"`
+		NextCursor string         `json:"next_cursor,omitempty"`
 	} `json:"data"`
 }
 
 type binaryResponse struct {
-	ID      string `json:"id"`
-	Content []byte `json:"content"`
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	MimeType string `json:"mime_type"`
+	Size     int64  `json:"size"`
+	SHA256   string `json:"sha256"`
+	Meta     string `json:"meta"`
+	Content  []byte `json:"content,omitempty"`
+	Chunked  bool   `json:"chunked"`
+	Version  int64  `json:"version"`
+}
+
+// newBinaryResponse - Содержимое попадает в ответ, только если оно было загружено,
+// списки бинарных данных возвращают только метаданные
+func newBinaryResponse(bin domain.Binary) binaryResponse {
+	return binaryResponse{
+		ID:       bin.ID.String(),
+		Name:     string(bin.Name),
+		MimeType: string(bin.MimeType),
+		Size:     bin.Size,
+		SHA256:   string(bin.SHA256),
+		Meta:     string(bin.Meta),
+		Content:  bin.Content,
+		Chunked:  bin.Chunks != 0,
+		Version:  bin.Version,
+	}
+}
+
+type BinaryUploadResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Size      int64   `json:"size"`
+		ChunkSize int     `json:"chunk_size"`
+		Received  []int64 `json:"received"`
+	} `json:"data"`
 }
 
 type GetAllBinariesResponse struct {
 	Status  bool   `json:"status"`
 	Message string `json:"message"`
 	Data    struct {
-		Binaries []binaryResponse `json:"binaries"`
+		Binaries   []binaryResponse `json:"binaries"`
+		NextCursor string           `json:"next_cursor,omitempty"`
 	} `json:"data"`
 }
 
@@ -110,6 +453,18 @@ type credentialsResponse struct {
 	Login    string `json:"login"`
 	Password string `json:"password"`
 	Meta     string `json:"meta"`
+	Version  int64  `json:"version"`
+}
+
+func newCredentialsResponse(cred *domain.Credentials) credentialsResponse {
+	return credentialsResponse{
+		ID:       cred.ID.String(),
+		Name:     string(cred.Name),
+		Login:    string(cred.Login),
+		Password: string(cred.Password),
+		Meta:     string(cred.Meta),
+		Version:  cred.Version,
+	}
 }
 
 type GetAllCredentialsResponse struct {
@@ -117,6 +472,7 @@ type GetAllCredentialsResponse struct {
 	Message string `json:"message"`
 	Data    struct {
 		Credentials []credentialsResponse `json:"credentials"`
+		NextCursor  string                `json:"next_cursor,omitempty"`
 	} `json:"data"`
 }
 
@@ -127,13 +483,249 @@ type bankCardResponse struct {
 	CVV        string `json:"cvv"`
 	CardHolder string `json:"card_holder"`
 	Meta       string `json:"meta"`
+	Version    int64  `json:"version"`
+}
+
+func newBankCardResponse(card *domain.BankCard) bankCardResponse {
+	return bankCardResponse{
+		ID:         card.ID.String(),
+		Number:     string(card.Number),
+		ValidThru:  string(card.ValidThru),
+		CVV:        string(card.CVV),
+		CardHolder: string(card.CardHolder),
+		Meta:       string(card.Meta),
+		Version:    card.Version,
+	}
 }
 
 type GetAllBankCardsResponse struct {
 	Status  bool   `json:"status"`
 	Message string `json:"message"`
 	Data    struct {
-		BankCards []bankCardResponse `json:"bank_cards"`
+		BankCards  []bankCardResponse `json:"bank_cards"`
+		NextCursor string             `json:"next_cursor,omitempty"`
+	} `json:"data"`
+}
+
+type otpResponse struct {
+	ID        string `json:"id"`
+	Issuer    string `json:"issuer"`
+	Account   string `json:"account"`
+	Secret    string `json:"secret"`
+	Algorithm string `json:"algorithm"`
+	Digits    string `json:"digits"`
+	Period    string `json:"period"`
+	Meta      string `json:"meta"`
+	Version   int64  `json:"version"`
+}
+
+func newOTPResponse(otp *domain.OTP) otpResponse {
+	return otpResponse{
+		ID:        otp.ID.String(),
+		Issuer:    string(otp.Issuer),
+		Account:   string(otp.Account),
+		Secret:    string(otp.Secret),
+		Algorithm: string(otp.Algorithm),
+		Digits:    string(otp.Digits),
+		Period:    string(otp.Period),
+		Meta:      string(otp.Meta),
+		Version:   otp.Version,
+	}
+}
+
+type GetAllOTPsResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		OTPs       []otpResponse `json:"otps"`
+		NextCursor string        `json:"next_cursor,omitempty"`
+	} `json:"data"`
+}
+
+type sshKeyResponse struct {
+	ID          string `json:"id"`
+	PrivateKey  string `json:"private_key"`
+	PublicKey   string `json:"public_key"`
+	Comment     string `json:"comment"`
+	Fingerprint string `json:"fingerprint"`
+	Meta        string `json:"meta"`
+	Version     int64  `json:"version"`
+}
+
+func newSSHKeyResponse(key *domain.SSHKey) sshKeyResponse {
+	return sshKeyResponse{
+		ID:          key.ID.String(),
+		PrivateKey:  string(key.PrivateKey),
+		PublicKey:   string(key.PublicKey),
+		Comment:     string(key.Comment),
+		Fingerprint: string(key.Fingerprint),
+		Meta:        string(key.Meta),
+		Version:     key.Version,
+	}
+}
+
+type GetAllSSHKeysResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		SSHKeys    []sshKeyResponse `json:"ssh_keys"`
+		NextCursor string           `json:"next_cursor,omitempty"`
+	} `json:"data"`
+}
+
+type itemResponse struct {
+	ID       string       `json:"id"`
+	Name     string       `json:"name"`
+	Template string       `json:"template"`
+	Fields   []item.Field `json:"fields"`
+	Meta     string       `json:"meta"`
+	Version  int64        `json:"version"`
+}
+
+func newItemResponse(obj *domain.Item) itemResponse {
+	fields := []item.Field{}
+	// Поля сохраняются сервером в JSON, поэтому ошибки разбора быть не может
+	_ = json.Unmarshal(obj.Fields, &fields)
+
+	return itemResponse{
+		ID:       obj.ID.String(),
+		Name:     string(obj.Name),
+		Template: string(obj.Template),
+		Fields:   fields,
+		Meta:     string(obj.Meta),
+		Version:  obj.Version,
+	}
+}
+
+type GetAllItemsResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Items      []itemResponse `json:"items"`
+		NextCursor string         `json:"next_cursor,omitempty"`
+	} `json:"data"`
+}
+
+type folderResponse struct {
+	ID       string `json:"id"`
+	ParentID string `json:"parent_id"`
+	Name     string `json:"name"`
+}
+
+func newFolderResponse(folder *domain.Folder) folderResponse {
+	return folderResponse{
+		ID:       folder.ID.String(),
+		ParentID: folder.ParentID.String(),
+		Name:     string(folder.Name),
+	}
+}
+
+type GetAllFoldersResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Folders []folderResponse `json:"folders"`
+	} `json:"data"`
+}
+
+type tagResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func newTagResponse(tag *domain.Tag) tagResponse {
+	return tagResponse{
+		ID:   tag.ID.String(),
+		Name: string(tag.Name),
+	}
+}
+
+type GetAllTagsResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Tags []tagResponse `json:"tags"`
+	} `json:"data"`
+}
+
+type templateResponse struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Fields      []item.FieldSpec `json:"fields"`
+}
+
+func newTemplateResponse(tmpl *domain.ItemTemplate) templateResponse {
+	fields := []item.FieldSpec{}
+	// Описания полей сохраняются сервером в JSON, поэтому ошибки разбора быть не может
+	_ = json.Unmarshal(tmpl.Fields, &fields)
+
+	return templateResponse{
+		ID:          tmpl.ID.String(),
+		Name:        string(tmpl.Name),
+		Description: string(tmpl.Description),
+		Fields:      fields,
+	}
+}
+
+type GetAllTemplatesResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Templates []templateResponse `json:"templates"`
+	} `json:"data"`
+}
+
+type labelsResponse struct {
+	EntityID string   `json:"entity_id"`
+	Kind     string   `json:"kind"`
+	FolderID string   `json:"folder_id"`
+	TagIDs   []string `json:"tag_ids"`
+}
+
+func newLabelsResponse(labels domain.Labels) labelsResponse {
+	tagIDs := make([]string, 0, len(labels.TagIDs))
+	for _, v := range labels.TagIDs {
+		tagIDs = append(tagIDs, v.String())
+	}
+
+	return labelsResponse{
+		EntityID: labels.EntityID.String(),
+		Kind:     labels.Kind,
+		FolderID: labels.FolderID.String(),
+		TagIDs:   tagIDs,
+	}
+}
+
+type GetLabelsResponse struct {
+	Status  bool           `json:"status"`
+	Message string         `json:"message"`
+	Data    labelsResponse `json:"data"`
+}
+
+type PreLoginResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		E2E  bool   `json:"e2e"`
+		Salt string `json:"salt"`
+	} `json:"data"`
+}
+
+type TwoFactorEnrollResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Secret string `json:"secret"`
+		URI    string `json:"uri"`
+	} `json:"data"`
+}
+
+type TwoFactorVerifyResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		RecoveryCodes []string `json:"recovery_codes"`
 	} `json:"data"`
 }
 
@@ -145,9 +737,123 @@ type GetAllResponse struct {
 		Binaries    []binaryResponse      `json:"binaries"`
 		Credentials []credentialsResponse `json:"credentials"`
 		BankCards   []bankCardResponse    `json:"bank_cards"`
+		OTPs        []otpResponse         `json:"otps"`
+		SSHKeys     []sshKeyResponse      `json:"ssh_keys"`
+		Items       []itemResponse        `json:"items"`
+		NextCursor  string                `json:"next_cursor,omitempty"`
 	} `json:"data"`
 }
 
+type deletedResponse struct {
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+}
+
+type GetChangesResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Revision    int64                 `json:"revision"`
+		More        bool                  `json:"more"`
+		Texts       []textResponse        `json:"texts"`
+		Binaries    []binaryResponse      `json:"binaries"`
+		Credentials []credentialsResponse `json:"credentials"`
+		BankCards   []bankCardResponse    `json:"bank_cards"`
+		OTPs        []otpResponse         `json:"otps"`
+		SSHKeys     []sshKeyResponse      `json:"ssh_keys"`
+		Items       []itemResponse        `json:"items"`
+		Folders     []folderResponse      `json:"folders"`
+		Tags        []tagResponse         `json:"tags"`
+		Templates   []templateResponse    `json:"templates"`
+		Labels      []labelsResponse      `json:"labels"`
+		Deleted     []deletedResponse     `json:"deleted"`
+	} `json:"data"`
+}
+
+type GetTextResponse struct {
+	Status  bool         `json:"status"`
+	Message string       `json:"message"`
+	Data    textResponse `json:"data"`
+}
+
+type GetBinaryResponse struct {
+	Status  bool           `json:"status"`
+	Message string         `json:"message"`
+	Data    binaryResponse `json:"data"`
+}
+
+type GetCredentialsResponse struct {
+	Status  bool                `json:"status"`
+	Message string              `json:"message"`
+	Data    credentialsResponse `json:"data"`
+}
+
+type GetBankCardResponse struct {
+	Status  bool             `json:"status"`
+	Message string           `json:"message"`
+	Data    bankCardResponse `json:"data"`
+}
+
+type GetOTPResponse struct {
+	Status  bool        `json:"status"`
+	Message string      `json:"message"`
+	Data    otpResponse `json:"data"`
+}
+
+type GetSSHKeyResponse struct {
+	Status  bool           `json:"status"`
+	Message string         `json:"message"`
+	Data    sshKeyResponse `json:"data"`
+}
+
+type GetItemResponse struct {
+	Status  bool         `json:"status"`
+	Message string       `json:"message"`
+	Data    itemResponse `json:"data"`
+}
+
+type UpdateTextConflictResponse struct {
+	Status  bool         `json:"status"`
+	Message string       `json:"message"`
+	Data    textResponse `json:"data"`
+}
+
+type UpdateBinaryConflictResponse struct {
+	Status  bool           `json:"status"`
+	Message string         `json:"message"`
+	Data    binaryResponse `json:"data"`
+}
+
+type UpdateCredentialsConflictResponse struct {
+	Status  bool                `json:"status"`
+	Message string              `json:"message"`
+	Data    credentialsResponse `json:"data"`
+}
+
+type UpdateBankCardConflictResponse struct {
+	Status  bool             `json:"status"`
+	Message string           `json:"message"`
+	Data    bankCardResponse `json:"data"`
+}
+
+type UpdateOTPConflictResponse struct {
+	Status  bool        `json:"status"`
+	Message string      `json:"message"`
+	Data    otpResponse `json:"data"`
+}
+
+type UpdateSSHKeyConflictResponse struct {
+	Status  bool           `json:"status"`
+	Message string         `json:"message"`
+	Data    sshKeyResponse `json:"data"`
+}
+
+type UpdateItemConflictResponse struct {
+	Status  bool         `json:"status"`
+	Message string       `json:"message"`
+	Data    itemResponse `json:"data"`
+}
+
 type ErrorResponse struct {
 	Status  bool     `json:"status"`
 	Message string   `json:"message"`
@@ -0,0 +1,232 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+func putChunk(router http.Handler, token string, uploadID string, index int, body []byte) *httptest.ResponseRecorder {
+	url := fmt.Sprintf("/api/v1/binary/upload/%s/%d", uploadID, index)
+	req := httptest.NewRequest("PUT", url, bytes.NewReader(body))
+	req.Header.Add("Content-Type", "application/octet-stream")
+	req.Header.Add("Authorization", token)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+
+	return responseRecorder
+}
+
+// Проверяем загрузку частями в произвольном порядке, продолжение загрузки и скачивание
+func TestBinaryUploadFlow(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	content := []byte("0123456789abcdefghij!")
+	chunkSize := 8
+
+	response := postJSON(router, "/api/v1/binary/upload", string(token), []byte(`{"size": 21, "chunk_size": 8}`))
+	require.Equal(t, http.StatusCreated, response.Code)
+	uploadID := response.Header().Get("Location")
+	require.NotEmpty(t, uploadID)
+
+	// Неправильный размер части и номер за пределами загрузки отклоняются
+	response = putChunk(router, string(token), uploadID, 0, content[:3])
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	response = putChunk(router, string(token), uploadID, 3, content[:5])
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = putChunk(router, string(token), uploadID, 2, content[2*chunkSize:])
+	require.Equal(t, http.StatusNoContent, response.Code)
+	response = putChunk(router, string(token), uploadID, 0, content[:chunkSize])
+	require.Equal(t, http.StatusNoContent, response.Code)
+
+	response = postJSON(router, "/api/v1/binary/upload/"+uploadID+"/commit", string(token), nil)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	req := httptest.NewRequest("GET", "/api/v1/binary/upload/"+uploadID, http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	response = httptest.NewRecorder()
+	router.ServeHTTP(response, req)
+	require.Equal(t, http.StatusOK, response.Code)
+	var state presentation.BinaryUploadResponse
+	err = json.Unmarshal(response.Body.Bytes(), &state)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), state.Data.Size)
+	assert.Equal(t, chunkSize, state.Data.ChunkSize)
+	assert.Equal(t, []int64{0, 2}, state.Data.Received)
+
+	response = putChunk(router, string(token), uploadID, 1, content[chunkSize:2*chunkSize])
+	require.Equal(t, http.StatusNoContent, response.Code)
+
+	req = httptest.NewRequest("POST", "/api/v1/binary/upload/"+uploadID+"/commit", http.NoBody)
+	req.Header.Add("X-Binary-Metadata", encodeBinaryMetadata(`{"name":"upload.bin","sha256":"abc"}`))
+	req.Header.Add("Authorization", string(token))
+	response = httptest.NewRecorder()
+	router.ServeHTTP(response, req)
+	require.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, uploadID, response.Header().Get("Location"))
+	assert.Equal(t, `"1"`, response.Header().Get("ETag"))
+
+	binID, err := uuid.Parse(uploadID)
+	require.NoError(t, err)
+	bin, err := binaryRepository.Get(userID, binID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), bin.Size)
+	assert.Equal(t, int64(3), bin.Chunks)
+	sha, err := decrypt(userID, bin.SHA256)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", string(sha))
+
+	chunk, err := binaryUploadRepository.GetChunk(userID, binID, 0)
+	require.NoError(t, err)
+	assert.NotContains(t, string(chunk.Content), string(content[:chunkSize]))
+
+	req = httptest.NewRequest("GET", "/api/v1/binary/"+uploadID+"/content", http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	response = httptest.NewRecorder()
+	router.ServeHTTP(response, req)
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "application/octet-stream", response.Header().Get("Content-Type"))
+	assert.Equal(t, fmt.Sprint(len(content)), response.Header().Get("Content-Length"))
+	assert.Equal(t, content, response.Body.Bytes())
+
+	// После завершения сессия загрузки удаляется
+	response = putChunk(router, string(token), uploadID, 0, content[:chunkSize])
+	assert.Equal(t, http.StatusNotFound, response.Code)
+
+	err = binaryRepository.Delete(userID, binID)
+	require.NoError(t, err)
+	_, err = binaryUploadRepository.GetChunk(userID, binID, 0)
+	require.Error(t, err)
+}
+
+// Проверяем, что брошенная сессия загрузки истекает и удаляется вместе с частями, а активная сохраняется
+func TestBinaryUploadExpired(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	response := postJSON(router, "/api/v1/binary/upload", string(token), []byte(`{"size": 16, "chunk_size": 8}`))
+	require.Equal(t, http.StatusCreated, response.Code)
+	abandoned := response.Header().Get("Location")
+	response = putChunk(router, string(token), abandoned, 0, []byte("01234567"))
+	require.Equal(t, http.StatusNoContent, response.Code)
+	response = postJSON(router, "/api/v1/binary/upload", string(token), []byte(`{"size": 16, "chunk_size": 8}`))
+	require.Equal(t, http.StatusCreated, response.Code)
+	active := response.Header().Get("Location")
+
+	_, err = pool.Exec(
+		context.Background(),
+		"UPDATE binary_uploads SET updated_at = now() - interval '2 days' WHERE id = $1",
+		abandoned,
+	)
+	require.NoError(t, err)
+
+	// Истекшую сессию нельзя продолжить еще до удаления
+	response = putChunk(router, string(token), abandoned, 1, []byte("89abcdef"))
+	assert.Equal(t, http.StatusNotFound, response.Code)
+
+	deleted, err := binaryUploadRepository.DeleteExpired()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(1))
+
+	abandonedID, err := uuid.Parse(abandoned)
+	require.NoError(t, err)
+	_, err = binaryUploadRepository.GetChunk(userID, abandonedID, 0)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	activeID, err := uuid.Parse(active)
+	require.NoError(t, err)
+	_, err = binaryUploadRepository.Get(userID, activeID)
+	require.NoError(t, err)
+
+	// Активная сессия продолжается, а завершение удаленной сессии не создает бинарные данные
+	response = putChunk(router, string(token), active, 0, []byte("01234567"))
+	require.Equal(t, http.StatusNoContent, response.Code)
+	response = putChunk(router, string(token), active, 1, []byte("89abcdef"))
+	require.Equal(t, http.StatusNoContent, response.Code)
+	err = binaryRepository.CommitUpload(domain.Binary{ID: abandonedID, UserID: userID})
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	_, err = binaryRepository.Get(userID, abandonedID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestBinaryUploadBadRequest(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	for _, body := range []string{
+		`{"size": 0, "chunk_size": 8}`,
+		`{"size": 10, "chunk_size": 0}`,
+		`{"size": 10, "chunk_size": 33554432}`,
+		`not json`,
+	} {
+		response := postJSON(router, "/api/v1/binary/upload", string(token), []byte(body))
+		assert.Equal(t, http.StatusBadRequest, response.Code, body)
+	}
+}
+
+func TestDownloadInlineBinary(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	message := []byte("my secret binary message")
+	req := httptest.NewRequest("POST", "/api/v1/binary/create", bytes.NewReader(message))
+	req.Header.Add("Content-Type", "multipart/form-data")
+	req.Header.Add("Authorization", string(token))
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, req)
+	require.Equal(t, http.StatusCreated, response.Code)
+	binID := response.Header().Get("Location")
+
+	req = httptest.NewRequest("GET", "/api/v1/binary/"+binID+"/content", http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	response = httptest.NewRecorder()
+	router.ServeHTTP(response, req)
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, message, response.Body.Bytes())
+
+	req = httptest.NewRequest("GET", "/api/v1/binary/"+uuid.NewString()+"/content", http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	response = httptest.NewRecorder()
+	router.ServeHTTP(response, req)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
 )
 
 func TestCreateBankCardBadRequest(t *testing.T) {
@@ -131,27 +133,27 @@ func TestCreateBankCardSuccess(t *testing.T) {
 	card, err := cardRepository.Get(userID, cardID)
 	require.NoError(t, err)
 
-	decrNumber, err := cryptoService.Decrypt(card.Number)
+	decrNumber, err := decrypt(userID, card.Number)
 	require.NoError(t, err)
 
 	assert.Equal(t, number, string(decrNumber))
 
-	decrValidThru, err := cryptoService.Decrypt(card.ValidThru)
+	decrValidThru, err := decrypt(userID, card.ValidThru)
 	require.NoError(t, err)
 
 	assert.Equal(t, validThru, string(decrValidThru))
 
-	decrCVV, err := cryptoService.Decrypt(card.CVV)
+	decrCVV, err := decrypt(userID, card.CVV)
 	require.NoError(t, err)
 
 	assert.Equal(t, cvv, string(decrCVV))
 
-	decrCardHolder, err := cryptoService.Decrypt(card.CardHolder)
+	decrCardHolder, err := decrypt(userID, card.CardHolder)
 	require.NoError(t, err)
 
 	assert.Equal(t, cardHolder, string(decrCardHolder))
 
-	decrMeta, err := cryptoService.Decrypt(card.Meta)
+	decrMeta, err := decrypt(userID, card.Meta)
 	require.NoError(t, err)
 	assert.Equal(t, meta, string(decrMeta))
 }
@@ -191,27 +193,66 @@ func TestCreateBankCardNoHolderNoMetaSuccess(t *testing.T) {
 	card, err := cardRepository.Get(userID, credID)
 	require.NoError(t, err)
 
-	decrNumber, err := cryptoService.Decrypt(card.Number)
+	decrNumber, err := decrypt(userID, card.Number)
 	require.NoError(t, err)
 
 	assert.Equal(t, number, string(decrNumber))
 
-	decrValidThru, err := cryptoService.Decrypt(card.ValidThru)
+	decrValidThru, err := decrypt(userID, card.ValidThru)
 	require.NoError(t, err)
 
 	assert.Equal(t, validThru, string(decrValidThru))
 
-	decrCVV, err := cryptoService.Decrypt(card.CVV)
+	decrCVV, err := decrypt(userID, card.CVV)
 	require.NoError(t, err)
 
 	assert.Equal(t, cvv, string(decrCVV))
 
-	decrCardHolder, err := cryptoService.Decrypt(card.CardHolder)
+	decrCardHolder, err := decrypt(userID, card.CardHolder)
 	require.NoError(t, err)
 
 	assert.Equal(t, "", string(decrCardHolder))
 
-	decrMeta, err := cryptoService.Decrypt(card.Meta)
+	decrMeta, err := decrypt(userID, card.Meta)
 	require.NoError(t, err)
 	assert.Equal(t, "", string(decrMeta))
 }
+
+func TestCreateBankCardE2E(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = userRepository.Create(domain.User{
+		ID:       userID,
+		Login:    uuid.NewString(),
+		Password: "password",
+		E2E:      true,
+	}, nil)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	number := "ZW5jcnlwdGVkIG51bWJlcg=="
+	bodyReader := bytes.NewReader([]byte(`{
+		"number": "` + number + `", ` +
+		`"valid_thru": "ZW5jcnlwdGVkIHZhbGlkX3RocnU=", ` +
+		`"cvv": "ZW5jcnlwdGVkIGN2dg==", ` +
+		`"card_holder": "ZW5jcnlwdGVkIGhvbGRlcg==",` +
+		`"meta": "ZW5jcnlwdGVkIG1ldGE="` +
+		`}`))
+	req := httptest.NewRequest("POST", "/api/v1/bank_card/create", bodyReader)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	cardID, err := uuid.Parse(responseRecorder.Header().Get("Location"))
+	require.NoError(t, err)
+
+	card, err := cardRepository.Get(userID, cardID)
+	require.NoError(t, err)
+	assert.Equal(t, number, string(card.Number))
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"bytes"
+	"encoding/base64"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,6 +12,12 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const binaryMetadata = `{"name":"message.txt","mime_type":"text/plain; charset=utf-8","sha256":"abc","meta":"note"}`
+
+func encodeBinaryMetadata(metadata string) string {
+	return base64.StdEncoding.EncodeToString([]byte(metadata))
+}
+
 func TestBinaryBadRequest(t *testing.T) { //nolint: dupl
 	tests := []struct {
 		name        string
@@ -67,6 +74,7 @@ func TestBinarySuccess(t *testing.T) {
 	bodyReader := bytes.NewReader(message)
 	req := httptest.NewRequest("POST", "/api/v1/binary/create", bodyReader)
 	req.Header.Add("Content-Type", "multipart/form-data")
+	req.Header.Add("X-Binary-Metadata", encodeBinaryMetadata(binaryMetadata))
 	req.Header.Add("Authorization", string(token))
 	responseRecorder := httptest.NewRecorder()
 	router.ServeHTTP(responseRecorder, req)
@@ -80,8 +88,37 @@ func TestBinarySuccess(t *testing.T) {
 	binObj, err := binaryRepository.Get(userID, binID)
 	require.NoError(t, err)
 
-	decrypted, err := cryptoService.Decrypt(binObj.Content)
+	decrypted, err := decrypt(userID, binObj.Content)
 	require.NoError(t, err)
-
 	assert.Equal(t, message, decrypted)
+	assert.Equal(t, int64(len(message)), binObj.Size)
+
+	name, err := decrypt(userID, binObj.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "message.txt", string(name))
+	meta, err := decrypt(userID, binObj.Meta)
+	require.NoError(t, err)
+	assert.Equal(t, "note", string(meta))
+}
+
+func TestCreateBinaryInvalidMetadata(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	for _, metadata := range []string{"not base64!", encodeBinaryMetadata("not json")} {
+		req := httptest.NewRequest("POST", "/api/v1/binary/create", bytes.NewReader([]byte("content")))
+		req.Header.Add("Content-Type", "multipart/form-data")
+		req.Header.Add("X-Binary-Metadata", metadata)
+		req.Header.Add("Authorization", string(token))
+		responseRecorder := httptest.NewRecorder()
+		router.ServeHTTP(responseRecorder, req)
+		assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	}
 }
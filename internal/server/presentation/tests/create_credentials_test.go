@@ -107,22 +107,22 @@ func TestCreateCredentialsSuccess(t *testing.T) {
 	cred, err := credentialsRepository.Get(userID, credID)
 	require.NoError(t, err)
 
-	decrName, err := cryptoService.Decrypt(cred.Name)
+	decrName, err := decrypt(userID, cred.Name)
 	require.NoError(t, err)
 
 	assert.Equal(t, name, string(decrName))
 
-	decrLogin, err := cryptoService.Decrypt(cred.Login)
+	decrLogin, err := decrypt(userID, cred.Login)
 	require.NoError(t, err)
 
 	assert.Equal(t, login, string(decrLogin))
 
-	decrPassword, err := cryptoService.Decrypt(cred.Password)
+	decrPassword, err := decrypt(userID, cred.Password)
 	require.NoError(t, err)
 
 	assert.Equal(t, password, string(decrPassword))
 
-	decrMeta, err := cryptoService.Decrypt(cred.Meta)
+	decrMeta, err := decrypt(userID, cred.Meta)
 	require.NoError(t, err)
 	assert.Equal(t, "", string(decrMeta))
 }
@@ -164,22 +164,22 @@ func TestCreateCredentialsWithMetaSuccess(t *testing.T) {
 	cred, err := credentialsRepository.Get(userID, credID)
 	require.NoError(t, err)
 
-	decrName, err := cryptoService.Decrypt(cred.Name)
+	decrName, err := decrypt(userID, cred.Name)
 	require.NoError(t, err)
 
 	assert.Equal(t, name, string(decrName))
 
-	decrLogin, err := cryptoService.Decrypt(cred.Login)
+	decrLogin, err := decrypt(userID, cred.Login)
 	require.NoError(t, err)
 
 	assert.Equal(t, login, string(decrLogin))
 
-	decrPassword, err := cryptoService.Decrypt(cred.Password)
+	decrPassword, err := decrypt(userID, cred.Password)
 	require.NoError(t, err)
 
 	assert.Equal(t, password, string(decrPassword))
 
-	decrMeta, err := cryptoService.Decrypt(cred.Meta)
+	decrMeta, err := decrypt(userID, cred.Meta)
 	require.NoError(t, err)
 	assert.Equal(t, meta, string(decrMeta))
 }
@@ -0,0 +1,179 @@
+// nolint: goconst
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+const itemFields = `[{"name": "ssid", "type": "string", "value": "Office"}, ` +
+	`{"name": "password", "type": "secret", "value": "s3cr3t"}]`
+
+func TestCreateItemBadRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        []byte
+		contentType string
+	}{
+		{
+			name:        "missing field",
+			body:        []byte(`{"template": "wifi", "fields": ` + itemFields + `}`),
+			contentType: "application/json",
+		},
+		{
+			name:        "invalid value type",
+			body:        []byte(`{"name": "Office", "fields": "ssid"}`),
+			contentType: "application/json",
+		},
+		{
+			name:        "not a json",
+			body:        []byte(`not a json`),
+			contentType: "application/json",
+		},
+		{
+			name:        "wrong content type",
+			body:        []byte{},
+			contentType: "plain/text",
+		},
+		{
+			name:        "unknown field type",
+			body:        []byte(`{"name": "Office", "fields": [{"name": "color", "type": "color", "value": "red"}]}`),
+			contentType: "application/json",
+		},
+		{
+			name:        "invalid field value",
+			body:        []byte(`{"name": "Office", "fields": [{"name": "router", "type": "url", "value": "router"}]}`),
+			contentType: "application/json",
+		},
+		{
+			name: "duplicate field name",
+			body: []byte(`{"name": "Office", "fields": [{"name": "pin", "type": "secret", "value": "1"}, ` +
+				`{"name": "pin", "type": "string", "value": "2"}]}`),
+			contentType: "application/json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, err := setup()
+			require.NoError(t, err)
+			defer teardown()
+
+			userID := uuid.New()
+			err = createUser(userID)
+			require.NoError(t, err)
+			token, err := joseService.IssueToken(userID)
+			require.NoError(t, err)
+
+			bodyReader := bytes.NewReader(tt.body)
+			req := httptest.NewRequest("POST", "/api/v1/item/create", bodyReader)
+			req.Header.Add("Content-Type", tt.contentType)
+			req.Header.Add("Authorization", string(token))
+			responseRecorder := httptest.NewRecorder()
+			router.ServeHTTP(responseRecorder, req)
+			assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+		})
+	}
+}
+
+func TestCreateItemSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	bodyReader := bytes.NewReader([]byte(`{
+		"name": "Office", ` +
+		`"template": "wifi", ` +
+		`"fields": ` + itemFields + `, ` +
+		`"meta": "my item meta"` +
+		`}`))
+	req := httptest.NewRequest("POST", "/api/v1/item/create", bodyReader)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	require.NotEmpty(t, responseRecorder.Header().Get("Location"))
+	itemID, err := uuid.Parse(responseRecorder.Header().Get("Location"))
+	require.NoError(t, err)
+
+	obj, err := itemRepository.Get(userID, itemID)
+	require.NoError(t, err)
+
+	expected := map[string]string{
+		"Office":       string(obj.Name),
+		"wifi":         string(obj.Template),
+		"my item meta": string(obj.Meta),
+	}
+	for want, encrypted := range expected {
+		decrypted, err := decrypt(userID, []byte(encrypted))
+		require.NoError(t, err)
+		assert.Equal(t, want, string(decrypted))
+	}
+
+	decrFields, err := decrypt(userID, obj.Fields)
+	require.NoError(t, err)
+	fields := []item.Field{}
+	err = json.Unmarshal(decrFields, &fields)
+	require.NoError(t, err)
+	assert.Equal(t, []item.Field{
+		{Name: "ssid", Type: item.TypeString, Value: "Office"},
+		{Name: "password", Type: item.TypeSecret, Value: "s3cr3t"},
+	}, fields)
+}
+
+func TestCreateItemE2E(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = userRepository.Create(domain.User{
+		ID:       userID,
+		Login:    uuid.NewString(),
+		Password: "password",
+		E2E:      true,
+	}, nil)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	// Тип и значение поля зашифрованы на клиенте, поэтому сервер их не проверяет
+	name := "ZW5jcnlwdGVkIG5hbWU="
+	bodyReader := bytes.NewReader([]byte(`{
+		"name": "` + name + `", ` +
+		`"template": "ZW5jcnlwdGVkIHRlbXBsYXRl", ` +
+		`"fields": [{"name": "ZW5jcnlwdGVk", "type": "ZW5jcnlwdGVkIHR5cGU=", "value": "ZW5jcnlwdGVkIHZhbHVl"}], ` +
+		`"meta": "ZW5jcnlwdGVkIG1ldGE="` +
+		`}`))
+	req := httptest.NewRequest("POST", "/api/v1/item/create", bodyReader)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	itemID, err := uuid.Parse(responseRecorder.Header().Get("Location"))
+	require.NoError(t, err)
+	obj, err := itemRepository.Get(userID, itemID)
+	require.NoError(t, err)
+	decrName, err := decrypt(userID, obj.Name)
+	require.NoError(t, err)
+	assert.Equal(t, name, string(decrName))
+}
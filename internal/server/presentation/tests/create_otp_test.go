@@ -0,0 +1,180 @@
+// nolint: goconst
+package tests
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+func TestCreateOTPBadRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        []byte
+		contentType string
+	}{
+		{
+			name:        "missing field",
+			body:        []byte(`{"secret": "JBSWY3DPEHPK3PXP", "algorithm": "SHA1"}`),
+			contentType: "application/json",
+		},
+		{
+			name:        "invalid value type",
+			body:        []byte(`{"secret": "JBSWY3DPEHPK3PXP", "algorithm": "SHA1", "digits": 6, "period": 30}`),
+			contentType: "application/json",
+		},
+		{
+			name:        "not a json",
+			body:        []byte(`not a json`),
+			contentType: "application/json",
+		},
+		{
+			name:        "wrong content type",
+			body:        []byte{},
+			contentType: "plain/text",
+		},
+		{
+			name:        "invalid secret",
+			body:        []byte(`{"secret": "not base32!", "algorithm": "SHA1", "digits": "6", "period": "30"}`),
+			contentType: "application/json",
+		},
+		{
+			name:        "invalid algorithm",
+			body:        []byte(`{"secret": "JBSWY3DPEHPK3PXP", "algorithm": "MD5", "digits": "6", "period": "30"}`),
+			contentType: "application/json",
+		},
+		{
+			name:        "invalid digits",
+			body:        []byte(`{"secret": "JBSWY3DPEHPK3PXP", "algorithm": "SHA1", "digits": "4", "period": "30"}`),
+			contentType: "application/json",
+		},
+		{
+			name:        "invalid period",
+			body:        []byte(`{"secret": "JBSWY3DPEHPK3PXP", "algorithm": "SHA1", "digits": "6", "period": "0"}`),
+			contentType: "application/json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, err := setup()
+			require.NoError(t, err)
+			defer teardown()
+
+			userID := uuid.New()
+			err = createUser(userID)
+			require.NoError(t, err)
+			token, err := joseService.IssueToken(userID)
+			require.NoError(t, err)
+
+			bodyReader := bytes.NewReader(tt.body)
+			req := httptest.NewRequest("POST", "/api/v1/otp/create", bodyReader)
+			req.Header.Add("Content-Type", tt.contentType)
+			req.Header.Add("Authorization", string(token))
+			responseRecorder := httptest.NewRecorder()
+			router.ServeHTTP(responseRecorder, req)
+			assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+		})
+	}
+}
+
+func TestCreateOTPSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	issuer := "GitHub"
+	account := "octocat"
+	secret := "JBSWY3DPEHPK3PXP"
+	meta := "my otp meta"
+
+	bodyReader := bytes.NewReader([]byte(`{
+		"issuer": "` + issuer + `", ` +
+		`"account": "` + account + `", ` +
+		`"secret": "` + secret + `", ` +
+		`"algorithm": "SHA256", ` +
+		`"digits": "8", ` +
+		`"period": "60", ` +
+		`"meta": "` + meta + `"` +
+		`}`))
+	req := httptest.NewRequest("POST", "/api/v1/otp/create", bodyReader)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	require.NotEmpty(t, responseRecorder.Header().Get("Location"))
+	otpID, err := uuid.Parse(responseRecorder.Header().Get("Location"))
+	require.NoError(t, err)
+
+	otp, err := otpRepository.Get(userID, otpID)
+	require.NoError(t, err)
+
+	expected := map[string]string{
+		issuer:   string(otp.Issuer),
+		account:  string(otp.Account),
+		secret:   string(otp.Secret),
+		"SHA256": string(otp.Algorithm),
+		"8":      string(otp.Digits),
+		"60":     string(otp.Period),
+		meta:     string(otp.Meta),
+	}
+	for want, encrypted := range expected {
+		decrypted, err := decrypt(userID, []byte(encrypted))
+		require.NoError(t, err)
+		assert.Equal(t, want, string(decrypted))
+	}
+}
+
+func TestCreateOTPE2E(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = userRepository.Create(domain.User{
+		ID:       userID,
+		Login:    uuid.NewString(),
+		Password: "password",
+		E2E:      true,
+	}, nil)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	secret := "ZW5jcnlwdGVkIHNlY3JldA=="
+	bodyReader := bytes.NewReader([]byte(`{
+		"issuer": "ZW5jcnlwdGVkIGlzc3Vlcg==", ` +
+		`"account": "ZW5jcnlwdGVkIGFjY291bnQ=", ` +
+		`"secret": "` + secret + `", ` +
+		`"algorithm": "ZW5jcnlwdGVkIGFsZ29yaXRobQ==", ` +
+		`"digits": "ZW5jcnlwdGVkIGRpZ2l0cw==", ` +
+		`"period": "ZW5jcnlwdGVkIHBlcmlvZA==", ` +
+		`"meta": "ZW5jcnlwdGVkIG1ldGE="` +
+		`}`))
+	req := httptest.NewRequest("POST", "/api/v1/otp/create", bodyReader)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	otpID, err := uuid.Parse(responseRecorder.Header().Get("Location"))
+	require.NoError(t, err)
+	otp, err := otpRepository.Get(userID, otpID)
+	require.NoError(t, err)
+	assert.Equal(t, secret, string(otp.Secret))
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
 )
 
 func TestCreateTextBadRequest(t *testing.T) { //nolint: dupl
@@ -80,8 +82,49 @@ func TestCreateTextSuccess(t *testing.T) {
 	textObj, err := textRepository.Get(userID, textID)
 	require.NoError(t, err)
 
-	decrypted, err := cryptoService.Decrypt(textObj.Content)
+	decrypted, err := decrypt(userID, textObj.Content)
 	require.NoError(t, err)
 
 	assert.Equal(t, message, string(decrypted))
 }
+
+// Проверяем, что данные пользователя с персональным ключом не расшифровываются мастер-ключом
+func TestCreateTextUserKey(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	userKey, err := userKeyRepository.Get(userID)
+	require.NoError(t, err)
+	dataKey, err := cryptoService.Decrypt(userKey.Key)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	message := "my text message to store"
+
+	bodyReader := bytes.NewReader([]byte(message))
+	req := httptest.NewRequest("POST", "/api/v1/text/create", bodyReader)
+	req.Header.Add("Content-Type", "plain/text")
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	textID, err := uuid.Parse(responseRecorder.Header().Get("Location"))
+	require.NoError(t, err)
+	textObj, err := textRepository.Get(userID, textID)
+	require.NoError(t, err)
+
+	_, err = cryptoService.Decrypt(textObj.Content)
+	require.Error(t, err)
+
+	userCrypto, err := crypto.New(dataKey)
+	require.NoError(t, err)
+	decrypted, err := userCrypto.Decrypt(textObj.Content)
+	require.NoError(t, err)
+	assert.Equal(t, message, string(decrypted))
+}
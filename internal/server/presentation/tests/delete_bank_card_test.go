@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+func TestDeleteBankCardSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	cardID := uuid.New()
+	card := domain.BankCard{
+		ID:         cardID,
+		UserID:     userID,
+		Number:     []byte("0000 0000 0000 0000"),
+		CVV:        []byte("000"),
+		ValidThru:  []byte("01/11"),
+		CardHolder: []byte(""),
+		Meta:       []byte(""),
+	}
+	err = cardRepository.Create(&card)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", cardURL+cardID.String(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	_, err = cardRepository.Get(userID, cardID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestDeleteBankCardNotFound(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", cardURL+uuid.NewString(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestDeleteBankCardInvalidID(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", cardURL+"invalid", http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
+
+func TestDeleteBankCardUnauthorized(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	req := httptest.NewRequest("DELETE", cardURL+uuid.NewString(), http.NoBody)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusUnauthorized, responseRecorder.Code)
+}
@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+func TestDeleteBinarySuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	binID := uuid.New()
+	bin := domain.Binary{
+		ID:      binID,
+		UserID:  userID,
+		Content: []byte("my binary message to delete"),
+	}
+	err = binaryRepository.Create(bin)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", binaryURL+binID.String(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	_, err = binaryRepository.Get(userID, binID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestDeleteBinaryNotFound(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", binaryURL+uuid.NewString(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestDeleteBinaryInvalidID(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", binaryURL+"invalid", http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
+
+func TestDeleteBinaryUnauthorized(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	req := httptest.NewRequest("DELETE", binaryURL+uuid.NewString(), http.NoBody)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusUnauthorized, responseRecorder.Code)
+}
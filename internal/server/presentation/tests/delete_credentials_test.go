@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+func TestDeleteCredentialsSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	credID := uuid.New()
+	cred := domain.Credentials{
+		ID:       credID,
+		UserID:   userID,
+		Name:     []byte("name"),
+		Login:    []byte("login"),
+		Password: []byte("password"),
+		Meta:     []byte(""),
+	}
+	err = credentialsRepository.Create(&cred)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", credURL+credID.String(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	_, err = credentialsRepository.Get(userID, credID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
+
+func TestDeleteCredentialsNotFound(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", credURL+uuid.NewString(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestDeleteCredentialsInvalidID(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", credURL+"invalid", http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
+
+func TestDeleteCredentialsUnauthorized(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	req := httptest.NewRequest("DELETE", credURL+uuid.NewString(), http.NoBody)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusUnauthorized, responseRecorder.Code)
+}
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+func TestDeleteOTPSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	otpID, err := createOTP(userID, "GitHub", "octocat", "JBSWY3DPEHPK3PXP", "")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", otpURL+otpID, http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	id, err := uuid.Parse(otpID)
+	require.NoError(t, err)
+	_, err = otpRepository.Get(userID, id)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+
+	changes, err := changesRepository.GetSince(userID, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, changes.Tombstones, 1)
+	assert.Equal(t, domain.OTPKind, changes.Tombstones[0].Kind)
+}
+
+func TestDeleteOTPNotFound(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", otpURL+uuid.NewString(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
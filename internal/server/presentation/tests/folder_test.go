@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+const folderURL = "/api/v1/folder/"
+
+func createFolder(userID uuid.UUID, name string, parentID uuid.UUID) (uuid.UUID, error) {
+	folderID := uuid.New()
+	encryptedName, err := encrypt(userID, []byte(name))
+	if err != nil {
+		return folderID, err
+	}
+	folder := domain.Folder{
+		ID:       folderID,
+		UserID:   userID,
+		ParentID: parentID,
+		Name:     encryptedName,
+	}
+	err = folderRepository.Create(&folder)
+
+	return folderID, err
+}
+
+func TestCreateFolderSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	parentID, err := createFolder(userID, "Work", uuid.Nil)
+	require.NoError(t, err)
+
+	body := []byte(`{"name": "Servers", "parent_id": "` + parentID.String() + `"}`)
+	req := httptest.NewRequest("POST", folderURL+"create", bytes.NewReader(body))
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	folderID, err := uuid.Parse(responseRecorder.Header().Get("Location"))
+	require.NoError(t, err)
+	folder, err := folderRepository.Get(userID, folderID)
+	require.NoError(t, err)
+	assert.Equal(t, parentID, folder.ParentID)
+	name, err := decrypt(userID, folder.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "Servers", string(name))
+
+	req = httptest.NewRequest("GET", folderURL+"all", http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder = httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	responseData := presentation.GetAllFoldersResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	require.Len(t, responseData.Data.Folders, 2)
+	assert.Equal(t, "Work", responseData.Data.Folders[0].Name)
+	assert.Equal(t, uuid.Nil.String(), responseData.Data.Folders[0].ParentID)
+	assert.Equal(t, "Servers", responseData.Data.Folders[1].Name)
+	assert.Equal(t, parentID.String(), responseData.Data.Folders[1].ParentID)
+}
+
+func TestCreateFolderParentNotFound(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	body := []byte(`{"name": "Servers", "parent_id": "` + uuid.NewString() + `"}`)
+	req := httptest.NewRequest("POST", folderURL+"create", bytes.NewReader(body))
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestUpdateFolderCycle(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	parentID, err := createFolder(userID, "Work", uuid.Nil)
+	require.NoError(t, err)
+	childID, err := createFolder(userID, "Servers", parentID)
+	require.NoError(t, err)
+
+	for _, newParentID := range []uuid.UUID{parentID, childID} {
+		body := []byte(`{"name": "Work", "parent_id": "` + newParentID.String() + `"}`)
+		req := httptest.NewRequest("POST", folderURL+parentID.String(), bytes.NewReader(body))
+		req.Header.Add("Authorization", string(token))
+		req.Header.Add("Content-Type", "application/json")
+		responseRecorder := httptest.NewRecorder()
+		router.ServeHTTP(responseRecorder, req)
+		assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	}
+
+	body := []byte(`{"name": "Infra", "parent_id": "` + uuid.Nil.String() + `"}`)
+	req := httptest.NewRequest("POST", folderURL+childID.String(), bytes.NewReader(body))
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	folder, err := folderRepository.Get(userID, childID)
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Nil, folder.ParentID)
+}
+
+func TestDeleteFolderMovesContentsToParent(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	parentID, err := createFolder(userID, "Work", uuid.Nil)
+	require.NoError(t, err)
+	folderID, err := createFolder(userID, "Servers", parentID)
+	require.NoError(t, err)
+	childID, err := createFolder(userID, "Staging", folderID)
+	require.NoError(t, err)
+	textID, err := createText(userID, "ssh root@staging")
+	require.NoError(t, err)
+	err = labelRepository.Save(&domain.Labels{
+		EntityID: uuid.MustParse(textID),
+		UserID:   userID,
+		Kind:     domain.TextKind,
+		FolderID: folderID,
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", folderURL+folderID.String(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	_, err = folderRepository.Get(userID, folderID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	child, err := folderRepository.Get(userID, childID)
+	require.NoError(t, err)
+	assert.Equal(t, parentID, child.ParentID)
+	labels, err := labelRepository.Get(userID, uuid.MustParse(textID))
+	require.NoError(t, err)
+	assert.Equal(t, parentID, labels.FolderID)
+
+	changes, err := changesRepository.GetSince(userID, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, changes.Tombstones, 1)
+	assert.Equal(t, domain.FolderKind, changes.Tombstones[0].Kind)
+}
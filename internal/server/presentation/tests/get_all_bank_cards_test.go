@@ -19,23 +19,23 @@ const getAllBankCardsURL = "/api/v1/bank_card/all"
 
 func createBankCard(userID uuid.UUID, number, validThru, cvv, cardHolder, meta string) (string, error) { // nolint: unparam
 	cardID := uuid.New()
-	encryptedNumber, err := cryptoService.Encrypt([]byte(number))
+	encryptedNumber, err := encrypt(userID, []byte(number))
 	if err != nil {
 		return "", err
 	}
-	encryptedValidThru, err := cryptoService.Encrypt([]byte(validThru))
+	encryptedValidThru, err := encrypt(userID, []byte(validThru))
 	if err != nil {
 		return "", err
 	}
-	encryptedCVV, err := cryptoService.Encrypt([]byte(cvv))
+	encryptedCVV, err := encrypt(userID, []byte(cvv))
 	if err != nil {
 		return "", err
 	}
-	encryptedCardHolder, err := cryptoService.Encrypt([]byte(cardHolder))
+	encryptedCardHolder, err := encrypt(userID, []byte(cardHolder))
 	if err != nil {
 		return "", err
 	}
-	encryptedMeta, err := cryptoService.Encrypt([]byte(meta))
+	encryptedMeta, err := encrypt(userID, []byte(meta))
 	if err != nil {
 		return "", err
 	}
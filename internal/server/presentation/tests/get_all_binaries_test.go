@@ -17,9 +17,15 @@ import (
 
 const getAllBinariesURL = "/api/v1/binary/all"
 
+const binaryName = "binary.bin"
+
 func createBinary(userID uuid.UUID, content []byte) (string, error) {
 	binID := uuid.New()
-	encryptedContent, err := cryptoService.Encrypt(content)
+	encryptedContent, err := encrypt(userID, content)
+	if err != nil {
+		return "", err
+	}
+	encryptedName, err := encrypt(userID, []byte(binaryName))
 	if err != nil {
 		return "", err
 	}
@@ -27,6 +33,8 @@ func createBinary(userID uuid.UUID, content []byte) (string, error) {
 		ID:      binID,
 		UserID:  userID,
 		Content: encryptedContent,
+		Name:    encryptedName,
+		Size:    int64(len(content)),
 	}
 	err = binaryRepository.Create(binary)
 
@@ -64,11 +72,16 @@ func TestGetAllBinariesSuccess(t *testing.T) {
 	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
 	require.NoError(t, err)
 
+	// Список возвращает только метаданные без содержимого
 	assert.Equal(t, responseData.Data.Binaries[0].ID, firstID)
-	assert.Equal(t, responseData.Data.Binaries[0].Content, firstContent)
+	assert.Equal(t, responseData.Data.Binaries[0].Name, binaryName)
+	assert.Equal(t, responseData.Data.Binaries[0].Size, int64(len(firstContent)))
+	assert.Empty(t, responseData.Data.Binaries[0].Content)
 
 	assert.Equal(t, responseData.Data.Binaries[1].ID, secondID)
-	assert.Equal(t, responseData.Data.Binaries[1].Content, secondContent)
+	assert.Equal(t, responseData.Data.Binaries[1].Name, binaryName)
+	assert.Equal(t, responseData.Data.Binaries[1].Size, int64(len(secondContent)))
+	assert.Empty(t, responseData.Data.Binaries[1].Content)
 }
 
 func TestGetAllBinariesInternalServerError(t *testing.T) { // nolint: dupl
@@ -86,6 +99,7 @@ func TestGetAllBinariesInternalServerError(t *testing.T) { // nolint: dupl
 		ID:      uuid.New(),
 		UserID:  userID,
 		Content: []byte("not encrypted"),
+		Name:    []byte("not encrypted"),
 	}
 	err = binaryRepository.Create(bin)
 	require.NoError(t, err)
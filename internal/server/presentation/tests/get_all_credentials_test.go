@@ -19,19 +19,19 @@ const getAllCredentialsURL = "/api/v1/credentials/all" // nolint: gosec
 
 func createCredentials(userID uuid.UUID, name, login, password, meta string) (string, error) { // nolint: unparam
 	credID := uuid.New()
-	encryptedName, err := cryptoService.Encrypt([]byte(name))
+	encryptedName, err := encrypt(userID, []byte(name))
 	if err != nil {
 		return "", err
 	}
-	encryptedLogin, err := cryptoService.Encrypt([]byte(login))
+	encryptedLogin, err := encrypt(userID, []byte(login))
 	if err != nil {
 		return "", err
 	}
-	encryptedPassword, err := cryptoService.Encrypt([]byte(password))
+	encryptedPassword, err := encrypt(userID, []byte(password))
 	if err != nil {
 		return "", err
 	}
-	encryptedMeta, err := cryptoService.Encrypt([]byte(meta))
+	encryptedMeta, err := encrypt(userID, []byte(meta))
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+const getAllItemsURL = "/api/v1/item/all"
+
+func createItem(userID uuid.UUID, name string, fields []item.Field, meta string) (string, error) {
+	itemID := uuid.New()
+	fieldsJSON, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	values := []string{name, item.Custom, string(fieldsJSON), meta}
+	encrypted := make([][]byte, len(values))
+	for i, v := range values {
+		value, err := encrypt(userID, []byte(v))
+		if err != nil {
+			return "", err
+		}
+		encrypted[i] = value
+	}
+	obj := domain.Item{
+		ID:       itemID,
+		UserID:   userID,
+		Name:     encrypted[0],
+		Template: encrypted[1],
+		Fields:   encrypted[2],
+		Meta:     encrypted[3],
+	}
+	err = itemRepository.Create(&obj)
+
+	return itemID.String(), err
+}
+
+func TestGetAllItemsSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	fields := []item.Field{{Name: "pin", Type: item.TypeSecret, Value: "1234"}}
+	firstID, err := createItem(userID, "Door", fields, "my meta data")
+	require.NoError(t, err)
+	secondID, err := createItem(userID, "Empty", []item.Field{}, "")
+	require.NoError(t, err)
+
+	bodyReader := bytes.NewReader(nil)
+	req := httptest.NewRequest("GET", getAllItemsURL, bodyReader)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "application/json", responseRecorder.Header().Get("Content-Type"))
+
+	responseData := presentation.GetAllItemsResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+
+	require.Len(t, responseData.Data.Items, 2)
+	assert.Equal(t, firstID, responseData.Data.Items[0].ID)
+	assert.Equal(t, "Door", responseData.Data.Items[0].Name)
+	assert.Equal(t, item.Custom, responseData.Data.Items[0].Template)
+	assert.Equal(t, fields, responseData.Data.Items[0].Fields)
+	assert.Equal(t, "my meta data", responseData.Data.Items[0].Meta)
+
+	assert.Equal(t, secondID, responseData.Data.Items[1].ID)
+	assert.Empty(t, responseData.Data.Items[1].Fields)
+}
+
+func TestGetAllItemsInternalServerError(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	notEncrypted := []byte("not encrypted")
+	obj := domain.Item{
+		ID:       uuid.New(),
+		UserID:   userID,
+		Name:     notEncrypted,
+		Template: notEncrypted,
+		Fields:   notEncrypted,
+		Meta:     notEncrypted,
+	}
+	err = itemRepository.Create(&obj)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", getAllItemsURL, http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
+
+	responseData := presentation.GetAllItemsResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	assert.False(t, responseData.Status)
+	assert.Equal(t, "cipher: message authentication failed", responseData.Message)
+}
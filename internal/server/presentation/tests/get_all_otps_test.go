@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+const getAllOTPsURL = "/api/v1/otp/all"
+
+func createOTP(userID uuid.UUID, issuer, account, secret, meta string) (string, error) {
+	otpID := uuid.New()
+	values := []string{issuer, account, secret, "SHA1", "6", "30", meta}
+	encrypted := make([][]byte, len(values))
+	for i, v := range values {
+		value, err := encrypt(userID, []byte(v))
+		if err != nil {
+			return "", err
+		}
+		encrypted[i] = value
+	}
+	otp := domain.OTP{
+		ID:        otpID,
+		UserID:    userID,
+		Issuer:    encrypted[0],
+		Account:   encrypted[1],
+		Secret:    encrypted[2],
+		Algorithm: encrypted[3],
+		Digits:    encrypted[4],
+		Period:    encrypted[5],
+		Meta:      encrypted[6],
+	}
+	err := otpRepository.Create(&otp)
+
+	return otpID.String(), err
+}
+
+func TestGetAllOTPsSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	firstID, err := createOTP(userID, "GitHub", "octocat", "JBSWY3DPEHPK3PXP", "my meta data")
+	require.NoError(t, err)
+	secondID, err := createOTP(userID, "GitLab", "tanuki", "HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ", "")
+	require.NoError(t, err)
+
+	bodyReader := bytes.NewReader(nil)
+	req := httptest.NewRequest("GET", getAllOTPsURL, bodyReader)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "application/json", responseRecorder.Header().Get("Content-Type"))
+
+	responseData := presentation.GetAllOTPsResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+
+	require.Len(t, responseData.Data.OTPs, 2)
+	assert.Equal(t, firstID, responseData.Data.OTPs[0].ID)
+	assert.Equal(t, "GitHub", responseData.Data.OTPs[0].Issuer)
+	assert.Equal(t, "octocat", responseData.Data.OTPs[0].Account)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", responseData.Data.OTPs[0].Secret)
+	assert.Equal(t, "SHA1", responseData.Data.OTPs[0].Algorithm)
+	assert.Equal(t, "6", responseData.Data.OTPs[0].Digits)
+	assert.Equal(t, "30", responseData.Data.OTPs[0].Period)
+	assert.Equal(t, "my meta data", responseData.Data.OTPs[0].Meta)
+
+	assert.Equal(t, secondID, responseData.Data.OTPs[1].ID)
+	assert.Equal(t, "GitLab", responseData.Data.OTPs[1].Issuer)
+}
+
+func TestGetAllOTPsInternalServerError(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	notEncrypted := []byte("not encrypted")
+	otp := domain.OTP{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Issuer:    notEncrypted,
+		Account:   notEncrypted,
+		Secret:    notEncrypted,
+		Algorithm: notEncrypted,
+		Digits:    notEncrypted,
+		Period:    notEncrypted,
+		Meta:      notEncrypted,
+	}
+	err = otpRepository.Create(&otp)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", getAllOTPsURL, http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
+
+	responseData := presentation.GetAllOTPsResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	assert.False(t, responseData.Status)
+	assert.Equal(t, "cipher: message authentication failed", responseData.Message)
+}
@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+const getAllSSHKeysURL = "/api/v1/ssh_key/all"
+
+func createSSHKey(userID uuid.UUID, privateKey, comment, meta string) (string, error) {
+	keyID := uuid.New()
+	values := []string{privateKey, sshPublicKey, comment, sshFingerprint, meta}
+	encrypted := make([][]byte, len(values))
+	for i, v := range values {
+		value, err := encrypt(userID, []byte(v))
+		if err != nil {
+			return "", err
+		}
+		encrypted[i] = value
+	}
+	key := domain.SSHKey{
+		ID:          keyID,
+		UserID:      userID,
+		PrivateKey:  encrypted[0],
+		PublicKey:   encrypted[1],
+		Comment:     encrypted[2],
+		Fingerprint: encrypted[3],
+		Meta:        encrypted[4],
+	}
+	err := sshKeyRepository.Create(&key)
+
+	return keyID.String(), err
+}
+
+func TestGetAllSSHKeysSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	firstID, err := createSSHKey(userID, "first private key", "octocat@github", "my meta data")
+	require.NoError(t, err)
+	secondID, err := createSSHKey(userID, "second private key", "tanuki@gitlab", "")
+	require.NoError(t, err)
+
+	bodyReader := bytes.NewReader(nil)
+	req := httptest.NewRequest("GET", getAllSSHKeysURL, bodyReader)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "application/json", responseRecorder.Header().Get("Content-Type"))
+
+	responseData := presentation.GetAllSSHKeysResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+
+	require.Len(t, responseData.Data.SSHKeys, 2)
+	assert.Equal(t, firstID, responseData.Data.SSHKeys[0].ID)
+	assert.Equal(t, "first private key", responseData.Data.SSHKeys[0].PrivateKey)
+	assert.Equal(t, sshPublicKey, responseData.Data.SSHKeys[0].PublicKey)
+	assert.Equal(t, "octocat@github", responseData.Data.SSHKeys[0].Comment)
+	assert.Equal(t, sshFingerprint, responseData.Data.SSHKeys[0].Fingerprint)
+	assert.Equal(t, "my meta data", responseData.Data.SSHKeys[0].Meta)
+
+	assert.Equal(t, secondID, responseData.Data.SSHKeys[1].ID)
+	assert.Equal(t, "tanuki@gitlab", responseData.Data.SSHKeys[1].Comment)
+}
+
+func TestGetAllSSHKeysInternalServerError(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	notEncrypted := []byte("not encrypted")
+	key := domain.SSHKey{
+		ID:          uuid.New(),
+		UserID:      userID,
+		PrivateKey:  notEncrypted,
+		PublicKey:   notEncrypted,
+		Comment:     notEncrypted,
+		Fingerprint: notEncrypted,
+		Meta:        notEncrypted,
+	}
+	err = sshKeyRepository.Create(&key)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", getAllSSHKeysURL, http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
+
+	responseData := presentation.GetAllSSHKeysResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	assert.False(t, responseData.Status)
+	assert.Equal(t, "cipher: message authentication failed", responseData.Message)
+}
@@ -96,10 +96,12 @@ func TestGetAllSuccess(t *testing.T) { // nolint: funlen
 	assert.Equal(t, responseData.Data.Credentials[1].Meta, credMeta)
 
 	assert.Equal(t, responseData.Data.Binaries[0].ID, firstBinaryID)
-	assert.Equal(t, responseData.Data.Binaries[0].Content, binaryContent)
+	assert.Equal(t, responseData.Data.Binaries[0].Name, binaryName)
+	assert.Empty(t, responseData.Data.Binaries[0].Content)
 
 	assert.Equal(t, responseData.Data.Binaries[1].ID, secondBinaryID)
-	assert.Equal(t, responseData.Data.Binaries[1].Content, binaryContent)
+	assert.Equal(t, responseData.Data.Binaries[1].Name, binaryName)
+	assert.Empty(t, responseData.Data.Binaries[1].Content)
 
 	assert.Equal(t, responseData.Data.BankCards[0].ID, firstCardID)
 	assert.Equal(t, responseData.Data.BankCards[0].Number, number)
@@ -149,3 +151,54 @@ func TestGetAllInternalServerError(t *testing.T) { // nolint: dupl
 	assert.Equal(t, responseData.Status, false)
 	assert.Equal(t, responseData.Message, "cipher: message authentication failed")
 }
+
+func TestGetAllPagination(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	textID, err := createText(userID, "my beautiful text")
+	require.NoError(t, err)
+	binaryID, err := createBinary(userID, []byte("my beautiful binary"))
+	require.NoError(t, err)
+	credID, err := createCredentials(userID, "name", "login", "password", "meta")
+	require.NoError(t, err)
+
+	cursor := ""
+	pages := []presentation.GetAllResponse{}
+	for {
+		bodyReader := bytes.NewReader(nil)
+		req := httptest.NewRequest("GET", getAllURL+"?limit=2&cursor="+cursor, bodyReader)
+		req.Header.Add("Authorization", string(token))
+		responseRecorder := httptest.NewRecorder()
+		router.ServeHTTP(responseRecorder, req)
+		require.Equal(t, http.StatusOK, responseRecorder.Code)
+
+		responseData := presentation.GetAllResponse{}
+		err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+		require.NoError(t, err)
+		pages = append(pages, responseData)
+
+		cursor = responseData.Data.NextCursor
+		if cursor == "" {
+			break
+		}
+	}
+
+	require.Len(t, pages, 2)
+	require.Len(t, pages[0].Data.Texts, 1)
+	assert.Equal(t, textID, pages[0].Data.Texts[0].ID)
+	require.Len(t, pages[0].Data.Binaries, 1)
+	assert.Equal(t, binaryID, pages[0].Data.Binaries[0].ID)
+	assert.Empty(t, pages[0].Data.Credentials)
+	require.Len(t, pages[1].Data.Credentials, 1)
+	assert.Equal(t, credID, pages[1].Data.Credentials[0].ID)
+	assert.Empty(t, pages[1].Data.Texts)
+	assert.Empty(t, pages[1].Data.BankCards)
+}
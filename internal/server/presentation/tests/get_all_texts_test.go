@@ -19,7 +19,7 @@ const getAllTextsURL = "/api/v1/text/all"
 
 func createText(userID uuid.UUID, message string) (string, error) {
 	textID := uuid.New()
-	encryptedContent, err := cryptoService.Encrypt([]byte(message))
+	encryptedContent, err := encrypt(userID, []byte(message))
 	if err != nil {
 		return "", err
 	}
@@ -105,3 +105,71 @@ func TestGetAllTextsInternalServerError(t *testing.T) { // nolint: dupl
 	assert.Equal(t, responseData.Status, false)
 	assert.Equal(t, responseData.Message, "cipher: message authentication failed")
 }
+
+func TestGetAllTextsPagination(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	ids := []string{}
+	for _, message := range []string{"first", "second", "third"} {
+		textID, err := createText(userID, message)
+		require.NoError(t, err)
+		ids = append(ids, textID)
+	}
+
+	bodyReader := bytes.NewReader(nil)
+	req := httptest.NewRequest("GET", getAllTextsURL+"?limit=2", bodyReader)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	responseData := presentation.GetAllTextsResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	require.Len(t, responseData.Data.Texts, 2)
+	assert.Equal(t, ids[0], responseData.Data.Texts[0].ID)
+	assert.Equal(t, ids[1], responseData.Data.Texts[1].ID)
+	require.NotEmpty(t, responseData.Data.NextCursor)
+
+	req = httptest.NewRequest("GET", getAllTextsURL+"?limit=2&cursor="+responseData.Data.NextCursor, bodyReader)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder = httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	responseData = presentation.GetAllTextsResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	require.Len(t, responseData.Data.Texts, 1)
+	assert.Equal(t, ids[2], responseData.Data.Texts[0].ID)
+	assert.Empty(t, responseData.Data.NextCursor)
+}
+
+func TestGetAllTextsBadRequest(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	for _, query := range []string{"?limit=0", "?limit=1001", "?limit=abc", "?cursor=not-a-cursor"} {
+		bodyReader := bytes.NewReader(nil)
+		req := httptest.NewRequest("GET", getAllTextsURL+query, bodyReader)
+		req.Header.Add("Authorization", string(token))
+		responseRecorder := httptest.NewRecorder()
+		router.ServeHTTP(responseRecorder, req)
+		assert.Equal(t, http.StatusBadRequest, responseRecorder.Code, query)
+	}
+}
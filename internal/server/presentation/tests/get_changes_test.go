@@ -0,0 +1,182 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+const getChangesURL = "/api/v1/changes"
+
+func getChanges(t *testing.T, router http.Handler, token []byte, since int64) presentation.GetChangesResponse {
+	return getChangesPage(t, router, token, "?since="+strconv.FormatInt(since, 10))
+}
+
+func getChangesPage(t *testing.T, router http.Handler, token []byte, query string) presentation.GetChangesResponse {
+	req := httptest.NewRequest("GET", getChangesURL+query, http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "application/json", responseRecorder.Header().Get("Content-Type"))
+
+	responseData := presentation.GetChangesResponse{}
+	err := json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+
+	return responseData
+}
+
+func TestGetChangesSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	firstTextID, err := createText(userID, "first text")
+	require.NoError(t, err)
+	secondTextID, err := createText(userID, "second text")
+	require.NoError(t, err)
+
+	responseData := getChanges(t, router, token, 0)
+	assert.Equal(t, responseData.Status, true)
+	assert.Len(t, responseData.Data.Texts, 2)
+	assert.Equal(t, responseData.Data.Texts[0].ID, firstTextID)
+	assert.Equal(t, responseData.Data.Texts[1].ID, secondTextID)
+	assert.Empty(t, responseData.Data.Deleted)
+	revision := responseData.Data.Revision
+	assert.Positive(t, revision)
+
+	encryptedContent, err := encrypt(userID, []byte("updated text"))
+	require.NoError(t, err)
+	err = textRepository.Update(domain.Text{
+		ID:      uuid.MustParse(firstTextID),
+		UserID:  userID,
+		Content: encryptedContent,
+	})
+	require.NoError(t, err)
+	err = textRepository.Delete(userID, uuid.MustParse(secondTextID))
+	require.NoError(t, err)
+
+	responseData = getChanges(t, router, token, revision)
+	assert.Len(t, responseData.Data.Texts, 1)
+	assert.Equal(t, responseData.Data.Texts[0].ID, firstTextID)
+	assert.Equal(t, responseData.Data.Texts[0].Content, "updated text")
+	assert.Len(t, responseData.Data.Deleted, 1)
+	assert.Equal(t, responseData.Data.Deleted[0].ID, secondTextID)
+	assert.Equal(t, responseData.Data.Deleted[0].Kind, domain.TextKind)
+	assert.Greater(t, responseData.Data.Revision, revision)
+
+	responseData = getChanges(t, router, token, responseData.Data.Revision)
+	assert.Empty(t, responseData.Data.Texts)
+	assert.Empty(t, responseData.Data.Deleted)
+}
+
+// Проверяем, что изменения не читаются, пока у пользователя есть незафиксированная транзакция с выданной ревизией,
+// иначе ревизия результата могла бы оказаться больше ревизии, которая будет зафиксирована позже
+func TestGetChangesWaitsForUncommittedRevision(t *testing.T) {
+	_, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	_, err = createText(userID, "first text")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx) //nolint: errcheck
+	encryptedContent, err := encrypt(userID, []byte("second text"))
+	require.NoError(t, err)
+	_, err = tx.Exec(ctx, `INSERT INTO text_data (id, user_id, content) VALUES ($1, $2, $3)`,
+		uuid.New(), userID, encryptedContent)
+	require.NoError(t, err)
+
+	result := make(chan domain.Changes)
+	go func() {
+		changes, err := changesRepository.GetSince(userID, 0, 100)
+		assert.NoError(t, err)
+		result <- changes
+	}()
+	select {
+	case <-result:
+		t.Fatal("changes were read before the revision was committed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	err = tx.Commit(ctx)
+	require.NoError(t, err)
+	changes := <-result
+	require.Len(t, changes.Texts, 2)
+	assert.Equal(t, changes.Texts[1].Revision, changes.Revision)
+}
+
+func TestGetChangesPages(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	firstTextID, err := createText(userID, "first text")
+	require.NoError(t, err)
+	secondTextID, err := createText(userID, "second text")
+	require.NoError(t, err)
+	err = textRepository.Delete(userID, uuid.MustParse(firstTextID))
+	require.NoError(t, err)
+
+	responseData := getChangesPage(t, router, token, "?since=0&limit=1")
+	assert.Len(t, responseData.Data.Texts, 1)
+	assert.Equal(t, secondTextID, responseData.Data.Texts[0].ID)
+	assert.Empty(t, responseData.Data.Deleted)
+	assert.True(t, responseData.Data.More)
+
+	responseData = getChangesPage(t, router, token, "?limit=1&since="+strconv.FormatInt(responseData.Data.Revision, 10))
+	assert.Empty(t, responseData.Data.Texts)
+	require.Len(t, responseData.Data.Deleted, 1)
+	assert.Equal(t, firstTextID, responseData.Data.Deleted[0].ID)
+	assert.False(t, responseData.Data.More)
+}
+
+func TestGetChangesBadRequest(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	for _, query := range []string{"?since=abc", "?since=-1", "?limit=0", "?limit=1001"} {
+		req := httptest.NewRequest("GET", getChangesURL+query, http.NoBody)
+		req.Header.Add("Authorization", string(token))
+		responseRecorder := httptest.NewRecorder()
+		router.ServeHTTP(responseRecorder, req)
+		assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	}
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+func TestGetItemSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	fields := []item.Field{
+		{Name: "pin", Type: item.TypeSecret, Value: "1234"},
+		{Name: "expires", Type: item.TypeDate, Value: "2030-12-31"},
+	}
+	itemID, err := createItem(userID, "Door", fields, "my meta data")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", itemURL+itemID, http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"1"`, responseRecorder.Header().Get("ETag"))
+
+	responseData := presentation.GetItemResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+
+	assert.True(t, responseData.Status)
+	assert.Equal(t, itemID, responseData.Data.ID)
+	assert.Equal(t, "Door", responseData.Data.Name)
+	assert.Equal(t, item.Custom, responseData.Data.Template)
+	assert.Equal(t, fields, responseData.Data.Fields)
+	assert.Equal(t, "my meta data", responseData.Data.Meta)
+}
+
+func TestGetItemNotFound(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", itemURL+uuid.NewString(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
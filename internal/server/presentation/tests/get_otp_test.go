@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+func TestGetOTPSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	otpID, err := createOTP(userID, "GitHub", "octocat", "JBSWY3DPEHPK3PXP", "my meta data")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", otpURL+otpID, http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"1"`, responseRecorder.Header().Get("ETag"))
+
+	responseData := presentation.GetOTPResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+
+	assert.True(t, responseData.Status)
+	assert.Equal(t, otpID, responseData.Data.ID)
+	assert.Equal(t, "GitHub", responseData.Data.Issuer)
+	assert.Equal(t, "octocat", responseData.Data.Account)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", responseData.Data.Secret)
+	assert.Equal(t, "my meta data", responseData.Data.Meta)
+}
+
+func TestGetOTPNotFound(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", otpURL+uuid.NewString(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
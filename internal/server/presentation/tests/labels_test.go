@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+const labelsURL = "/api/v1/labels/"
+
+func TestSaveLabelsSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	folderID, err := createFolder(userID, "Work", uuid.Nil)
+	require.NoError(t, err)
+	tagID, err := createTag(userID, "prod")
+	require.NoError(t, err)
+	textID, err := createText(userID, "ssh root@prod")
+	require.NoError(t, err)
+
+	body := []byte(`{"kind": "text", "folder_id": "` + folderID.String() + `", "tag_ids": ["` + tagID.String() + `"]}`)
+	req := httptest.NewRequest("POST", labelsURL+textID, bytes.NewReader(body))
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	req = httptest.NewRequest("GET", labelsURL+textID, http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder = httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	responseData := presentation.GetLabelsResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	assert.Equal(t, textID, responseData.Data.EntityID)
+	assert.Equal(t, domain.TextKind, responseData.Data.Kind)
+	assert.Equal(t, folderID.String(), responseData.Data.FolderID)
+	assert.Equal(t, []string{tagID.String()}, responseData.Data.TagIDs)
+
+	changes := getChanges(t, router, token, 0)
+	require.Len(t, changes.Data.Folders, 1)
+	assert.Equal(t, "Work", changes.Data.Folders[0].Name)
+	require.Len(t, changes.Data.Tags, 1)
+	assert.Equal(t, "prod", changes.Data.Tags[0].Name)
+	require.Len(t, changes.Data.Labels, 1)
+	assert.Equal(t, responseData.Data, changes.Data.Labels[0])
+}
+
+func TestSaveLabelsNotFound(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	textID, err := createText(userID, "ssh root@prod")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		entityID string
+		body     string
+	}{
+		{
+			name:     "missing entity",
+			entityID: uuid.NewString(),
+			body:     `{"kind": "text"}`,
+		},
+		{
+			name:     "wrong kind",
+			entityID: textID,
+			body:     `{"kind": "item"}`,
+		},
+		{
+			name:     "missing folder",
+			entityID: textID,
+			body:     `{"kind": "text", "folder_id": "` + uuid.NewString() + `"}`,
+		},
+		{
+			name:     "missing tag",
+			entityID: textID,
+			body:     `{"kind": "text", "tag_ids": ["` + uuid.NewString() + `"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", labelsURL+tt.entityID, bytes.NewReader([]byte(tt.body)))
+			req.Header.Add("Authorization", string(token))
+			req.Header.Add("Content-Type", "application/json")
+			responseRecorder := httptest.NewRecorder()
+			router.ServeHTTP(responseRecorder, req)
+			assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+		})
+	}
+}
+
+func TestDeleteTextDeletesLabels(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	folderID, err := createFolder(userID, "Work", uuid.Nil)
+	require.NoError(t, err)
+	textID, err := createText(userID, "ssh root@prod")
+	require.NoError(t, err)
+	err = labelRepository.Save(&domain.Labels{
+		EntityID: uuid.MustParse(textID),
+		UserID:   userID,
+		Kind:     domain.TextKind,
+		FolderID: folderID,
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", textURL+textID, http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	_, err = labelRepository.Get(userID, uuid.MustParse(textID))
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
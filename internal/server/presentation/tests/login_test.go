@@ -99,7 +99,7 @@ func TestLoginSuccess(t *testing.T) {
 		Login:    login,
 		Password: hashedPassword,
 	}
-	err = userRepository.Create(user)
+	err = userRepository.Create(user, nil)
 	require.NoError(t, err)
 
 	bodyReader := bytes.NewReader([]byte(`{"login": "` + login + `", "password": "` + password + `"}`))
@@ -109,6 +109,7 @@ func TestLoginSuccess(t *testing.T) {
 	router.ServeHTTP(responseRecorder, req)
 	assert.Equal(t, http.StatusOK, responseRecorder.Code)
 	require.NotEmpty(t, responseRecorder.Header().Get("Authorization"))
+	require.NotEmpty(t, responseRecorder.Header().Get("X-Refresh-Token"))
 }
 
 func TestLoginWrongPasswordUnauthorized(t *testing.T) {
@@ -122,7 +123,7 @@ func TestLoginWrongPasswordUnauthorized(t *testing.T) {
 		Login:    login,
 		Password: "password",
 	}
-	err = userRepository.Create(user)
+	err = userRepository.Create(user, nil)
 	require.NoError(t, err)
 
 	bodyReader := bytes.NewReader([]byte(`{"login": "` + login + `", "password": "qwerty"}`))
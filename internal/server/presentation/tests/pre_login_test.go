@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+func TestPreLoginSuccess(t *testing.T) {
+	tests := []struct {
+		name     string
+		register bool
+		e2e      bool
+	}{
+		{
+			name:     "e2e user",
+			register: true,
+			e2e:      true,
+		},
+		{
+			name:     "regular user",
+			register: true,
+			e2e:      false,
+		},
+		{
+			name:     "unknown user",
+			register: false,
+			e2e:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, err := setup()
+			require.NoError(t, err)
+			defer teardown()
+
+			login := uuid.NewString()
+			if tt.register {
+				body, err := json.Marshal(map[string]any{"login": login, "password": "password", "e2e": tt.e2e})
+				require.NoError(t, err)
+				req := httptest.NewRequest("POST", "/api/v1/auth/register", bytes.NewReader(body))
+				req.Header.Add("Content-Type", "application/json")
+				responseRecorder := httptest.NewRecorder()
+				router.ServeHTTP(responseRecorder, req)
+				require.Equal(t, http.StatusOK, responseRecorder.Code)
+			}
+
+			bodyReader := bytes.NewReader([]byte(`{"login": "` + login + `"}`))
+			req := httptest.NewRequest("POST", "/api/v1/auth/prelogin", bodyReader)
+			req.Header.Add("Content-Type", "application/json")
+			responseRecorder := httptest.NewRecorder()
+			router.ServeHTTP(responseRecorder, req)
+			assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+			responseData := presentation.PreLoginResponse{}
+			err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+			require.NoError(t, err)
+			assert.Equal(t, tt.e2e, responseData.Data.E2E)
+			expected := joseService.Salt(login)
+			if tt.register {
+				user, err := userRepository.GetByLogin(login)
+				require.NoError(t, err)
+				assert.NotEqual(t, expected, user.Salt)
+				expected = user.Salt
+			}
+			assert.Equal(t, base64.StdEncoding.EncodeToString(expected), responseData.Data.Salt)
+		})
+	}
+}
+
+func TestPreLoginBadRequest(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	bodyReader := bytes.NewReader([]byte(`{"login": ""}`))
+	req := httptest.NewRequest("POST", "/api/v1/auth/prelogin", bodyReader)
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
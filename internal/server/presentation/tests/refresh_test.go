@@ -0,0 +1,155 @@
+// nolint: goconst
+package tests
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+)
+
+func refresh(router http.Handler, refreshToken string) *httptest.ResponseRecorder {
+	bodyReader := bytes.NewReader([]byte(`{"refresh_token": "` + refreshToken + `"}`))
+	req := httptest.NewRequest("POST", "/api/v1/auth/refresh", bodyReader)
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+
+	return responseRecorder
+}
+
+func createRefreshToken(userID uuid.UUID, expiresAt time.Time) (string, error) {
+	refreshToken, err := joseService.IssueRefreshToken()
+	if err != nil {
+		return "", err
+	}
+	err = refreshTokenRepository.Create(domain.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		FamilyID:  uuid.New(),
+		Hash:      joseService.HashRefreshToken(refreshToken),
+		ExpiresAt: expiresAt,
+	})
+
+	return refreshToken, err
+}
+
+func TestRefreshBadRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        []byte
+		contentType string
+	}{
+		{
+			name:        "no refresh token",
+			body:        []byte(`{"field": "value"}`),
+			contentType: "application/json",
+		},
+		{
+			name:        "empty string",
+			body:        []byte(`{"refresh_token": ""}`),
+			contentType: "application/json",
+		},
+		{
+			name:        "not a json",
+			body:        []byte(`not a json`),
+			contentType: "application/json",
+		},
+		{
+			name:        "wrong content type",
+			body:        []byte{},
+			contentType: "plain/text",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, err := setup()
+			require.NoError(t, err)
+			defer teardown()
+
+			bodyReader := bytes.NewReader(tt.body)
+			req := httptest.NewRequest("POST", "/api/v1/auth/refresh", bodyReader)
+			req.Header.Add("Content-Type", tt.contentType)
+			responseRecorder := httptest.NewRecorder()
+			router.ServeHTTP(responseRecorder, req)
+			assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+		})
+	}
+}
+
+func TestRefreshSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	refreshToken, err := createRefreshToken(userID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	responseRecorder := refresh(router, refreshToken)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	token := responseRecorder.Header().Get("Authorization")
+	newRefreshToken := responseRecorder.Header().Get("X-Refresh-Token")
+	require.NotEmpty(t, newRefreshToken)
+	assert.NotEqual(t, refreshToken, newRefreshToken)
+
+	tokenUserID, err := joseService.ParseUserID([]byte(token))
+	require.NoError(t, err)
+	assert.Equal(t, userID, tokenUserID)
+}
+
+func TestRefreshUnknownTokenUnauthorized(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	responseRecorder := refresh(router, uuid.NewString())
+	assert.Equal(t, http.StatusUnauthorized, responseRecorder.Code)
+}
+
+func TestRefreshExpiredTokenUnauthorized(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	refreshToken, err := createRefreshToken(userID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	responseRecorder := refresh(router, refreshToken)
+	assert.Equal(t, http.StatusUnauthorized, responseRecorder.Code)
+}
+
+func TestRefreshReuseRevokesFamily(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	refreshToken, err := createRefreshToken(userID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	responseRecorder := refresh(router, refreshToken)
+	require.Equal(t, http.StatusOK, responseRecorder.Code)
+	rotatedToken := responseRecorder.Header().Get("X-Refresh-Token")
+
+	responseRecorder = refresh(router, refreshToken)
+	assert.Equal(t, http.StatusUnauthorized, responseRecorder.Code)
+
+	responseRecorder = refresh(router, rotatedToken)
+	assert.Equal(t, http.StatusUnauthorized, responseRecorder.Code)
+}
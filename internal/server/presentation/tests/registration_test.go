@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
 	"github.com/Nickolasll/goph-keeper/internal/server/domain"
 )
 
@@ -76,13 +77,62 @@ func TestRegistrationSuccess(t *testing.T) {
 	require.NoError(t, err)
 	defer teardown()
 
-	bodyReader := bytes.NewReader([]byte(`{"login": "` + uuid.NewString() + `", "password": "password"}`))
+	login := uuid.NewString()
+	bodyReader := bytes.NewReader([]byte(`{"login": "` + login + `", "password": "password"}`))
 	req := httptest.NewRequest("POST", "/api/v1/auth/register", bodyReader)
 	req.Header.Add("Content-Type", "application/json")
 	responseRecorder := httptest.NewRecorder()
 	router.ServeHTTP(responseRecorder, req)
 	assert.Equal(t, http.StatusOK, responseRecorder.Code)
 	require.NotEmpty(t, responseRecorder.Header().Get("Authorization"))
+	require.NotEmpty(t, responseRecorder.Header().Get("X-Refresh-Token"))
+
+	user, err := userRepository.GetByLogin(login)
+	require.NoError(t, err)
+	userKey, err := userKeyRepository.Get(user.ID)
+	require.NoError(t, err)
+	dataKey, err := cryptoService.Decrypt(userKey.Key)
+	require.NoError(t, err)
+	assert.Len(t, dataKey, crypto.KeyLength)
+}
+
+// Проверяем, что пользователь со сквозным шифрованием тоже получает персональный ключ для секретов сервера,
+// а соль, из которой клиент вывел ключи, сохраняется у пользователя
+func TestRegistrationE2EUserKey(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	login := uuid.NewString()
+	bodyReader := bytes.NewReader([]byte(
+		`{"login": "` + login + `", "password": "password", "e2e": true, "salt": "MDEyMzQ1Njc4OWFiY2RlZg=="}`,
+	))
+	req := httptest.NewRequest("POST", "/api/v1/auth/register", bodyReader)
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	user, err := userRepository.GetByLogin(login)
+	require.NoError(t, err)
+	assert.True(t, user.E2E)
+	assert.Equal(t, []byte("0123456789abcdef"), user.Salt)
+	_, err = userKeyRepository.Get(user.ID)
+	require.NoError(t, err)
+}
+
+func TestRegistrationE2EWithoutSalt(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	login := uuid.NewString()
+	bodyReader := bytes.NewReader([]byte(`{"login": "` + login + `", "password": "password", "e2e": true}`))
+	req := httptest.NewRequest("POST", "/api/v1/auth/register", bodyReader)
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
 }
 
 func TestRegistrationConflict(t *testing.T) {
@@ -92,7 +142,7 @@ func TestRegistrationConflict(t *testing.T) {
 
 	login := uuid.NewString()
 	user := domain.User{ID: uuid.New(), Login: login, Password: ""}
-	err = userRepository.Create(user)
+	err = userRepository.Create(user, nil)
 	require.NoError(t, err)
 
 	bodyReader := bytes.NewReader([]byte(`{"login": "` + login + `", "password": "password"}`))
@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+const tagURL = "/api/v1/tag/"
+
+func createTag(userID uuid.UUID, name string) (uuid.UUID, error) {
+	tagID := uuid.New()
+	encryptedName, err := encrypt(userID, []byte(name))
+	if err != nil {
+		return tagID, err
+	}
+	tag := domain.Tag{
+		ID:     tagID,
+		UserID: userID,
+		Name:   encryptedName,
+	}
+	err = tagRepository.Create(&tag)
+
+	return tagID, err
+}
+
+func TestCreateTagSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", tagURL+"create", bytes.NewReader([]byte(`{"name": "prod"}`)))
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+	tagID := responseRecorder.Header().Get("Location")
+
+	req = httptest.NewRequest("POST", tagURL+tagID, bytes.NewReader([]byte(`{"name": "production"}`)))
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder = httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	req = httptest.NewRequest("GET", tagURL+"all", http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder = httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	responseData := presentation.GetAllTagsResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	require.Len(t, responseData.Data.Tags, 1)
+	assert.Equal(t, tagID, responseData.Data.Tags[0].ID)
+	assert.Equal(t, "production", responseData.Data.Tags[0].Name)
+}
+
+func TestCreateTagBadRequest(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", tagURL+"create", bytes.NewReader([]byte(`{"name": ""}`)))
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
+
+func TestDeleteTagUntagsData(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	prodID, err := createTag(userID, "prod")
+	require.NoError(t, err)
+	sharedID, err := createTag(userID, "shared")
+	require.NoError(t, err)
+	textID, err := createText(userID, "ssh root@prod")
+	require.NoError(t, err)
+	err = labelRepository.Save(&domain.Labels{
+		EntityID: uuid.MustParse(textID),
+		UserID:   userID,
+		Kind:     domain.TextKind,
+		TagIDs:   []uuid.UUID{prodID, sharedID},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", tagURL+prodID.String(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	labels, err := labelRepository.Get(userID, uuid.MustParse(textID))
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{sharedID}, labels.TagIDs)
+
+	changes, err := changesRepository.GetSince(userID, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, changes.Tombstones, 1)
+	assert.Equal(t, domain.TagKind, changes.Tombstones[0].Kind)
+}
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+const templateURL = "/api/v1/template/"
+
+func TestCreateTemplateSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	body := `{"name": "server", "description": "ssh server", "fields": [` +
+		`{"name": "host", "type": "url", "required": true}, {"name": "password", "type": "secret"}]}`
+	req := httptest.NewRequest("POST", templateURL+"create", bytes.NewReader([]byte(body)))
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("Content-Type", "application/json")
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+	templateID := responseRecorder.Header().Get("Location")
+
+	req = httptest.NewRequest("GET", templateURL+"all", http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder = httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	responseData := presentation.GetAllTemplatesResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	require.Len(t, responseData.Data.Templates, 1)
+	tmpl := responseData.Data.Templates[0]
+	assert.Equal(t, templateID, tmpl.ID)
+	assert.Equal(t, "server", tmpl.Name)
+	assert.Equal(t, "ssh server", tmpl.Description)
+	assert.Equal(t, []item.FieldSpec{
+		{Name: "host", Type: item.TypeURL, Required: true},
+		{Name: "password", Type: item.TypeSecret},
+	}, tmpl.Fields)
+
+	changes := getChanges(t, router, token, 0)
+	require.Len(t, changes.Data.Templates, 1)
+	assert.Equal(t, tmpl, changes.Data.Templates[0])
+
+	stored, err := templateRepository.Get(userID, uuid.MustParse(templateID))
+	require.NoError(t, err)
+	assert.NotEqual(t, "server", string(stored.Name))
+}
+
+func TestCreateTemplateBadRequest(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	for _, body := range []string{
+		`{"name": ""}`,
+		`{"name": "server", "fields": [{"name": "host", "type": "ip"}]}`,
+		`{"name": "server", "fields": [{"name": "host", "type": "url"}, {"name": "host", "type": "string"}]}`,
+	} {
+		req := httptest.NewRequest("POST", templateURL+"create", bytes.NewReader([]byte(body)))
+		req.Header.Add("Authorization", string(token))
+		req.Header.Add("Content-Type", "application/json")
+		responseRecorder := httptest.NewRecorder()
+		router.ServeHTTP(responseRecorder, req)
+		assert.Equal(t, http.StatusBadRequest, responseRecorder.Code, body)
+	}
+}
+
+func TestDeleteTemplate(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	encrypted := [][]byte{}
+	for _, v := range []string{"server", "", "[]"} {
+		value, err := encrypt(userID, []byte(v))
+		require.NoError(t, err)
+		encrypted = append(encrypted, value)
+	}
+	templateID := uuid.New()
+	err = templateRepository.Create(&domain.ItemTemplate{
+		ID:          templateID,
+		UserID:      userID,
+		Name:        encrypted[0],
+		Description: encrypted[1],
+		Fields:      encrypted[2],
+	})
+	require.NoError(t, err)
+	revision := getChanges(t, router, token, 0).Data.Revision
+
+	req := httptest.NewRequest("DELETE", templateURL+templateID.String(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	_, err = templateRepository.Get(userID, templateID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+
+	changes := getChanges(t, router, token, revision)
+	require.Len(t, changes.Data.Deleted, 1)
+	assert.Equal(t, templateID.String(), changes.Data.Deleted[0].ID)
+	assert.Equal(t, domain.TemplateKind, changes.Data.Deleted[0].Kind)
+
+	req = httptest.NewRequest("DELETE", templateURL+templateID.String(), http.NoBody)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder = httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
@@ -0,0 +1,222 @@
+// nolint: goconst
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+	"github.com/Nickolasll/goph-keeper/internal/totp"
+)
+
+func postJSON(router http.Handler, url, token string, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", url, bytes.NewReader(body))
+	req.Header.Add("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Add("Authorization", token)
+	}
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+
+	return responseRecorder
+}
+
+// Проверяем полный цикл: подключение, подтверждение, вход с кодом и отключение
+func TestTwoFactorFlow(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	login := uuid.NewString()
+	password := "password"
+	userID := uuid.New()
+	key, err := newUserKey(userID)
+	require.NoError(t, err)
+	err = userRepository.Create(domain.User{
+		ID:       userID,
+		Login:    login,
+		Password: joseService.Hash(password),
+	}, key)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	response := postJSON(router, "/api/v1/auth/2fa/enroll", string(token), nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	var enroll presentation.TwoFactorEnrollResponse
+	err = json.Unmarshal(response.Body.Bytes(), &enroll)
+	require.NoError(t, err)
+	assert.Contains(t, enroll.Data.URI, "otpauth://totp/")
+	secret := enroll.Data.Secret
+
+	stored, err := twoFactorRepository.Get(userID)
+	require.NoError(t, err)
+	assert.False(t, stored.Enabled)
+	assert.NotContains(t, string(stored.Secret), secret)
+	// Секрет шифруется персональным ключом пользователя, а не мастер-ключом сервера
+	_, err = cryptoService.Decrypt(stored.Secret)
+	require.Error(t, err)
+
+	// До подтверждения вход выполняется только по паролю
+	credentials := []byte(`{"login": "` + login + `", "password": "` + password + `"}`)
+	response = postJSON(router, "/api/v1/auth/login", "", credentials)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	response = postJSON(router, "/api/v1/auth/2fa/verify", string(token), []byte(`{"code": "000000"}`))
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	code, err := totp.Code(secret, time.Now())
+	require.NoError(t, err)
+	response = postJSON(router, "/api/v1/auth/2fa/verify", string(token), []byte(`{"code": "`+code+`"}`))
+	require.Equal(t, http.StatusOK, response.Code)
+	var verify presentation.TwoFactorVerifyResponse
+	err = json.Unmarshal(response.Body.Bytes(), &verify)
+	require.NoError(t, err)
+	require.NotEmpty(t, verify.Data.RecoveryCodes)
+
+	response = postJSON(router, "/api/v1/auth/2fa/enroll", string(token), nil)
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	response = postJSON(router, "/api/v1/auth/login", "", credentials)
+	require.Equal(t, http.StatusAccepted, response.Code)
+	assert.Empty(t, response.Header().Get("Authorization"))
+	challenge := response.Header().Get("X-Two-Factor-Challenge")
+	require.NotEmpty(t, challenge)
+
+	// Токен частичной аутентификации не дает доступа к данным
+	req := httptest.NewRequest("GET", "/api/v1/text/all", nil)
+	req.Header.Add("Authorization", challenge)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusUnauthorized, responseRecorder.Code)
+
+	// Код уже использован при подтверждении
+	response = postJSON(router, "/api/v1/auth/2fa/login", "",
+		[]byte(`{"challenge": "`+challenge+`", "code": "`+code+`"}`))
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+
+	recoveryCode := verify.Data.RecoveryCodes[0]
+	response = postJSON(router, "/api/v1/auth/2fa/login", "",
+		[]byte(`{"challenge": "`+challenge+`", "code": "`+recoveryCode+`"}`))
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.NotEmpty(t, response.Header().Get("Authorization"))
+	assert.NotEmpty(t, response.Header().Get("X-Refresh-Token"))
+
+	// Код восстановления одноразовый
+	response = postJSON(router, "/api/v1/auth/2fa/login", "",
+		[]byte(`{"challenge": "`+challenge+`", "code": "`+recoveryCode+`"}`))
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+
+	nextCode, err := totp.Code(secret, time.Now().Add(totp.Period))
+	require.NoError(t, err)
+	response = postJSON(router, "/api/v1/auth/2fa/disable", string(token), []byte(`{"code": "`+nextCode+`"}`))
+	require.Equal(t, http.StatusOK, response.Code)
+
+	_, err = twoFactorRepository.Get(userID)
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	response = postJSON(router, "/api/v1/auth/login", "", credentials)
+	assert.Equal(t, http.StatusOK, response.Code)
+}
+
+// Проверяем, что по одному токену частичной аутентификации можно сделать ограниченное количество попыток
+func TestTwoFactorLoginAttemptsLimit(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	login := uuid.NewString()
+	password := "password"
+	userID := uuid.New()
+	key, err := newUserKey(userID)
+	require.NoError(t, err)
+	err = userRepository.Create(domain.User{
+		ID:       userID,
+		Login:    login,
+		Password: joseService.Hash(password),
+	}, key)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	response := postJSON(router, "/api/v1/auth/2fa/enroll", string(token), nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	var enroll presentation.TwoFactorEnrollResponse
+	err = json.Unmarshal(response.Body.Bytes(), &enroll)
+	require.NoError(t, err)
+	code, err := totp.Code(enroll.Data.Secret, time.Now())
+	require.NoError(t, err)
+	response = postJSON(router, "/api/v1/auth/2fa/verify", string(token), []byte(`{"code": "`+code+`"}`))
+	require.Equal(t, http.StatusOK, response.Code)
+	nextCode, err := totp.Code(enroll.Data.Secret, time.Now().Add(totp.Period))
+	require.NoError(t, err)
+
+	credentials := []byte(`{"login": "` + login + `", "password": "` + password + `"}`)
+	response = postJSON(router, "/api/v1/auth/login", "", credentials)
+	require.Equal(t, http.StatusAccepted, response.Code)
+	challenge := response.Header().Get("X-Two-Factor-Challenge")
+
+	for i := 0; i < 5; i++ {
+		response = postJSON(router, "/api/v1/auth/2fa/login", "",
+			[]byte(`{"challenge": "`+challenge+`", "code": "000000"}`))
+		assert.Equal(t, http.StatusUnauthorized, response.Code)
+	}
+	// Попытки по токену исчерпаны, верный код больше не принимается
+	response = postJSON(router, "/api/v1/auth/2fa/login", "",
+		[]byte(`{"challenge": "`+challenge+`", "code": "`+nextCode+`"}`))
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+
+	response = postJSON(router, "/api/v1/auth/login", "", credentials)
+	require.Equal(t, http.StatusAccepted, response.Code)
+	nextChallenge := response.Header().Get("X-Two-Factor-Challenge")
+
+	// Новый вход по паролю отменяет предыдущий токен
+	response = postJSON(router, "/api/v1/auth/2fa/login", "",
+		[]byte(`{"challenge": "`+challenge+`", "code": "`+nextCode+`"}`))
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+
+	response = postJSON(router, "/api/v1/auth/2fa/login", "",
+		[]byte(`{"challenge": "`+nextChallenge+`", "code": "`+nextCode+`"}`))
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.NotEmpty(t, response.Header().Get("Authorization"))
+}
+
+func TestTwoFactorLoginInvalidChallenge(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	token, err := joseService.IssueToken(uuid.New())
+	require.NoError(t, err)
+	response := postJSON(router, "/api/v1/auth/2fa/login", "",
+		[]byte(`{"challenge": "`+string(token)+`", "code": "123456"}`))
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+
+	response = postJSON(router, "/api/v1/auth/2fa/login", "", []byte(`{"challenge": "challenge"}`))
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestTwoFactorVerifyNotEnrolled(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	response := postJSON(router, "/api/v1/auth/2fa/verify", string(token), []byte(`{"code": "123456"}`))
+	assert.Equal(t, http.StatusNotFound, response.Code)
+	response = postJSON(router, "/api/v1/auth/2fa/disable", string(token), []byte(`{"code": "123456"}`))
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
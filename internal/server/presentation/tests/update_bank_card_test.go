@@ -112,27 +112,27 @@ func TestUpdateBankCardSuccess(t *testing.T) {
 	cardObj, err := cardRepository.Get(userID, cardID)
 	require.NoError(t, err)
 
-	decrNumber, err := cryptoService.Decrypt(cardObj.Number)
+	decrNumber, err := decrypt(userID, cardObj.Number)
 	require.NoError(t, err)
 
 	assert.Equal(t, number, string(decrNumber))
 
-	decrValidThru, err := cryptoService.Decrypt(cardObj.ValidThru)
+	decrValidThru, err := decrypt(userID, cardObj.ValidThru)
 	require.NoError(t, err)
 
 	assert.Equal(t, validThru, string(decrValidThru))
 
-	decrCVV, err := cryptoService.Decrypt(cardObj.CVV)
+	decrCVV, err := decrypt(userID, cardObj.CVV)
 	require.NoError(t, err)
 
 	assert.Equal(t, cvv, string(decrCVV))
 
-	decrCardHolder, err := cryptoService.Decrypt(cardObj.CardHolder)
+	decrCardHolder, err := decrypt(userID, cardObj.CardHolder)
 	require.NoError(t, err)
 
 	assert.Equal(t, cardHolder, string(decrCardHolder))
 
-	decrMeta, err := cryptoService.Decrypt(cardObj.Meta)
+	decrMeta, err := decrypt(userID, cardObj.Meta)
 	require.NoError(t, err)
 
 	assert.Equal(t, meta, string(decrMeta))
@@ -89,6 +89,7 @@ func TestUpdateBinarySuccess(t *testing.T) {
 	bodyReader := bytes.NewReader(message)
 	req := httptest.NewRequest("POST", binaryURL+binID.String(), bodyReader)
 	req.Header.Add("Content-Type", "multipart/form-data")
+	req.Header.Add("X-Binary-Metadata", encodeBinaryMetadata(binaryMetadata))
 	req.Header.Add("Authorization", string(token))
 	responseRecorder := httptest.NewRecorder()
 	router.ServeHTTP(responseRecorder, req)
@@ -97,10 +98,13 @@ func TestUpdateBinarySuccess(t *testing.T) {
 	binObj, err := binaryRepository.Get(userID, binID)
 	require.NoError(t, err)
 
-	decrypted, err := cryptoService.Decrypt(binObj.Content)
+	decrypted, err := decrypt(userID, binObj.Content)
 	require.NoError(t, err)
-
 	assert.Equal(t, message, decrypted)
+
+	name, err := decrypt(userID, binObj.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "message.txt", string(name))
 }
 
 func TestUpdateBinaryNotFound(t *testing.T) {
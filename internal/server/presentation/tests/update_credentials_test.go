@@ -2,6 +2,7 @@ package tests
 
 import (
 	"bytes"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
 )
 
 const credURL = "/api/v1/credentials/" //nolint: gosec
@@ -108,22 +110,22 @@ func TestUpdateCredentialsSuccess(t *testing.T) {
 	credObj, err := credentialsRepository.Get(userID, credID)
 	require.NoError(t, err)
 
-	decrName, err := cryptoService.Decrypt(credObj.Name)
+	decrName, err := decrypt(userID, credObj.Name)
 	require.NoError(t, err)
 
 	assert.Equal(t, name, string(decrName))
 
-	decrLogin, err := cryptoService.Decrypt(credObj.Login)
+	decrLogin, err := decrypt(userID, credObj.Login)
 	require.NoError(t, err)
 
 	assert.Equal(t, login, string(decrLogin))
 
-	decrPassword, err := cryptoService.Decrypt(credObj.Password)
+	decrPassword, err := decrypt(userID, credObj.Password)
 	require.NoError(t, err)
 
 	assert.Equal(t, password, string(decrPassword))
 
-	decrMeta, err := cryptoService.Decrypt(credObj.Meta)
+	decrMeta, err := decrypt(userID, credObj.Meta)
 	require.NoError(t, err)
 
 	assert.Equal(t, meta, string(decrMeta))
@@ -176,3 +178,54 @@ func TestUpdateCredentialsInvalidID(t *testing.T) {
 	router.ServeHTTP(responseRecorder, req)
 	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
 }
+
+func TestUpdateCredentialsVersionConflict(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	credID, err := createCredentials(userID, "name", "login", "password", "meta")
+	require.NoError(t, err)
+
+	update := func(name, ifMatch string) *httptest.ResponseRecorder {
+		bodyReader := bytes.NewReader([]byte(`{"name": "` + name + `", "login": "login", "password": "password"}`))
+		req := httptest.NewRequest("POST", credURL+credID, bodyReader)
+		req.Header.Add("Content-Type", "application/json")
+		req.Header.Add("Authorization", string(token))
+		req.Header.Add("If-Match", ifMatch)
+		responseRecorder := httptest.NewRecorder()
+		router.ServeHTTP(responseRecorder, req)
+
+		return responseRecorder
+	}
+
+	responseRecorder := update("first device", `"1"`)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"2"`, responseRecorder.Header().Get("ETag"))
+
+	responseRecorder = update("second device", `"1"`)
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+	assert.Equal(t, `"2"`, responseRecorder.Header().Get("ETag"))
+	assert.Equal(t, "application/json", responseRecorder.Header().Get("Content-Type"))
+
+	responseData := presentation.UpdateCredentialsConflictResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	assert.Equal(t, false, responseData.Status)
+	assert.Equal(t, credID, responseData.Data.ID)
+	assert.Equal(t, "first device", responseData.Data.Name)
+	assert.Equal(t, int64(2), responseData.Data.Version)
+
+	responseRecorder = update("second device", "not a version")
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+
+	responseRecorder = update("second device", `"2"`)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"3"`, responseRecorder.Header().Get("ETag"))
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/item"
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+const itemURL = "/api/v1/item/"
+
+const itemBody = `{"name": "Gate", "template": "custom", ` +
+	`"fields": [{"name": "pin", "type": "secret", "value": "4321"}], "meta": "meta to update"}`
+
+func TestUpdateItemSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	itemID, err := createItem(userID, "Door", []item.Field{{Name: "pin", Type: item.TypeSecret, Value: "1234"}}, "")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", itemURL+itemID, bytes.NewReader([]byte(itemBody)))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("If-Match", `"1"`)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"2"`, responseRecorder.Header().Get("ETag"))
+
+	id, err := uuid.Parse(itemID)
+	require.NoError(t, err)
+	obj, err := itemRepository.Get(userID, id)
+	require.NoError(t, err)
+	decrName, err := decrypt(userID, obj.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "Gate", string(decrName))
+	decrFields, err := decrypt(userID, obj.Fields)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"name": "pin", "type": "secret", "value": "4321"}]`, string(decrFields))
+	decrMeta, err := decrypt(userID, obj.Meta)
+	require.NoError(t, err)
+	assert.Equal(t, "meta to update", string(decrMeta))
+}
+
+func TestUpdateItemConflict(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	fields := []item.Field{{Name: "pin", Type: item.TypeSecret, Value: "1234"}}
+	itemID, err := createItem(userID, "Door", fields, "")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", itemURL+itemID, bytes.NewReader([]byte(itemBody)))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("If-Match", `"5"`)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+
+	responseData := presentation.UpdateItemConflictResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	assert.Equal(t, "Door", responseData.Data.Name)
+	assert.Equal(t, fields, responseData.Data.Fields)
+}
+
+func TestUpdateItemNotFound(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", itemURL+uuid.NewString(), bytes.NewReader([]byte(itemBody)))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
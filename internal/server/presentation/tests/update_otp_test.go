@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+const otpURL = "/api/v1/otp/"
+
+const otpBody = `{"issuer": "GitLab", "account": "tanuki", "secret": "HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ", ` +
+	`"algorithm": "SHA512", "digits": "7", "period": "45", "meta": "meta to update"}`
+
+func TestUpdateOTPSuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	otpID, err := createOTP(userID, "GitHub", "octocat", "JBSWY3DPEHPK3PXP", "")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", otpURL+otpID, bytes.NewReader([]byte(otpBody)))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("If-Match", `"1"`)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"2"`, responseRecorder.Header().Get("ETag"))
+
+	id, err := uuid.Parse(otpID)
+	require.NoError(t, err)
+	otp, err := otpRepository.Get(userID, id)
+	require.NoError(t, err)
+	decrSecret, err := decrypt(userID, otp.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, "HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ", string(decrSecret))
+	decrAlgorithm, err := decrypt(userID, otp.Algorithm)
+	require.NoError(t, err)
+	assert.Equal(t, "SHA512", string(decrAlgorithm))
+	decrDigits, err := decrypt(userID, otp.Digits)
+	require.NoError(t, err)
+	assert.Equal(t, "7", string(decrDigits))
+	decrPeriod, err := decrypt(userID, otp.Period)
+	require.NoError(t, err)
+	assert.Equal(t, "45", string(decrPeriod))
+}
+
+func TestUpdateOTPConflict(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	otpID, err := createOTP(userID, "GitHub", "octocat", "JBSWY3DPEHPK3PXP", "")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", otpURL+otpID, bytes.NewReader([]byte(otpBody)))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("If-Match", `"5"`)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+
+	responseData := presentation.UpdateOTPConflictResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	assert.Equal(t, "GitHub", responseData.Data.Issuer)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", responseData.Data.Secret)
+}
+
+func TestUpdateOTPNotFound(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", otpURL+uuid.NewString(), bytes.NewReader([]byte(otpBody)))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
+)
+
+const sshKeyURL = "/api/v1/ssh_key/"
+
+const sshKeyBody = `{"private_key": "private key to update", "public_key": "` + sshPublicKey + `", ` +
+	`"comment": "tanuki@gitlab", "fingerprint": "` + sshFingerprint + `", "meta": "meta to update"}`
+
+func TestUpdateSSHKeySuccess(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	keyID, err := createSSHKey(userID, "private key", "octocat@github", "")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", sshKeyURL+keyID, bytes.NewReader([]byte(sshKeyBody)))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("If-Match", `"1"`)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"2"`, responseRecorder.Header().Get("ETag"))
+
+	id, err := uuid.Parse(keyID)
+	require.NoError(t, err)
+	key, err := sshKeyRepository.Get(userID, id)
+	require.NoError(t, err)
+	decrPrivateKey, err := decrypt(userID, key.PrivateKey)
+	require.NoError(t, err)
+	assert.Equal(t, "private key to update", string(decrPrivateKey))
+	decrComment, err := decrypt(userID, key.Comment)
+	require.NoError(t, err)
+	assert.Equal(t, "tanuki@gitlab", string(decrComment))
+	decrMeta, err := decrypt(userID, key.Meta)
+	require.NoError(t, err)
+	assert.Equal(t, "meta to update", string(decrMeta))
+}
+
+func TestUpdateSSHKeyConflict(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	keyID, err := createSSHKey(userID, "private key", "octocat@github", "")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", sshKeyURL+keyID, bytes.NewReader([]byte(sshKeyBody)))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	req.Header.Add("If-Match", `"5"`)
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+
+	responseData := presentation.UpdateSSHKeyConflictResponse{}
+	err = json.Unmarshal(responseRecorder.Body.Bytes(), &responseData)
+	require.NoError(t, err)
+	assert.Equal(t, "private key", responseData.Data.PrivateKey)
+	assert.Equal(t, "octocat@github", responseData.Data.Comment)
+}
+
+func TestUpdateSSHKeyNotFound(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = createUser(userID)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", sshKeyURL+uuid.NewString(), bytes.NewReader([]byte(sshKeyBody)))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
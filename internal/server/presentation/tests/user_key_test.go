@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cryptoprovider "github.com/Nickolasll/goph-keeper/internal/server/application/crypto_provider"
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
+	keyrotation "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/key_rotation"
+	"github.com/Nickolasll/goph-keeper/internal/server/logger"
+)
+
+// Проверяем, что данные пользователя без персонального ключа не отдаются, а мастер-ключ не используется вместо него
+func TestUserWithoutKey(t *testing.T) {
+	router, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = userRepository.Create(domain.User{ID: userID, Login: uuid.NewString(), Password: "password"}, nil)
+	require.NoError(t, err)
+	token, err := joseService.IssueToken(userID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", getAllTextsURL, nil)
+	req.Header.Add("Authorization", string(token))
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+	assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
+}
+
+// Проверяем, что пользователь, зарегистрированный до появления персональных ключей, получает ключ,
+// а его данные перешифровываются из мастер-ключа. Пользователь со сквозным шифрованием тоже получает ключ,
+// но перешифровывается только секрет второго фактора, а секреты пользователей с ключом переходят на него
+func TestBackfillUserKeys(t *testing.T) {
+	_, err := setup()
+	require.NoError(t, err)
+	defer teardown()
+
+	userID := uuid.New()
+	err = userRepository.Create(domain.User{ID: userID, Login: uuid.NewString(), Password: "password"}, nil)
+	require.NoError(t, err)
+	message := "stored before user keys"
+	encrypted, err := cryptoService.Encrypt([]byte(message))
+	require.NoError(t, err)
+	textID := uuid.New()
+	err = textRepository.Create(domain.Text{ID: textID, UserID: userID, Content: encrypted})
+	require.NoError(t, err)
+
+	e2eID := uuid.New()
+	err = userRepository.Create(domain.User{ID: e2eID, Login: uuid.NewString(), Password: "password", E2E: true}, nil)
+	require.NoError(t, err)
+	e2eContent := []byte("encrypted on the client")
+	e2eTextID := uuid.New()
+	err = textRepository.Create(domain.Text{ID: e2eTextID, UserID: e2eID, Content: e2eContent})
+	require.NoError(t, err)
+
+	keyID := uuid.New()
+	err = createUser(keyID)
+	require.NoError(t, err)
+
+	secret := []byte("JBSWY3DPEHPK3PXP")
+	masterSecret, err := cryptoService.Encrypt(secret)
+	require.NoError(t, err)
+	for _, id := range []uuid.UUID{userID, e2eID, keyID} {
+		err = twoFactorRepository.Save(domain.TwoFactor{UserID: id, Secret: masterSecret})
+		require.NoError(t, err)
+	}
+
+	rotator := keyrotation.New(pool, cryptoService, 10, time.Minute, logger.New())
+	err = rotator.Backfill()
+	require.NoError(t, err)
+
+	_, err = userKeyRepository.Get(userID)
+	require.NoError(t, err)
+	_, err = userKeyRepository.Get(e2eID)
+	require.NoError(t, err)
+
+	e2eText, err := textRepository.Get(e2eID, e2eTextID)
+	require.NoError(t, err)
+	assert.Equal(t, e2eContent, e2eText.Content)
+
+	provider := cryptoprovider.New(cryptoService, userRepository, userKeyRepository)
+	for _, id := range []uuid.UUID{userID, e2eID, keyID} {
+		twoFactor, err := twoFactorRepository.Get(id)
+		require.NoError(t, err)
+		_, err = cryptoService.Decrypt(twoFactor.Secret)
+		require.Error(t, err)
+		userCrypto, err := provider.ForServer(id)
+		require.NoError(t, err)
+		decrypted, err := userCrypto.Decrypt(twoFactor.Secret)
+		require.NoError(t, err)
+		assert.Equal(t, secret, decrypted)
+	}
+
+	text, err := textRepository.Get(userID, textID)
+	require.NoError(t, err)
+	_, err = cryptoService.Decrypt(text.Content)
+	require.Error(t, err)
+	decrypted, err := decrypt(userID, text.Content)
+	require.NoError(t, err)
+	assert.Equal(t, message, string(decrypted))
+
+	// Повторный запуск не выдает ключи заново
+	err = rotator.Backfill()
+	require.NoError(t, err)
+	decrypted, err = decrypt(userID, text.Content)
+	require.NoError(t, err)
+	assert.Equal(t, message, string(decrypted))
+}
@@ -10,13 +10,27 @@ import (
 
 	"github.com/Nickolasll/goph-keeper/internal/crypto"
 	"github.com/Nickolasll/goph-keeper/internal/server/application"
+	cryptoprovider "github.com/Nickolasll/goph-keeper/internal/server/application/crypto_provider"
 	"github.com/Nickolasll/goph-keeper/internal/server/application/jose"
 	"github.com/Nickolasll/goph-keeper/internal/server/config"
 	"github.com/Nickolasll/goph-keeper/internal/server/domain"
 	bcardrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/bank_card_repository"
 	binrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/binary_repository"
+	binuprepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/binary_upload_repository"
+	changesrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/changes_repository"
 	crederepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/credentials_repository"
+	folderrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/folder_repository"
+	itemrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/item_repository"
+	labelrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/label_repository"
+	otprepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/otp_repository"
+	rtrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/refresh_token_repository"
+	sshrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/ssh_key_repository"
+	tagrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/tag_repository"
+	tmplrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/template_repository"
 	txtrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/text_repository"
+	tmbrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/tombstone_repository"
+	tfarepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/two_factor_repository"
+	ukeyrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/user_key_repository"
 	usrrepo "github.com/Nickolasll/goph-keeper/internal/server/infrastructure/user_repository"
 	"github.com/Nickolasll/goph-keeper/internal/server/logger"
 	"github.com/Nickolasll/goph-keeper/internal/server/presentation"
@@ -24,12 +38,25 @@ import (
 
 var joseService *jose.JOSEService
 var pool *pgxpool.Pool
-var cryptoService *crypto.CryptoService
+var cryptoService *crypto.KeyRing
 var userRepository *usrrepo.UserRepository
+var userKeyRepository *ukeyrepo.UserKeyRepository
+var refreshTokenRepository *rtrepo.RefreshTokenRepository
+var twoFactorRepository *tfarepo.TwoFactorRepository
 var textRepository *txtrepo.TextRepository
 var binaryRepository *binrepo.BinaryRepository
+var binaryUploadRepository *binuprepo.BinaryUploadRepository
 var credentialsRepository *crederepo.CredentialsRepository
 var cardRepository *bcardrepo.BankCardRepository
+var otpRepository *otprepo.OTPRepository
+var sshKeyRepository *sshrepo.SSHKeyRepository
+var itemRepository *itemrepo.ItemRepository
+var folderRepository *folderrepo.FolderRepository
+var tagRepository *tagrepo.TagRepository
+var templateRepository *tmplrepo.TemplateRepository
+var labelRepository *labelrepo.LabelRepository
+var tombstoneRepository *tmbrepo.TombstoneRepository
+var changesRepository *changesrepo.ChangesRepository
 
 func setup() (*chi.Mux, error) {
 	log := logger.New()
@@ -40,7 +67,7 @@ func setup() (*chi.Mux, error) {
 		return nil, err
 	}
 
-	joseService, err = jose.New(cfg.RawJWK, cfg.JWTExpiration, log)
+	joseService, err = jose.New(cfg.RawJWK, cfg.SaltSecret, cfg.JWTExpiration, cfg.RefreshTokenExpiration, log)
 	if err != nil {
 		return nil, err
 	}
@@ -50,26 +77,67 @@ func setup() (*chi.Mux, error) {
 		return nil, err
 	}
 
-	cryptoService, err = crypto.New(cfg.CryptoSecret)
+	cryptoService, err = crypto.NewKeyRing(cfg.CryptoSecret, cfg.PreviousCryptoSecrets()...)
 	if err != nil {
 		return nil, err
 	}
 
 	userRepository = usrrepo.New(pool, cfg.DBTimeOut, log)
+	userKeyRepository = ukeyrepo.New(pool, cfg.DBTimeOut, log)
+	refreshTokenRepository = rtrepo.New(pool, cfg.DBTimeOut, log)
+	twoFactorRepository = tfarepo.New(pool, cfg.DBTimeOut, log)
 	textRepository = txtrepo.New(pool, cfg.DBTimeOut, log)
 	binaryRepository = binrepo.New(pool, cfg.DBTimeOut, log)
+	binaryUploadRepository = binuprepo.New(pool, cfg.DBTimeOut, cfg.UploadExpiration, log)
 	credentialsRepository = crederepo.New(pool, cfg.DBTimeOut, log)
 	cardRepository = bcardrepo.New(pool, cfg.DBTimeOut, log)
+	otpRepository = otprepo.New(pool, cfg.DBTimeOut, log)
+	sshKeyRepository = sshrepo.New(pool, cfg.DBTimeOut, log)
+	itemRepository = itemrepo.New(pool, cfg.DBTimeOut, log)
+	folderRepository = folderrepo.New(pool, cfg.DBTimeOut, log)
+	tagRepository = tagrepo.New(pool, cfg.DBTimeOut, log)
+	templateRepository = tmplrepo.New(pool, cfg.DBTimeOut, log)
+	labelRepository = labelrepo.New(pool, cfg.DBTimeOut, log)
+	tombstoneRepository = tmbrepo.New(pool, cfg.DBTimeOut, log)
+	changesRepository = changesrepo.New(
+		pool,
+		cfg.DBTimeOut,
+		log,
+		textRepository,
+		binaryRepository,
+		credentialsRepository,
+		cardRepository,
+		otpRepository,
+		sshKeyRepository,
+		itemRepository,
+		folderRepository,
+		tagRepository,
+		templateRepository,
+		labelRepository,
+		tombstoneRepository,
+	)
 
 	app := application.New(
 		log,
 		joseService,
 		cryptoService,
 		userRepository,
+		userKeyRepository,
+		refreshTokenRepository,
+		twoFactorRepository,
 		textRepository,
 		binaryRepository,
+		binaryUploadRepository,
 		credentialsRepository,
 		cardRepository,
+		otpRepository,
+		sshKeyRepository,
+		itemRepository,
+		folderRepository,
+		tagRepository,
+		templateRepository,
+		labelRepository,
+		changesRepository,
 	)
 
 	router := presentation.New(app, joseService, log)
@@ -87,6 +155,40 @@ func createUser(id uuid.UUID) error {
 		Login:    uuid.NewString(),
 		Password: "password",
 	}
+	key, err := newUserKey(id)
+	if err != nil {
+		return err
+	}
+
+	return userRepository.Create(user, key)
+}
+
+// newUserKey - Генерирует ключ шифрования данных пользователя, зашифрованный мастер-ключом
+func newUserKey(userID uuid.UUID) (*domain.UserKey, error) {
+	key, err := cryptoprovider.New(cryptoService, userRepository, userKeyRepository).NewKey(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// encrypt - Зашифровывает значение ключом данных пользователя
+func encrypt(userID uuid.UUID, value []byte) ([]byte, error) {
+	userCrypto, err := cryptoprovider.New(cryptoService, userRepository, userKeyRepository).ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return userCrypto.Encrypt(value)
+}
+
+// decrypt - Расшифровывает значение ключом данных пользователя
+func decrypt(userID uuid.UUID, value []byte) ([]byte, error) {
+	userCrypto, err := cryptoprovider.New(cryptoService, userRepository, userKeyRepository).ForUser(userID)
+	if err != nil {
+		return nil, err
+	}
 
-	return userRepository.Create(user)
+	return userCrypto.Decrypt(value)
 }
@@ -1,21 +1,39 @@
 package presentation
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+
+	"github.com/Nickolasll/goph-keeper/internal/server/domain"
 )
 
 const contentTypeHeader = "Content-Type"
 const jsonType = "application/json"
 const textType = "plain/text"
 const binaryType = "multipart/form-data"
+const octetStreamType = "application/octet-stream"
+const refreshTokenHeader = "X-Refresh-Token"
+const challengeHeader = "X-Two-Factor-Challenge"
+const ifMatchHeader = "If-Match"
+const eTagHeader = "ETag"
+const binaryMetadataHeader = "X-Binary-Metadata"
 
 var errInvalidContentType = errors.New("invalid content type")
+var errInvalidRevision = errors.New("invalid revision")
+var errInvalidVersion = errors.New("invalid version")
+var errInvalidLimit = errors.New("invalid limit")
+var errFingerprintMismatch = errors.New("fingerprint does not match public key")
+
+const defaultPageLimit = 100
+const maxPageLimit = 1000
 
 type authenticatedHandler func(w http.ResponseWriter, r *http.Request, userID uuid.UUID)
 
@@ -26,6 +44,162 @@ func getRouteID(r *http.Request, name string) (uuid.UUID, error) {
 	return id, err
 }
 
+func getSinceQuery(r *http.Request) (int64, error) {
+	raw := r.URL.Query().Get("since")
+	if raw == "" {
+		return 0, nil
+	}
+	since, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if since < 0 {
+		return 0, errInvalidRevision
+	}
+
+	return since, nil
+}
+
+// getLimitQuery - Возвращает размер страницы из параметра limit,
+// по умолчанию defaultPageLimit, не больше maxPageLimit
+func getLimitQuery(r *http.Request) (int, error) {
+	raw := r.URL.Query().Get("limit")
+	if raw == "" {
+		return defaultPageLimit, nil
+	}
+	limit, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, err
+	}
+	if limit <= 0 || limit > maxPageLimit {
+		return 0, errInvalidLimit
+	}
+
+	return limit, nil
+}
+
+// getCursorQuery - Возвращает курсор страницы из параметра cursor.
+// Курсор непрозрачен для клиента: это JSON с видом данных, временем создания и идентификатором
+// последней записи предыдущей страницы, закодированный в base64url. Если параметр не передан, возвращает nil
+func getCursorQuery(r *http.Request) (*domain.AllCursor, error) {
+	var payload cursorPayload
+	raw := r.URL.Query().Get("cursor")
+	if raw == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+	payload, err = payload.Load(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AllCursor{
+		Kind:  payload.Kind,
+		After: &domain.Cursor{CreatedAt: payload.CreatedAt, ID: payload.ID},
+	}, nil
+}
+
+// getPageQuery - Возвращает параметры страницы для списка данных вида kind,
+// курсор другого вида данных считается невалидным
+func getPageQuery(r *http.Request, kind string) (domain.Page, error) {
+	limit, err := getLimitQuery(r)
+	if err != nil {
+		return domain.Page{}, err
+	}
+	cursor, err := getCursorQuery(r)
+	if err != nil {
+		return domain.Page{}, err
+	}
+	page := domain.Page{Limit: limit}
+	if cursor != nil {
+		if cursor.Kind != kind {
+			return domain.Page{}, domain.ErrInvalidCursor
+		}
+		page.After = cursor.After
+	}
+
+	return page, nil
+}
+
+// encodeCursor - Кодирует курсор следующей страницы, для последней страницы возвращает пустую строку
+func encodeCursor(kind string, cursor *domain.Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+	data, err := json.Marshal(cursorPayload{Kind: kind, CreatedAt: cursor.CreatedAt, ID: cursor.ID})
+	if err != nil {
+		log.Error(err)
+
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// encodeAllCursor - Кодирует курсор следующей страницы всех данных пользователя.
+// Курсор на начало вида данных кодируется нулевыми временем создания и идентификатором
+func encodeAllCursor(cursor *domain.AllCursor) string {
+	if cursor == nil {
+		return ""
+	}
+	if cursor.After == nil {
+		return encodeCursor(cursor.Kind, &domain.Cursor{})
+	}
+
+	return encodeCursor(cursor.Kind, cursor.After)
+}
+
+// getIfMatchVersion - Возвращает ожидаемую версию данных из заголовка If-Match.
+// Если заголовок не передан или равен "*", возвращает 0 и версия не проверяется
+func getIfMatchVersion(r *http.Request) (int64, error) {
+	raw := strings.TrimSpace(r.Header.Get(ifMatchHeader))
+	if raw == "" || raw == "*" {
+		return 0, nil
+	}
+	raw = strings.Trim(strings.TrimPrefix(raw, "W/"), `"`)
+	version, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if version <= 0 {
+		return 0, errInvalidVersion
+	}
+
+	return version, nil
+}
+
+// getBinaryMetadata - Возвращает метаданные бинарных данных из заголовка X-Binary-Metadata,
+// заголовок содержит JSON, закодированный в base64. Если заголовок не передан, метаданные пустые
+func getBinaryMetadata(r *http.Request) (domain.BinaryMetadata, error) {
+	var payload binaryMetadataPayload
+	raw := r.Header.Get(binaryMetadataHeader)
+	if raw == "" {
+		return domain.BinaryMetadata{}, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil {
+		return domain.BinaryMetadata{}, err
+	}
+	payload, err = payload.Load(data)
+	if err != nil {
+		return domain.BinaryMetadata{}, err
+	}
+
+	return domain.BinaryMetadata{
+		Name:     payload.Name,
+		MimeType: payload.MimeType,
+		SHA256:   payload.SHA256,
+		Meta:     payload.Meta,
+	}, nil
+}
+
+func setETag(w http.ResponseWriter, version int64) {
+	w.Header().Set(eTagHeader, `"`+strconv.FormatInt(version, 10)+`"`)
+}
+
 func parseBody(contentType string, r *http.Request) ([]byte, error) {
 	if r.Header.Get(contentTypeHeader) != contentType {
 		return []byte{}, errInvalidContentType
@@ -60,3 +234,12 @@ func responseError(w http.ResponseWriter, message string) error {
 
 	return nil
 }
+
+func responseConflict(w http.ResponseWriter, version int64, response any) {
+	w.Header().Set(contentTypeHeader, jsonType)
+	setETag(w, version)
+	err := makeResponse(w, http.StatusConflict, response)
+	if err != nil {
+		log.Error(err)
+	}
+}
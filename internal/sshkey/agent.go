@@ -0,0 +1,129 @@
+package sshkey
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+var (
+	// ErrRefused - Пользователь не подтвердил подпись
+	ErrRefused = errors.New("signature refused by user")
+	// ErrReadOnly - Агент выдает только ключи из хранилища, добавлять и удалять ключи нельзя
+	ErrReadOnly = errors.New("agent serves vault keys only")
+)
+
+// ConfirmFunc - Запрашивает у пользователя подтверждение подписи ключом с комментарием comment
+// и отпечатком fingerprint, возвращает истину, если подпись разрешена
+type ConfirmFunc func(comment, fingerprint string) bool
+
+// Agent - Агент ssh-agent, который хранит расшифрованные ключи только в памяти
+// и перед каждой подписью запрашивает подтверждение пользователя
+type Agent struct {
+	keyring agent.ExtendedAgent
+	confirm ConfirmFunc
+	// mu - Подтверждения запрашиваются по одному, даже если подписи запрошены одновременно
+	mu sync.Mutex
+}
+
+// NewAgent - Возвращает пустой агент с функцией подтверждения подписи confirm
+func NewAgent(confirm ConfirmFunc) *Agent {
+	return &Agent{
+		keyring: agent.NewKeyring().(agent.ExtendedAgent), //nolint: forcetypeassert // keyring реализует ExtendedAgent
+		confirm: confirm,
+	}
+}
+
+// AddKey - Добавляет расшифрованный закрытый ключ из хранилища
+func (a *Agent) AddKey(privateKey any, comment string) error {
+	return a.keyring.Add(agent.AddedKey{PrivateKey: privateKey, Comment: comment})
+}
+
+// List - Возвращает открытые ключи агента
+func (a *Agent) List() ([]*agent.Key, error) {
+	return a.keyring.List()
+}
+
+// Sign - Подписывает данные после подтверждения пользователя
+func (a *Agent) Sign(key ssh.PublicKey, data []byte) (*ssh.Signature, error) {
+	return a.SignWithFlags(key, data, 0)
+}
+
+// SignWithFlags - Подписывает данные с флагами алгоритма после подтверждения пользователя
+func (a *Agent) SignWithFlags(key ssh.PublicKey, data []byte, flags agent.SignatureFlags) (*ssh.Signature, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	keys, err := a.keyring.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		if bytes.Equal(k.Marshal(), key.Marshal()) {
+			if !a.confirm(k.Comment, ssh.FingerprintSHA256(key)) {
+				return nil, ErrRefused
+			}
+
+			break
+		}
+	}
+
+	return a.keyring.SignWithFlags(key, data, flags)
+}
+
+// Add - Не поддерживается, агент выдает только ключи из хранилища
+func (a *Agent) Add(_ agent.AddedKey) error {
+	return ErrReadOnly
+}
+
+// Remove - Не поддерживается, агент выдает только ключи из хранилища
+func (a *Agent) Remove(_ ssh.PublicKey) error {
+	return ErrReadOnly
+}
+
+// RemoveAll - Не поддерживается, агент выдает только ключи из хранилища
+func (a *Agent) RemoveAll() error {
+	return ErrReadOnly
+}
+
+// Lock - Блокирует агент парольной фразой
+func (a *Agent) Lock(passphrase []byte) error {
+	return a.keyring.Lock(passphrase)
+}
+
+// Unlock - Снимает блокировку агента
+func (a *Agent) Unlock(passphrase []byte) error {
+	return a.keyring.Unlock(passphrase)
+}
+
+// Signers - Не поддерживается, подпись в обход подтверждения невозможна
+func (a *Agent) Signers() ([]ssh.Signer, error) {
+	return nil, ErrReadOnly
+}
+
+// Extension - Расширения протокола не поддерживаются
+func (a *Agent) Extension(_ string, _ []byte) ([]byte, error) {
+	return nil, agent.ErrExtensionUnsupported
+}
+
+// Serve - Обслуживает соединения listener по протоколу ssh-agent, пока listener не будет закрыт.
+// Ошибки отдельных соединений, в том числе их закрытие клиентом, не прерывают работу агента
+func (a *Agent) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			_ = agent.ServeAgent(a, conn)
+		}()
+	}
+}
@@ -0,0 +1,108 @@
+// Package sshkey содержит разбор закрытых SSH ключей и агент ssh-agent, который выдает подписи
+// ключами из хранилища только после подтверждения пользователя
+package sshkey
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+var (
+	// ErrInvalidKey - Строка не является закрытым SSH ключом в поддерживаемом формате
+	ErrInvalidKey = errors.New("invalid ssh private key")
+	// ErrPassphraseRequired - Ключ защищен парольной фразой, а открытый ключ без нее не получить
+	ErrPassphraseRequired = errors.New("ssh private key is passphrase protected, please pass the passphrase")
+	// ErrWrongPassphrase - Парольная фраза не подходит к ключу
+	ErrWrongPassphrase = errors.New("wrong ssh private key passphrase")
+)
+
+// Key - Открытая часть закрытого SSH ключа
+type Key struct {
+	// PublicKey - Открытый ключ в формате authorized_keys без комментария
+	PublicKey string
+	// Fingerprint - Отпечаток открытого ключа SHA256 в формате ssh-keygen
+	Fingerprint string
+	// Protected - Закрытый ключ защищен парольной фразой
+	Protected bool
+}
+
+// Parse - Разбирает закрытый ключ в формате PEM и возвращает его открытую часть.
+// Ключ в формате OpenSSH содержит открытый ключ без шифрования, поэтому парольная фраза нужна
+// только для ключей старого формата. Если парольная фраза передана, она проверяется
+func Parse(privateKey, passphrase string) (Key, error) {
+	raw, err := ssh.ParseRawPrivateKey([]byte(privateKey))
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		if passphrase == "" {
+			if missing.PublicKey == nil {
+				return Key{}, ErrPassphraseRequired
+			}
+
+			return newKey(missing.PublicKey, true), nil
+		}
+		raw, err = parseProtected(privateKey, passphrase)
+		if err != nil {
+			return Key{}, err
+		}
+		signer, err := ssh.NewSignerFromKey(raw)
+		if err != nil {
+			return Key{}, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+		}
+
+		return newKey(signer.PublicKey(), true), nil
+	}
+	if err != nil {
+		return Key{}, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+	signer, err := ssh.NewSignerFromKey(raw)
+	if err != nil {
+		return Key{}, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+
+	return newKey(signer.PublicKey(), false), nil
+}
+
+// PrivateKey - Возвращает расшифрованный закрытый ключ для добавления в агент.
+// Для ключа без защиты парольная фраза не используется
+func PrivateKey(privateKey, passphrase string) (any, error) {
+	raw, err := ssh.ParseRawPrivateKey([]byte(privateKey))
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		if passphrase == "" {
+			return nil, ErrPassphraseRequired
+		}
+
+		return parseProtected(privateKey, passphrase)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+
+	return raw, nil
+}
+
+// parseProtected - Расшифровывает закрытый ключ, защищенный парольной фразой
+func parseProtected(privateKey, passphrase string) (any, error) {
+	raw, err := ssh.ParseRawPrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase))
+	if errors.Is(err, x509.IncorrectPasswordError) {
+		return nil, ErrWrongPassphrase
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+
+	return raw, nil
+}
+
+// newKey - Возвращает открытую часть ключа
+func newKey(publicKey ssh.PublicKey, protected bool) Key {
+	return Key{
+		PublicKey:   strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey))),
+		Fingerprint: ssh.FingerprintSHA256(publicKey),
+		Protected:   protected,
+	}
+}
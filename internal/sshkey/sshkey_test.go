@@ -0,0 +1,163 @@
+package sshkey
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+func newPrivateKey(t *testing.T, passphrase string) (string, ssh.PublicKey) {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	var block *pem.Block
+	if passphrase == "" {
+		block, err = ssh.MarshalPrivateKey(private, "test")
+	} else {
+		block, err = ssh.MarshalPrivateKeyWithPassphrase(private, "test", []byte(passphrase))
+	}
+	require.NoError(t, err)
+	publicKey, err := ssh.NewPublicKey(public)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(block)), publicKey
+}
+
+func TestParse(t *testing.T) {
+	privateKey, publicKey := newPrivateKey(t, "")
+
+	key, err := Parse(privateKey, "")
+	require.NoError(t, err)
+	assert.False(t, key.Protected)
+	assert.Equal(t, ssh.FingerprintSHA256(publicKey), key.Fingerprint)
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key.PublicKey))
+	require.NoError(t, err)
+	assert.Equal(t, publicKey.Marshal(), parsed.Marshal())
+}
+
+func TestParseProtected(t *testing.T) {
+	privateKey, publicKey := newPrivateKey(t, "secret")
+
+	// Открытый ключ в формате OpenSSH доступен без парольной фразы
+	key, err := Parse(privateKey, "")
+	require.NoError(t, err)
+	assert.True(t, key.Protected)
+	assert.Equal(t, ssh.FingerprintSHA256(publicKey), key.Fingerprint)
+
+	key, err = Parse(privateKey, "secret")
+	require.NoError(t, err)
+	assert.Equal(t, ssh.FingerprintSHA256(publicKey), key.Fingerprint)
+
+	_, err = Parse(privateKey, "wrong")
+	require.ErrorIs(t, err, ErrWrongPassphrase)
+
+	_, err = PrivateKey(privateKey, "")
+	require.ErrorIs(t, err, ErrPassphraseRequired)
+	raw, err := PrivateKey(privateKey, "secret")
+	require.NoError(t, err)
+	assert.NotNil(t, raw)
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse("not a key", "")
+	require.ErrorIs(t, err, ErrInvalidKey)
+	_, err = PrivateKey("not a key", "")
+	require.ErrorIs(t, err, ErrInvalidKey)
+}
+
+// serve - Подключает клиент ssh-agent к агенту через соединение в памяти
+func serve(t *testing.T, a *Agent) agent.ExtendedAgent {
+	t.Helper()
+	server, client := net.Pipe()
+	go func() {
+		_ = agent.ServeAgent(a, server)
+	}()
+	t.Cleanup(func() {
+		client.Close()
+		server.Close()
+	})
+
+	return agent.NewClient(client)
+}
+
+func TestAgentConfirmsSignature(t *testing.T) {
+	privateKey, publicKey := newPrivateKey(t, "")
+	raw, err := PrivateKey(privateKey, "")
+	require.NoError(t, err)
+
+	var asked []string
+	allow := true
+	a := NewAgent(func(comment, fingerprint string) bool {
+		asked = append(asked, comment+" "+fingerprint)
+
+		return allow
+	})
+	require.NoError(t, a.AddKey(raw, "octocat@github"))
+	client := serve(t, a)
+
+	keys, err := client.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "octocat@github", keys[0].Comment)
+
+	data := []byte("session data")
+	signature, err := client.Sign(publicKey, data)
+	require.NoError(t, err)
+	require.NoError(t, publicKey.Verify(data, signature))
+	assert.Equal(t, []string{"octocat@github " + ssh.FingerprintSHA256(publicKey)}, asked)
+
+	allow = false
+	_, err = client.Sign(publicKey, data)
+	require.Error(t, err)
+	assert.Len(t, asked, 2)
+}
+
+func TestAgentReadOnly(t *testing.T) {
+	privateKey, publicKey := newPrivateKey(t, "")
+	raw, err := PrivateKey(privateKey, "")
+	require.NoError(t, err)
+
+	a := NewAgent(func(_, _ string) bool { return true })
+	client := serve(t, a)
+
+	require.Error(t, client.Add(agent.AddedKey{PrivateKey: raw}))
+	require.Error(t, client.Remove(publicKey))
+	require.Error(t, client.RemoveAll())
+	keys, err := client.List()
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestAgentServe(t *testing.T) {
+	privateKey, publicKey := newPrivateKey(t, "")
+	raw, err := PrivateKey(privateKey, "")
+	require.NoError(t, err)
+
+	a := NewAgent(func(_, _ string) bool { return true })
+	require.NoError(t, a.AddKey(raw, "test"))
+
+	socket := t.TempDir() + "/agent.sock"
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	done := make(chan error)
+	go func() {
+		done <- a.Serve(listener)
+	}()
+
+	conn, err := net.Dial("unix", socket)
+	require.NoError(t, err)
+	signature, err := agent.NewClient(conn).Sign(publicKey, []byte("data"))
+	require.NoError(t, err)
+	require.NoError(t, publicKey.Verify([]byte("data"), signature))
+	conn.Close()
+
+	listener.Close()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,188 @@
+package totp
+
+import (
+	"crypto/sha1" //nolint: gosec // RFC 6238 по умолчанию использует HMAC-SHA1
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Алгоритмы HMAC, которые поддерживают приложения-аутентификаторы
+const (
+	// SHA1 - HMAC-SHA1, алгоритм по умолчанию
+	SHA1 = "SHA1"
+	// SHA256 - HMAC-SHA256
+	SHA256 = "SHA256"
+	// SHA512 - HMAC-SHA512
+	SHA512 = "SHA512"
+)
+
+const (
+	// minDigits - Минимальное количество цифр кода по RFC 4226
+	minDigits = 6
+	// maxDigits - Максимальное количество цифр кода, которое поддерживают приложения-аутентификаторы
+	maxDigits = 8
+	// maxPeriod - Максимальный период действия кода
+	maxPeriod = 10 * time.Minute
+)
+
+var (
+	// ErrInvalidURI - Строка не является otpauth URI для TOTP
+	ErrInvalidURI = errors.New("invalid otpauth URI")
+	// ErrInvalidKey - Параметры генерации кодов вне допустимых значений
+	ErrInvalidKey = errors.New("invalid one-time password parameters")
+)
+
+// hashes - Хеш-функции поддерживаемых алгоритмов
+var hashes = map[string]func() hash.Hash{
+	SHA1:   sha1.New,
+	SHA256: sha256.New,
+	SHA512: sha512.New,
+}
+
+// Key - Параметры генерации кодов TOTP стороннего сервиса
+type Key struct {
+	// Issuer - Наименование сервиса
+	Issuer string
+	// Account - Учетная запись в сервисе
+	Account string
+	// Secret - Секрет в кодировке base32
+	Secret string
+	// Algorithm - Алгоритм HMAC: SHA1, SHA256 или SHA512
+	Algorithm string
+	// Digits - Количество цифр в коде
+	Digits int
+	// Period - Период действия кода
+	Period time.Duration
+}
+
+// NewKey - Возвращает параметры с алгоритмом, количеством цифр и периодом по умолчанию
+func NewKey(issuer, account, secret string) Key {
+	return Key{
+		Issuer:    issuer,
+		Account:   account,
+		Secret:    secret,
+		Algorithm: SHA1,
+		Digits:    Digits,
+		Period:    Period,
+	}
+}
+
+// Validate - Проверяет секрет, алгоритм, количество цифр и период
+func (k Key) Validate() error {
+	if _, ok := hashes[k.Algorithm]; !ok {
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidKey, k.Algorithm)
+	}
+	if k.Digits < minDigits || k.Digits > maxDigits {
+		return fmt.Errorf("%w: digits must be from %d to %d", ErrInvalidKey, minDigits, maxDigits)
+	}
+	if k.Period < time.Second || k.Period > maxPeriod || k.Period%time.Second != 0 {
+		return fmt.Errorf("%w: period must be a whole number of seconds up to %s", ErrInvalidKey, maxPeriod)
+	}
+	key, err := DecodeSecret(k.Secret)
+	if err != nil || len(key) == 0 {
+		return fmt.Errorf("%w: secret must be a non-empty base32 string", ErrInvalidKey)
+	}
+
+	return nil
+}
+
+// counter - Возвращает номер периода для момента времени t
+func (k Key) counter(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(k.Period/time.Second))
+}
+
+// Code - Возвращает код на момент времени t
+func (k Key) Code(t time.Time) (string, error) {
+	if err := k.Validate(); err != nil {
+		return "", err
+	}
+	key, err := DecodeSecret(k.Secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hotp(hashes[k.Algorithm], key, k.counter(t), k.Digits), nil
+}
+
+// Remaining - Возвращает время до смены кода, действующего в момент t
+func (k Key) Remaining(t time.Time) time.Duration {
+	period := int64(k.Period / time.Second)
+	if period <= 0 {
+		return 0
+	}
+
+	return time.Duration(period-t.Unix()%period) * time.Second
+}
+
+// URI - Возвращает otpauth URI для приложений-аутентификаторов
+func (k Key) URI() string {
+	query := url.Values{}
+	query.Set("secret", k.Secret)
+	if k.Issuer != "" {
+		query.Set("issuer", k.Issuer)
+	}
+	query.Set("algorithm", k.Algorithm)
+	query.Set("digits", strconv.Itoa(k.Digits))
+	query.Set("period", strconv.Itoa(int(k.Period/time.Second)))
+	label := k.Account
+	if k.Issuer != "" {
+		label = k.Issuer + ":" + k.Account
+	}
+	uri := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + label,
+		RawQuery: query.Encode(),
+	}
+
+	return uri.String()
+}
+
+// ParseURI - Разбирает otpauth URI формата Key Uri Format, который используют приложения-аутентификаторы.
+// Наименование сервиса берется из параметра issuer или из префикса метки, отсутствующие параметры
+// принимают значения по умолчанию
+func ParseURI(uri string) (Key, error) {
+	parsed, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil || parsed.Scheme != "otpauth" {
+		return Key{}, ErrInvalidURI
+	}
+	if !strings.EqualFold(parsed.Host, "totp") {
+		return Key{}, fmt.Errorf("%w: only totp is supported", ErrInvalidURI)
+	}
+
+	query := parsed.Query()
+	key := NewKey(query.Get("issuer"), strings.TrimPrefix(parsed.Path, "/"), query.Get("secret"))
+	if issuer, account, found := strings.Cut(key.Account, ":"); found {
+		key.Account = strings.TrimSpace(account)
+		if key.Issuer == "" {
+			key.Issuer = issuer
+		}
+	}
+	if v := query.Get("algorithm"); v != "" {
+		key.Algorithm = strings.ToUpper(v)
+	}
+	if v := query.Get("digits"); v != "" {
+		if key.Digits, err = strconv.Atoi(v); err != nil {
+			return Key{}, fmt.Errorf("%w: invalid digits", ErrInvalidURI)
+		}
+	}
+	if v := query.Get("period"); v != "" {
+		period, err := strconv.Atoi(v)
+		if err != nil {
+			return Key{}, fmt.Errorf("%w: invalid period", ErrInvalidURI)
+		}
+		key.Period = time.Duration(period) * time.Second
+	}
+	if err = key.Validate(); err != nil {
+		return Key{}, err
+	}
+
+	return key, nil
+}
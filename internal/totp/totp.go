@@ -0,0 +1,130 @@
+// Package totp содержит имплементацию одноразовых паролей HOTP (RFC 4226) и TOTP (RFC 6238)
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint: gosec // RFC 6238 по умолчанию использует HMAC-SHA1
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits - Количество цифр в коде
+	Digits = 6
+	// Period - Период действия кода
+	Period = 30 * time.Second
+	// Skew - Допустимое расхождение часов клиента и сервера в периодах
+	Skew = 1
+	// secretLength - Длина секрета в байтах, рекомендованная RFC 4226
+	secretLength = 20
+	// truncateMask - Маска динамического усечения HMAC
+	truncateMask = 0x7fffffff
+	// offsetMask - Маска смещения динамического усечения
+	offsetMask = 0x0f
+)
+
+// ErrInvalidCode - Код не совпадает ни с одним допустимым кодом
+var ErrInvalidCode = errors.New("invalid one-time code")
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret - Возвращает новый случайный секрет в кодировке base32 без выравнивания
+func GenerateSecret() (string, error) {
+	raw := make([]byte, secretLength)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(raw), nil
+}
+
+// DecodeSecret - Декодирует секрет из base32, пробелы и регистр игнорируются
+func DecodeSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+
+	return encoding.DecodeString(strings.TrimRight(normalized, "="))
+}
+
+// HOTP - Вычисляет одноразовый пароль для счетчика counter
+func HOTP(key []byte, counter uint64, digits int) string {
+	return hotp(sha1.New, key, counter, digits)
+}
+
+// hotp - Вычисляет одноразовый пароль для счетчика counter с заданной хеш-функцией HMAC
+func hotp(newHash func() hash.Hash, key []byte, counter uint64, digits int) string {
+	message := make([]byte, 8) //nolint: gomnd // счетчик - 8 байт big-endian
+	binary.BigEndian.PutUint64(message, counter)
+	mac := hmac.New(newHash, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & offsetMask
+	value := binary.BigEndian.Uint32(sum[offset:]) & truncateMask
+	modulo := uint32(1)
+	for i := 0; i < digits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, value%modulo)
+}
+
+// Counter - Возвращает номер периода для момента времени t
+func Counter(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(Period/time.Second))
+}
+
+// Code - Возвращает код для секрета в base32 на момент времени t
+func Code(secret string, t time.Time) (string, error) {
+	key, err := DecodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return HOTP(key, Counter(t), Digits), nil
+}
+
+// Validate - Проверяет код с учетом допустимого расхождения часов и возвращает номер периода совпавшего кода.
+// Номер периода используется для защиты от повторного использования кода
+func Validate(secret, code string, t time.Time) (uint64, error) {
+	key, err := DecodeSecret(secret)
+	if err != nil {
+		return 0, err
+	}
+	code = strings.ReplaceAll(code, " ", "")
+	current := Counter(t)
+	for delta := -Skew; delta <= Skew; delta++ {
+		counter := current + uint64(delta)
+		expected := HOTP(key, counter, Digits)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return counter, nil
+		}
+	}
+
+	return 0, ErrInvalidCode
+}
+
+// URI - Возвращает otpauth URI для приложений-аутентификаторов, используется как содержимое QR-кода
+func URI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(int(Period/time.Second)))
+	uri := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}
+
+	return uri.String()
+}
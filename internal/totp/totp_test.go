@@ -0,0 +1,172 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Тестовые векторы RFC 6238, приложение B, для SHA1 и 8 цифр
+func TestHOTPRFC6238(t *testing.T) {
+	key := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "94287082"},
+		{unix: 1111111109, want: "07081804"},
+		{unix: 1111111111, want: "14050471"},
+		{unix: 1234567890, want: "89005924"},
+		{unix: 2000000000, want: "69279037"},
+		{unix: 20000000000, want: "65353130"},
+	}
+	for _, tt := range tests {
+		counter := Counter(time.Unix(tt.unix, 0))
+		assert.Equal(t, tt.want, HOTP(key, counter, 8))
+		assert.Equal(t, tt.want[2:], HOTP(key, counter, Digits))
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	now := time.Unix(1111111109, 0)
+
+	code, err := Code(secret, now)
+	require.NoError(t, err)
+	assert.Equal(t, "081804", code)
+
+	counter, err := Validate(secret, code, now)
+	require.NoError(t, err)
+	assert.Equal(t, Counter(now), counter)
+
+	counter, err = Validate(secret, code, now.Add(Period))
+	require.NoError(t, err)
+	assert.Equal(t, Counter(now), counter)
+
+	_, err = Validate(secret, code, now.Add(3*Period))
+	require.ErrorIs(t, err, ErrInvalidCode)
+
+	_, err = Validate(secret, "000000", now)
+	require.ErrorIs(t, err, ErrInvalidCode)
+
+	_, err = Validate("not base32!", code, now)
+	require.Error(t, err)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	key, err := DecodeSecret(secret)
+	require.NoError(t, err)
+	assert.Len(t, key, secretLength)
+
+	other, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("GophKeeper", "user", "JBSWY3DPEHPK3PXP")
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.Equal(t, "/GophKeeper:user", parsed.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", parsed.Query().Get("secret"))
+	assert.Equal(t, "GophKeeper", parsed.Query().Get("issuer"))
+	assert.Equal(t, "6", parsed.Query().Get("digits"))
+	assert.Equal(t, "30", parsed.Query().Get("period"))
+}
+
+// Тестовые векторы RFC 6238, приложение B, для SHA256 и SHA512
+func TestKeyCodeRFC6238(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		seed      string
+		unix      int64
+		want      string
+	}{
+		{algorithm: SHA1, seed: "12345678901234567890", unix: 59, want: "94287082"},
+		{algorithm: SHA256, seed: "12345678901234567890123456789012", unix: 59, want: "46119246"},
+		{algorithm: SHA256, seed: "12345678901234567890123456789012", unix: 1111111109, want: "68084774"},
+		{
+			algorithm: SHA512,
+			seed:      "1234567890123456789012345678901234567890123456789012345678901234",
+			unix:      59,
+			want:      "90693936",
+		},
+		{
+			algorithm: SHA512,
+			seed:      "1234567890123456789012345678901234567890123456789012345678901234",
+			unix:      2000000000,
+			want:      "38618901",
+		},
+	}
+	for _, tt := range tests {
+		key := NewKey("", "", base32.StdEncoding.EncodeToString([]byte(tt.seed)))
+		key.Algorithm = tt.algorithm
+		key.Digits = 8
+		code, err := key.Code(time.Unix(tt.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, code, tt.algorithm)
+	}
+}
+
+func TestKeyRemaining(t *testing.T) {
+	key := NewKey("", "", "JBSWY3DPEHPK3PXP")
+	assert.Equal(t, 30*time.Second, key.Remaining(time.Unix(60, 0)))
+	assert.Equal(t, time.Second, key.Remaining(time.Unix(89, 0)))
+
+	key.Period = time.Minute
+	assert.Equal(t, 50*time.Second, key.Remaining(time.Unix(70, 0)))
+}
+
+func TestParseURI(t *testing.T) {
+	key, err := ParseURI("otpauth://totp/ACME%20Co:john.doe@email.com?secret=HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ" +
+		"&issuer=ACME%20Co&algorithm=SHA256&digits=8&period=60")
+	require.NoError(t, err)
+	assert.Equal(t, Key{
+		Issuer:    "ACME Co",
+		Account:   "john.doe@email.com",
+		Secret:    "HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ",
+		Algorithm: SHA256,
+		Digits:    8,
+		Period:    time.Minute,
+	}, key)
+
+	// Наименование сервиса из метки и значения по умолчанию
+	key, err = ParseURI("otpauth://totp/GitHub:octocat?secret=JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+	assert.Equal(t, NewKey("GitHub", "octocat", "JBSWY3DPEHPK3PXP"), key)
+
+	parsed, err := ParseURI(key.URI())
+	require.NoError(t, err)
+	assert.Equal(t, key, parsed)
+
+	invalid := []string{
+		"https://example.com",
+		"otpauth://hotp/GitHub:octocat?secret=JBSWY3DPEHPK3PXP&counter=1",
+		"otpauth://totp/GitHub:octocat?secret=JBSWY3DPEHPK3PXP&digits=x",
+		"otpauth://totp/GitHub:octocat?secret=JBSWY3DPEHPK3PXP&period=x",
+	}
+	for _, uri := range invalid {
+		_, err = ParseURI(uri)
+		require.ErrorIs(t, err, ErrInvalidURI, uri)
+	}
+
+	invalid = []string{
+		"otpauth://totp/GitHub:octocat?secret=not-base32!",
+		"otpauth://totp/GitHub:octocat",
+		"otpauth://totp/GitHub:octocat?secret=JBSWY3DPEHPK3PXP&algorithm=MD5",
+		"otpauth://totp/GitHub:octocat?secret=JBSWY3DPEHPK3PXP&digits=4",
+		"otpauth://totp/GitHub:octocat?secret=JBSWY3DPEHPK3PXP&period=0",
+	}
+	for _, uri := range invalid {
+		_, err = ParseURI(uri)
+		require.ErrorIs(t, err, ErrInvalidKey, uri)
+	}
+}
@@ -0,0 +1,291 @@
+// Package vaultfile содержит формат зашифрованного файла резервной копии хранилища.
+// Ключ выводится из парольной фразы с помощью Argon2id, данные шифруются потоково частями по SegmentSize байт
+// AES-256-GCM, поэтому файл любого размера пишется и читается без загрузки в память целиком
+package vaultfile
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/Nickolasll/goph-keeper/internal/crypto"
+)
+
+const (
+	// Version - Текущая версия формата файла
+	Version = 1
+	// SegmentSize - Размер открытого текста одной части
+	SegmentSize = 64 * 1024
+	// saltLength - Длина соли Argon2id
+	saltLength = 16
+	// tagSize - Размер тега аутентификации GCM
+	tagSize = 16
+	// counterSize - Размер счетчика частей в nonce, последний байт nonce - признак последней части
+	counterSize = 11
+)
+
+const (
+	// magic - Сигнатура файла
+	magic = "GKX"
+	// headerSize - Размер заголовка: сигнатура, версия, параметры Argon2id и соль
+	headerSize = len(magic) + 1 + 4 + 4 + 1 + saltLength
+)
+
+var (
+	// ErrInvalidFormat - Файл не является резервной копией хранилища
+	ErrInvalidFormat = errors.New("not a gophkeeper vault file")
+	// ErrUnsupportedVersion - Файл создан более новой версией клиента
+	ErrUnsupportedVersion = errors.New("unsupported vault file version")
+	// ErrWrongPassphrase - Первую часть не удалось расшифровать: неверная парольная фраза или поврежденный файл
+	ErrWrongPassphrase = errors.New("wrong passphrase or corrupted vault file")
+	// ErrCorrupted - Часть файла повреждена, переставлена или файл обрезан
+	ErrCorrupted = errors.New("vault file is corrupted or truncated")
+	// ErrEmptyPassphrase - Парольная фраза не задана
+	ErrEmptyPassphrase = errors.New("passphrase is required")
+)
+
+// header - Заголовок файла, целиком передается в GCM как дополнительные данные каждой части
+type header struct {
+	version uint8
+	params  crypto.KDFParams
+	salt    []byte
+}
+
+func (h header) marshal() []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, headerSize))
+	buf.WriteString(magic)
+	buf.WriteByte(h.version)
+	binary.Write(buf, binary.BigEndian, h.params.Time)   //nolint: errcheck // запись в bytes.Buffer не возвращает ошибок
+	binary.Write(buf, binary.BigEndian, h.params.Memory) //nolint: errcheck
+	buf.WriteByte(h.params.Threads)
+	buf.Write(h.salt)
+
+	return buf.Bytes()
+}
+
+func readHeader(r io.Reader) (header, []byte, error) {
+	raw := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return header{}, nil, ErrInvalidFormat
+		}
+
+		return header{}, nil, err
+	}
+	if string(raw[:len(magic)]) != magic {
+		return header{}, nil, ErrInvalidFormat
+	}
+	rest := raw[len(magic):]
+	h := header{version: rest[0]}
+	if h.version != Version {
+		return header{}, nil, ErrUnsupportedVersion
+	}
+	h.params.Time = binary.BigEndian.Uint32(rest[1:5])
+	h.params.Memory = binary.BigEndian.Uint32(rest[5:9])
+	h.params.Threads = rest[9]
+	h.salt = rest[10:]
+	if err := h.params.Validate(); err != nil {
+		return header{}, nil, err
+	}
+
+	return h, raw, nil
+}
+
+// newAEAD - Выводит ключ из парольной фразы и возвращает AES-256-GCM
+func newAEAD(passphrase string, h header) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(h.params.DeriveKey(passphrase, h.salt))
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// nonce - Nonce части: номер части big-endian и признак последней части.
+// Ключ уникален для каждого файла благодаря случайной соли, поэтому счетчик начинается с нуля
+func nonce(counter uint64, last bool) []byte {
+	n := make([]byte, counterSize+1)
+	binary.BigEndian.PutUint64(n[counterSize-8:counterSize], counter)
+	if last {
+		n[counterSize] = 1
+	}
+
+	return n
+}
+
+// Writer - Шифрует записываемые данные. Close обязателен: он записывает последнюю часть,
+// без которой файл считается обрезанным
+type Writer struct {
+	dest    io.Writer
+	aead    cipher.AEAD
+	header  []byte
+	buf     []byte
+	counter uint64
+	closed  bool
+}
+
+// NewWriter - Записывает заголовок в dest и возвращает Writer, ключ которого выведен из passphrase
+func NewWriter(dest io.Writer, passphrase string) (*Writer, error) {
+	if passphrase == "" {
+		return nil, ErrEmptyPassphrase
+	}
+	h := header{version: Version, params: crypto.DefaultKDFParams, salt: make([]byte, saltLength)}
+	if _, err := rand.Read(h.salt); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(passphrase, h)
+	if err != nil {
+		return nil, err
+	}
+	raw := h.marshal()
+	if _, err = dest.Write(raw); err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		dest:   dest,
+		aead:   aead,
+		header: raw,
+		buf:    make([]byte, 0, SegmentSize),
+	}, nil
+}
+
+func (w *Writer) flush(last bool) error {
+	sealed := w.aead.Seal(nil, nonce(w.counter, last), w.buf, w.header)
+	w.counter++
+	w.buf = w.buf[:0]
+	_, err := w.dest.Write(sealed)
+
+	return err
+}
+
+// Write - Шифрует p. Заполненная часть записывается, только когда за ней следуют данные,
+// чтобы последняя часть всегда была непустой
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, io.ErrClosedPipe
+	}
+	written := 0
+	for len(p) > 0 {
+		if len(w.buf) == SegmentSize {
+			if err := w.flush(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(w.buf[len(w.buf):SegmentSize], p)
+		w.buf = w.buf[:len(w.buf)+n]
+		p = p[n:]
+		written += n
+	}
+
+	return written, nil
+}
+
+// Close - Записывает последнюю часть, dest не закрывается
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	return w.flush(true)
+}
+
+// Reader - Расшифровывает и проверяет данные по частям
+type Reader struct {
+	source  io.Reader
+	aead    cipher.AEAD
+	header  []byte
+	sealed  []byte
+	opened  []byte
+	plain   []byte
+	counter uint64
+	last    bool
+}
+
+// NewReader - Читает заголовок из source, выводит ключ из passphrase и расшифровывает первую часть,
+// поэтому неверная парольная фраза обнаруживается до чтения данных
+func NewReader(source io.Reader, passphrase string) (*Reader, error) {
+	if passphrase == "" {
+		return nil, ErrEmptyPassphrase
+	}
+	h, raw, err := readHeader(source)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(passphrase, h)
+	if err != nil {
+		return nil, err
+	}
+	r := &Reader{
+		source: source,
+		aead:   aead,
+		header: raw,
+		// Лишний байт позволяет понять, что за заполненной частью следуют данные
+		sealed: make([]byte, SegmentSize+tagSize+1),
+		opened: make([]byte, 0, SegmentSize),
+	}
+	if err = r.next(); err != nil {
+		if errors.Is(err, ErrCorrupted) {
+			return nil, ErrWrongPassphrase
+		}
+
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// next - Читает и расшифровывает следующую часть
+func (r *Reader) next() error {
+	// Первый байт части уже прочитан вместе с предыдущей частью
+	carried := 0
+	if r.counter > 0 {
+		carried = 1
+	}
+	n, err := io.ReadFull(r.source, r.sealed[carried:])
+	n += carried
+	switch {
+	case errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF):
+		r.last = true
+	case err != nil:
+		return err
+	default:
+		n--
+	}
+	if n < tagSize {
+		return ErrCorrupted
+	}
+
+	plain, err := r.aead.Open(r.opened[:0], nonce(r.counter, r.last), r.sealed[:n], r.header)
+	if err != nil {
+		return ErrCorrupted
+	}
+	r.plain = plain
+	r.counter++
+	if !r.last {
+		r.sealed[0] = r.sealed[n]
+	}
+
+	return nil
+}
+
+// Read - Возвращает расшифрованные данные. io.EOF возвращается только после проверки последней части
+func (r *Reader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.last {
+			return 0, io.EOF
+		}
+		if err := r.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+
+	return n, nil
+}
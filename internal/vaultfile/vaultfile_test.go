@@ -0,0 +1,125 @@
+package vaultfile
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seal(t *testing.T, plain []byte, passphrase string) []byte {
+	buf := bytes.Buffer{}
+	w, err := NewWriter(&buf, passphrase)
+	require.NoError(t, err)
+	_, err = w.Write(plain)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	sizes := []int{0, 1, SegmentSize - 1, SegmentSize, SegmentSize + 1, 3*SegmentSize + 100}
+	for _, size := range sizes {
+		plain := make([]byte, size)
+		_, err := rand.Read(plain)
+		require.NoError(t, err)
+
+		sealed := seal(t, plain, "correct horse")
+		r, err := NewReader(bytes.NewReader(sealed), "correct horse")
+		require.NoError(t, err, size)
+		got, err := io.ReadAll(r)
+		require.NoError(t, err, size)
+		assert.Equal(t, plain, got, size)
+	}
+}
+
+func TestSmallWrites(t *testing.T) {
+	buf := bytes.Buffer{}
+	w, err := NewWriter(&buf, "passphrase")
+	require.NoError(t, err)
+	want := bytes.Repeat([]byte("0123456789"), SegmentSize/5)
+	for i := 0; i < len(want); i += 7 {
+		_, err = w.Write(want[i:min(i+7, len(want))])
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(&buf, "passphrase")
+	require.NoError(t, err)
+	got, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestWrongPassphrase(t *testing.T) {
+	sealed := seal(t, []byte("secret"), "correct horse")
+	_, err := NewReader(bytes.NewReader(sealed), "wrong horse")
+	assert.ErrorIs(t, err, ErrWrongPassphrase)
+}
+
+func TestEmptyPassphrase(t *testing.T) {
+	_, err := NewWriter(&bytes.Buffer{}, "")
+	assert.ErrorIs(t, err, ErrEmptyPassphrase)
+	_, err = NewReader(&bytes.Buffer{}, "")
+	assert.ErrorIs(t, err, ErrEmptyPassphrase)
+}
+
+func TestInvalidHeader(t *testing.T) {
+	_, err := NewReader(bytes.NewReader([]byte("GK")), "passphrase")
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+
+	sealed := seal(t, []byte("secret"), "passphrase")
+	other := append([]byte("ZIP"), sealed[len(magic):]...)
+	_, err = NewReader(bytes.NewReader(other), "passphrase")
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+
+	future := bytes.Clone(sealed)
+	future[len(magic)] = Version + 1
+	_, err = NewReader(bytes.NewReader(future), "passphrase")
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+
+	// Заголовок аутентифицируется вместе с каждой частью
+	tampered := bytes.Clone(sealed)
+	tampered[len(magic)+1+4+4]++
+	_, err = NewReader(bytes.NewReader(tampered), "passphrase")
+	assert.ErrorIs(t, err, ErrWrongPassphrase)
+}
+
+func TestTruncated(t *testing.T) {
+	plain := make([]byte, 3*SegmentSize)
+	sealed := seal(t, plain, "passphrase")
+
+	// Отбрасываем последнюю часть целиком: предпоследняя часть не помечена как последняя
+	segment := SegmentSize + tagSize
+	truncated := sealed[:headerSize+2*segment]
+	r, err := NewReader(bytes.NewReader(truncated), "passphrase")
+	require.NoError(t, err)
+	_, err = io.ReadAll(r)
+	assert.ErrorIs(t, err, ErrCorrupted)
+
+	r, err = NewReader(bytes.NewReader(sealed[:len(sealed)-1]), "passphrase")
+	require.NoError(t, err)
+	_, err = io.ReadAll(r)
+	assert.ErrorIs(t, err, ErrCorrupted)
+}
+
+func TestReordered(t *testing.T) {
+	plain := make([]byte, 3*SegmentSize)
+	_, err := rand.Read(plain)
+	require.NoError(t, err)
+	sealed := seal(t, plain, "passphrase")
+
+	segment := SegmentSize + tagSize
+	first := sealed[headerSize : headerSize+segment]
+	second := sealed[headerSize+segment : headerSize+2*segment]
+	swapped := append(bytes.Clone(sealed[:headerSize]), second...)
+	swapped = append(swapped, first...)
+	swapped = append(swapped, sealed[headerSize+2*segment:]...)
+
+	_, err = NewReader(bytes.NewReader(swapped), "passphrase")
+	assert.ErrorIs(t, err, ErrWrongPassphrase)
+}
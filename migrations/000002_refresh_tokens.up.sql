@@ -0,0 +1,13 @@
+CREATE TABLE refresh_tokens (
+	id           uuid         NOT NULL PRIMARY KEY
+	, user_id    uuid         NOT NULL
+	, family_id  uuid         NOT NULL
+	, token_hash varchar(64)  NOT NULL UNIQUE
+	, expires_at timestamptz  NOT NULL
+	, revoked    boolean      NOT NULL DEFAULT false
+);
+
+CREATE INDEX refresh_tokens_family_idx on refresh_tokens(family_id);
+
+ALTER TABLE refresh_tokens
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
@@ -0,0 +1,10 @@
+DROP FUNCTION IF EXISTS next_revision CASCADE;
+
+DROP TABLE IF EXISTS tombstones CASCADE;
+
+ALTER TABLE text_data DROP COLUMN IF EXISTS revision, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS revision, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE credentials_data DROP COLUMN IF EXISTS revision, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE bank_card_data DROP COLUMN IF EXISTS revision, DROP COLUMN IF EXISTS updated_at;
+
+DROP SEQUENCE IF EXISTS revision_seq;
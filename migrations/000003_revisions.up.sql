@@ -0,0 +1,69 @@
+CREATE SEQUENCE revision_seq;
+
+ALTER TABLE text_data
+	ADD COLUMN revision     bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, ADD COLUMN updated_at timestamptz NOT NULL DEFAULT now();
+
+CREATE INDEX text_data_revision_idx on text_data(user_id, revision);
+
+ALTER TABLE binary_data
+	ADD COLUMN revision     bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, ADD COLUMN updated_at timestamptz NOT NULL DEFAULT now();
+
+CREATE INDEX binary_data_revision_idx on binary_data(user_id, revision);
+
+ALTER TABLE credentials_data
+	ADD COLUMN revision     bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, ADD COLUMN updated_at timestamptz NOT NULL DEFAULT now();
+
+CREATE INDEX credentials_data_revision_idx on credentials_data(user_id, revision);
+
+ALTER TABLE bank_card_data
+	ADD COLUMN revision     bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, ADD COLUMN updated_at timestamptz NOT NULL DEFAULT now();
+
+CREATE INDEX bank_card_data_revision_idx on bank_card_data(user_id, revision);
+
+CREATE TABLE tombstones (
+	id           uuid        NOT NULL PRIMARY KEY
+	, user_id    uuid        NOT NULL
+	, kind       varchar(20) NOT NULL
+	, revision   bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, deleted_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX tombstones_revision_idx on tombstones(user_id, revision);
+
+ALTER TABLE tombstones
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+-- Ревизия выдается под блокировкой пользователя, которая держится до конца транзакции.
+-- Чтение изменений открывает снимок под этой же блокировкой, поэтому в снимок попадают все ревизии,
+-- выданные до него, а ревизии, выданные после него, больше любой ревизии в снимке
+CREATE FUNCTION next_revision() RETURNS trigger AS $$
+BEGIN
+	PERFORM pg_advisory_xact_lock(hashtextextended('revision:' || NEW.user_id::text, 0));
+	NEW.revision := nextval('revision_seq');
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER text_data_revision
+	BEFORE INSERT OR UPDATE OF revision ON text_data
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
+
+CREATE TRIGGER binary_data_revision
+	BEFORE INSERT OR UPDATE OF revision ON binary_data
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
+
+CREATE TRIGGER credentials_data_revision
+	BEFORE INSERT OR UPDATE OF revision ON credentials_data
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
+
+CREATE TRIGGER bank_card_data_revision
+	BEFORE INSERT OR UPDATE OF revision ON bank_card_data
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
+
+CREATE TRIGGER tombstones_revision
+	BEFORE INSERT OR UPDATE OF revision ON tombstones
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
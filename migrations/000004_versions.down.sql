@@ -0,0 +1,4 @@
+ALTER TABLE text_data DROP COLUMN IF EXISTS version;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS version;
+ALTER TABLE credentials_data DROP COLUMN IF EXISTS version;
+ALTER TABLE bank_card_data DROP COLUMN IF EXISTS version;
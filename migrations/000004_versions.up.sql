@@ -0,0 +1,4 @@
+ALTER TABLE text_data ADD COLUMN version bigint NOT NULL DEFAULT 1;
+ALTER TABLE binary_data ADD COLUMN version bigint NOT NULL DEFAULT 1;
+ALTER TABLE credentials_data ADD COLUMN version bigint NOT NULL DEFAULT 1;
+ALTER TABLE bank_card_data ADD COLUMN version bigint NOT NULL DEFAULT 1;
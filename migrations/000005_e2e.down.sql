@@ -0,0 +1,2 @@
+ALTER TABLE users DROP COLUMN IF EXISTS salt;
+ALTER TABLE users DROP COLUMN IF EXISTS e2e;
@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN e2e boolean NOT NULL DEFAULT false;
+ALTER TABLE users ADD COLUMN salt bytea;
@@ -0,0 +1,8 @@
+CREATE TABLE user_keys (
+	user_id      uuid         NOT NULL PRIMARY KEY
+	, key        bytea        NOT NULL
+	, created_at timestamptz  NOT NULL DEFAULT now()
+);
+
+ALTER TABLE user_keys
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
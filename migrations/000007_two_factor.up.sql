@@ -0,0 +1,24 @@
+CREATE TABLE two_factor (
+	user_id        uuid         NOT NULL PRIMARY KEY
+	, secret       bytea        NOT NULL
+	, enabled      boolean      NOT NULL DEFAULT false
+	, last_counter bigint       NOT NULL DEFAULT 0
+	, challenge_id uuid
+	, attempts     integer      NOT NULL DEFAULT 0
+	, created_at   timestamptz  NOT NULL DEFAULT now()
+);
+
+ALTER TABLE two_factor
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+CREATE TABLE recovery_codes (
+	id           uuid         NOT NULL PRIMARY KEY
+	, user_id    uuid         NOT NULL
+	, code_hash  varchar(64)  NOT NULL
+	, used_at    timestamptz
+);
+
+CREATE INDEX recovery_codes_user_idx on recovery_codes(user_id);
+
+ALTER TABLE recovery_codes
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS binary_chunks CASCADE;
+DROP TABLE IF EXISTS binary_uploads CASCADE;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS chunks;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS size;
@@ -0,0 +1,28 @@
+ALTER TABLE binary_data ADD COLUMN size bigint NOT NULL DEFAULT 0;
+ALTER TABLE binary_data ADD COLUMN chunks bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE binary_uploads (
+	id           uuid         NOT NULL PRIMARY KEY
+	, user_id    uuid         NOT NULL
+	, size       bigint       NOT NULL
+	, chunk_size integer      NOT NULL
+	, created_at timestamptz  NOT NULL DEFAULT now()
+	, updated_at timestamptz  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX binary_uploads_updated_at_idx on binary_uploads(updated_at);
+
+ALTER TABLE binary_uploads
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+CREATE TABLE binary_chunks (
+	id           uuid    NOT NULL PRIMARY KEY
+	, binary_id  uuid    NOT NULL
+	, user_id    uuid    NOT NULL
+	, idx        bigint  NOT NULL
+	, content    bytea   NOT NULL
+	, UNIQUE (binary_id, idx)
+);
+
+ALTER TABLE binary_chunks
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
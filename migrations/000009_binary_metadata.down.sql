@@ -0,0 +1,4 @@
+ALTER TABLE binary_data DROP COLUMN IF EXISTS meta;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS sha256;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS mime_type;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS name;
@@ -0,0 +1,19 @@
+ALTER TABLE binary_data ADD COLUMN name bytea NOT NULL DEFAULT ''::bytea;
+ALTER TABLE binary_data ADD COLUMN mime_type bytea NOT NULL DEFAULT ''::bytea;
+ALTER TABLE binary_data ADD COLUMN sha256 bytea NOT NULL DEFAULT ''::bytea;
+ALTER TABLE binary_data ADD COLUMN meta bytea NOT NULL DEFAULT ''::bytea;
+
+-- Размер данных, сохраненных одним запросом, восстанавливается по длине шифротекста.
+-- Данные пользователей со сквозным шифрованием хранятся как есть, их размер пересчитывает клиент
+UPDATE binary_data
+SET size = CASE
+	WHEN users.e2e THEN octet_length(binary_data.content)
+	WHEN substring(binary_data.content FROM 1 FOR 3) = 'GK1'::bytea THEN octet_length(binary_data.content) - 7 - 28
+	ELSE octet_length(binary_data.content) - 28
+END
+FROM users
+WHERE
+	users.id = binary_data.user_id
+	AND binary_data.chunks = 0
+	AND octet_length(binary_data.content) >= 28
+;
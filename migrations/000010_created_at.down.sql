@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS text_data_created_at_idx;
+DROP INDEX IF EXISTS binary_data_created_at_idx;
+DROP INDEX IF EXISTS credentials_data_created_at_idx;
+DROP INDEX IF EXISTS bank_card_data_created_at_idx;
+
+ALTER TABLE text_data DROP COLUMN IF EXISTS created_at;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS created_at;
+ALTER TABLE credentials_data DROP COLUMN IF EXISTS created_at;
+ALTER TABLE bank_card_data DROP COLUMN IF EXISTS created_at;
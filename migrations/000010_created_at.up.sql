@@ -0,0 +1,15 @@
+ALTER TABLE text_data ADD COLUMN created_at timestamptz NOT NULL DEFAULT now();
+ALTER TABLE binary_data ADD COLUMN created_at timestamptz NOT NULL DEFAULT now();
+ALTER TABLE credentials_data ADD COLUMN created_at timestamptz NOT NULL DEFAULT now();
+ALTER TABLE bank_card_data ADD COLUMN created_at timestamptz NOT NULL DEFAULT now();
+
+-- Время создания существующих данных неизвестно, ближайшая оценка - время последнего изменения
+UPDATE text_data SET created_at = updated_at;
+UPDATE binary_data SET created_at = updated_at;
+UPDATE credentials_data SET created_at = updated_at;
+UPDATE bank_card_data SET created_at = updated_at;
+
+CREATE INDEX text_data_created_at_idx on text_data(user_id, created_at, id);
+CREATE INDEX binary_data_created_at_idx on binary_data(user_id, created_at, id);
+CREATE INDEX credentials_data_created_at_idx on credentials_data(user_id, created_at, id);
+CREATE INDEX bank_card_data_created_at_idx on bank_card_data(user_id, created_at, id);
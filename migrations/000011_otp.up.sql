@@ -0,0 +1,25 @@
+CREATE TABLE otp_data (
+	id           uuid        NOT NULL PRIMARY KEY
+	, user_id    uuid        NOT NULL
+	, issuer     bytea       NOT NULL
+	, account    bytea       NOT NULL
+	, secret     bytea       NOT NULL
+	, algorithm  bytea       NOT NULL
+	, digits     bytea       NOT NULL
+	, period     bytea       NOT NULL
+	, meta       bytea       NOT NULL
+	, version    bigint      NOT NULL DEFAULT 1
+	, revision   bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, updated_at timestamptz NOT NULL DEFAULT now()
+	, created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX otp_data_revision_idx on otp_data(user_id, revision);
+CREATE INDEX otp_data_created_at_idx on otp_data(user_id, created_at, id);
+
+ALTER TABLE otp_data
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+CREATE TRIGGER otp_data_revision
+	BEFORE INSERT OR UPDATE OF revision ON otp_data
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS ssh_key_data CASCADE;
@@ -0,0 +1,23 @@
+CREATE TABLE ssh_key_data (
+	id            uuid        NOT NULL PRIMARY KEY
+	, user_id     uuid        NOT NULL
+	, private_key bytea       NOT NULL
+	, public_key  bytea       NOT NULL
+	, comment     bytea       NOT NULL
+	, fingerprint bytea       NOT NULL
+	, meta        bytea       NOT NULL
+	, version     bigint      NOT NULL DEFAULT 1
+	, revision    bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, updated_at  timestamptz NOT NULL DEFAULT now()
+	, created_at  timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX ssh_key_data_revision_idx on ssh_key_data(user_id, revision);
+CREATE INDEX ssh_key_data_created_at_idx on ssh_key_data(user_id, created_at, id);
+
+ALTER TABLE ssh_key_data
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+CREATE TRIGGER ssh_key_data_revision
+	BEFORE INSERT OR UPDATE OF revision ON ssh_key_data
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS item_templates CASCADE;
+DROP TABLE IF EXISTS item_data CASCADE;
@@ -0,0 +1,42 @@
+CREATE TABLE item_data (
+	id           uuid        NOT NULL PRIMARY KEY
+	, user_id    uuid        NOT NULL
+	, name       bytea       NOT NULL
+	, template   bytea       NOT NULL
+	, fields     bytea       NOT NULL
+	, meta       bytea       NOT NULL
+	, version    bigint      NOT NULL DEFAULT 1
+	, revision   bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, updated_at timestamptz NOT NULL DEFAULT now()
+	, created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX item_data_revision_idx on item_data(user_id, revision);
+CREATE INDEX item_data_created_at_idx on item_data(user_id, created_at, id);
+
+ALTER TABLE item_data
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+CREATE TRIGGER item_data_revision
+	BEFORE INSERT OR UPDATE OF revision ON item_data
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
+
+CREATE TABLE item_templates (
+	id            uuid        NOT NULL PRIMARY KEY
+	, user_id     uuid        NOT NULL
+	, name        bytea       NOT NULL
+	, description bytea       NOT NULL
+	, fields      bytea       NOT NULL
+	, revision    bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, updated_at  timestamptz NOT NULL DEFAULT now()
+	, created_at  timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX item_templates_revision_idx on item_templates(user_id, revision);
+
+ALTER TABLE item_templates
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+CREATE TRIGGER item_templates_revision
+	BEFORE INSERT OR UPDATE OF revision ON item_templates
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS label_tags CASCADE;
+DROP TABLE IF EXISTS labels CASCADE;
+DROP TABLE IF EXISTS tags CASCADE;
+DROP TABLE IF EXISTS folders CASCADE;
@@ -0,0 +1,64 @@
+CREATE TABLE folders (
+	id           uuid        NOT NULL PRIMARY KEY
+	, user_id    uuid        NOT NULL
+	, parent_id  uuid        NOT NULL
+	, name       bytea       NOT NULL
+	, revision   bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, updated_at timestamptz NOT NULL DEFAULT now()
+	, created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX folders_revision_idx on folders(user_id, revision);
+CREATE INDEX folders_parent_idx on folders(user_id, parent_id);
+
+ALTER TABLE folders
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+CREATE TABLE tags (
+	id           uuid        NOT NULL PRIMARY KEY
+	, user_id    uuid        NOT NULL
+	, name       bytea       NOT NULL
+	, revision   bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, updated_at timestamptz NOT NULL DEFAULT now()
+	, created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX tags_revision_idx on tags(user_id, revision);
+
+ALTER TABLE tags
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+CREATE TABLE labels (
+	entity_id    uuid        NOT NULL PRIMARY KEY
+	, user_id    uuid        NOT NULL
+	, kind       varchar(20) NOT NULL
+	, folder_id  uuid        NOT NULL
+	, revision   bigint      NOT NULL DEFAULT nextval('revision_seq')
+	, updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX labels_revision_idx on labels(user_id, revision);
+CREATE INDEX labels_folder_idx on labels(user_id, folder_id);
+
+ALTER TABLE labels
+	ADD FOREIGN KEY (user_id) REFERENCES users(id);
+
+CREATE TRIGGER folders_revision
+	BEFORE INSERT OR UPDATE OF revision ON folders
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
+
+CREATE TRIGGER tags_revision
+	BEFORE INSERT OR UPDATE OF revision ON tags
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
+
+CREATE TRIGGER labels_revision
+	BEFORE INSERT OR UPDATE OF revision ON labels
+	FOR EACH ROW EXECUTE FUNCTION next_revision();
+
+CREATE TABLE label_tags (
+	entity_id uuid NOT NULL REFERENCES labels(entity_id) ON DELETE CASCADE
+	, tag_id  uuid NOT NULL REFERENCES tags(id) ON DELETE CASCADE
+	, PRIMARY KEY (entity_id, tag_id)
+);
+
+CREATE INDEX label_tags_tag_idx on label_tags(tag_id);